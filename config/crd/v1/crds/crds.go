@@ -29,17 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccXK\x8f۶\x13\xbf\xfbS\f\xf6\x7f\xfd\xcbnP\xb4(|K\xdc\x06\b\x9a\x04\v{\x91;-\x8dlf)\x92%\x87N\xdd\xc7w/\x86\x94lY\xa2\xad\xf5\x1e\x8a\xf2&r\u07bfy\xd9EQ̄\x95_\xd0yi\xf4\x12\x84\x95\xf8;\xa1\xe6/?\x7f\xfe\xc9ϥY\x1c\xde̞\xa5\xae\x96\xb0\n\x9eL\xb3Fo\x82+\xf1g\xac\xa5\x96$\x8d\x9e5H\xa2\x12$\x963\x00\xa1\xb5!\xc1מ?\x01J\xa3\xc9\x19\xa5\xd0\x15;\xd4\xf3\xe7\xb0\xc5m\x90\xaaB\x17\x85w\xaa\x0f\xdf\xcd\xdf\xfc8\xffa\x06\xa0E\x83K؊\xf29X\x87\xd6xI\xc6I\xf4\xf3\x03*tf.\xcd\xcc[,Y\xfaΙ`\x97p~Hܭ\xe6d\xf5\xbb(h\xdd\t:\xc6'%=\xfd\x9a}\xfe(=E\x12\xab\x82\x13*gH|\xf6R\xef\x82\x12nD\xc0\n|i,.\xe13\xdbbE\x89\xd5\f\xa0\xf54\xdaV\x80\xa8\xaa\x18;\xa1\x1e\x9dԄneTh\xba\x98\x15\xf0\xd5\x1b\xfd(h\xbf\x84y\x17\xddy\xe90\x06\xf6I6\xe8I46\xd2v\x01{\xbb\xc3\xf6\x9b\x8e\xac\xbc\x12\x84ca\x1c\xb9\xf9\xd9֧\xa3\xc5\v)\xe7@@\xef-I\xf4\xe4\xa4\xde\xcd\xceć7)\x14\xe5\x1e\x1b\xb1li\x8dE\xfd\xf6\xf1×\xef7\x17\xd7\x00\xd6\x19\x8b\x8ed\aO:\xbd\xf4\xeb\xdd\x02T\xe8K'-\xc5\xe4\xf8\xab\xb8x\x03`\x05\x89\v*\xceC\xf4@{\xecb\x8cUk\x13\x98\x1ah/=8\xb4\x0e=ꔙ|-4\x98\xedW,i>\x10\xbdA\xc7b\xc0\xefMP\x15\xa7\xef\x01\x1d\x81\xc3\xd2\xec\xb4\xfc\xe3$\xdb\x03\x99\xa8T\tBO\x10Q\xd4B\xc1A\xa8\x80\xff\a\xa1\xab\x81\xe4F\x1c\xc1!넠{\xf2\"\x83\x1f\xda\xf1\xc98\x04\xa9k\xb3\x84=\x91\xf5\xcb\xc5b'\xa9+\xca\xd24MВ\x8e\x8bX_r\x1b\xc88\xbf\xa8\xf0\x80j\xe1\xe5\xae\x10\xae\xdcK\u0092\x82Å\xb0\xb2\x88\x8e\xe8X\x98\xf3\xa6\xfa\x9fk\xcb\xd8_\xa8\x1d\x01\x9dN\xac\xa4;\xe0\xe1\xd2\x02\xe9A\xb4\xa2\x92\x8bg\x14\xf8\x8aC\xb7\xfee\xf3\x04\x9d%\t\xa9\x04ʙt\x14\x97\x0e\x1f\x8e\xa6\xd45\xba\xc4W;\xd3D\x99\xa8+k\xa4\xa6\xf8Q*\x89\x9a\xc0\x87m#\x89\xd3ව\x9e\x18\xba\xa1\xd8Ul\\\xb0E\b\x96K\xa7\x1a\x12|а\x12\r\xaa\x95\xf0\xf8/cŨ\xf8\x82Ax\x11Z\xfdv<$N\xe1\xed=t\xad\xf4\n\xb4\xc3\xf6\xb8\xb1X2\xb2\x1c\\f\x95\xb5,SM\xd5Ɓ\x18\xd1_F*\xdf\x02\xf8\xa4&\xba!\xe3\xc4\x0e?\x9a$sH4\x95v|\xde\xe5\x04u\x16s\xdbJ=\x01\xf3\x84\x19\x81\xb4\x17\xd4k\x06$\xa4>\xf5\x94\xac\x937\x90\x89\xe8\b\xee\x14Z\xe8\x12\xdf\xc7|\xd4\xe5q\xc2\xd1O\x19\x16vio\xbe\x81\xa9\tu_hkkƓ-\x82\v\xfa.c\xcf>\xae\x8c\xae\xe5nlh\x7f\x90]\x03wB\xc9\xc0\xdb\xf5@'{\xca\xc9u\xb6\xa5\xe82\x8f\x01\xa9\xe5.\xb8k\xe0\xd5\x12U5j!\x00:(%\xb6\n\x97@.\xe0\x95\x88\x8cj\xe52\"<\x1f'\x80[_\x10\x83\xd4\x15WK;\xacXI\x97\x8c\x9c\xfe\xa8+p\x97kJ\xff\xa0\x0e\xcdX]\x01\xcf\xc6J\x91\xb9w\xe8I\x96\x99\x87\x87\x87\xfb2\x80\xc5|\xa8\xb8\x1d\xd5\x12\xddkjr=\x90ѕc\x1d\x94j\x15\x14\xa5i\xac \xb9U\xd8\xcd\f\xc6\\&\x9ec.i`T\x86\xf0\x14'\x01c\xce*\x8cVG\b\x1e+\xf8\xb6G=\x02\xc3\xc3C\xd2\xfdpWI\x1cxQ\xc3\xd3j\xf7\x9ax|\xb9\x14\xd1\xefN\xe9\":\x96ZbϿ\xae\xfd\xf8\x8cHk\xaaֲ\x96/\xd6\xcc\x1d\x8eq_\x91\x0e\ac\xbe\xc87\xe6\x01M\xae\xa5\rH\x06Q{\xd1d\"A\xc1\xdf3\x9b\"C\x17\xcd28\x17g\x7f\xba\xe5\x95\xef\xd5\xd3I\tO\xbd&\xcc\v\xf8\x04\xee\x1f\xc7\x1c\x9da,\f\x88/\x18\xda~\xf02\xb8\xfaP\x96\x88\xd5x\x1d\x01Ʒ\x11\x94\x16\xfd\x82彮\xcb\xe5\x87\x14z/vSN~JTi\xd3kY@lM\xa0+\b\xd0>\xe7\xe3mT&,\xb5{\xe1\xa7\xec|d\x9a\\^\f\x96\x81[&\\k\xbf\x9f\xf1[\xe6v\x8d\xa2\x1a\xb7\xf0\x02>\x1b\xca?\xdd\xec\xc0%\xea~2M\x0e\x9d\x01={~\x81A+r\x94\x7fc\xaf%a\x93\x1d\xe7\xd7k%\x1dn\xe7\n\tO\xbfU\xf3d\x03\xd3WC\xae\x13h\xe9\x81W\xb9X9Ws\xa9\vٔc\xe9L\x97P:\x13\x85\x94\xce\xcd\r\an\x15U&\x12\xf7\x96\xd6\xd5P$\xb8_\x16\x8eI\x0f\x1c\xfa\xa0\xe8E\x0e\xac#i\x87_b<\xa7\xdf\xcb\xec\xc9\xd7\\:\x05l\xba\xd6x\x95⽐\xea\xea\U000e4cde\x84\xa3\xfb\xf2ws\xc1r\xfa\x9dķ\xfd\xbc\xfdO\xe6獝\xb7{\x14Ή\xe3\xf4\xe8\x1e]z\xfe\xc9^\xf5\x8c\xf3i\x9d\xe8߄\xed\xe9\x1f\x89%\xfc\xf9\xf7\xec\x9f\x00\x00\x00\xff\xff\x18\xd9g\x90\x9b\x14\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}_s\x1c)\x92\xf8\xbb?\x05\xa1\xdf\xc3\xecntK\xeb\xf8\xdd]\\\xe8\xcd+\xdb;\x1d3c+,\x8d\xf6\x99\xae\xca\xeefDA-P-\xf7\xde\xddw\xbf \x81\xfa\xd3EUQ\xad\x96ƻg^luAB\xfe!3I\x12X.\x97oh\xc9\x1e@i&\xc55\xa1%\x83\xaf\x06\x84\xfdK_>\xfe\xa7\xbed\xf2j\xff\xf6\xcd#\x13\xf95\xb9\xa9\xb4\x91\xc5\x17вR\x19\xbc\x87\r\x13\xcc0)\xde\x14`hN\r\xbd~C\b\x15B\x1aj\x7f\xd6\xf6OB2)\x8c\x92\x9c\x83ZnA\\>VkXW\x8c\xe7\xa0\x10x\xe8z\xff\xe7˷\xffq\xf9\xefo\b\x11\xb4\x80k\xb2\xa6\xd9cU\xea\xcb=pP\xf2\x92\xc97\xba\x84̂\xdc*Y\x95פ\xf9\xe0\x9a\xf8\xee\xdcP\xff\x82\xad\xf1\aδ\xf9\xa9\xf5\xe3\xcfL\x1b\xfcP\xf2JQ^\xf7\x84\xbfi&\xb6\x15\xa7*\xfc\xfa\x86\x10\x9d\xc9\x12\xae\xc9'\xdbEI3\xc8\xdf\x10\xe2G\x8d].\xfd\x80\xf7o\x1d\x84l\a\x05uc!D\x96 \xdeݮ\x1e\xfe\xff]\xe7gBrЙb\xa5A\xdc\xff{Y\xffN\xfc(\tӄ\x92\ađ(Orbv\xd4\x10\x05\xa5\x02\r\xc2hbv@2Z\x9aJ\x01\x91\x1b\xf2S\xb5\x06%\xc0\x80n\xc1\xcbx\xa5\r(\xa2\r5@\xa8!\x94\x94\x92\tC\x98 \x86\x15@\xfe\xf0\xeevE\xe4\xfa7Ȍ&T\xe4\x84j-3F\r\xe4d/yU\x80k\xfb\xc7\xcb\x1aj\xa9d\tʰ@tWZ\x92\xd4\xfau\fW[,y\\+\x92[\x91\x02\x87\x96'1䞢\x16?\xb3c\xbaA\x1f\x85\xcc\xfeL\x85\x1f\xfe\xe5\x11\xe8;P\x16\f\xd1;Y\xf1\xdcJ\xe2\x1e\x94%`&\xb7\x82\xfd\xa3\x86\xad\x89\x91\xd8)\xa7\x06\xb4\xa5\x8c\x01%('{\xca+XX\xa2\x1cA.\xe8\x81(\xb0}\x92J\xb4\xe0a\x03}<\x8e_\xa4\x02\xc2\xc4F^\x93\x9d1\xa5\xbe\xbe\xba\xda2\x13\xe6W&\x8b\xa2\x12\xcc\x1c\xaep\xaa\xb0ue\xa4\xd2W9\xec\x81_i\xb6]R\x95혁̲\xf9\x8a\x96l\x89\x88\b\x9cc\x97E\xfe\xff\x82x\xe8N\xb7\xe6`\xc5V\x1b\xc5Ķ\xf5\x01\xe7\xc7\f\xf6ة\xe3\x84сr(6\\\xb0?Y\xd2}\xf9pw\xdf\x16T\xa6=SZ\xf2:\xc4\x1fKM&6\xa0\\\xbb\x8d\x92\x05\xc2\x04\x91;QE9\xe7\f\x84!\xbaZ\x17\xccX1\xf8{\x05\xda\xce\x01y\f\xf6\x06u\x10Y\x03\xa9\xca܊\xf1q\x85\x95 7\xb4\x00~C5\xbc2\xaf,W\xf4\xd22!\x89[m\xcdz\\ّ\xb7\xf5!(\xc8\x01\xd6:\xc5rWB֙h\xb6\x15۰\xccM\xa7\x8dT\x8d\xdeq:\xb0K\xa1\xf8Է%\xd3\xecN\xd0R魯g\x05\xc8\xca\x1cט\x925d\xde\xdd\xea\bJ\x18\xa1\x1f/\xea\xacJCn'\xed\x13e\x06\xc7|s\xb7\"\x0f\xa8\xacBkTZ\x95&\xa6R\xc2JI\xa4\xaf/@\xf3ý\xfcU\x03\xc9+\x14\xeeL\x01\xd2aAְ\xb1\x92\xa0\xc0\xb6\xb7\x9f@)K\x1b\x8d\x03\x90UO\xd9\xd8r\xbf\x03K[Zq\xe3\xe7\t\xd3\xe4\xed\x9fI\xc1Dez\xa26\xc8u\xa4\x145\xb4\x90{P\xa7\x10\xf1=5\xf4\x17\xdb\xf8\x88v\x16(A\xa8\x96xkO\xc7\xf5\x01?Ƹ\xed\xcajӂ\xc84\xb9\xb8 R\x91\vg\x81/\x16\xaeuŸY2\xd1\xee\xe3\x89q\x1ez\x99\x87\xbc\xa3\xa1c\xa8\xbe\x97\x1f\xb5\x13ޓh1\x00\xabE\x9a\xa7\x1d\x98\x1d(R\xca\xda\xe2m\x18\a\xa2\x0f\xda@\xe1\t\x13\xac\x88\xc7'\xd2\x13\xce\x1d\xce=\bm\xe9\xea\x11\xe9#/*\xce\xe9\x9a\xc351\xaa\x82\x01ڬ\xa5\xe4@\xc5\x04q\xbe\x806,;\ai\x1c\xa4\ba\x94\xffС\x00\x1aM\xfa\b\x84F@{\x9aY\xeb\xccy\x8b\xb0]\xaaD\xc7T*Ȭ־\xf6ր\x01G\v$$\xe1RlA\xb9ޭ\xa7\x12\x04L\x81\x15\xb8\x9cXE\xab\x80[kB6\x95\xd5\xc1\x97\xc4\xce\xeeA\x19`B\x1b\xa0\x11\xe1|\x06\x7f\xe0kƫ\x1c\xf2\x1b\xe7x\xddY\xff1\x0f^sOk\xa6\xf0\xe9\xc3(Do\x9d9\xcb\xd0\t\xf4\xfe\xde\x12\xfd֘\x986F\xfaP\x82s\x9d-+\xfd\xb0\x1b\xeb;\xaa\x0f4\x18\xdb\xe8\xe2O\x17\v\xe4p\xb7\xd7n\x1f\x9aP\x055Y\x92\xf5&\x14\xa59\xf4k3\x03E\x84\x8a\xa3\xfa$\x91\x9fT)z\x18\xe0f\xed\xff\x9f\x91\x9fC0\x8f8*B\xb5W\xe6\xe9q\xbf\xff\xca\\=\x0f\x1f5\xaev)\x13\x96\x7fv\xe1\xd9a\x9fv\xeb7K6!M\x04\x1e\x13\x0e\x1e.\xcdF\xb8\xf5;\x11\xeb,2?$\xe4\xb5ly\xe1\xfd\xa7\xa4\xd4N\xca\xc7)\xea\xfch\xeb4\x8b\"\x92aT\x85\xacaG\xf7L*\x8fzcj\xe1+d\x95\x89\xcezjH\xce6\x1bP\x16N\xb9\xa3\x1a\xb4[&\x0f\x13d\xd8}'-5\x12\xfdx\x84G\xc3H\xcb&\xc4|h\xe8֏8\xb6\x92\xa1\u0601Z\xf7\x1a\x8dq\xce\xf6,\xaf(G\xbbLE\xe6\xf0\xa1\xf5\xb8bZf\x84ɽ1G%\xd3\x15\xe7\x10\x04\xa4,\x93:+%)\xc0\xfa\xbc\x85]\x13\xf4\xab\x0ec\xbe\xa6\xd6W\x91C\xd8\x13d\x96\xaa8h\xdfU\x8end\xa33\x16\rS0\x10A8]\x03'\x1a8dF\xaa8E\xa6\xf8\xecJ\x8a\x12\x1c dD\xf3uW\x1a\r\x02# \t.\xe1v,\xdb9W\xcf\n\x11\xc2!\xb9\x04\xeb\xf0\x19B˒G\xccESF\x99\xef;\x19\x9b\xebM\x99\x98\xf5\xc7\xf0b\xf3\xbf)\t:\xb3)Q\xd26\xf3\xabK\xd9Z\x1c\xe2kڦ\xfck\x126h\xfe\x13\x84vd\xf6\x13\x8c\n%\xcb\xf4\xa0\xdcZ\xaa2З֝BOgA\x98\t\xbfN̈́\x8e\xcf\xd5\v\x96u\x88\xf0m\xf3f\xbe\xd0'\xb2&eN\xbc\x10c\xea.\xfe\t\xf9\x82&\xe3\xce[\x8cd\x9e\xfc\xdcn\xb5 lS\x13=_\x90\r\xe3\x06\xd4\x11\xf5OR\xf5\x813\xe7 F\x8a\xd5#\x18\xbe7\xd9\xee\xc3W\xeb\x82\xe9f\xa7*\x91.Ǎ\x9d#\x1b\xbc\xfd\xaey\x9e\x80K0\x8c\xcd\x14\x14\x18\x1e\xc7\x15S\xfb\x17t\xad\xde}z\x1f__\xb5K\x82\xe4\xf5\x10\x99\x98t\xae\xbc;¨=>\xef\u0087/\xe8\x03\xd5\v \xb7\x15\xb2 \x94<\xc2\xc1\xb9.T\x10\xcb\x1f\x1a*'t\xaf\x00\xf7dP\xce\x1e\xe1\x80`\xe2\x9b,\xfd\x92*\r\xae<\xc2!\xa5\xda\x11\r혘\xf6\x9bG\x96N\xf6\a$\x04\xc6\xd6S\xc5\xc0\x15?\x15\"[\x1a\xf1\x92\xa8KB\t\xb4?\x01\xcd$Qi\xf7\xd1ޥD\t\xf8A;^\xda\x19\xb3c%\xaaU\x8c8\xc8M2C]y\xa0\x9c\xe5uGn\x8e\xacĂ|\x92\xc6\xfe\xf3\xe1+\xd3~#\xf3\xbd\x04\xfdI\x1a\xfc\xe5E(\xea\x06\xfe\x92\xf4t=\xe0D\x13N\xcb[\x82\xb5\xb7\xe2\x9cM\xb3\xd2VӞi\xb2\x12v\xb9\xe2H\x92\xd8\x15\ueeba\xee\\GE\xa5q\x17MH\xb1ta\x9bXO\x9e\xdeRu\xc8\xfd\xecN}\x87\xf7\xd6X\xb8/n\xef\x97\xd3\f\xf2\xb0]\x83\x9b\x92\xd4\xc0\x96e\x89\xfd\x15\xa0\xb6@J\xab\xc2\xd3$\"Q\xb1zl\xe6\x89O\x9a\xf5n\x97\xaf\xcb\xc7z\x8f\x7fiM\xce\xd2C0\xb2H\xa0\x81\xd7\xdd\xf94>K;g\x13j\x05I\x98\xac:\xb0g9\\5\x85(\xcf \aZqtq&\xb9K\xf3\x1c\xf3\\(\xbf\x9daQf\xc8\xc2\\\xd5\xd0\x1a\xbb3\xc1\x05ŭ\x96\xff\xb2\x96\x16g\xd3\xff\x90\x922\xa5/\xc9;Li\xe1\xd0\xf9\xe6\x83f-0\t]bJ\x8a\x95\x9f=\xe5\xd6\xf6[\x05.\bp\xe7\t\xc8M\xcf/Z\x90\xa7\x9d\xd4\xcelכ8\x17\x8fpp;\x86\x93]\xb6\x95\xcc\xc5J\\8\x1f\xa2\xa70j\x87C\n~ \x17\xf8\xed\xe29\xaeT\xa2\xa4&V\xeb\x88hA\xcb4\tŔ\xa2TG\xdd.X\x83\x13b\x1b֩2\xd6\xc9\x1e\xc36IDK\xa9#\x1b\xf9\x03C\x99\x10\xde[\xa9\x8d\x8b\x97u|\xe6h@M\x86 \x1a\xa1\x1b\x97\xbf$UH6\xb1Jy*\xf4\xdb.\xf7;\xd0\xe0\xf7+|`\xce\x01\xb5+\xbb\x8bf~;m\x7f\xe1\xf6K\xb0\x13\x9a\xa1ǂmK%3\xd0ѽ\xec\xa6$؋HVF\x1b\xf7:\xe6H\xdd*ɥd\x8c\x87@CIwy-!f\xae\x17>|m\x05D\xedܷ\x7fO\xc9\xd8\xdcq\x11L\x19,\nz\x9c\xa6\x944\xc4\x1b\xd72\xcc\x06\x0f\xc8->ԶBM\x90j\xcbk\x01\xfc\x16\x1c\x85\x82\x89\x15v@\u07be\x80c\xe1uh,\xd9$VNseoB'\rw\xea\x1f\xdcT.%n\x15(\xe80\xaf\x1fUG?TH\xd3\nH\xccp7K\x99\xff\xa0Ɇ)m\xdaC\xd0\x03i*Q03\x17^\xe2\x83R'\xad\xbb>\xbb\x96\xadp\xd7N>\x85\xf4,G\x98D\xccq\x7f\t\b\xdb\x10f\b\x88LV\x02\x038v\x1ec\x17\x8e\xb8Nò\xd4I\x926\xfbm\x01Q\x15i\x04X\xa2\xa401\x1a\xe9iW\xffH\x19\x7f\t\xb6\x99\xa1,\xb6X9mN\x84\x14\xb7vB^A\xbf\xb2\xa2*\b-,\x8fИ\xb3\x02\xbaLo\x12\xdfl\v4\x13F\xda\x19Sr0\xe0\x93\xd7\x12ǐI\xa1Y\x0e\xb5q\xf5\x82 \x05\xa1dC\x19\xafT\xa2\x06\x9cE\xde9K\x11\xaf\tη\xc6H\xeb|\x89\xa4H\x88\xe6&\xfa\x8a\xe3ڸT\xe9\x1eߔ\x9b\xa5`\xbe\x97U*&1-\xf0̎\x96O\xa4\xa4\xe2\xf0\xdd\xd3J\x1d\xeawOk\xac|\xf7\xb4&\xcawO뻧\x95R\xf3\xbb\xa7\xf5\xdd\xd3j\x97\xff\x13\x9e\xd6Ԉ\xdcy\xbe\x81\x8f\x93\xa3Hت\x1e\x1b\xe2\b|\x9f\\\xe1s\xc0\x9f\x95\x8b\xb9\x8a\x83\x8a$\xfe\x0f\xa4uǔVc<\xea\xe4L;k\x82̻\xe3E\x13\xae\xe43\xb2\xeeC\xa7\xe7˺_\x8dB<Sֽ\x1f\xf6\xb4\x8f}R\xce} ʼ\xec\xec\x85O\xd4(\x80\x86\xb0\xbaۆ\x8f\xe15$!\x13\xfd\xbfrbn/k\xec\x8c\xf2\xf1\xe2Y\xfc\xc92\x12e\xe9ş.\xbe=\xf2\x9f\x87\xe0\x83$\xee\xd3Οo\x8e@\xb5+\xd0vZX7\v\xef\xdb\x14\xe3\xb3\xc8mj&~M\xc4\b\xac\xaeH\x1eQ\xf1[\xd5\x05\x06\x8aϥ\xb7H\xcf8\xa9\xba\x8a\xc0I:\xabJ\xf5Ad;%\x85\xac\xb4\x8fJXX\xef2w\xa0=\x80\x8c\tkt\x86\xff\x1b\xd9\xc9*\x92\t>B\xbe\x89\x8c\xc0i\xe4;Ɂ~\x13\x1a\fݿ\xbd\xec~1ҧ\n\x92'fv\x11@O;\x10\xb8\xc3.\xb6\xed\x03\x00\xe1>\x02\x7f0\xffX\xc0\"\x80\xa4\"\x82q'y\xf5m\x06m\xb9#\x9fK\x17{\x9a\xedw\x8c\xc7TҒ\tON!\xec\xa6\b\x0e\xf8\xa5sw\xbb\xcfrd\xe2wI\r\x9c\x9f\x10\x98\x12\x11\x9bH\xfe;!\xe5/1\xb7\xf8\xd9\xdb\xf3)I}sV\xcc/\x96\xc0w\xfe\xb4\xbd$\xfaL\xa7\xe8͡\u038b\xa7\xe3\xbdb\x12\xde\xeb\xa4\xde%&ܝ/s>-\x1e{R\xe6\xd8t\xe8`8in2Un2\xb40\x85\xd8l\x94&S\xe0\xe6$\xbeMr'm\x9a\xbdZj۫%\xb4\xbdn\x1aۨ\x14\x8d~\x9c\x93\xa8\x16\xbf\x96\x86L\x1a[\xfeZ\xc2v*\x19\xa4긯'\xad\xaf>\x1f\xc1\xb0\x8c\x0f\xae\xdd+\xf9\xc8E\xc5\r+9n\xa4\xeeY\x1e\r6\x98\x1d\x1c\xea\v4~\x93x\xf4\xd4\xdf\x04\xf3\xf9K-\xb5\x97G\x9e>\xd5\xe4\t8'46\xafz\x98g\xee&\xa6L.\xc1\xda#;;\xfd\xc5 \xfe\xfa\xa6\x85\x13w<]\x8bV\xad\x88\x85\x98\xa8\x18\xbeEf\xd0p\xa4蛞\a\xeb\xfcp\xfc\xed\xef\x15\xa8\x03\xc1{lj?\xa79\x04\xe6'\xa6\xb6\v\xb1\xa0*\xbc\xda\x1a\x8a\x9f\xf7\x9c\xfef*\x93w\xc2Y\xdd\xe3\xf1`\x1b\xab#\x9aE\x8dU|v\xbd\x12\xedc\xa0\xb9\x90u\xebH\xb3)\a9\xf5\xb4\xd4\xcb.q\xe6/r&\xbd\x8at\xcf\xefw:\x05u\xca駴\x04\x80\xc9\xd3N/\xb5\xe4\x99Z\xf4$\xfbyi\xa7\x99\xe6m\x16\xbe\xe0饗8\xb5\x94H\xa9\x94SJ\xf3\xe8\xf4\n\xa7\x92^\xf54\xd2k\x9dBJ>}\x94\x94Ⓖ\v\x9c\x9a\xa2r\xe2q\x9a\xe9=\xde\xf1\xd3D\t\xa7\x88\x12v\x7f\xa7\x91<\x01\xbd\x84SB\xf3N\a%\xf0,u*\xbe\xe2)\xa0W<\xfd\xf3ڧ~&$k\xe2\xf3\xbc\xd3='oYH\x95\x83\x1a\xdd\xf6I\x95\xc2Q\xf9KY\xdbt\ar\xb4\xdf\x11n\xfd\xb3\xb5:\xfe2\x9a\a\x7f\xd1(^);\xb4}i%\xad\xe5mt\xf6\xa2\x1a\xf7\xa7\xebL\xfa{f\xddv\x95\x86\x92*\xbc\xbbx}p\xe9,Q\xd3\xfc\x81f\xbb#\xe8;\xaa\xc9F\xaa\x82\x1arQo\x00^9\xe0\xf6\xef\x8bKB>\xca:'\xa2}/\x8ffE\xc9\x0fv\x85B.\xda\rN\x93\x80\xa8\xb4\x85\xden%gY\xc4w\x8b\xde\xcd\xe4*\xf7.\xcb\xc0\x1b\xa3\xb2v\xca@i+\xc6]7t\xf3\xbaW`n$\xe7\xf2i\xe6ڟ\x96\xec\xafxs\xf73\xa2C\xefnW\b#\x88\a^\x05^'g\xd5ج\xc1\x9a\xe5\x06ϡ\xb9\xbf\xdat v\xf3\x1cۗ\xe3B\xee\xeeA\x0en\x81W\x9d\x99\xb4\xda\xe5v\xe5\xc61ԋ\x95\x19*\x0eDbF\x8d\xd91\x95/K\xaa\xcc\xc1%j,:c\b\xb6t,\xba3h=\xfaw;G\xc9\x1b\xaet\xc6\x1d\xcaC\xd9\xdd\xf4=\xa6\xdd)\xe3\x18>\xbd8yn\xf1\x8c\xe3\x18vK\x96H\xa9\xc8\xcf\xd1̯\xb3Eʹ\xbf\x99\xf8\x17\xb9\x87\xf7\xd1\xe8Y\x87<wG\xd5#\xe9Y\x01\xa2\xbbtw0Ku\rx!o\xff\xd33\xf2\xadB\xd7\xfeN\xd5S\x02ew]\x10\x11\xfc\xc2\r\xb3\xa1\xb3\x98~\xc2\v\xe0\x0f\xe4\xf6\x01\xd7h\xb5j\xf3Sԯ\xd1B\xa8,l\x06G\xe0\xf8\x06\x7f9\x7fj\x9a6R\xd1-\xfc,\xdd\x1d\xdbSl\xef\xd6\xeeܽ\uef5e\x90?\x1a&M\xec\x02^\x7f\xdb\xf7\x11\xb0&\xe7\xbbw\xa9\xb1\x1d\xe5\xcck\x9a\x8d\xe1\xa7\xf0\xfd\xfe\xfeg\x87\x95a\x05\\\xbe\xaf\\\xba\x83Չ\x1a,\x89\x03\xb6\x0e\xd2\xda\xfew'\x9f\xf0\xf2\xdfx\x1c3\xbc\x99\xd0 \xa3\x00\x93\xcd1\x05q\x16JU\xc9%\xcdA\xddH\xb1a\xdb\t\xec~\xedT>2\xb3\x19\xfe葫mT\x80\x7f\xe6\x1c\x04\xeb\xf3p\x0e\xfc#\xe3\xa0ݰ\x12\x14\xf0m\xbfU\xad\x8f\xabb\xed|\xb8\x8d\xfdXw0`\xe3\x1cZ\x18\x8a.AY/\xca\x05\xad+\x1ddu\x18\xf1\x86#L\x18\xd8B\x7f\x158\xa2\x81ݭ\xd2h>\x83:\xc1\xb5\xccO\xb1\xf8V\a\xf9\x87\xe1\x96G\x9cl\x85\xbcb7\xee9'\xe4\xf6\xe1F\x93J\xe4\x18.~\xf8\xeb\xdd,\xa9\xdbwn\xae\x0f\xb3uJ\xa9>\xc4[\xb5\x9c㖾pޱ\xdcD\x10\x18\x82\xd3z\a\xe4\x89\x19\x7fq\xd7yoZ\x1dZ\xf2\f\xbdp\x80W\xfaO\xbfq\xe0n\xfe\xf7/\xa3\xf8\xe9X)\xbc&տ\n\x80\u05ca\x9e\xf4\xcc\xc1\xbaNت\x93\xbf\xf4;c\xa0(M\xccטV\x87\x7f\x19\x03X\xfbi\xd2Pޚ\x954T\x88y\xda\xfa \xb2\xb1\xc42\xaf\x8dF\xb896\x1fc\x04\xb8\xf1\xe7!\xceF\x80\x1a\xe0\x10\x01t\x95e\xa0\xf5\xa6\xe2\xfcP\x1f\xc7\xf8F\xa8\xf1\x912~>R8h\x83\x82`\xd1\x1b\x854\x89\xb0O\xf7\x06\x91\x87\x99\x1e\x8e*\xcd#\x85\xe7\x82φԆ\x16'=\xd8p\xd3\a\x83O\xf6\xa8\xbc\x95TI\xeb\xb1Sݰ?f\\\x1ap\xae%.\xb2,4\xc8\t\xecA\x10k\x9d\x1d\x89ÛS3\xa1\xf8\x13\xae\xce\xc2\x05{\x17B!ч\x89\x88\x8fvh|\x00\xe7\a]\xc3\xc4\\Q|ϤO\x84\xbe\xf3\xeb\xa2\x15\xd7\xd6\xfb\x87\xa5\x05q\x9a\xd7\x1a\xd5͙f]\xbb\xf0<%ws\xb7\x1a\x02w\x8a\x8a\xeb?\xf7\xf2\xcci\xdcG\xf7Y*\xad\x8f\xee,\x85\x16\x81X\xcb\xf8\xf9qǩ~ڥ\xee\xd8\xd29\x1cY8CG9\xf7\a\x1d\vКn\xc3m\xeeOv\xe9\xb1\x05\x01.<\xe76O\"@\x9bSqݻ\xccݔ\xa1\x99\xa9\xa8\xef $\xf8\xb6j\xfd\xa0\t\x971\xa8\xf8\xa0\v\v/\x85\x855\xd9LB}-\x99JY\xc3}\xa8+Zڠ'\x8c\xdci\xdev\x03ζ̮u,\xe7\xb6T\xad\xe9\x16\x96\x99\xe4\x1cP[\xf7\xc7\xf5\x92sݟ=\xfc\x02TO\xa2\xf6\xb1]\xd7\xef\x00:n\xbb\x8do\xea\xd2\xdd\xf1\xf5.\xc3\x144\x0f\xe9\xf5\x06$\xb1\xe3Y\x8e\xb2\xa3B\xf4\x95\xb9\xfeH\xdbuì\xf3j\xd9\xc7y\xfd#s\v\x1f\x17\x88\xcbcA\x7f\x93jA\n&\xec?T\xe4n\x03/4\x9e5\xfe\x9d\x94\x8fw\x11'\xb67\xf8\x1f\xeb\x8a\xcdV\a\x13n\xd8x`t-+\xbf\xfb^;\xb4\xf1m\x15\xbc\x99\xff\xcc\xcbM\x849b\x0fz\xe8\fFt\x7f\xec@\x9a4\x05\xae\xe7\x01Xw\xe1%3\xce\x0f\x8bc\xc8G\xaf&6\xb0[/\x17x7\xa0\xb9\x8f`\xa0\xa3\xb0#\x15\x05R_|\xd1V觬z=\x99\x87\x9c\xc9\x1e\x8d\x7flj\x0f\xd1\xd1\r\xb3\xe5\xee\r \xd8q\x02ϻ`\xc7g*&\x84\xff\xd6֩\xef.h-\xdcB\x96\xd8`\x94.~\xf6}I>A\x7f\xbb\xc2\x1dg\x87\x1c33pVE\xaa\xacĭ\x92[\x05\xba/tK\xf27\xca\f\x13ۏR\xdd\xf2j\xcb\xc4\xe7\xe1\xa3;c\x95o\xa92\xcc\n\xad\x1bOl\xa0LP\xce\xfe\x11\xd3O\xed\x8fӀn\x06\x17JK\x920\x8c\xa1\x0f\xef\xc1\xfa\xaa\x83\xeb\xfb\xa8*,=]O\xf1;\x02O\xa6tc\xed\x134>E\xe8\xf6\x92|\x92\xd1\t\xeeӚX\x17\xa6u\xad@\x9b%l6R\x19\xb7\xeb\xbc\\\x12\xb6\tA\x04\xab;0\xfe\xe5ޜ$,\xb6]\\'\x8c4f\b\x83\xd7\n\xad)^I_Ѓ\xdba\xa2YVYO\xe9J\x1b\xca#\x8eʳ\x148Fk\xec$\x82\xfc\xd7g\xedȭڀ\xfa\xc1C\xecǑ\x14/\xc5p\xde\x1b\xb7(\x82 O\x8a\x19c}#9\x92\x12\xe0Ie\xac\x8f\xc49і\xd4'E\x11\x89S\x87\xab\xe1Ԛ4\x94\xefk(Cj\xd6c\x8d/,\xae\x916\xc4\xfa\xaf\x98E\xe4kY6g;*\xb6\x837\r씬\xb6\xbb \xc9\x03N1\xc9+\xc0\xa0+\xaa\x14\x1d\x1e\b6\x95\x12\xad\x94\x80\x91\xe3\xdb$\b\x03\x0e\x97f\x8f\xa4*\x17\xfe\x01^\xff\xbe\xf2\x95\x7f\xcbd\xb9Q\xb2X\xfa~1&\xba\xf0;\xf2\x8aI끘]\x94\xea\xc4y\xdf\xfe\xb9\x00\x94\x84\xb2\x04A\xa8\xf6='\xdc\xf8t\xb2\xb9ц*\xf3\xacp\xc4]\a\xc2D$\x02\xbb\x8b#q\xe7\x13\x13ܕW7\xfe\xa1\xd1\x1a\xf0\x82h&\xc2\x13\xcf.\xc9\xc1\xc9Gt\xcfK\xe0\x93\x8cR\xc5\xf3\x0e\xc7C\v]\x84^7\xaa\xb0\xafm퇓\x17\x9d\x0fG0\x8e\x8e/\xe3\v\x9cu\x95\xb0P\xfc\x03\x8bE\xbe1a5\xb3\xa8\xfc\xf1w?\x96\xbcOZ\xd4\xc4)2\xb6\xc6\xc1\xe5\xcb\xf0b\xa5\xfb\xe2\xe6-\a\xebzi\x80\xee\xf2i\xd62y\x7fƸ\xd19\x83F\xe11\xf3\xf3DM\xf6g\f\x17\xbdX\xac\xe8\xbc(?Q|\n\xf9\xa4Y\xfb7\xdf6\x12,\xf2`\xcf\x1d.jE\x8b\xc2\xc0_5^\x14\xb5J\xbd\x1fQO\xe7-m\xe1{\xf2\xbf\xfco\x00\x00\x00\xff\xff\xaf\x05\xd1\xf3\xa2\x81\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccYK\x8f\x1b\xb9\x11\xbe\xebW\x14v\x0f{ٖ\xec\x04\t\x02\xdd\xc6r\x02\x18\x19\xc7\x03k2\xb9.EVK\\\xb1\xc9\x0e\x1f\x92\x95\xc7\x7f\x0f\x8a\x0f\xa9\xd5\x0fK\xe3\x04\x9b\xe5eF|\x14\xeb\xf9U\x15\xbb\xaa\xaa\x19k\xe5\vZ'\x8d^\x02k%~\xf1\xa8闛\xef\xff\xe0\xe6\xd2,\x0eog{\xa9\xc5\x12V\xc1y\xd3|Fg\x82\xe5\xf8\x1ek\xa9\xa5\x97F\xcf\x1a\xf4L0ϖ3\x00\xa6\xb5\xf1\x8c\xa6\x1d\xfd\x04\xe0F{k\x94B[mQ\xcf\xf7a\x83\x9b \x95@\x1b\x89\x97\xab\x0fo\xe6o\x7f?\xff\xdd\f@\xb3\x06\x97\xb0a|\x1fZ\xe7\x8de[T\x86'\x92\xf3\x03*\xb4f.\xcd̵\xc8醭5\xa1]\xc2e!Qȷ'\xce\xdfEb\xebD\xec1\x13\x8b\xebJ:\xff\xe7\xe9=\x8f\xd2\xf9\xb8\xafU\xc125\xc5V\xdc\xe2v\xc6\xfa\xbf\\\xae\xae`\xe3TZ\x91z\x1b\x14\xb3\x13\xc7g\x00\x8e\x9b\x16\x97\x10O\xb7\x8c\xa3\x98\x01d\xd5Dj\x150!\xa2\xb2\x99z\xb2R{\xb4+\xa3B\xa3\xcfw\tt\xdc\xca\xd6Ge&Y \v\x03E\x1ap\x9e\xf9\xe0\xc0\x05\xbe\x03\xe6\xe0\xe1\xc0\xa4b\x1b\x85\x8b\xbfjV\xfe\x8f\xf4\x00~vF?1\xbf[\xc2<\x9d\x9a\xb7;\xe6\xcaj\xb2\xd1SgƟH\x00\xe7\xad\xd4\xdb1\x96\x1e\x99\xf3/LI\x119y\x96\r\x82t\xe0w\b\x8a9\x0f\x9e&\xe8W\xd2\x10\x90\x8a\x10\x8a\x86\xe0\xc8\\\xbe\a\xe0\x90\xa8D\x1d\x8ds\xaa\x06w]\xb1M\xac\xc0K\x8fJ\xe2\x9ff2\xf7\x1d\xb2ſ\xe7\xdc♤\xf3\xaci\xaf\xe8>lq\x8aؕ*\xdec͂\xf2]Q\xc9J\xaa\xeb\x97\xd7b\xb5\xc8\xe7\"\x9d\xba\xba\xf1\xfd\xd5\\\xbauc\x8cB\x96\xa8\xa4]\x87\xb7\xc9\v\xf9\x0e\x1b\xb6̛M\x8b\xfa\xe1\xe9\xc3\xcbo\xd7W\xd30\xe6H\xbd\xa0 ñ\x8emvh\x11^b\xfc%\xbb\xb9,ڙ&\x80\xd9\xfc\x8c\xdc_\x8c\xd8ZӢ\xf5\xb2\x04K\x1a\x1d,\xea\xcc\xf6x\xfaWu\xb5\x06@b\xa4S \b\x940\xf9U\x8e\x1f\x14Yr05\xf8\x9dt`\xb1\xb5\xe8P'\x98\xa2i\xa63\x83\xf3\x1e\xe95Z\"C\xb1\x1d\x94 ,;\xa0\xf5`\x91\x9b\xad\x96\xff8\xd3v\xe0Mvf\x8f\xceC\x8cP\xcd\x149k\xc0\x1f\x81iѣܰ\x13X\xa4;!\xe8\x0e\xbdx\xc0\xf5\xf9\xf8H\xd1 um\x96\xb0\xf3\xbeu\xcb\xc5b+}Ahn\x9a&h\xe9O\x8b\b\xb6r\x13\xbc\xb1n!\xf0\x80j\xe1\xe4\xb6b\x96\xef\xa4G\xee\x83\xc5\x05ke\x15\x05\xd1\tR\x1b\xf1\xbd͘\uebae\x1d\x84t\x1a\x11R_a\x1e\x82\xd7\xe42\x89T\x12\xf1b\x05\x9a\"\xd5}\xfe\xe3\xfa\x19\n'\xc9R\xc9(\x97\xad\x03\xbd\x14\xfb\x906\xa5\xaeѦs\xb55M\xa4\x89Z\xb4Fj\x1f\x7fp%Q{pa\xd3HOn\xf0\xf7\x80Γ\xe9\xfadW1\x8b\xc1\x06!\xb4\x11$\xfa\x1b>hX\xb1\x06Պ9\xfc\x85mEVq\x15\x19\xe1.kuss\x7fsRog\xa1\xe4\xd4\tӎ\xa2\xc1\xbaE~\x15w\x02\x9d\xb4\x14\x19\x9ey\x8c\xd1\xd5SP\x86\x8a\xe9\xa4\\\xc68H\xd0`\x9c\xa3s\x1f\x8d\xc0\xfeJ\x8f\xe5\x87\xf3\xc6+\x1e[\xb4\x8dt1\xbdBml?\xf3\xb03\x92wGA\xbc\xbe\xc1\x01P\x87f\xc8H\x05\x9f\x91\x89OZ\x9d&\x96\xfef\xa5\x1f^4aH\x1a\x89\xc5\xf5I\xf3'\xb4҈\x1b¿\xebm?\xab`g\x8ePG\xff\xd7^\x9d\b\xbb\xdcI\xf3!j\x97\xf1\xf0\xf4\xa1 x\x8a\xad\x1c\x98YWsx\xc8Amjx\x03B:*$\\$:T\x96\x0e*\x16\x1aK\xf06\xbcJ|nt-\xb7C\xa1\xbb\xb5є\xc7\xdc \xdd\xd3\xdc*\xdeD\xa8E\xde\xd1Zs\x90\x02mE\xf1!k\xc93'\xc1\xa6\fRKTb\x80M\x93Q\x16E\xb1((\xa8\x99\xbaa\xc3\xd5yc\xac\xa4\x99\xd4Ƀ/\x04\"\xd6\xd8&\xa7f\xedQ\v\xecg\x9bȍ\x89\x80\xe6P\xc0Q\xfa]BJ5\x16w\xf0\xd5أ\xb1\xc7\xd3\xd8t\x8f\xf7\xe7\x1d\xd2Δx\x11\x1cr\x8b>z\x1b*r\x1fr\xa59\xc0\xc7\xe0\"\xd6\xf6q\xa2\x8cX\xf0\x95\xd3{<\r\x15\r\xb7\x8c\x9bK\xa1\t\x96c\x11\xb5\x84ﾻ-\xd2 \xbb\x95A\xa5{\x11\xd4b\x8d\x16\xf5\xa0\x9a(\xe39\xe6(r\x1a\xf20\xack\xe4^\x1eP\x9dbN\"\xf0\xfc\x116\xc1\x83\b\x18\xad\xc6\xf8\xfeȬp\xc0M\xd32/7RI\x7f\x02\xe9&\xe83\xa5\xcc\x11E\xb686\xad?\xcd\xe1\x83v\x9ei\x8e\xee\\\a\x91ƒ+0\x9dv\xe5(\x8e\x05\x1d\xb3c\x18\x98\xc87\xc6y\xe0h\xc9\x1d\xd5\t\x8e\xd6\xe8픰#\xe9\x90z@\xab\xd1c̈\xc2pGɐc\xeb\xdd\xc2\x1c\xd0\x1e$\x1e\x17Gc\xf7Ro+b\xb0\xcaೈ\x9d\xdd\xe2\xfb\xf8\xe7[\xbc\xc0\xb4\t'\xeep\xdeu\x8c\xf5\x13\x95\xb7~\x87)E\xac\x93\x0f\x1a\vT@\x90k7\xd9w\x13\xb2\x8e\x85\xddX]\xde\x1d\xc5\xe4c\xf9c\x8f\xc3\xd4\xf1\x15P\x01\xf8R]t[5\xac\xad\xd2n\xe6M#\xf9\xac/m\xf2\xfb\xaf\xe3OiV\xa4\x16\x92Sq{\x8d\x1b\xa5\x89\x13W=͈\x1a\xfa]\xce\x14Z\x8e\xab)\x89\x9bk\x85\x1b\x1c\x7f\xea\uef74\xbe\t\xbas\xfew\xe8\xa9\xeet\xa0\x91\xea\x03f\x87z\x8e\x80ɍքT\xde\x00;\xa7\x81\x1f\\?\xff\xbd\x12=7\x81\xefqD\xf1\x03Q\xdeōE\xc7\xe9\x18\xf1\x12\x1c\xc6\xc4t\x8b\r\xb8\x1d\x11\x9c\xad\xd0\xde\xc3\xcb\xea\x816\x9eK\b\x06\xab\a\xd8\x04-\x14\x16\x8e\x8e;\xd4\xd4u\xc9\xfa4~\x17\x8d\xe7\xc7u\xd1j\xac\xber\xdfTt;.C\xcaoK\u061cF\xea\xa5;\x84l-\xd6\xf2\xcb\x1dB>ōE\xe1-\xf3;\x90\xdaI\x81\xc0Fԟ\n\xd9\tAϵѧ\x8c9\xdf`\x9e\xafaCb\xe75\xf0Pt|#~\x9e\xf2\xb6\xb3\x16\xca\xef\x9cݮ\xeb\xe4\xa98\x1e\x95\xe8p~\x94\xf9S\xaa>\xf9H\x19q\xc5\xcc\xcb\xf0\xc4W\xaa\xd8\xf244\x16\xccT3\x19kѵF\v\xea9\xef\xaba/,\xff\xef*\xd9q\xb3V\xd7(\xd7[+V\xb8\xab\x8d\x8b\xcf`\xafn\xe4\xd2\xe3`\xb7M2\x1bG\r\xf6\xa5\x97\xeb\xc9\xf8\x8b\xb4p\xa3%W\xa7\xaf\x93\x8eꗠce\x1b\xab\xaa\xf9l\xe4\xc4{l-R\x06\x13K\x92\xcdƃ\xda\x1c\xe9p\x87Z*ˌN\xf9\x9ez[\xa6E~U\xa0\xa5\x11\xcaG\xa9\x14\xd5\x00\x16\x1bCʢ\xb2\xdcR5\xc7b\xadu\xf8\xcd\xfc\xcd\xff\xafeT\xccy\xea\x00Q|ƃ\x1c>\xadݧ\xee\xc7\x01\x95\x82\x0e瘡\x1f?\x95׆\x85\xcd\xdb~\x82Z*\xaa\xff:\xd0qGu0\xf20\xfcn\xfd\xf8\x83\x8b=\x10j\xef\xe0H\x16t\x91%jzL~\xe1\t\xceS\x12\xb9i\xffn\x01\xae\r(\xa3\xb7h\xcbk\x0f\x15xɛ\x8c\x05\x81\x9er\x95\xde\x02\xdf1\xbd\xa5\xc8\x18\x83\xfc\xc8p\xe6\xbe\xcb'yϤ\x83H=\xe1\x1dw\x19\xf4Y\x8e\xb54\xaf1\xe6\xf43\xfc\x99\xffl\xd9\xcbkoO\xefSP[,\xd1_,\xa9\x9c\x14]\xf9\xcb\xd3\xfce|\xfb\xfb\xc0\xf0\xdd\xff[\xd5\xf3_}\xa9\x18|\xa1\xf8U(\xa7\xa1:\xf7f\xf1\xfc1\xedJ\xef\xb5\xf9\b\xb0\x8d\t~$\xf7w\x1c~4\xa6\xe3ǘ\xd7\xf0\x18?1\xdd*OhO\xb1\b\x0f\xd6\xc67\xdd\xf2\xd6\x18\x91b,+ݏ\xc0\x0f\xbd/aݵ\xe1w\xb2;\xe4\x1a\xcd҃ɔi;v\xcdJ\xee΄\xcd\xf9\xa5~\t\xff\xfc\xf7\xec?\x01\x00\x00\xff\xff\x03f\x86Y\xc0\x1d\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVMo\x1b7\x10\xbd\xebW\f\xd0kwU\xa3hQ\xec\xadqr0\xda\x06\x82\x1d\xe4N\x91#-c.\xc9\xce\f\xe5\xba\x1f\xff\xbd \xb9+K\xab\x95\x93\\\xb27\x91Ù\xc7\xf7f\x1e\xd54\xcdJE\xfb\x11\x89m\xf0\x1d\xa8h\xf1/A\x9f\x7fq\xfb\xf8\v\xb76\xac\x0f7\xabG\xebM\a\xb7\x89%\f\xf7\xc8!\x91Ʒ\xb8\xb3ފ\r~5\xa0(\xa3Du+\x00\xe5}\x10\x95\x979\xff\x04\xd0\xc1\v\x05琚=\xfa\xf61mq\x9b\xac3H%\xf9T\xfa\xf0C{\xf3s\xfb\xd3\n\xc0\xab\x01;0\xe8Pp\xab\xf4c\x8a\x84\x7f&d\xe1\xf6\x80\x0e)\xb46\xac8\xa2\xce\xf9\xf7\x14R\xec\xe0e\xa3\x9e\x1fkW\xdcoK\xaa7%\xd5}MUv\x9de\xf9\xedZ\xc4\xefv\x8c\x8a.\x91rˀJ\x00[\xbfON\xd1b\xc8\n\x80u\x88\xd8\xc1\xfb\f+*\x8df\x050^\xbb\xc0l@\x19S\x88TnC\xd6\v\xd2mpi\x98\bl\xc0 k\xb2Q\nQ\x1fz,W\x84\xb0\x03\xe9\x11j9\x90\x00[\x1c\x11\x98r\x0e\xe0\x13\a\xbfQ\xd2w\xd0f\xbe\xda\x1a\x9a\x81\x8c\x01\x95\xea7\xf3ey\u0380Y\xc8\xfa\xfd5\b,J\x12O J]\x1b<\xd0\t\xbf\xe7\x00J|\x1b{\xc5\xe7\xd5\x1f\xcaƵ\xca5\xe6pS\x99\xd6=\x0e\xaa\x1bcCD\xff\xeb\xe6\xee\xe3\x8f\x0fg\xcbp\x8euAZ\xb0\fjB\x9a\x89\xab\xacA\xf0\b\x81`\b4\xb1\xca\xed1i\xa4\x10\x91\xc4N\xadU\xbf\x93\xe19Y\x9dA\xf8\xb79\xdb\x03Ȩ\xeb)0y\x8a\x90\v\x89cS\xa0\x19/Zɵ\f\x84\x91\x90\xd1\u05f9\xca\xcb\xcaC\xd8~B-\xed,\xf5\x03RN\x03܇\xe4L\x1e\xbe\x03\x92\x00\xa1\x0e{o\xff>\xe6\xe6|\xef\\\xd4))\x94\xe4\xb6\xf3\xca\xc1A\xb9\x84߃\xf2f\x96yP\xcf@\x98kB\xf2'\xf9\xca\x01\x9e\xe3\xf8#\x93h\xfd.tЋD\xee\xd6뽕\xc9Rt\x18\x86\xe4\xad<\xaf\x8b;\xd8m\x92@\xbc6x@\xb7f\xbbo\x14\xe9\xde\njI\x84k\x15mS.⋭\xb4\x83\xf9\x8eF\x13Ⳳ\x17\xddS\xbf\xe2\x02_!O\xf6\x84\xda#5U\xbd\xe2\x8b\ny)Sw\xff\xee\xe1\x03LH\xaaRU\x94\x97\xd0\v^&}2\x9b\xd6\xef\x90\xea\xb9\x1d\x85\xa1\xe4Dob\xb0^\xca\x0f\xed,z\x01N\xdb\xc1\nO\x1d\x9b\xa5\x9b\xa7\xbd-\xb6\x9b\x1d E\xa3\x04\xcd<\xe0\xceí\x1a\xd0\xdd*\xc6o\xacUV\x85\x9b,\xc2\x17\xa9u\xfa\x98̃+\xbd'\x1b\xd33pEڅ\xe1\x7f\x88\xa8\xb3\xb8\x99\xdf|\xda\ueb2ec\xb5\v\x04O\xbd\xd5\xfd4\xfc3\x9a\x8eFq\xce߲1\xe4\xef\xc5n\xe7;W/\x0fEdK8k\xd8\x06.\xbc\xfbu^\x8a\xa9~%3\xd5\xd1Gnt\"*\xcdw\xf4y\xb5t\xe8K\xb9@\xa2@\x17\xab3P\xefJP\xf9Ǡ\xacgP\xfey<\b\xd2+\x81'\xa4<!:\xa4\xecVh\xc0\xa4\v\xfeFZNߤHA#_\x8c\"\x80\x15\x1c\x160\xbd\xa2N\xfe|rNm\x1dv \x94\xf0\x8a\xb2\x8aH=\xcf\xf6\xca\xdb\xf7\x19\n69fI\x83\xe3{\xffY\x11\n\xdd>\r\x97\x95\x1ax\x8fO\v\xabw~CaO\xc8\xf3\x96ϛ\x9b\xca\x1e\xce߃WXZlʋE\xceVhNXd\t\xa4\xf6\xa7\xbcr\xda\x1e\x9d\xbe\x83\x7f\xfe[\xfd\x1f\x00\x00\xff\xff\xbeM\x1a\xea\xb1\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcWMo\xe36\x10\xbd\xfbW\f\xd0K\v\xac\xe4\x06E\x8b·\xd6\xd9C\xb0\xe96\x88\xb7\xb9S\xd4HbC\x91,9t6E\x7f|1\xa4\xe4\x0fYv\x9c\xcb\xea\xe6\xe1p\xf8\xe6\xcd\xcc#]\x14\xc5B8\xf5\x84>(kV \x9c¯\x84\x86\x7f\x85\xf2\xf9\xd7P*\xbb\xdc\xde,\x9e\x95\xa9W\xb0\x8e\x81l\xff\x88\xc1F/\xf1\x16\x1be\x14)k\x16=\x92\xa8\x05\x89\xd5\x02@\x18cI\xb09\xf0O\x00i\ry\xab5\xfa\xa2ES>\xc7\n\xab\xa8t\x8d>\x05\x1f\x8f\xde\xfeX\xde\xfcR\xfe\xbc\x000\xa2\xc7\x15\xd4\xf6\xc5h+j\x8f\xffD\f\x14\xca-j\xf4\xb6Tv\x11\x1cJ\x8e\xddz\x1b\xdd\n\xf6\vy\xefpn\xc6|;\x84y\xccaҊV\x81>ͭޫ\xc1\xc3\xe9\xe8\x85>\x05\x91\x16\x832m\xd4\u009f,/\x00\x82\xb4\x0eW\xf0\x99a8!\xb1^\x00\f)&XŐ\xdd\xf6&\x87\x92\x1d\xf6\"\xe3\x05\xb0\x0e\xcdo\x0fwO?m\x8e\xcc\x005\x06镣D\xd4\x7f\xc5\xce\x0e\xd3\x04@\x05\x100\xc0\x01\xb2;\x84 \f\bO\xaa\x11\x92\xa0\xf1\xb6\x87J\xc8\xe7\xe8\xc0V\x7f\xa3$\bd\xbdh\xf1\x03\x84(;\x10\x1c%;\x1c\x9c\xa5m\v\x8d\xd2X\xeel\xce[\x87\x9e\xd4Hy\xfe\x0e\x1a\xea\xc0z)\v\xfe8\xf1\xbc\vj\xee,\f@\x1d\x8e\xe4a=p\x05\xb6\x01\xeaT\x00\x8f\xcec@\x93{\x8d\xcd\xc2\fٔ\x93\xd0\x1b\xf4\x1c\x06Bg\xa3\xae\xb9!\xb7\xe8\t<J\xdb\x1a\xf5\xef.v`\xc6\xf8P-(\x91i\b\xbd\x11\x1a\xb6BG\xfc\x00\xc2ԓȽx\x05\x8f\x89\xc1h\x0e\xe2\xa5\ra\x8a\xe3\x0f\xeb\x11\x94i\xec\n:\"\x17V\xcbe\xabh\x1c3i\xfb>\x1aE\xaf\xcb41\xaa\x8ad}XָE\xbd\f\xaa-\x84\x97\x9d\"\x94\x14=.\x85SEJĤQ+\xfb\xfa;?\ff8:\x96^\xb9!\x03yeڃ\x854\x1d\xef(\x0f\xcfK\xee\xae\x1c*\xa7\xb8\xaf\x02\x9b\x98\xbaǏ\x9b/0\"ɕ\x1aZl\xe7z\xc2\xcbX\x1ffS\x99\x06}ޗڔc\xa2\xa9\x9dU\x86\xd2\x0f\xa9\x15\x1a\x82\x10\xab^Q\x18{\x9dK7\r\xbbNR\x04\x15Bt\xb5 \xac\xa7\x0ew\x06֢G\xbd\x16\x01\xbfq\xad\xb8*\xa1\xe0\"\\U\xadC\x81\x9d:gz\x0f\x16Fy<Sډdl\x1cJ.,s\xcb;U\xa3d\x1e\xa9\xc6z\x10{\x05\x19\x98>&j^\x01\x128\xe1[\xa4\xa9u\x82\xe5Kr\xe2\xe3_:q,X\xdfcٖ\xac9a\x00\x92\xf5\xe8\x87i\xa1.a\x80\xd9F\x9fE2\xf67\xd3\xc0\xbc\xb2\xa0\xb0\xd8\x1db:=\x9a?4\xb1\x9f?\xa0\x80\xdf\x13\xe6{\xdb^\\_[C<\x17\x17\x9d\x9e\xac\x8e=n\x8cp\xa1\xb3o\xf8\xde\x11\xf6\x7f:\xf4\xf9\x1a\xbe\xe8:\xde滫\xef\x82c\xd4g\xcf}D\xbeA\xf0|\xa6\x83\xc3UQ\xae\xc04x^\x95\xe8zs\xf7\x1e\nϸ\xbf\xa3Hw\xa6\xb1o\xa4\xb8w\x9c\xf5;#\x03\xe3\x97\xde\x10o\xf74\xbfBƞ\xe6-\xf9\xeeD\xf8\x14+\xf4\x06\t\xc3^\xa9_\x14u\xb3\x11\x01^:%\xbb\xb41\r\x04_\x02!X\xa9\xe6$\xf5\n\xf8\xac#\xca\xe3\xccP\x16iXg\xcc\f\xfe\xc4|F\xfd\xce\x1dP\f\x8at\x95\x82\x92\xa0\x18ޡ\xa1\xc9\x7f\xa4ZF\xef\xd3\x15\x95\xad\xfc2\x99n\xb8VDG\xe5\xf9\xeb\xf1\xfe\r%\xbd\xdd{\xa6\x17\xb7P&\xa3q\x1e\x8b\xa0Z~A\xf1\x1akiҸS2\xf2w\xfc\xc2;&j\xb6\xa2\xf8թ<\x80o@\xfc\xb8ŝ\x8f&\xdf\xf3\xd37l\n\x88\x81\x9f[ \x85\x99\xc1X!Ԩ\x91\xb0\x86\xea5\xdf\\\xaf\x81\xb0?\xc5\xddX\xdf\vZ\x01\xdf\xff\x05\xa9\x9962QkQi\\\x01\xf9x\xae\xcbf\x13w\x9d\b3cx\x94\xf3\x03\xfb\xcc5\xc6n\x18/v\x06\x9c\xbd_\n\xf8\x8c/3\xd6\ao%\x86\x80\xa7ct6\x93\xd9!81\x06~\xa4\xd5\a,\r\x7f\x19\x06\xcb\xff\x01\x00\x00\xff\xffx\xae@\xbaJ\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4ZK\x93\x1b\xb7\x11\xbe\xef\xaf\xe8Z\x1flWi\xc8HI\\)ޤU\x9c\xda\xc4V\xb6ĕ..\x1f\xc0A\x93\x03\xef\f\x80\x00\x18R\x8c\xe3\xff\x9ej<\x86\xf3\x00\xc9]\xca\xf2\xe2\xb2K<\x1a\x8d\xaf\x1b\xfd\xc2\x14EqŴ\xf8\x88\xc6\n%\x17\xc0\xb4\xc0O\x0e%\xfd\xb2\xb3\x87\xbfٙP\xf3\xed˫\a!\xf9\x02nZ\xebT\xf3\x1e\xadjM\x89oq-\xa4pBɫ\x06\x1d\xe3̱\xc5\x15\x00\x93R9Fݖ~\x02\x94J:\xa3\xea\x1aM\xb1A9{hW\xb8jE\xcd\xd1x\xe2i\xeb\xed\x9ff/\xbf\x9b\xfd\xf5\n@\xb2\x06\x17\xa0\x15ߪ\xbamp\xc5ʇV\xdb\xd9\x16k4j&ԕ\xd5X\x12\xed\x8dQ\xad^\xc0a \xac\x8d\xfb\x06\x9e\xef\x14\xff\xe8ɼ\xf1d\xfcH-\xac\xfbWn\xf4\aa\x9d\x9f\xa1\xebְzʄ\x1f\xb4Bnښ\x99\xc9\xf0\x15\x80-\x95\xc6\x05\xbc#64+\x91_\x01\xc4#z\xb6\n`\x9c{\xd0X}g\x84thn\x88B\x02\xab\x00\x8e\xb64B;\x0fʈ?\xb0\x8e\xb9ւm\xcb\n\x98\x85w\xb8\x9b\xdf\xca;\xa36\x06m`\x0e\xe0\x17\xab\xe4\x1ds\xd5\x02fa\xfaLW\xccb\x1c\r\xe0.\xfd@\xecr{b\xd9:#\xe4&\xc7Ľh\x10xk\xbcP\xe9\xf4%\x82\xab\x84\x9dp\xb7c\x9684\xce\x1f;ϋ\x1f'\x8aֱF\x8f\x99\xea-\r\\q\xe60\xc7Ӎjt\x8d\x0e9\xac\xf6\x0e\xd3I\xd6\xca4\xcc-@H\xf7\xdd_\x8e\xc3\x11\xf1\x9a\xf9\xa5o\x95\x1cb\xf3\x86z\xa1\xd7\x1d8!Ym\xd0d\x01R\x8e՟È#\x02oz\xeb\x03'\x81n\xbf\xff,+\xa4x\xa0\xd6\xe0*\x84(\x95\xa5S\x86m\x10~Pe\x90\xe0\xaeB\x13%\xb8\x8ajU\xa9\xb6\xe6\xb0J'\x06\xb0N\x99\xac\x145\x96\xb3\xb0*\xd2MdG\xa2\x1c\xee\xf9%4\xad4Ȳ\x9a\x96\xac\xd1\xcc\xcf\x10J\xe6\xd5\xed\xf5\x06\x1f\xa5j}H\xa5\xe2\xd8\xe1\x87\x13\xb6\x84\x05mT\x89֞\xb8\x01Dc\xc0ȻC\xc7Y\x80*\xf4s\x12?\xad\xae\x15\xe3h\xc0)\xa8\x98\xe45\xd21\x188ä]G\x15\x99\n0-\xbb\xdf\xeb!+\x1f\xe2\xc01v¬\xed\xcb`\a\xcb\n\x1b\xb6\x88s\x95F\xf9\xfa\xee\xf6㟗\x83n D4\x1a'\x92]\x0e\xad\xe7uz\xbd0<\xee\xff\x8a\xc1\x18\x00m\x10V\x01'\xf7\x83\xd6\xc3\x10-,\xf2\xc8S\x80GX0\xa8\rZ\x94\xc1!Q7\x93\xa0V\xbf`\xe9f#\xd2K4D&݅R\xc9-\x1a\a\x06K\xb5\x91\xe2\xbf\x1dmKXӦ5sh\x9d\xbf\x8cF\xb2\x1a\xb6\xacn\xf1\x050\xc9G\x94\x1b\xb6\a\x83\xb4'\xb4\xb2G\xcf/\xb0c>~T\x06AȵZ@圶\x8b\xf9|#\\\xf2ťj\x9aV\n\xb7\x9f{\xb7*V\xadS\xc6\xce9n\xb1\x9e[\xb1)\x98)+\xe1\xb0t\xad\xc19Ӣ\xf0\a\x91\xde\x1f\xcf\x1a\xfe\x95\x89\xde\xdb\x0e\xb6\x9d\b:4\xefB\x9f \x1er\xaat\tX$\x15\x8ex\x90\x02u\x11t\xef\xff\xbe\xbc\x87\xc4I\x90T\x10\xcaa\xea\x04\x97$\x1fBS\xc85\xe9<\xad[\x1b\xd5x\x9a(\xb9VB:\xff\xa3\xac\x05J\a\xb6]5\u0091\x1a\xfc\xa7E\xebHtc\xb27>^\x81\x15\xdd%\xb2\x00|<\xe1V\xc2\rk\xb0\xbea\x16\xff`Y\x91TlABx\x94\xb4\xfaQ\xd8xr\x80\xb77\x90b\xa8#\xa2\x1dY\xb6\xa5ƒ\x04K\xd8\xd2J\xb1\x16љ\xac\x95\x016\x9e>\xc4)o\x00\xa8e\x1d\xc9x\xd29\xa5\xa3\xf6&G(1,{\x06<9\xbc\xe8\x9f\xea\xa1\x7f귃\x95\x8fk\fje\x85SfO\x84\x83\x83\x1c+\xc4Q\xd9P+\x99,\xb1\xbe\xe4x7~%\b\xc9\tv\xec\x14\x9aLQ\xa0\xea\x19Ur\xa3芍\xa5\x01\xb7\x8e\xa6\x91\x92[t\xf9\xb3\xcac\x0eMH8\x84\x98\xd0\x0f%Ǉ^)U#\x1bcI\xee\xee̙\xc9\x01\xe6\x84彭\xab\x98K\xbc\xd1$\xd3J9Ŗ\x9a\x92O\x12\x87V\xfc\f_qG\x06\x06\xd7h\xd0G#\xc1\xf6k\xe5=\x84cB&\x9b\x16\x12\x01p*\xc3\xd9*(\x11r\x18\xdf\r8y?\xe0\x84\xa3\xccr\xfc\xfa\xee69\xc3\x04b\xe4}\xe2\xef\xce\xe2Cm-\xb0\xe6>r8\xbfwVs\xa9ݮ\x03\x13\xde#8\x05\f\xb4\xc0\x12\a\xde\x18\x84\xb4\x0e\x19\x8f\x9dd\x04\rƱ\x17\xc1\xd2\x1fe\x92\xda\xc1k\x93L\x80\x91\xe7\x11\x1c\xfe\xb9\xfc\xf7\xbb\xf9?T8\a\xb0\x92B3\x9fDa\x83ҽ\xe8\x12)\x8eV\x18\xe4\x94\x16\xe1\xacaR\xacѺY\xa4\x86\xc6\xfe\xf4\xea\xe7<~\x00\xdf+\x03\xf8\x89Q:\xf2\x02D\xc0\xbcsfIm\x84\r\a\xef(\xc2N\xb8\xca3\xaa\x15\x8f\a\xdc\xf9#8\xf6@79\x1c\xa1E\xa8\xc5C\xe6\xfe\x84v\xed\xa3\xb9\x03\x9b\xbf\xd2\xed\xf9\xed\x1a\xbe\t\xc6\xeb\x9a~^\a6\xba\xb0\xa5\x7f\xc1\x0e\xec\x84[f\xc4f\x83\x87\xb8\x7f\xa2,\xe4f\xc9A}\v\xca\xd0Y\xa5\xea\x91\xf0\x84IN\xc1? \x9f\xb0\xf7ӫ\x9f\xaf\xe1\x9b!\x06G\xb6\x12\x92\xe3'xE\xd6\xc7c\xa3\x15\xffv\x06\xf7^\x0f\xf6ұO\xb4SY)\x8b\x12\x94\xac\xf7!\x00\xde\"X\xd5 찮\x8b\x10 rر=\xa8\xf5\x91}\x92\x88H5\x19hf\xdc\xc9 1\xe2p\xfa\xd2L\xa3\xa6\xd4\x1ew_|\x14\xf5\xa8\xdb\xfbl\x11\xc8#\x91\xf0\xe9\xc2g \xd1O\xbd.@\xe2\xa1]\xa1\x91\xe8Ѓ\xc1Ui\t\x87\x12\xb5\xb3s\xb5E\xb3\x15\xb8\x9b\xef\x94y\x10rS\x902\x16A\xeav\xee\xcbH\xf3\xaf\xfc\x9fK\x0f\xee\xeb?\x9f{zO\xe4\xf9 \xa0\xdd\xed\xfc\x12\x04Rt\xffx\xdfu\x14\x87e\f8\xc74\xe9\xce\xef*QV)\xd7\xebYۆ\xf1`\x8e\x99\xdc?\xd3\xdd!\x9c[C\x1c\xed\x8bX\x03-\x98\xe4\xf4\xbf\x15\xd6Q\xff%\xc0\xb6Ⳍˇ۷\xcfy\xa3Zq\x89%9\x92Ä\xf6\xa98pU4L\x17a6s\xaa\x11\xe5h6\xc5\U00037704\xb4\x16h΄\x7f\xef\a\x93S\x80\x9a\xc9\x06\xba9O\x8a?\x1d\xdbd\x02\xbe~y\xf8TXx\x12\xaf\xf3\xaap\xcf6\x16\x98A`\xd00M\x1a\xf1\x80\xfb\"D\x1c\x9a\t\n\x17(\"\xe8\n\x83\xc0\xb4\xaeɧ\x87(\"C1ƿ\x11\x1ef\xfd\xf9\x8e\x01\x92\x15e\xaaJ-\xd19!\x9f\x11\x9c\x0f#F~_\xa0\xba\x9a]\xa9\xe4Zlb\xb5s\x8a\x94l뚭j\\\x803\xed\xb1\x9c\xeb$\x90\xf74\xe5\xf4\xf9?\xf4\xa6&\r?S`̟jPv\x9c\x1e\x06e\xdbLY)\xe0Ai\xc12\xfd\x06\xad\x9b\xdc^\x1a\xb8\xbe~\xca\x1d\vJyI\xca\x1d\xd2\xe0\\V\x1a\x15=\x06\xf0)3u\xea\x90\xe5e\x85\xfe\x04\xdb@\xd9=\xa5#C\xbe\x8b|\xb9d4\xa7W]N]Z\xf1Q\xcf\xd0\f\x8e\x06\xc3\xf9\x1eUC\xf2\x05\xed'T\x91\xc2\xebU\xc448G\x97\u07b4(쾴\x8eD\x89\x9dvȻB\xff%\x12\x7f=&\xe2k\xbf\x86\xc7K!\x1a\xecR\xff\xa1\xad\v\xc9\xdd\nA\x1b\xd4,[\x15\x02_\xb9\xb7\xbe\x84\xf9\xb5\rĄ\x85\xd6\"\xf7\x15\xb4\xc9\xde\x13\n\xe9E\x893\x87\x05\xad\xbf\xcc^\xe4\vS\xe11\xad\xffRrQ\x95jJf\n!K\xa8\xf9'\x9c\xf4\x8a\x97C\xec@\xae\xc3+PC\xee\xb3PJ\x92\xd7L\xd4\xc8!=\x11?\x91\xca\n\xd7\x14\xe2\x04\x1b\x97\xea8\x91\xbd\xe3\xf9\xdfiIf@\x98\x06<_R\x98\rZ\xcb6\xe7lޏaV(o\xc5%\xc0V\xaauy%\xff\xda\xc6{\xfa\xb4\x12[\xb6r44\x11\xccU\xc9\"\xacۺ\xf6k\xfa\xc6\xf5\xf0\xf9\x80\xe7j\x85\xf9\xb0\xf8D}\xed\x14\x83\x15\xb3砺\xa399\xa3\xd5y\x84\x93V\vNx\xbfw\xb8\xcb\xf4&c\x90\x19\xba\x8b\x16&34\xf9\x0e\xa0?\x18\n\xc89\xe4\xd2X\x96f\xf7ʞ\x19\xfb\xde_\xbd'\x81\x1d\xf9\xbbĶt\x05\xe8J\xd5ɜ\xf8\xd7q\xd96+4$\t\xff\xfe>r\xd2L\xf2\xbe\xd8r\x99\xfaa}Ҡ@)V\x9bb\xdd\xdc\xdfo\xa7\x80\v\xabk\xb6\xef\xce\xe2\xf3#\xba\xcc\xf9G\x84ÍJfE\xe3\xb1x\xeft\x19\xb8\xfbV!\x9f\xfc\xe5>8\x18\xb6\xe9\xa7\x03\xa3\xf1\xee\x1b\x84/\xb3Éx\xd5J\xa6m\xa5\xdc\xed\xdb3\xaa\xb1\xec&\xa6\xfbxȽ\xbc\xf5\xf5\xefSqRT\x85\f\xab\a\xeb\xf6$c1\xfct\xe5\x12-^\x0e(\x9cq\x8e\xf1K\x9a\x9c\vZ\x92\x15 \x03\xe4_?oƟ9\xbc\xe8>\x9d`.V\x91ˊ\xc9M\xb6\x96\xa5\xa4\x0f\xb6\x95\x99>E\xc3Yo7<\xd0\x1f\xe9\xe8\xb2\xea4\xe9\xf4\x9c\xf3\x1e\xed\xf8\xf0\xd7\xefiWݛ\xf8\x02~\xfd\xed\xea\xff\x01\x00\x00\xff\xff\xd9H\xdbA\x14'\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z_\x93۶\x11\x7fקع<$\x991\xa5\xc6m3\x1d\xbd\xd9\xe7\xa6smr\xbd\xb1l\xbfd\xf2\xb0\"V\x12\"\x12@\x01P\xb2\x9a\xe6\xbbw\x16 )\xfe\x81\xa4\x93<\x8e\xf9b\v\x00\x97?\xfc\xf6/\x16\x97e\xd9\x04\x8d\xfc@\xd6I\xad\xe6\x80F\xd2GO\x8a\x7f\xb9\xe9\xf6on*\xf5l\xf7\xddd+\x95\x98\xc3}\xe5\xbc.ߒӕ\xcd\xe9\r\xad\xa4\x92^j5)ɣ@\x8f\xf3\t\x00*\xa5=\xf2\xb0\xe3\x9f\x00\xb9V\xde\xea\xa2 \x9b\xadIM\xb7Ւ\x96\x95,\x04\xd9 \xbc\xf9\xf4\xeeO\xd3ﾟ\xfeu\x02\xa0\xb0\xa49\x18-v\xba\xa8J\xb2伶\xe4\xa6;*\xc8\xea\xa9\xd4\x13g(g\xe1k\xab+3\x87\xe3D|\xb9\xfep\x04\xfd\xa4Ň \xe7m\x94\x13\xa6\n\xe9\xfc\xbf\x92\xd3?J\xe7\xc3\x12ST\x16\x8b\x04\x8e0\xeb\xa4ZW\x05\xda\xf1\xfc\x04\xc0\xe5\xda\xd0\x1c\x1e\x19\x8a\xc1\x9c\xc4\x04\xa0\xdeg\x80\x96\x01\n\x11\x98\xc3\xe2\xc9J\xe5\xc9\u07b3\x88\x86\xb1\f\x04\xb9\xdcJ\xe3\x033C\x88\xe0<\xfaʁ\xab\xf2\r\xa0\x83G\xda\xcf\x1eԓ\xd5kK.\xc2\x03\xf8\xd5i\xf5\x84~3\x87i\\>5\x1btT\xcfF\x8a\x17a\xa2\x1e\xf2\a\xc6켕j\x9dB\xf1N\x96\x04\xa2\xb2A\xb5\xbc\xff\x9c\xc0o\xa4\x1b\xc3ۣc\x88և\x8d\xa7\xc1\x84y\x16\xe9<\x96f\x88\xaa\xf3j\x84%\xd0S\nԽ.MA\x9e\x04,\x0f\x9e\x9a\xad\xac\xb4-\xd1\xcfA*\xff\xfd_N\xf3Q\x136\r\xaf\xbeѪO\xcek\x1e\x85\xcepD\xc2\xdaZ\x93M2\xa4=\x16\x9f\x02ĳ\x80ם\xf7#\x92(\xb7;~\x11\n\x9b\x1e\xe8\x15\xf8\r\xc1k̷\x95\x81\x85\xd7\x16\xd7\x04?\xea<\xaap\xbf!Ka\xc52\xae`\x0f\x06ɺ\xd36\xa9:C\xf94\xae\xad\x855\xb2\x06\xfa\xeb\x7f\xe8\xb3\xd8Wn\t\x93\xf6Մ\xa2iX!\xb5J\x1b٫5=\xcb\xc0\xbaD*-\xa8\xc3\xda\b\x97t`\xac\xceɹ3\x86\xcfBzH\x1e\x8f\x03\x17)\xdaPX\xd3\x00\xaaL\xa1Q\x90\x05\xafa\x83J\x14\x14u\xe8-*\xb7\xaa-c\xac\xc2\xe6\xb5w\aӇ\xf2\xbe\x91י\x19a\x8aKw\xdf\xc50\x98o\xa8\xc4y\xbdV\x1bR\xaf\x9e\x1e>\xfcy\xd1\x1b\x06\xa6Ő\xf5\xb2\x89\xcc\xf1\xe9$\x9e\xce(\xf4\xf7\xfc\xbf\xac7\a\xc0\x1f\x88o\x81\xe0\fD.pQ\xc7W\x125\xa6ȑt`\xc9Xr\xa4bN\xe2aT\xa0\x97\xbfR\xee\xa7\x03\xd1\v\xb2,\x06\xdcFW\x85\xe0ĵ#\xeb\xc1R\xae\xd7J\xfe\xb7\x95\xed\x98p\xfeh\x81\x9e\x9c\x0f\x8eh\x15\x16\xb0â\xa2\x17\x80J\f$\x97x\x00K\xfcM\xa8TG^x\xc1\rq\xfc\x14\xacI\xad\xf4\x1c6\xde\x1b7\x9f\xcd\xd6\xd27\xe98\xd7eY)\xe9\x0f\xb3\x90Y\xe5\xb2\xf2ں\x99\xa0\x1d\x153'\xd7\x19\xda|#=徲4C#\xb3\xb0\x11\x15R\xf2\xb4\x14_\xd9:\x81\xbb\xdegG\x8a\x8eOH\xa2W\xa8\x87\xb3*{\x02֢\xe2\x16\x8fZ\xe0!\xa6\xee\xed\xdf\x17\xef\xa0A\x125\x15\x95r\\:\xe2\xa5\xd1\x0f\xb3)Պ\r\x9f\xdf[Y]\x06\x99\xa4\x84\xd1R\xf9\xf0#/$)\x0f\xaeZ\x96ҳ\x19\xfc\xa7\"\xe7YuC\xb1\xf7\xa1d\x81%;\x14\xc7\x011\\\xf0\xa0\xe0\x1eK*\xee\xd1\xd1\x1f\xac+֊\xcbX\t\xcf\xd2V\xb7\x10\x1b.\x8e\xf4v&\x9a*\xea\x84j\x87\xf1ma(g\xcd2\xb9\xfc\xaa\\\xc9:\x93\xac\xb4\x05\x1c\xad\xef3\x95\x0e\x01\xfc$3\xcap\xd1%\xb3\xe3\xe7uJP\x83Xu\x02y\x9d\xef\\\x9d\xa8\x8a~\xa2\xea>\xa3\x1ci\xc9h'\xbd\xb6\x87c\xa6\x1c\x9a\xc4I\xed\xf0\x93\xa3ʩ\xb8e{\xf7\xe1M\x90J0\xefԚ4\a\xa3(5\x00\xd5j\xad\xd9\xc9F\xea\x80\a\xcf\xeb\xd8\xce\x1d\xf9\xf4f\xd5\xc9\xcc&\x15\x1ckL\xe8֒\xc3m/\xb5.\b\x87l\x1a-.l\xfaIׁ\xc3Ҋ,\x85\xfc\x1fì\xd1!\x18{\x94\xaa\t\x1f\xb1\xe4\x06\xaf\x13\xfbXr\xb89\xa5\x9a\xd3v\bgRR\x12𫧇&\xed4\x96UC\x1fe\x96.?I\xb3\xe0g%\xa9\x10!Q_\xfev\xd2B\xf8yXE\x10!\xf6z\r\bFRN\xbd\xbc\aR9O(\xeaA\x0e7\x96\xea\xb9\x171\xa6\x9e\x04\xc9\xcf1?\xb2J\x009\xc6K\x01\xff\\\xfc\xfbq\xf6\x0f\x1d\xf7\x01\x98s%\x14\xce*T\x92\xf2/\xda\xf3\x8a '-\t>}дD%W\xe4\xfc\xb4\x96F\xd6\xfd\xfc\xf2\x974\x7f\x00?h\v\xf4\x11\xb9\xe8\x7f\x012rަ\x8d\xc6j\xa4\x8b\x1bo%\xc2^\xfaM\x00j\xb4\xa87\xb8\x0f[\xf0\xb8e\x8f\x89[\xa8\b\n\xb9\xa54\xfb\x00w\xa1x:\xc2\xfc\x8dC\xca\xefw\xf0M\f\x12w\xfc\xf3.\xc2h\v\x84n\xd49\xc2\xf1\x1b\xf4\xe0\xad\\\xaf\xe9Xh\x8f\x8c\x85\x13\x1a\xa7\x82oA[ޫ\xd2\x1d\x11A0\xeb)\x06b\x12#x?\xbf\xfc\xe5\x0e\xbe\xe9sp\xe2SR\t\xfa\b/\xd9\xc7\x037F\x8bo\xa7\xf0.\xd8\xc1Ay\xfc\xc8_\xca7ڑ\x02\xad\x8aC\xac7w\x04N\x97\x04{*\x8a,\x96b\x02\xf6x\x00\xbd:\xf1\x9dFEl\x9a\b\x06\xad?[\x8e\xd5<\x9cw\x9aq}\xd2<\xcf\xf3\x97P\xaf<\xcb{\xbfX\xae\x7f&\x13\xa10\xff\x04&\xbaG\x9d\x1b\x98\xd8VK\xb2\x8a<\x052\x84\xce\x1d\xf3\x90\x93\xf1n\xa6wdw\x92\xf6\xb3\xbd\xb6[\xa9\xd6\x19\x1bc\x16\xb5\xeef\xa1e3\xfb*\xfcs\xeb\xc6C\x9f\xe5Sw\x1f\x84|9\n\xf8\xebnv\v\x03M\x1d\xfd\xfc\xdcu\x92\x87E]\xd9\re\xb2\xcf\xef72\xdf4\xa7\xaaN\xb4-Q\xc4p\x8c\xea\xf0\x85|\x87y\xae,#:du\xc31C%\xf8\xffN:\xcf\xe3\xb7\x10[\xc9O\n.\xef\x1f\xde|I\x8f\xaa\xe4-\x91\xe4\xc4i!>\x1f\xb3#\xaa\xacD\x93\xc5\xd5\xe8u)\xf3\xc1j\xae\x95\x1f\x04+i%\xc9^\xa8\xfe\xde\xf6\x167U{\xa2\xean\xd7\\Uv;\x85\xc6m\xb4\x7fxs\x01Ǣ]\xd8`8\xea\xb0.:\x1bY\xec\x12gk\xcdsx\x82o=\x9e\x8e\\}P\xfd\xd5\r2m\xe5Z*,\x8e\x110\x1c\xc5\x14\x96\x18~%t_\xa21R\xad\xaf\xc2\xda\xf4\x8b\x16\xe4\xf9\xf8\x9e(\x9c\xbb\xed\xecs\xe5\xf5Y\xbb\xbb\xecR\xef\a@\x00-\x01\xf2\x9eXC[:d\xb1\x8a3(\xb9\x04\xe3*\xab.U\x97\x04hL\xc1uR\xac\xccR\xbe\xdet\xbfr\xadVr]w\"\xc7L\xa9\xaa(pY\xd0\x1c\xbc\xadN\x1d\x82\x92\xee\xd3m\xbc]\xd0\xf8\xfb\xce\xd2F\xdd\x17Z\x7f\xe9]\xf5\x1a\x82\xe3͐\xaa\xca1\x94\f\xb6\xdaHL\x8c\xb3\xb1\x8f\x1c\x9d'\xee\xee\xae1\xa9\xe8I\x178\x88g\xd0\xd4\x01\xbevĺ\xac\xaf\x8f\xac\xd1\x1d\xd3\xd9\xf1Z\a\xe5\xa35\x9fQ\xfa\b\xb3t\xafb\xb0\xc6h1\x19\x92֍m\x83\xc9cd\x1aN\xf4\x9d~0\x1b)xV\x9b'4\x9e\xafi\xf4\xc4륚\xf7\x98V}s\xe9\xc4\x05\xfbͭ\x1e>\x13\x1aO\xa2\xed\xc9\xdf\xd2\ay5\x14\x12\x1a\xb4V\xd4N\"Kj\x9b\x06\xb5\x9d\xd8c\x1b#\x86lc\xc9`\xd2\" 4\xd9]h4~\xed\xa24\xe9\xa0r$Bl\x1d}|$\xa1\xb9\xf3\x11\xe8)\xe3\xf7o\v \xe9\xe6Q\xbc\xee\xea\xdej\xdc\xd4I\x1a\x8b\x19s\x88-mᾥ\xb9hKQv\x94\xd7\x12\x16ő\bGX>a\xafP\x16$\xa0\xbd̽\x9a\xf9\x04\xe8qq\xf39\xc9/\xc99\\_\nZ?\xc5U\xb1\x93U\xbf\x02\xb8ԕ?a\x95_\xbbڵ\xae\xca\xc9J\x8bKH\x1e\xb5\b0\xd4\xe9+\xac1\x9a\x84Z\xba\xd7ZWa\fM\xc2KM?^\x93\n5-\xe4\xf3\xb1\x06\xce\xe4\xb0G\xda'F\x1b\x0fNL=\xd5a!15\xba_\xefN\xc6\xcel\xaa\xa6i\xe6\x922\xdb\xcb\xeb\xc4\xdc\x0f\xc1]\xaeb\xbb\xc6wK@h\xfb\xba\x1b]41 \\:\xab\xaa\\\x92eU\x84k\xedF'm\x05\x8cJt5\x97:\x9c\xb7\x12\x9a4\x1cE\xd5\xfd\xa5\xba!\x1d\xbc\xdck\x10ҙ\x02\x0f\xedf\u0089\x88]:ݞ?\xfaU\x13\xab8\xf3\x9c\xa8\xdb\xcew~\xdb?\x02H\x9f\xf7R7\xf9\xfdg|'?\x98o/\xf7?\xcf\x17\xceԝ\xfd?\xb6\xb8\xc5@\x16=\t\x97\x92E\xfd\xc7\x1f\xd7\xc7\xf8\xfeg\xfe\xc8\xf0\x9edo4\x18\x90\x8b\x8e\xec\xfa\n\xa9;R-\xdb\xfb\xd59\xfc\xf6\xfb\xe4\xff\x01\x00\x00\xff\xff\xec\xe3\xc3\ac%\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc=[s\xdb8w\xef\xf9\x15\x98\xf4a\xdb\x19\xcbi\xa6\x97\xe9\xf8\xcd\xf5:\x8d\xfb}\xebx\xec4\xfb\f\x91G\">\x83\x00\x17\x00\xa5h\xdb\xfe\xf7\x0e\x0e.$%\x90\x84d˛-^2\xa6\x80\x03\xe0\xdc\xcf\xc1\x01\xb2X,\xdeц}\x03\xa5\x99\x14W\x846\f\xbe\x1b\x10\xf6/}\xf9\xfco\xfa\x92\xc9\x0f\x9b\x8f\uf799(\xaf\xc8M\xab\x8d\xac\x1fA\xcbV\x15\xf03\xac\x98`\x86I\xf1\xae\x06CKj\xe8\xd5;B\xa8\x10\xd2P\xfbY\xdb?\t)\xa40Jr\x0ej\xb1\x06q\xf9\xdc.a\xd92^\x82B\xe0a\xea\xcd?^~\xfc\xd7\xcb\x7fyG\x88\xa05\\\x11\x05\xdaH\x05\xfar\x03\x1c\x94\xbcd\xf2\x9dn\xa0\xb00\xd7J\xb6\xcd\x15\xe9~pc\xfc|n\xad\x8fn8~\xe1L\x9b\xbf\xf4\xbf\xfe\x95i\x83\xbf4\xbcU\x94w\x93\xe1G\xcdĺ\xe5T\xc5\xcf\xef\bхl\xe0\x8a\xdc\xdbi\x1aZ@\xf9\x8e\x10\xbft\x9cv\xe1W\xbd\xf9\xe8@\x14\x15\xd4ԭ\x87\x10ـ\xb8~\xb8\xfb\xf6OO\x83τ\x94\xa0\v\xc5\x1a\x83\b\xf8\x9fE\xfcN\xc2B\tӄ\x92o\xb8Q\xbb\x1aD<1\x155DA\xa3@\x830\x9a\x98\n\bm\x1a\xce\n\xc4;\x91\xab\x1e\xa40J\x93\x95\x92u\amI\x8b\xe7\xb6!F\x12J\fUk0\xe4/\xed\x12\x94\x00\x03\x9a\x14\xbc\xd5\x06\xd4e\x04\xd4(ـ2,`ٵ\x1e\xef\xf4\xbeNm\xcc6\x8b\v7\x8a\x94\x96\x89\xc0m\xc1\xe3\x13J\x8f>\"W\xc4TLw[\r\xdb#T\x10\xb9\xfc\x1b\x14\xe6r\x0f\xf4\x13(\v\x86\xe8J\xb6\xbc\xb4\xbc\xb7\x01e\x91Uȵ`\xbfG\xd8\xdan\xdcNʩ\x01m\b\x13\x06\x94\xa0\x9cl(o\xe1\x82PQ\xeeA\xae\xe9\x8e(\xb0s\x92V\xf4\xe0\xe1\x00\xbd\xbf\x8e_\x90xb%\xafHeL\xa3\xaf>|X3\x13$\xaa\x90u\xdd\nfv\x1fP8ز5R\xe9\x0f%l\x80\x7f\xd0l\xbd\xa0\xaa\xa8\x98\x81´\n>І-p#\x02\xa5\xea\xb2.\xff.\x12u0\xad\xd9Y\x1e\xd5F1\xb1\xee\xfd\x80\x02q\x04y\xac\xa88\xc6s\xa0\xdc\x16;*\xd8O\x16u\x8f\xb7O_\xfbLɴ'J\x8f7\xc7\xe8c\xb1\xc9\xc4\n\x94\x1b\x87\xacia\x82(\x1bɄ\xc1?\n\xce@\x18\xa2\xdbe͌e\x83\xdfZЖ\xdf\xe5>\xd8\x1b\xd4:d\t\xa4mJj\xa0\xdc\xefp'\xc8\r\xad\x81\xdfP\roL+K\x15\xbd\xb0DȢV_\x97\xeewv\xe8\xed\xfd\x104\xe2\bi\xbd\x16yj\xa0\x18H\x9a\x1d\xc6VA]\xac\xa4\x1a(\x19;d\x88\xa3\xb4\xf0\xdb洈U\x8b\xfb\xbf\xccq\x99m\xff\x1eG[~\xb3+k\x05\xfb\xad\x05T\xa6N\xfc\xe1P_\xa9\x9ej\x1f6\xcbF\xfb\xd4\x1dE\xb4m\xf0\xbd\xe0m\te\xd4\xeb\a\x1b\xcc\xd9\xc6\xed\x01\x144z\x94\t+D\xd6\xfaؽ\x88\xeeWT\xe0T\x01\x11\xd2$\xe01\xe1\xe0\x11&\x10\x03I\x9a`G\x03ubœ[&D\xb4\x9c\xd3%\x87+bT{\x88F7\x96*Ew#\xd8\n\x1e\xc0\x8b\x90\x15\x81xU\xc3Y\x81$\x8f\n\x05\xf1\xf5\xe7E\x15\xd3VQ\x86]>HΊ\xdd\f\xben\x93\x83\x82\xb4z\xd9\xf5;$K\xa8\xe8\x86I\x95\x12\x03\xa9\xb0kϞwjZZ-\xe9\x81\xec۸\xcc\r'\x91UI\xf9<\xc7\x10\x9fm\x9f\xce:\x90\x02\x1dʸ\x15Omo\xbb\x97@\xe0;\x14\xadI,\x93\x90\xb2E\xd3$\x15i\xa46\xe3t\x1fW]\xa4\xef\x1c\xa5~\x9c`\x9a\x83\x9d%Y\xdd5\xaf\x84\x03Q-\x0e\x06\nY\n\xb0ۨ-Q\xbb\xbeJ\xb6\xae\xef(RȒj(\x89\x14\xa33#\xbb\xb4\x1c\xb4\x9f\xabD\xce\xe8\xf4\xd0E\xb7\x7f\xf4x\b\xa7K\xe0D\x03\x87\xc2Hu\x88\xcc\x1c\x94\xba\x96\xa3XGP\x99ЦC\t\xe860\x01\x92XN\xdfV\xac\xa8\x9c\x87a\xd9\x13\xe1\x90R\x82\xb6\xda\x04]\xe6\xdd\xd8&\xc9\x1c\xf9\xfd$Sڣk3b\xb5\x0f/\xa5Q\xba\x96\xa1\x86\xbb\x96Dm\xa7{\x0ft\x8b\xffn\xe4\xe4\xb6\xff\x7f\"6\x18\x93\x13\x98vB\xfe\t\xba\x9f\xd9<=ʷ\x18ၾ$w+\x02ucv\x17\x84\x99\xf0uN\x12(\xe7\xbd9\xfeĴ9\x9e\xe93I\x93#\x13g\"L\x9c\xe2OH\x174\x19O\xdebd\xd3\xe4\xaf\xfdQ\x17\x84\xad\"\xd2\xcb\v\xb2b܀\xda\xc3\xfeI\xaa>P\xe65\x90\x91c\xf5\b\xe6\tLQ\xdd~\xb7.\x8e\xee\x92`\x99x\xd9\x1f\xec|\xe3\x10A\f\xcd\xf3\f\\\x82\xf12SPc\x1cN\xbe\"6\xbb/\xe8T_\xdf\xff|\x18+\xef\xb7\f\xce;\xd8Ȍйv\xbd\xb7\xa3\xfe\xfa|T\x10~A\x1f(\x06U.\xe7rA(y\x86\x9ds]\xa8 \x96>4tΘ^\x01&\x7f\x90Ϟa\x87`\xd2ٜÖ\xcb\r\xae=C\xc2\xf5O\xb5\x01\x0e\xed\x9a|X\xec\xf0d? \"0\x86\xcfe\x03\u05fc($r'閩KB\v\xb8?a\x9bY\xacҟ\xa3\x9f\xfaD\x0e\xf8I;ZZ\x89\xa9\x98\xcfij@\x99\xc9%\xa8k\xdf(ge\x9c\xc8\xc9ȝ\xb8 \xf7\xd2\xd8\x7f0@\xd3\xc8(?K\xd0\xf7\xd2\xe0\x97\xb3`\xd4-\xfc\x9c\xf8t3\xa0\xa0\t\xa7\xe5-\xc2\xfa9?g\xd3,\xb7E\xdc3M\ue10dW\x1cJ2\xa7\xc2\xf4\xae\x9b\xceMT\xb7\x1a\xd3uB\x8a\x05\xda\xcc\xe4L\x1e\xdfR\r\xd0\xfd\xe2I\xfd\x84_\xad\xb1p\xbf\xb8$3\xa7\x05\x94!\xb2\xc4\xec'5\xb0fE\xe6|5\xa85\x90ƪ\xf0<\x8e\xc8T\xac~7ǱO\x9e\xf5\xee\xb7\xef\x8b\xe7\x98/XX\x93\xb3\xf0\x10\x8c\xac3p\xe0uw9\xbf\x9f\x85\x95ٌ^\x81\x13f\xbb\x8e$Gǻ\xe6 \xe5\x05\xe8@+\x8e.\xce,uiY\xe2\x11\x1a\xe5\x0fGX\x94#x\xe1X\xd5\xd0[\xbb3\xc15m\xacZ\xf8okiQ\x9a\xfe\x974\x94)}I\xae\xf1\xa4\x8c\xc3\xe07\x9f\x87\xeb\x81ɘ\xb2\xb1SY\xfe\xd9Pnm\xbfU\xe0\x82\x00w\x9e\x80\\\x1d\xf8E\x17d[I\xed\xcc\xf6\x8a\x01\xc7\xf3\x8a\xf7ϰ{\x7fa\xa7\x9f\x9d\xb2\xafd\xde߉\xf7·8P\x18\xd1ᐂ\xef\xc8{\xfc\xed\xfdK\\\xa9LN\xcd\xec6`њ6y\x1c*\x92\xc9\xfa\xae\r8\xa6\x9f\x9b\xef\x92\xf2\xdeɞ\xdam\x16\x8b6R\x9b\xcf\xe9\xbc\xe1\xc8z\x1e\u0088\xa1g\x9cȱ\xcdF\f>\x8f\x16\xf5\xbdu\"W\x06\x94\xcf%:\x1b\x10\xe2\x8f\x17Ff\xa9S\x99\xfebc2\x90\xc6\xfc\xaeE\xf0\f7\xb9\x83\x9b\x9c%\x1e\xe3\xb0Z\xbc\x1c\xe9\xed\xdf~\xef\xe53\xad\xe4ڿ\xfb\x1bym\x87\xba\x90uM\xf7O5\xb3\x96z\xe3F\x06\x9e\xf6\x80\x1c\xf5պEyε\xc8\x1d\x0f\xe1\xf9喙\x8a\tB\x83\xda\x00\xe5\x19\x8a\x92F\xa6rةVQM\x96\x00\"\xa6\xe8\x7f\x04W\xa2f\xe2\x0e' \x1f\xcf\xe0zDt\x9d\xd3ٽ\x894\x89\x94\x8f\x1f\x9c\xc9jdI\xb6\x15(\x180\xc6a\xde\x1d=U!M/eq\x84C\xda\xc8\xf2'MVLi\xd3_\x82&\xadΥ\xf5\x91\xe4\xb3\xeb\xfe\xcaj\x90\xad9'\x82o\xbbi\x06g\xcd5\xfd\xce\xea\xb6&\xb4\x96\xad3\xe6\x86\xd5\xf1TףwK\x99\x89\xc7V\x98\xbf1Ғ\xa0\xe1`\x80,a\x95>\xefM\xb5B\n\xcdJP\xa1J\xc1\x91\x8dI+\x98+\xcax\x9b:%J\xb5c#`q\xab\xd4I\x01\xf0\x177\xb2\x97w\xac\xe4v\x88\xa0̽\xe3A\x1a\x10\xb6\"\xcc\x10\x10\x85\xc58(\xa7\x92q\n\x8f\fD\r\xcb\xd5sy\n\xdc6\x10m\x9d\x87\x80\x05\n$\x13\x93)\xb7~\xf7O\x94\xf1s\x90\xcdr\xde'\xa9\x1e\x81\x96\xa7\xe4h~\xed\r' t\xab\xf0\xf0\xdf\xe9\x8e-\xe3yk\xb6\x94#\x9c\xb6\xa2\xa8\x00\x95\x90\x18\xea\x06\a\x9e\tm\x80\xe6\xf2\x82\xf5\x8aZ!\x98X\xe7\xd1.;\x11\xda5\x87\ua954\x1c\xe8\xf8)d\xd7,\xae\xdf@\x13\xfd\xdaM\xf3BM\xd4\x11\xc1\x1d\x9b#\x1d\xb2)j\x95\x16\xa1\xc6@\xdd8\x91\x93D\xb5\xa2o]Π\x88\x8e\t\xc3\xfd*^3\xbef\x82e\xd0v@\xd7;\xc1L\xdfy\xb4 \xce\xea<\xda\t\xa2;pJ\x86\xedn\x00\xc0\nh\x88Cp\xed\x91k\x8ep$\x97@hYB\xe9r\x97\xd6\x15\xf1a\x89+|\x1b)nH\xee\xeexO0\x8b\xb2\xa1\r\x82N\xccê\r,Z\xf1,\xe4V,0\x18\xd7G\xeb\x90\x13\xb3T/\x9dޜ\xac\x8c\xe6\xf5K\xbe\x9a\x9e\xd3BC~\xcd\xe7\xa9\xe0?\x9dA\xcbd\xf3\xcdQ\t\x8f).\x98\xd3k\xae\x00{\xe4\xc7\xd9UL\xcd?1\xd8\x1fJ߸b\xe9\x17\x95\xc5ݥA\xf5\x9c\xc2m\x05\xa6\x02\x15J\xb3\x17X\x92^N\x9e\x90v\xc1K\xac\x93\xb3L\x15\\dW\xfe\xb9W9\x87\xd1M\xcb\xf9\x85\xe5m\xda\xf2d8l$\x8a\xd8!geՏ\xa5=\x86\x9c\xea\x8bl<\xf6+-\x86\xf5\x85\xb1\n\"\x14\x18\xca0\xb3\xa7qj\xbfXX\xda;\xdf\x1f\x96S`\xfe/,\xff\x0f/=̨\x94\xc8Gcn\x95fDb\x02V\x82\xc1zh\xec\xea+|?_\xe8\xfbc\xe1\xd4@\xfd\xa5\xf1\x123\xea\xc2f\xa05\x01g\xaf\xde\x04\xadA\xab\x9d+\x10\xed\x80\xcf\x19\xda\xf1ׅ\xbb\x05\x11\xc0\xa4\xf8\xf5k\x05A|}\xf5>\xd3\xe4\x9fI%\xdbDU\xdf\x04\xcaf\xaa;\xe67<(\xf4\xf0\a\n`\xe8\xe6\xe3\xe5\xf0\x17#}\xd9\af\xd1\x12\x800(\xea2\xb3L\x94l\xc3ʖ\xf2 \xb5\xdd\x1d\x02\xc7@\x1d\x9f%\xa0IE\x04\xe3\x8e\x01\xc3\xf8\x01Ñ/\x8d;\x969Z\xc5M\xfb\xa2y\xd5!'ׄ\fk>F\xac\xe1\xb1\xc7\x17\xafR\x05\xfb\x87\xd4z\x1c_\xe1\x91\x13I\xccTs\x9cPÑY,\xf6\xe2\xf3\x96\x9c*\x8dcb\xee\xb3Ud\xbc~\x1dF\x16~\xe6k.\x8e\xc1\xce\xd9\xeb+ް\xaa\xe2mj)2+(^\xaf\x142/\xfa<\xa9\x14`>`\x19\xaf\x82\x98\xad}xQ@sҖfk\x1a\x8e\xa9d\x98\xa5N\x9e\x98\xbdY\xad\u009bU(\xbcm]\xc2$\x17M\xfexL\xe5A\x8c\x93~\xa1M\xc3\xc4\xfa\x90)rYg\x92m\xe6Y\xe6~o!\x03\x9e\xe9\x873]t8\x12\xfa\xba\xeb҉H2\xa4-\x990\xf2\x92\\\x8b\x9d\x87\x9b\x80\xd3\v\x1f\x854\a\x17\xd9첶\x8c\xf3\xfem-\x04;\r\xcaߙԴv\xab\x1a\xf3\xf6\x93t\x95j\xe0\x94\x9f\x148~ك\xd1ώ\xbe\xa5\xe7_\xb7ܰ\x86\x83\xf5\xe86\xacL\xde!3\x15\xec\"\x92\xff&\xf1\x86\xd4r\x87\x90\xbe<FY\xbc\xdc\vb\xa8&[\xe0\x9c\xd0\x14w\x1cl\xbfp7\x93\v\xb9\xc0+\x81\x96\xbc\x81I\xfc}\xe6\v'\xc5x\r\f\xa9W'\xe0\x16T\xe0\xedf\x9d\xd8Ȩ9\xccѢ\a~\xb9\x8b.\xf0\xdbo-\xa8\x1d\x91\x1b,a\xf0\xde[wW\xc1\xab\x1bmc̠\x00\xbd2\x1e;T8\be:\x05E\xae\x85\xf3%\xf6׃c\xac\xe6\xebB5\xab\xcem\x14\x96\x9ccd\xb8\x90qtb\u061c۟[\xd4\x7f\xde\xc0\xed\xf8\xd0m\xd6W\xca\xf7g\xff\xa0b\xfdS\x8a\xf4\xf3\x8e\x83f\x8b\xf2\xcf\x15\xc8ͅr\xd9\xdek^\xd1\xfdq\x87\xa8g,\xb2?Gq}&\xa6r\x8a\xe9\x8f\xc3\xd3\x1b\x14Ͽi\xd1\xfc[\x15\xcbg\x17\xc9g\x9dcf\x1fZ\xe5\x1e3\x9eX\xf5=\x7f\xea>]\xf4\x9eQ\xec\x9eq\x926\xbf\xc9\x13\xb6\x97Q\xcc~\\\x11{\x06\xcdrE\xf1\r\x8b\xd5߰H\xfd\xad\x8b\xd3g8k\xe6\xe7\xe3\x8a\xd0O>\x81\tG\xfd\xf7\xb2\x84\a\xa9\xcc\\p\xf2\xb0\xdf?q\x92\xda\v\xd8$/\x89\b]\x13\xbb\xc4\x10Ç\x17\xa7m*}\xe8\x19\xdc\xe9_di\xd76w\xc6\xf2\xb8\xd7\xfd\xe0\xae\xf2\n\x14\b\xf7\xcc\xc7\x7f>}\xb9\x8f\xf0S>\xaf\xf7\x8c\xf7\x9e\x97p\x1eL\xe9\x91\xe3\x8f\xe6|1\x93\xc3\x16\xfa\x00\xaf|.B\x1b\xf6\x1f\xf8\xaa\xdb\v\xd2A\xd7\x0fw\b#\xf8i\xf8L\\\xac\xa2\x88'\x96K\xb0\x16+\xa2jT,\xeeV\x03\x88Ê\xdf\xfe3JP\xba'\xb3\x82\xc5d\xa1\xc6\xcb\n\xdeÝ[\xc7\xd8,\x9f\xac\xd3(vD:\x8e\xac\x98*\x17\rUf\x87l\xa3/\x06k\bff*\x9d3\xaaX\x0f\x9f\x01K\xa27\xbc\xfe\x85g\x91\xbbfxڻ\x8f\xbbS\xd61~\xffd\xf6\xe6\xc9+\xaec\xdcb/\x10S\x89\xcf\xc9\x02\x93WK\x93yM\xf4\xf0\xed\xa4\xb4\xcbc\x1c=\xad\xe7l\x14\x1dRM\t0v<\xaa:-h\xa3\xabēK/\xd3u\xf8\x1a\x99\xa1\xa6}\xc9&\x1d\x80\xc1>YQ\xf5\xb4\xd5\x16\x82>\v\xdbFi\xc5a)\xddnm\xb3\xab{a\xfc\xa2\x97)x\x9b#\xe1\xcc\xe7\\N~\xc8šgD\xfd`\xf6˪\xb6CL\x9dp\x18<\xeb\xdae\x14\x19O;\xb1\x99π\xe4\x19\x8c\x13\x9e\xfe@|\xe5\xe2\x8a$_\x04\xc9|\xf5\xe3\x0fE\xf4\x84V\xd3E\x05e\xcb\xe1\xd47\xff\x9ez\xe3\xe7_\xfd\v\xb3e\xbc\xfbg\x91\xdd3\xd0\xd6g\x1e\xbe/\xe8)\xe1!\xf7)9\xe6\xf0ap\xe0\x9e\x17+\xdcK\x94E\x01Z\xafZ\x1e\xaa\x94\n\x05\xd4@\x19\xba3\x1dW|T\x9dM\xdbpIKP7R\xacX\xe2\x84d\x80\xd6\xff\x1at\xde\xe3\xd9\x02?\xb6\xaa{\xdaq\xf2Y\xbc\x17i\xae\x86*\xca9\xf0O\x8c\x83\xfeYn\x85]W\x86@>\xa4\xc6\xf5\xeee\x15\xad\xb2f}GD[/\xad\x93\vƌ\a\x8b+\xa9\xa6+\xa4\x1dޙ0\xb0\x86T|\xbdU\xcc\xc0SC\x95\x06\\Q\xc6\x0e~\xdd\x1b\xe2\xa2\xcf\x15\xa7kW\nW\xb2\x82\x1a\x88\x06\x18g\x18[>\x8e\xd7\b\x8b\xef\xb02I\x8e$\xbd\xb2\x85z\xecJƨX\x8f=/\x9a0\xd5\xc9\aF\x9dE.hc\xf0\x02\f\xd2\x11\x89h<\f|\xb4w\xef\x8d\xd1\x01\xd8qN\xf3e̾`N\x1bZ'\xa2\x84y\xbdss\b\x06\x9f\x05Ve\xaf\xee\xae\xff\xc0b,\xb0#[\xaac1u\xd2\xf7\xee`;0\xe8\xaa[\xd0P\x12\u0600 V\x14)\xe3PNq\xeaWL$\xab\r\xa8\x9ft\x84\x83\x95\x80\x96ş\fU&.\xfdЏYIUSsEJj`aG\x9f溥\x9fIU\xea\xc4\xe3@\xbc\xd9\xe6ţ\b\xd7n\xac\xf5s\xf7\xd1jК\xaeC\x10\xba\x05\x05d\r\xc2\xe2=\xe6\x16\x93\x1eS\xb8\xd2\xe7\x8dE,-\xb5(\xa4\x85i\xa9\x9f\xc0\xb9p\xf1\xf44\xbcO\x8cQ\xeczTE\xa7U\x85\xbf<\xf8\bT\xef?w}\x80\x8bO\xfd\xbe>I\xecv\xec\xceF\xa8+\xf0\xc4\a\x8f\r\x8b\x91uJ\xa6\x8dę\x8f2'\x95\x94\xcfYn\xf6\xe7رK'1\xe1X\t\xafL.ekz~\x8eGxb\x99\xf8\xfc\xe7+\xdb\x17\x84y\xed.P\x8d\xe5V\xf3<\xbd\xcf\x03H1\xbc\x95\x86\xf2`d,_\xc6\x0e\xd5\xc4\x03\x02O\xe1\xf1d\xcew\x17\xfb\x90\xf7^e\xef`W\xddS\x9e^\x13t\xd7\xc7\xc7Ҫ>\xeb\x97\x04\x12_\x01\xed|\x92\xb17\x17\xe7\xec\x1fB\xfd\x84\x8b\xca\xc0\xf1\xe7\xae\xf7\x18\x1e\xdd2\x9d\xc3\f\"\x1di\x12\f>L\x15%ㄥOx\xa9ME\xf5\x9c{\xfa`\xfbD\xb7\xa3g\xae\xa2\x13\xfa8\"\x95\xe9{\xae\vr\x0f\xdb\xc4W\x87,<\xfdB\xa9Jt\xb9\x13\x0fJ\xae\x15\xe8C\xa6[\xe0}F&֟\xa4z\xe0횉/\xe3\x95\xdfS\x9d\x1f\xa82\xcc2\xad[Ob\xecM\xb0q\x89\xdf\xe6G\x8f\xff\xc0\x04\xe5\xec\xf7\x94.\xef\xff87Ä\xbek<\xf2N\xb1P\x01\xf1s\n\xd0k\xe8\x9ft\xcf\xfc\x84y/ɽL\x8a\xb1? fC\xa0L\x93%h\xb3\x80\xd5J*\xe3\xf2\xf7\x8b\x05a\xab\xe0 Y\r\x81q\xa2{͞\xb0T\xe2=\x1e\xbd\x05\x87e\xe5S\x89\n\xad\x0e\x86\x9c5ݹ\x8c$-\n\x1b\x13\xc0\amh*6y\x91\x9e\xc6P\xd5\xcbJ\x8e\n\xb9\xeb\xf7\x8f9\xbe\xa8>\x10\x9cC\x1d^gw\x06\x9d\x8f\x9di\r^\xcb \xdab\xef\x14eB\x9c\x1a\xbb\x1b\x0f\xbb\xf3L\xcd\xd7\beL=\xfa\xfd\r\x1e\xe2\xf6\a\xac\xbe\x93%[QQ\xb1\x1e\xbd\xd0V)ٮ\xab\xc0\x9bc\x0e\x11)[\x8c\x9c\x1bT\x05:\xfc\xc7!\xa6U\xa2wh\xe7k,ƴt\\\uee0f\xf2\x02E\xad\xba\x8b-\x9d\xaa\x9a\xb0\xf9\xd9Y\xc2\x11\x88\xb3\xb6?\x01\x91\xea\x9d(&\xaf\xe0\xf8@\x9bM\xdc՝\xc2P\x12\tQ\x1b\xbf\x1a\x12\"\xc41$\xf4}\x89.\xe2\xf9a02棜\x88\x8ei'\x06\xb78\rj~\xd3}'h\xe8\xee\x1c\x87\x0e=\b\xfeNJ\xbb\r \x1c\x13\xf9\xe2\xdc\xe9\xb8\xf7ǍX7\xd1ۺ=9v\xfd\xb6\ac\xef\n\xa4\x8db\xbbiB\xbc\xf9\xf7l\x95\x92\x17\xf7\xbf3-9\xfc\xc3\xc1\xafo|\x95qK\x95`b}\x12F~\xf5c\x13\xf1\xbc\a{Έ>\xac\xfc\xd5b\xfa\xa4Y:\xf8\x88\f^\xf6\xf0\xecg\xf2_\xfe/\x00\x00\xff\xffP\a\xb5\x16Cm\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]s\x1c)\x92\xef\xfa\x15\x84\xeea?B\xdd^\xc7}ą\xde|\xb2gO\xb1\x1e[ai\xf4\xbctU\xb6\x9aQ\x15\xd4\x00\xd5r\xdf\xde\xfe\xf7\x8dL\xa0\xbe\xba\xe8\xa2Z-ygǼت\x86$\xc9L\xf2\x03\x12X,\x16g\xbc\x12\xf7\xa0\x8dP\xf2\x92\xf1J\xc0W\v\x12\xff2\xcb\xc7\xff6K\xa1\xdelߞ=\n\x99_\xb2\xab\xdaXU~\x01\xa3j\x9d\xc1{X\v)\xacP\xf2\xac\x04\xcbsn\xf9\xe5\x19c\\Je9~6\xf8'c\x99\x92V\xab\xa2\x00\xbdx\x00\xb9|\xacW\xb0\xaaE\x91\x83&\xe0\xa1\xebퟖo\xffk\xf9\x9fg\x8cI^\xc2%3\xd9\x06\xf2\xba\x00\xb3\xdcB\x01Z-\x85:3\x15d\b\xf4A\xab\xba\xbad\xed\x0f\xae\x91\xef\xd0!{\xeb\xdbӧB\x18\xfb\x97\xde\xe7\x8f\xc2X\xfa\xa9*j͋N\x7f\xf4\xd5\b\xf9P\x17\\\xb7\xdf\xcf\x183\x99\xaa\xe0\x92}®*\x9eA~Ƙǟ\xba^0\x9e\xe7D\x11^\xdch!-\xe8+U\xd4e\xa0Ă\xe5`2-*K#\xbe\xb5\xdcֆ\xa95\xb3\x1b\xe8\xf6\x83\xe5g\xa3\xe4\r\xb7\x9bK\xb64ToYm\xb8\t\xbf:\x129\x00\xfe\x93\xdd!n\xc6j!\x1f\xc6z{Ǯ\xb4\x92\f\xbeV\x1a\f\xa2\xccrb\xa0|`O\x1b\x90\xcc*\xa6kI\xa8\xfc\x0f\xcf\x1e\xebj\x04\x91\n\xb2\xe5\x00O\x8fI\xff\xe3\x14.w\x1b`\x057\x96YQ\x02\xe3\xbeC\xf6\xc4\r\xe1\xb0V\x9aٍ0\xd34A =l\x1d:\x1f\x87\x9f\x1dB9\xb7\xe0\xd1\xe9\x80\n»\xcc4\x90\xdcމ\x12\x8c\xe5e\x1f\xe6\xbb\aH\x00F$\xaaxmH8\xda\xd67\xddO\x0e\xc0J\xa9\x02\xb8<k+m\xdf:\xd9\xcb6P\xf2K_YU \xdf\xdd\\\xdf\xff\xfbm\xef3\xebS\xf4\xff\x17\xcdw\xd6p\x83\t\xc38\xbb\xa7Y´\x9f\xb6\xccn\xb8e\x1aP\f@Z\xacQiX\x04R\xe7L\xe9\x0e\xa8\n\xb4P\xb9\xc8\x02\x8b\xa8\xb1٨\xba\xc8\xd9\n\x90[˦v\xa5U\x05ڊ0\x0f]騗\xce\xd7C\xe8c\xc1\x11\xbbVNL\xc1\x90d\xfa\xd9\x06\xb9'\x92\x9b<´\xe3!\x0e\xe2g.\x99Z\xfd\f\x99]\x0e@߂F0a\x14\x99\x92[\xd0H\x91L=H\xf1\x7f\rl\x83S\u0092\xa4Z0\x96\xd1|\x96\xbc`[^\xd4p\xc1\xb8\xcc\a\x90K\xbec\x1a\xb0OV\xcb\x0e<j`\x86x\xfc\xa840!\xd7\xea\x92m\xac\xad\xcc\xe5\x9b7\x0f\xc2\x06\xa5\x9b\xa9\xb2\xac\xa5\xb0\xbb7\xa4?Ū\xb6J\x9b79l\xa1xc\xc4Â\xebl#,d\xb6\xd6\xf0\x86WbA\x03\x91\xa4x\x97e\xfeo\x81ߦ\xd7\xed\xde\xcct\x85T\xe6\f\xf6\xa0.u\xd2\xe5@\xb9!\xb6\\\xc0OH\xba/\x1fnﺒ'\x8cgJG\x00c\xfcAj\n\xb9\x06\xaf\v\xd6Z\x95\x04\x13d^)!-\xfd\x91\x15\x02\xa4e\xa6^\x95¢\x18\xfcR\x83\xb1Ⱥ!\xd8+2L(\xb4u\x85s7\x1fV\xb8\x96슗P\\q\x03\xaf\xcc+\xe4\x8aY \x13\x92\xb8\xd55\xb7\xc3ʎ\xbc\x9d\x1f\x82͌\xb06\xe8\x8a\xdb\n\xb2\xdeT\xc3vb-27\xa1P%7\xaad\xa0\x96]\x19\x9f\xfd\xf4\v\xe9\xbe\xe1\xd7\x01\x1eNA\x86^\xc1\xa0Q\xb2\x1bb~k\x1bQ\xe4\x1c4\xa64\x93\xca\xee\xc1\xdcW\xad\x1dJx(\x13\x98\xec\t;\xdbS\xa9)\x96t\x04Hk[\x87\xf2\x15e5\xe1\xfd(\xaa버\\p\v\xc5\xee(\xf4\xfb \xc6Ȭ\xa8\x1f\xb6rz^\xac{D\xcfk`\xa2Ӟ&\xe3_C\x8d}k\xfcW\xb2\xecdD\x1dM\xba\xc0j\xd9\xf2pЏ\x84\xa7}\xd20v\xbdfV\xa3\xce\xf5\xd8=\x89\xa2\xc0\x99\x8c\x18W\x90\xf7P\x8bw'\xd6L\xd80\x9a\x15'\f$[:/j\xd9\xfa\f\x8d\xfdG\x04\aؑ\xdaw\xfd\xa3\xa7\xc2-\x93\xf0ն\xb5pؑ\x11\xacya\x06C\xf0\ni\xd60.ت\xb6\xc7a\x00eew\x17\xae\xedZ\x15\x85zb\x86\x94-\x1a\xc1\xb5x\xa8\xb5\x9b\xec\xbf\xcfa\xcd\xeb\xc2^:\x9c\xff\x10\x93\xd6\xf1if\xa1\xac\xd0d\x1e#\xa7w\xbe-\x0e\x18gK\xde\xc4\x18\xc1M\x0e~\x88\xf2\xee\xc7\b\x10\xe5\xbc\xd8J\xab\xadȽ9\xdfSW\xec\xa0\xca\u0092\x19q+ye6ʢD\xa8ڎ\xd5J\x19\x15\x96\xab\xdb\xeb\x01\xb4\xce$Dt\xc9\x15&9\xb5\x8a=qaI\xe7^\xdd^\xb3{\x8c! \xb4fn\xb21[k\x89v.\xd2\xdf\x17\xe0\xf9\xeeN\xfdd\x80\xe55\x99\xe8\xe0\xde^\xb0\x15\xacўi@\x18\xf8\x13h\x8d\xfa\xdd\x10\x12\xaa\xdes\x99\x1a\xf68\x96\xa0lx\x8b/\f{\xfb'V\nY\xdbQ\xa9;\xa8؈z\xdc\xf2RmA?\x87\xb8\xef\xb9\xe5?\"\x90\x01M\x118#\xe8^`\x88\xbe\xab\x1d\xfd\xb8\x8ahbW\xae\xd7\x1d\xa8°\xf3s\xd4\x06\xe7.\xe4<\xbfp\x10jQ\u0605\x90\xdd~\x82j\u009e\x8e#\x88\xa3\xafc\xba\xb9S?\x18'\xf2ϢO\x04\xe6\x88\x1d\xa8TζT\x8f\xadE\x01\xcc쌅2h\xad\xd6\xf3\xef\x843\xc3B\xbeBQx0\x06\xe9\xed\a5N\x10Y\x17\x05_\x15pIJ\xfe\x00\xcd\xc6\xf5\xcd\x18Ѿ\x80\xb1\";%\xc9\x1c\xc4\x11\x82i\xffC\x8f2\x14:\xf0G`<\x02\xde\xd3\x13㔢\xe8\x10\xbdO\xad(n\x95\x86\f}\xd8K\xef\x1b\v(\xc8\x1f\x97\x8a\x15J>\x80vX4\xb6\nu%\xa0\x80\xe6\f\xddN\x8d\x16FH\xb6\xae\xd1#]2\xd4\x12Q\x19\x11\xd2X\xe0\x11a>\x01\xef\xe0kV\xd49\xe4WEm,\xe8\xdbLU\x90\x87E\xa6Q͜\xca\xc3\x0f\a!\xfb\xf8\xa5\x10\x19 \x1f2WiA\x8b<1\xd1nC\x99]\x05n\xcd\tY\xed\x87\xd0\xc6(\x93\xbaŀņ\xe7\x7f<\xbf \t\xe8\xf7\xde\xef\xc70\xae\xa1!\xd3,\xddL\x16\x7f\xbc\x85\xb0PF\xa8;\xa9\xa3f\xf0\x9dk\xcdw\a\xb8\xde,\xa6\xbd\x00\xdfc\xb0\a\x9c\x97\xa1\xda7\xe2\xfd\xb0\xff\xdf\"\xf7O\xcboC\x8b\xce\\H\xe4s!\x8c\xed\xb1ٸU,$\xebX\b\xe9\t$\x1dLT\x93S\\\xfd'!\xe6I\xe7Nl\xb24\xb2\xe9'\xc0\xbf\x14%7J=\xa6P\xef\x7f\xb1^\xbb\x84\xc52\xda\x18a+\xd8\xf0\xadP\xda\f\x97I\xe1+d\xb5\x8dj\x16nY.\xd6k\xd0\b\x8b\x96\xf9\x9b]\x81C\xc4:\x1c\xbe\xb0\x8eʊV\x18\x8c\xabe:\xb2\x94\xa8\x11\x1b\n\x05\xa8Q\xa8\xce\xc1\xc1Ђ\x1c\x88\\lE^\xf3\x82|\t.37>\xde\xe0\x17\xd3j\x13\x02\xb1\x87\x7fT\xaa]q\x0eM\x18$2\xb1\xb7\xea\xa5$\xa0\x8f_bl\xb4_5N\x89\xb0\x94p\xb0od\xa6\xae\v0\xbe\xbb\x9c\xdc\xe4V']\xb4\xccrk\f\x05_A\xc1\f\x14\x90Y\xa5\xe3\x14J\x91\x03WR\x95n\x84\xb8#Z\xb6\x1fm\xb5\x83\x99\x00\xcb(\xc4݈l\xe3\xdcW\x144\x82\xc5r\x05\x86VExU\x15\x11\xd3ՖI\xe1\xf0\x9dM鍶$h\x90!ܘ.iK\xa2~n\xcb(\xd9۹٧\xfa\xf8:\xff(\xbe\xbf%\xa2\a\xabs\xa4\xb0Oh\x12F\xfb\x05\xc9\xf3!Jz\xa4\xb8\x00\xb3\xec\xac\xce\t\x1b\xbe\xa60\xb4\xe7?\xeem\xa5\xec\x11\xe5\xd7Ż\xe3&\xcc\f\xd6MΩ\x97e\\\xd3Ϳ\b\xdf\xc8d\xddz\x8b5\x8bg\x1f\xbb-/hW\xc03$\xbf`kQX \xa7j\nQ6\x83s\xa7$P\xaa\x05f\xb4Il\xb3͇f\xef(\xa1ŀVC\x00\xceA\x0fQ\x0e\xf1 \x01$k\\\v\xda4\x15\x1aJڌ\xa5H\xb2\xfb\x85\\\xc1w\x9f\xde\xc7c\xcfnI\x94ԽA%LZW\xde\r\x1c\xa3.\xae>T\t\xbf\x90\xbf\xd6\x04\x82n\x13\xfe\x82q\xf6\b;\xe7bqɐo<TNDA\x03e\x04\x90\xa6x\x84\x1d\x81\x1a\xdf\xe2\x1f/s\xa4ŕG\x18\xd9\xf5\x8b\x95\x1e]\x11?\xbf\x97\xe2\xe8\x86\x1f\x880)\xb3\xa9-\rQ\xfd\xf4\x19\xd9`\x8f\x97\x19z)\x94\xc0\x97#\x87\x9d,Nݾ\xfaI1\x8f\xb0\xfb\x9dq\xbc\xc6Y\xb6\x11\xb4\xe9\xc4i\xf5F\xadg1ܕ{^\x88\xbc\xe9\xccͫky\xc1>)\x8b\xff|\xf8*\fv,s\xf6^\x81\xf9\xa4,}yQ*\xbbA\xbc\x06\x8d]O4A\xa5\xb3$H\xc4n\U00088ce5(\xa8\r?\x84a\xd7\x12C2G\xa2\x19\xddQ\xae\x90\xeb\xd2uVֆ\xb6Z\xa5\x92\v\xb7,6֛\xe7\x81\xd2=\x16\x9c\xa4c\xdf\xe9\x1d\x1a#\xf7\x8b\xcbZ*x\x06yآ\xa3t\x1an\xe1Ad3\xfa,A?\x00\xab\xd0,\xa4K\xcb\fE\xedG6_\xbc\xd2=\x87n\xf9\xbax\xacW\xa0%X0\v4k\v\x0fŪ2\x91.\xde&\x8c䜌\x95\x05\xce\xf5ĚAZ\x92\xaaG2r\x0eWO%\xd63\xc9D^\x04\xb9]IR\xd0Ml\x9dg\xbdf\xca\xcd1*\xa63\x16\xe7\x02\x94\x9c\xb6\xd6\xfe\x86\x96\x9ef\xe3\xdfYŅ6K\xf6\x8e2{\v\xe8\xfd\xe6\x17&;`\x12\xbb\xadh\x95\xfd\x97Zly\x81\xfe\a\x1a\bɠpވZ\xef\xf9j\x17\xeci\xa3\x8cs\x1b\x9aM\xbb\xf3Gع\x1d\xe5\xa4n\xbb\n\xeb\xfcZ\x9e;_fO\xf14\x8e\x8f\x92Ŏ\x9d\xd3o\xe7\xcfu\xeffH\xf4\x8c\xaa=Q.y\x95.ɔ7;'\xd0\xc0`=8DظI \xc5\x00a\x8a\x02ɢ\\)\x13I\x16\x89\xa0\x95 \xe87\xcaX\xb7\x0e\xd9\xf3\xf7G\x17*UX\x9cd|mA3c\x95\x0e)\x99\xa8\xf8S\x96\xe2\xbb\xe5n\x03\x06\xfc>\x94_\xf4t\x801\x8a=ou\x83\xb3*\xe7n/\x8c:\xe2\x19yOԶ\xd2*\x03\x13͋hK\xa2m\xeaQp\x9f\x0eͺ.w\xd1\xdf:Ik\xa7,J\x872ϑG\xd2\x1d\x11\x19}\xf8\xdaY\xa2F\xed\x82\x7f\xa7H\xeb182:\xafQ\x96|\x98\x0e\x9c\x8c\xee\x95k\x1d\xe6\x98\a\xe6\xc2-\xfdP\x93Ι\xe3u4\xa2\xfc\xcf\xe6ڔB^SG\xec\xed\v\xbaC^\x8b\xc7ң\xc6\xca\xf1N\xfaU\xe8\xac\xe5^\xf3\xc1\xe7\xd4)\xda\xf8\xd1\xd0c\xee\xfe\x9e\by\xd7R\xd9\xce2\xceL'\xbaR\xf9\xef\f[\vml\x17\rs \xb1j\x14\xd4\x11\xa1\xa7\xfc\xa0\xf5ё\xe7g\u05fa\xb3\xa0\xb8QO>qzN\xbc\x1dH\xba\xe1[\xf0\x99\xab 3UKZ\nC=\x80\xdd̀\xe8X\xe3\xac@\xa2\xbd\xeb4\x96u\x99N\x90\x05I\x92\x90\x93\xebf\xdd&?p\x91\xb6nŎc\xab=\x94\xc39V\x8e\x9fG!\xc1\xb3\x9bN_\U000af8acK\xc6K\xe4!\xb9\x1d\xa2\x84&\xa3ޱ\xbbI\xfb\xc4\x16d\xb4\xac\xc2YV\x15`\xc1\xa7m\xce\xc0#S҈\x1c\x1a\xd3\xefE@I\xc6ٚ\x8b\xa2\xd63\xb4\xeal\x92\xcf\r¼69}d\x95\x8eȂH\x94\xb8\xce>\xc3\v\x9e\xd6\xf8\x95\x9e\xe7Ǧ8\x8c\x1a\xe6\xfb\x8b\x95\x16\xca\x1d\x068\xbd\xcb\xe8ӎ\xb9\xdc}\xf7\x19\xbf\xfb\x8c\xdf}\xc69\x1d}\xf7\x19'\xcaw\x9f\xf1\xbb\xcfx\xb8|\xf7\x19S\xcaw\x9fq&\"\xdf\xcagL\xc1pAk\x9c\a*$a\x95\x98\n1\x85\xf6D_>\xe9ǟ\xd58I.\xf3\xf58ȑC<\x91\xe3\x171\xaf\xa35^Mr3\xce\xc00w\xdc)\xca\x04\x87\xf9\x04\xa7g\x02\x02\xa7?=s}\x10\xf2\tO\xcf\xf8!\xa4E\x18G\x9d\x9d\tD\x9a\x7fz\xe2\xc2'\x11\x95\xc0\xc3V\x8aK\xff\x88\x8d1&I\tx|\xe3\xe4\xf7\xbd\x8c\xc9\x17\x90\xa5W9\x913K\x9eFY\x7f\xfe\xc7\xf3_\a\x8bN˔(\x1b\xf6i\xeb\xd4xL?b,\xdfM\x8d\xecg\xa9\xfez\xa6\xc2Ie?\xf5DMC\xe4\b\xbc\xbeX\x0f\xa8\xfck\xd27\x16\xcaϕ\xb7\x96'8a\x7f=\x02/\xe9\x8c=7;\x99m\xb4\x92\xaa6~M\ba\xbd\xcbܽ\x03\x01dL\xd8G5\xc8\x7f\xb0\x8d\xaa#\xa76&H\x9b\x90E\x9bF\x90^R\xadO\x8c\x00˷o\x97\xfd_\xac\xf2)\xb6\xecI\xd8M\x04\x18\xddG\xc1\xf3\x1c\xe3\x82\u0381\x1e\xaf\a\xc2UIC\xa1\x8c\x00S\x9aIQ8\x89\r\x10z\xf2\xca>Wnu\xf0h\xbfiz\r+=\x11wn\xfam\x93-9\xed\xbe?#\xe9\xf6\xa4G\xa3\xbeYZ\xedqɴ\xa9+\x94\t\x89\xb3\xe9\xe9\xb2)lu%=I69BNM\x88\x9d\xbb\x02\xf1\xa2ɯ/\x93\xf2\x9aL\xb3\xb4\xf4ֹ\x14{\x95T\xd6WN`}\xbd\xb4\xd5\x19ɪ\xa7?\xf5\x92\xbe\x96~tveڲ\xcc\xe1\x84Ӥ4Ӥ\xa5\x9b\x94\x01\x1f5Ԥ\xf4ѹI\xa3I\x9cL\x9f\xae\xaf\x9a\x16\xfa\xaaɠ\xaf\x9f\x02:)m\x93\x15\xe6&y\x8e_r\x18ʴ\x03P|\v\xe1|.\x99\x94\xee\xb9\xe6ϊ;?\x0f`\xa1\xb0\x047\xf5\x15〲.\xac\xa8\x8a\xf6>\xb6X\xc0\xb9\x81]sY\xd1ϊ\x8e\xc8\xfb\x9b\xba>\x7fi$~9\x88j\xb8aOP\x14\x8c\xc7\xe6\xe6\x1e\x152w\x0fh\xa6\x16\x80\xb6\x11g\xb9\xbf\x8c\xc9_\x1ez\xe1\xa6\v\xdd\x06@\x16\xb6\x8c-\xf5qy\xf8\xa6\xaf\x83\x06,U\x8f\xedy\xe6.ޠo\xbfԠw\x8c\xee\x1dk|\xb3\xf6P\xa9\x9f\xe8\x06\x03Ӡ~\xbc:<\xb4g\xb2\x17\xe0\xb4ꁽ\x93\xce#\x18\xe2DmP\xef\xb4\x01\x1d*U\x8cӢ\xfdD@H\xd5@\x884Mq\xfe眲|\x89\xf0\xee\x14\x01^\x92\a4\xcf{\xfd\x86\xa7'\x8f=5\x99\x9e\x8c\x92tJ\xf2%½9\x01\xdf,\x7f5\xfd\x14\xe4\xfc\x8d\xe7\x17>\xf5\xf8R\xa7\x1dgP/\xf5t\xe3|ڽ\xd2i\xc6W?\xc5\xf8\x9a\xa7\x17g\x9dZLNϚ\x95q0'\xb5\xea\x19\xc7\xed\xd2r\t\xa6O!&\x9e>L\xcc4H\x1b\xfc\x91\xc3N<]8\xffTa\"\x7f\xe7L\xe9W>=\xf8ʧ\x06\xbf\xc5i\xc1\x04\tL\xa82\xffT\u0cf7\xa4\x94\xceAOn\xfb͑\xdaIyM\x8d\xe5\xfa\x88\r\xf6\xb5\xc2m\xb2X\xab\x17\x03\x90Y\xf2\x17\xf9ӣ\r\x87\xb6\xc1Q2;\x1eQo_\xb2u\xd7\xfa\x0e\xb1\x7f\xcd\xc1m]\x1a\xa88\x1a\x00\n\xdc(5+\xea*|\xe0\xd9f\xd0Æ\x1b\xb6V\xba䖝7\x9b\xc5o\\\a\xf8\xf7\xf9\x92\xb1\x1fT\x93\xabӽ/͈\xb2*v\x18\x89\xb1\xf3n\x83\xe7IIT:C\xcf7\xaa\x10Y\xc4\xe7\x1c\xbdW\xcf5ػl\x88n\xfe\xcb:\xd9\"\xb1\xc0\a\x9b\x8bp\xebb\xffJfw\x9f\xfb\x91k%\xbc\x12\x7f\xa6'\x95N\xb0\xea\xf6\xee\xe6\x9a`\x051\xa2\xb7\x9a\x9a\x04ņ\xe5+@\x97\xa1\x1d\xfb!}r\xbd\xeeA\xed\xe7\bw\x1f\xab\x80ܽL\x12\xdc\x16\xaf\x9a3\x85Z\xeb\xe6\xda\xe1r\xa8'\x94/.wL\xf9\xa7'\x84\xce\x17\x15\xd7v璉.zx\x04\xbb>\xb5jv\xd0Z\xed\xbf\xbc\xd2-=\xb2\x87GWh'{W\xf5\x93\a\x86\xf4|\x0eN\x87OUO\x9e\xa7~\x01\x9c\x0e\xbbP\v\xa2b\xe4\xa7h\x06\xe4\xc9W,\x8d\xbf\xa1\xffG\xb5\x85\xf7ѕ\xcb\xfe\xeb+\x83&#\xa9\x89\x01*]2\x1f\xa1`\x9b\x8fHw|?O\xed\xc5s\r\x03*\xfe\x8e\xf0\xe7,N\xde\xf6A\x8d?HB7\xa8\x87Nc^\x15=\xf5\xb4c7\xf7\x14\xb76\xaa\xd4O}\x1f\xb7\x86\xe5ɐ`\x10\x81%\xe4\xc17ZNEF\xab4\x7f\x80\x8fʽ\xad\x93\"&\xfd\x16\xbd\x97\x97\xbc\xe7\x16\xf2\xb5\xfd$\x8c)z?\xb6!\xc0\xf6|\xc6\xdeE\xff\x88\xed\x91O\x19X[<GF\xee\xee>\xba\x91ғ&\xef\xfd\xeb$\xa8\x8f\r \v\x02\x05\x1c\xb4\x15\xfew\xa3\x9e\xe8\x02\xfc\xf8\x1asx@\xa4\xf3\x86\x19\xd0A\x11J\xe1=j\x98uU(\x9e\x83\xbe\xa2GT\x12F\xfcS\xaf\xc1\xc0\x1d\xe8?\xc5\xe2\xedfd<\xa1\xe7\x17̒A\x8f\xae(\xa0\xf8A\x14`\x1c≦\xe1f\xbfec)\xear\xe5<\xd55\xfe\xd8tr\xc02\xbb\xa1\xd2\x06C\x05\x1a\xfdD\xb7\x15Q\x9b \xf9\x87\x89\xc1\x1a>\ni\xe1\x01\xc6c\xe8\t\x9b\xe0\xdeh \a (0\x8a\xf8\xfe\x12[y\xec\x11\xe4>\xdez \x03\xcdbdL\x8e\x95w\xabn\xee\xaf\f\xabeN\x1b\x00\xf7\x7f\xbe=J~\xb7\xbd\xf7e\x82NHQ\xef\xf7\xe3-;!BG;\x91O\x1fW\xe21X\xdc\x18\x95\t\x8a*\x9e\x84\xf5\xd79\xbe\xdc\x1d\xe2\x87\x02\xc4\x03\xd2Q\x1b\xf8\xfc$A\x7f\t\x16\xc8\\\xcbػ-\xd3\xda\xef\xa7=h\xd1\xf7Z\xac¾G`\f\x000\x15\xf6\xb9\x8c{\t(l\xaf\t\xd3<n\xb6O\xcf\t\x15\x12\xb7t\xe3\x0e\xdbb\xfc-\xa6E\xf3f\xd4Y\x02\xb9\xdd\xfbG}\xc0\xe3Oڹ\x87\x922^\xd9Z\a\xedZk\xbae\x1d\x81\x80\xbb\x84\xfc\xb8G\xedڷΎap\xfb\xd8X\xbb\xff0\xf9\x1c\xea\b\x9c\xe6Y\xba\xe8\x1bW.\xa2vϕ.\x10\xfeq<\x1e\x9d1\x88\xf3\xad{\xbbl\x82\b\x1fۚc\x03n\x86\x81C\xf6\xaf\xa1\xbd\xeaH\xe8\xd2\xfd\x891\xdc`\x9d攫\x97#j\x18.뿍1a\xfc(\xe4\x82}\x82\xfd\x88}\xc1>H\x1c\xc4>\x01\xdcyG\xc8ik\x85\xb4\xe3\x9c!n\x9bVt\xd8tDCN\x8b\xed\xfd\x00\xc6 \x93\x9d\x1e}j\xaa\xb8Ӧ\x86\xfd^\x8cy\xa3\xb4c\x96\xe1@\xff\xb0\xf7kT\x83\x1f\xd4\xde1\xcd=\xaaF\xf6>\xd2CxyGr\xbc\x97\xde\xfdR\xaf\xda\a\x15\xd8\xdf\xfe~\xf6\x8f\x00\x00\x00\xff\xff)\x00\x87w>{\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcV\xcfo+5\x10\xbe\xe7\xaf\x18\x89+\xbb\xa1B \x94\x1b*\x1c*\xe0\xa9j\x9ezw\xbc\x93d\xa8\xd7^f\xc6)A\xfc\xf1\xc8\xf6n\x9b\xee:\xb4\x8f\x03\xbe\xad\xed\xf9\xe6\x9bo~x\x9b\xa6Y\x99\x81\x1e\x91\x85\x82߀\x19\b\xffT\xf4\xe9Kڧ\x1f\xa4\xa5\xb0>ݬ\x9e\xc8w\x1b\xb8\x8d\xa2\xa1\x7f@\t\x91-\xfe\x84{\xf2\xa4\x14\xfc\xaaG5\x9dQ\xb3Y\x01\x18\uf0da\xb4-\xe9\x13\xc0\x06\xaf\x1c\x9cCn\x0e\xe8ۧ\xb8\xc3]$\xd7!g\xf0\xc9\xf5\xe9\x9b\xf6\xe6\xfb\xf6\xbb\x15\x807=n@\x90ә\x1a\x8d\xc2\xf8GDQiO\xe8\x90CKa%\x03ڄ\x7f\xe0\x10\x87\r\xbc\x1e\x14\xfb\xd1w\xe1\xbd\xcdP\xdb\f\xf5P\xa0\xf2\xa9#\xd1_\xae\xdd\xf8\x95\xc6[\x83\x8bl\\\x9dP\xbe \xc7\xc0\xfa\xe9\xd5i\x03\"\\N\xc8\x1f\xa23\\5^\x01\x88\r\x03n \xdb\x0e\xc6b\xb7\x02\x18\x05\xc9Xͨ\xc5\xe9\xa6\xc0\xd9#\xf6\xa68\x01\b\x03\xfa\x1f\xef\xef\x1e\xbfݾ\xd9\x06\xe8P,ӠYֿ\x9b\x97}\xa8\x85\t$``\xa4\x04\x1a\xc0X\x8b\"`#3z\x85B\x19\xc8\xef\x03\xf79\xad`v!\xea\x05\xaa\x1e\x11\x1e\xb3\xfec\x98\xed\xcb\xe1\xc0a@V\x9a\xa4)\xeb\xa2\xe2.v\xff\x8dxZ)\xd6b\x05]*=\x94\xecy\xd4\v\xbbQ\x1e\b{\xd0#\t0\x0e\x8c\x82\xbe\x14c\xda6\x1e\xc2\xeew\xb4\xdaΠ\x8b.\x922\x19]\x97*\xf6\x84\xac\xc0h\xc3\xc1\xd3_/ؒ\x04JN\x9dѬ\x9dWdo\x1c\x9c\x8c\x8b\xf85\x18\xdf͐{s\x06\xc6\xe4\x13\xa2\xbf\xc0\xcb\x062\xe7\xf1[`\xccRo\xe0\xa8:\xc8f\xbd>\x90N}hC\xdfGOz^疢]\xd4\xc0\xb2\xee\xf0\x84n-th\f\xdb#)Z\x8d\x8ck3P\x93\x03\xf1\xb9\x17۾\xfb\x8a\xc7Ε7n\xf5\x9cjP\x94\xc9\x1f.\x0er\xeb|AzR#\x95b*P%\xc4\xd7,\xa4\xad$\xdd\xc3\xcf\xdb\xcf01)\x99*Iy\xbd\xba\xd0e\xcaOR\x93\xfc\x1e\xb9\xd8\xed9\xf4\x19\x13}7\x04\xf2\x9a?\xac\xa3\\\xb8qד\xcaT\xda)us\xd8\xdb<\xab`\x87\x10\x87\xce(v\xf3\vw\x1enM\x8f\xee\xd6\b\xfeϹJY\x91&%\xe1Cٺ\x9c\xc0\xf3\xcbEދ\x83iv^ImeJl\a\xb4)\xb9I\xdfdM{\xb2\xa5\xad\xf6\x81\xc1\xd4L\xda\x0f1\xc9\x16_\xc8e\x9cH\x85\xcdlN\xa5.\x7f\x9fM},哣\x11\x9co\xce8ݧ;s\xff\x8e\xf6h\xcf\xd6a\x81(S\bߧ\x92\x16\xfa\xd8/}6\xf0\t\x9f+\xbb\xf7\x1c҄\xc6\xf9\xa8\xb9Z\x1bP\x1e\xb1\x03\xf9E\xb8\xf3\xc8ʭ\xfc0.G~\x0eh\x04\x02\x8eާ\x96\x0e~\x01Yy\x11\x16wH\xb1\xaf\xb0\xa9\xf2\xb9\xf3\xfb\x90\xff\"Lrl\xb4\xb4\x13\x8e\xc9\x1e\xfd\x14^\x15\xc0\xeb\xb9.k9\xe7>$hY\xf9y\xfeo\xc6i.\x11c\xd5w\x93YU\x0f\x92ǚ\xe2\xf5\xfe\x1aYF\xe7\xcc\xce\xe1\x06\x94\xe3Һ\xd8\x1afs\x9eW\xcdTj\x9f\xa9GQ\xd3\x0f\xef\x14\xd0\xe2UH\xeb~\x81\x92\x9a\xe7\xf9\x88\xfeZ\x8b\xc0\xb3\x91W\xe7\x15\xc8\xdd\xf9\x9a\xe9\xed\xcb\xdf\xe6\xb2\xcfJ=o \xcd\xfaF\xa9\"䇔\xaa\xa6\xb4\xd4y\xf5\xb7f\xa1\xd2\xf6\xf2\xee4H\xde\xf4\xcb\xf4W\xb3\x8c\xe1*\x85j\x05,63|w\x11\x9eh`s\x98\x02\xfe'\x00\x00\xff\xff\xef\xf8\xa6>\x10\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVM\x8f\xdb6\x10\xbd\xfbW\f\x92kd7(Z\x14\xbe\x05\xdb\x1e\x82&\xc5\"N\xf7N\x93#{j\x8ad\x87C9.\xfa\xe3\v\x92Ү-\xcb\xc9nQT\x17\xc3\xe4\xf0q>\u07bca\xd34\v\x15\xe8\x019\x92wkP\x81\xf0\x8b\xa0\xcb\xff\xe2\xf2\xf0S\\\x92_\xf5o\x17\arf\rw)\x8a\xef>a\xf4\x895\xfe\x8c-9\x12\xf2nѡ(\xa3D\xad\x17\x00\xca9/*/\xc7\xfc\x17@{'\xec\xadEnv薇\xb4\xc5m\"k\x90\v\xf8xu\xff\xdd\xf2\xed\x8f\xcb\x1f\x16\x00Nu\xb8\x86\xde\xdb\xd4at*Ľ\x17\xebu\xc5\\\xf6h\x91\xfd\x92\xfc\"\x06\xd4\xf9\x8a\x1d\xfb\x14\xd6\xf0\xb4Q!\x86\xeb\xab\xeb\x0f\x05m3\xa0}\x18Њ\x81\xa5(\xbf~\xc5\xe8\x03E)\x86\xc1&V\xf6\xa6g\xc5&\xee=\xcboO\xb77\xd0G[w\xc8\xed\x92U|\xeb\xfc\x02 j\x1fp\r\xe5xP\x1a\xcd\x02`\xc8O\x81k\xc6Լ\xad\x88z\x8f\x9d\xaa\xf7\x00\xf8\x80\xee\xdd\xfd\xfb\x87\xef7\x17\xcb\x00\x06\xa3f\nR\xb2<\x1f\"P\x04\x05\xa3'p\xdc##<\x94|B\x14\xcf\x18\a\xa7\x1fA\x01F\xff\xe3\xf2q1\xb0\x0f\xc8Bc\xf0\xf5;\xe3\xd7\xd9\xeaį\xbf\x9b\x8b=\x80\x1cJ=\x05&\x13\r#\xc8\x1e\xc7t\xa0\x19\xa2\a߂\xec)\x02c`\x8c\xe8*\xf5\xf2\xb2r\xe0\xb7\x7f\xa0\x96\xe5\x04z\x83\x9car\xad\x925\x99\x9f=\xb2\x00\xa3\xf6;G\x7f=bG\x10_.\xb5J0\n\x90\x13d\xa7,\xf4\xca&|\x03ʙ\tr\xa7N\xc0\x98\xef\x84\xe4\xce\xf0ʁ8\xf5\xe3\xa3g\x04r\xad_\xc3^$\xc4\xf5j\xb5#\x19\xbbN\xfb\xaeK\x8e\xe4\xb4*\rD\xdb$\x9e\xe3\xca`\x8fv\x15i\xd7(\xd6{\x12Ԓ\x18W*PS\x02q\xb5K:\xf3\x9a\x87>\x8d\x17\xd7\xca)S,\n\x93\u06ddm\x94.yAyr\xc3T\xd6T\xa8\x1a\xe2S\x15\xf2RNݧ_6\x9fa\xf4\xa4V\xaa\x16\xe5\xc9\xf4*/c}r6ɵ\xc8\xf5\\˾+\x98\xe8L\xf0\xe4\xa4\xfcі\xd0\tĴ\xedH2\r\xfeL\x18%\x97n\n{W\x94\t\xb6\b)\x18%h\xa6\x06\xef\x1dܩ\x0e흊\xf8?\xd7*W%6\xb9\bϪֹ\xdeN\x8dkz\xcf\x1bu\x90\xc9\x1b\xa5\x9dW\x84M@}\xd1x\x19\x85Z\x1a\x14\xa2\xf5<I\x90\x1a\xf5b\x1e\xef2\x9f\xf3B\x01uX\xb4\xb4\x9b\xae\x02(cʨQ\xf6\xfe\xe6ٯ$l&\xee\xbbrS\xe6p\xeb9{ԓAn\xc68\aO\x12\x0f\x01\x13Zs\xc5ԛ9/\xa10\x9a\\be\xaf\x1d\xbd\xf4\xe4Ѱ\xccJE\xae\xa6\xfc\t\xa00\x8f\xbbA\xab\x9d\xa038՞\xe2\x8d/\xf4\x8eh\xe0H\xb2\xaf}cχ\xdd\U000ea43f\x03\x9e\xe6\x96'\xbe\x7f\xdec\xb6\xac2\x8c\x10Q3J\xf6#\xa2\xcd]\x9e\x9bv\t\xf01\xc5\xd2yj\x16\x11\xb2z\x90\x19O\x1f\xf0t\x9dh\xf8Vq\x87w\xc3\r\x97[\x95\xac\xac\xe1իo\x87t\xa5u\xe3\x97\xe7\xf2\x18(c\x8b\x8c\xeej\xb6\x8c\xdf\xe7\xa2X\x994\x99aض\xa8\x85z\xb4\xa7\xa2P\xc4h\xde\xc06\t\x98\x84\xa5jJ\x1f\x8e\x8aM\x04\xed\xbb\xa0\x84\xb6dIN@\xf1\x06\xbe\xb2\xd6\x1f\xd1\f\x15\xc7.\xc8i\t\xef]\x14\xe54\xc6ǩ\x983V\xa9\xa0\\\xb5\x1a\x84\xbaLx\xc5x\x13\xbe\xf3Q@#g:\xda\x13\x1cٻݭ`g\xc41\xbf\xf2ء`\xd1G\xe3u\xccҨ1H\\\xf9\x1e\xb9'<\xae\x8e\x9e\x0f\xe4vMv\xb0\xa9=\x14W\xe5\xe9\xb6z]~\xfe\r\v|\xa8:\xf1\f\xf2nJ\xaf\x9f\xf2{G\xf6e\xcc l*\a=C\x1e'\x99\xda\xdd\xc0ݪ\x86sm7\xfa\xb4\xf5ޢ\xban\xb4\xb1\xe4\xd7.5\xf9\x86\x97\x88\n\xc0\x97\xe6)\xb7M\xa7BS\xad\x95\xf8\x8e\xf4\xc4zT\xb5o\b\xd0\xfd`\x96\xa9\x9as0\x1e\x1b\xc9^\xdf~\xe5%\xa8vW\xa3\xf1+\x15\x99\x0f\xbcy\xbc\xe0Y\xe3K\x94\xa4\xf8\xf2\x01V\x8e\r\x96\xdba\x88\xe9\xc4\\^\ves\x12G~2\xfe7C,\xecU\x9cQ\xa2gx}\x9fO\x8ee\xb0Ԣ>i\x8b\x15\x10|;ý\x17\xb9\x9c?t\xa9\x9b#\xe2\xbb^\x91U[{-\t\r\xfc\xee\xd4\xcdݛş\xad\xe7\xd5b̏=\xb3\x06\xe1T\xb1\a\x96\r+\xff\x04\x00\x00\xff\xffNy\xc1Q\xa1\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecYY\x8f\xe3\xb8\x11~ׯ(̾\xae\xe4\f\x82\x04\x81\xdfv;Y`0G\x1a\xeeƼ\xd3b\xc9\xe2\x9a\"\xb5d\xd1^\xe5\xf8\xefA\xe9\xb0e\x89\xf2\xd1\b\x06\x012c\x03\xd3\x12\xab\x8au~\xfc$\xa7i\x9a\x88Z}E\xe7\x955k\x10\xb5\xc2\xdf\t\r_\xf9l\xff\x17\x9f)\xbb:\xbcO\xf6\xca\xc85<\x05O\xb6ڠ\xb7\xc1\xe5\xf8W,\x94Q\xa4\xacI*$!\x05\x89u\x02 \x8c\xb1$\xf8\xb6\xe7K\x80\xdc\x1arVkt\xe9\x0eM\xb6\x0f[\xdc\x06\xa5%\xba\xd6\xf8\xb0\xf5\xe1\x0f\xd9\xfb?g\x7fJ\x00\x8c\xa8p\r[\x91\xefC\xed\xb0\xb6^\x91u\n}v@\x8d\xcef\xca&\xbeƜ\xad\xef\x9c\r\xf5\x1a\xce\v\x9dv\xbfs\xe7\xf5ϭ\xa1\xcd`\xa8i\x97\xb4\xf2\xf41\xba\xfcIyjEj\x1d\x9c\xd01G\xdae\xaf\xcc.h\xe1f\x02M\x02\xe0s[\xe3\x1a\xbe\x88\n}-r\x94\t@\x1fi\xeb[\nB\xca6wB?;e\bݓա\x1ar\x96¯ޚgA\xe5\x1a\xb2!\xbbY\xee\xb0M쫪Г\xa8\xea֑!a?\xed\xb0\xbf\xa6\x867\x97\x82pn\x8c3\x97\x9d}}m\xeaA\xab\xb3rN\x04\x8c\xd6:\x8b\x9e\x9c2\xbb\xe4,|x\xdf^\xf8\xbcĪ->_\xd9\x1a\xcdO\xcf\x1f\xbe\xfe\xf1\xe5\xe26@\xedl\x8d\x8e\xd4P\x9e\xee3j\xbf\xd1]\x00\x89>w\xaa\xe6x\xd7\xf0\xaf\xf4b\r\x807\xe8\xb4@r\x1f\xa2\a*q\xc81\xca\xde'\xb0\x05P\xa9<8\xac\x1dz4]g\xf2ma\xc0n\x7fŜ\xb2\x89\xe9\x17tl\x06|i\x83\x96ܾ\at\x04\x0es\xbb3\xea\x1f'\xdb\x1eȶ\x9bjA\xe8\t\xda*\x1a\xa1\xe1 t\xc0\x1fA\x189\xb1\\\x89\x06\x1c\xf2\x9e\x10\xcc\xc8^\xab\xe0\xa7~|\xb6\x0eA\x99®\xa1$\xaa\xfdz\xb5\xda)\x1a\x862\xb7U\x15\x8c\xa2f\xd5Η\xda\x06\xb2ί$\x1eP\xaf\xbcڥ\xc2\xe5\xa5\"\xcc)8\\\x89Z\xa5m \x86\xc3\xf7Y%\x7fp\xfd\x18\xfb\x8bmg\x85\xee\xbe\xed$=P\x1e\x1e-P\x1eDo\xaa\xcbɹ\n|\x8bS\xb7\xf9\xdb\xcb+\f\x9et\x95\xea\x8ar\x16\xf5K\xf5\xe1l*S\xa0\xeb\xf4\ng\xab\xb6\x1chdm\x95\xa1\xf6\"\xd7\n\r\x81\x0f\xdbJ\x11\xb7\xc1o\x01=q\xe9\xa6f\x9fZ\xe0\x82-B\xa8yt\xe4T\xe0\x83\x81'Q\xa1~\x12\x1e\xbfq\xad\xb8*>\xe5\"\xdcU\xad1\x1c\x9f\xffu\xc2]zG\v\x03\x94.\x94v\n\x8f/5\xe6\\YN.\xab\xaaB\xe5\xddL\x15ց\x98\xc1\xe9e\xa6\xe2\x10\xc0\x9f\x0eD_\xc8:\xb1\xc3O\xb6\xb39\x15\xba\xd5v\xfc\xf99fh\xf0\x981\x8e\x87\x9f\xff\x8e\nF\fR)h\x04\x06$\x949aJ4\xc8+\x95\xe1o%\x18)\x8c09\xfe\xd2\xf6\xa3ɛ\x1b\x81~\x8e\xa8pH\xa5=\x82-\b\xcd\xd8h\xef\xeb\xcc\"po\xbb`\x1er\xf6\x1c\xe3\x935\x85\xda\xcd\x1d\x1d\x1fdKŽ\xb1\xc9$\xdas\xf3t{r\xa4\xdc\\g_ҡ\xf3\x18\x9d\v\xb5\vn\xa9x\x85B-g\x10\x02`\x82\xd6b\xabq\r\xe4\x02&\x17k˳r\x99\x91\x8fؼ`\xee\x90\xd6\xd7㉶\xe9fnfh\xd2=6C\x8f\xf6\v\xa5\xd5r\x80\xccZx\x7f\xb4N\x0e\"g\x7f\xb2k\xdb(\xf4pTT\xda@`\rB\xf0xiΗ¡\x84m\x03B\xebK\xcb̽\xe0\xefF7\xb0\xb7\xb5\x12\x91mƢ\x90\v\x03\xa58 \xef\xf3p\xe6\x97\x11\x82?{\x8c\x8c\xca,ᯗI\xf4]\x12ɂG͇/\x1f\x15\x19\xc0\xe7\xe0\x89g\"\x16\x10\x7f\x0eB+9h\xef1\x9a\xdf\x1b}\xdd\x13\xa5\xa8\xa2\xc4B\x04Mkx\xf7\xeevH\xd1\x1e\xe2\xef\x97\x11\xa29,С\xa1lA\xf6\x95Q\xab\x9d\bn5,\n\xccI\x1dP3+\xf9-(\x87\xf2G\xd8\x06\x02\x19\x90\xb9\rC\xf2Q8\xe9!\xb7U-Hm\x95VԀ\xf2I\xc48\x007\x8e=\xa2lu\x11\xb0\xaa\xa9\xc9\xe0\x83\xf1İ\xe4O\\\x8c3\xd6\xf6\"\b\xd3I\xf5\xf4\xa0D\x87 \x1c.\x9a\xaf\xac'\xc8\xd11\x06\xeb\x06\x8eΚ\xddR\xb0\x91#\x99\x1f=\x9cA\xc2\xf6\xb1F\xda\xdc3yʱ&\xbf\xb2\at\a\x85\xc7\xd5Ѻ\xbd2\xbb\x94\x1dL\xbb\xd3ү\xb8\x8a~\xf5C\xfb\xdf[\xba\xc0\xb6\x9d)\xf4\x1d\xcd\xcb\a\xac*\x1a8\x96H%\xba1\fX\aLb\x18\x1f\xaa\xbew;\xf2+\xaf\xf8\xb4\xb5V\xa3\x98c\xe3P\xf2\xb9K)\x0f\xcf#\x90\b\xf0{z\xcemZ\x89:\xed\xf6\x16d+\x95'\vX\xd1\xf0\x03\xc6:\xb9\x9a\x8d3L\xb20(#\x99n\xf4l\x9f7\x19z\x9f\x9b\x15\x8d\x1cY\x9f\x19F\x13\xaah\xb4QPK\x99\x97\xd2\xcc{V\x88\f\xec\x95\xfawf>H\xe6s\x85B\xb7N\x1e\x9f\xf4\xcd\xc4\xc6pT\x14A\xeb\xde\xcft\x18R\x8d\xbd\x1f\xed\xa1\xa9:\x9d&ޗS\x1e3\x81\b˘\x1f<J\xeeF3z6\xec\x8a\xe1\xe1]\xb7\xf7\xbb쑄\x1c\xf8I\x17O\xcf\xc6o\xc9\xc7\xd7K\x13C:\xcc\xe9F\x1b\x18\xf7D\xa8G\xf1\r\xfc-\x06`\xb5\x95\xbdg=\x17mI\xc7\x03\x81\xc5\a*\x8d3ۉL\x8c\x13ND&YK\xee\x98MO\x82\xc2\xe4\x1c\xbdN\xee[\x85!\x9byp|\x9a\xf4fx\xd0\xdeN\xef\xb5\xf0\xf4\x11\x9bM\xffj\x88\xdf`ܨ\xfb\xa7\xb9\xc6\xe0\x18\x1b\x03RՄ\xc1\xd8bf\x11.\x89L\x03G\xe1\xc1Y\x8a=\xdf\x01\u05fb\x12Խ9I\xd9\xfe\xa3\xe4\xe5Jӳ\xcf#\x1a\x7fg\x02&\x1a\xf3\x04phc\xf2?3\t\xe0C\x9e#\xcao\x1dp\x85ދݭ ?wR\x1c\x98\x18T@l\x99\xa5\xc6[\x90J\\|\xe8[j\xcb\x1b\x9e\xd6h\x98]G\x18\xf9[\xa0\xe9y\xd1ڃ\xfc\xfe\xb2s#;1\xc2!\xab\xf5\r\x1dy\xa9\xf1\x9dn\x7f\xa7\xdb\xdf\xe9\xf6\xff5ݮK\xe1o\xa1\xf03\xcbĎ\xfdS\xaf\xdf\x06\xd8%v\xfd\x05\x8f\x91\xbb\x1b\x14r\x1et\n_,ŗ\xaeT\xdca\x8ef|\xb8ވv3\x95\xe7\xc8/N\x18~\xd9\xcf)\x98\x9e\xae\xf3\xa8\x15a\x15\x85\xce\xeb\xc0ʿ\x8aU\xb5F\xc2\xd3o9q\xb1\x89\xebOS\xadSѺ\x05~\xd5\xc9ĥ\x8fc\xc1$\xdc\x11ؽ\x04\xe1\xaeS\xe6f\toP\x86\xff\x02qX\xb0y&\x88\xf7\xa4\xe3f\x04\x0e=\xbfҹ'\x80M+:ԯS<\xb7\xdf}\xfe\xc4gn\x98\xa5\x97\x81\xf8-J\xfc\"\x94F\xf9\xd6`=\tG\x8f\xf5\xef˅\xca\x10|khܷ\xff\x93\xfdy\x15\x91{\x00vN4\xc9M\xa5\xd9M\x8f\xee\x80r\xe4\x9c\xef\x9e\x16\xc7w\xc2\xf6\xf4\x8b\xdd\x1a\xfe\xf9\xef\xe4?\x03\x00D\v3\x81\xbb\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[s\x1b\xb9\x95\xf0;\x7f\x05J߃\x93\x14I\xc7\xf5\xednm\xe9͑\xed\x8cj\x9c\xb1bi\x94g\xb0\xfb\x90\xc4\b\r\xf4\x00h\xc9\xccf\xff\xfb\xd6\xc1\xa5oDw\xa3)Z\xe3IY\xed\xaa\x19\xf6\xe5\x008\xe7\xe0\xdc\x01\xacV\xab\x05-\xd9=(ͤ\xb8$\xb4d\xf0ŀ\xc0_z\xfd\xf0\xdfz\xcd\xe4\xeb\xc77\x8b\a&\xf2KrUi#\x8bϠe\xa52x\a[&\x98aR,\n04\xa7\x86^.\b\xa1BHC\xf1\xb6Ɵ\x84dR\x18%9\a\xb5ځX?T\x1b\xd8T\x8c\xe7\xa0,\xf0\xd0\xf4\xe3\x9f\xd7o\xfek\xfd\x9f\vB\x04-\xe0\x92lh\xf6P\x95z\xfd\b\x1c\x94\\3\xb9\xd0%d\br\xa7dU^\x92\xe6\x81\xfb\xc47\xe7\xba\xfa\x17\xfb\xb5\xbd\xc1\x996?\xb6n~d\xda\xd8\a%\xaf\x14\xe5uK\xf6\x9efbWq\xaa\xc2\xdd\x05!:\x93%\\\x92\x9fh\x01\xba\xa4\x19\xe4\vB|\xafm\x93+\xdf\xe1\xc77\x0eB\xb6\x87\xc2b\x02\x7f\xc9\x12\xc4ۛ\xeb\xfb\xff\x7f۹MH\x0e:S\xacD<]\x92\x7f\xad\xea\xfb\xc4\xf7\x920M(\xb9\xb7c$ʣ\x9c\x98=5DA\xa9@\x830\x9a\x98=\x90\x8c\x96\xa6R@\xe4\x96\xfcXm@\t0\xa0[\xf02^i\x03\x8ahC\r\x10j\b%\xa5d\xc2\x10&\x88a\x05\x90?\xbc\xbd\xb9&r\xf3\vdF\x13*rB\xb5\x96\x19\xa3\x06r\xf2(yU\x80\xfb\xf6\x8f\xeb\x1aj\xa9d\tʰ\x80tw\xb58\xa9uwl\xacx!z\xdcW$G\x96\x027,\x8fb\xc8=Fq|f\xcft3|\xcbdx\x9b\n\xdf\xfd\xa6\x83\xee\xba\x05\x85`\x88\xdeˊ\xe7ȉ\x8f\xa0\x10\x81\x99\xdc\t\xf6\xcf\x1a\xb6&F\xdaF95\xa0\x113\x06\x94\xa0\x9c<R^\xc1\x12\x91҃\\\xd0\x03Q\x80(#\x95h\xc1\xb3\x1f\xe8~?\xfe&\x15\x10&\xb6\xf2\x92\xec\x8d)\xf5\xe5\xeb\xd7;f\xc2\xfc\xcadQT\x82\x99\xc3k;Uئ2R\xe9\xd79<\x02\x7f\xad\xd9nEU\xb6g\x062S)xMK\xb6\xb2\x03\x118|\xbd.\xf2\xff\x17أMuB\xcc\x01\xd9V\x1b\xc5Į\xf5\xc0Ώ\x19\xe4\xc1\xa9\xe3\x98сr8i\xa8\xc0\xc4\u03a2\xee\xf3\xfbۻ6\xa32\xed\x89Ҽ\xaa\x87\xe8\x83\xd8db\v\xca}\xb7U\xb2\xb00A\xe4\x8eU\xf1G\xc6\x19\bCt\xb5)\x98A6\xf8\xb5\x02\x8ds@\xf6\xc1^Y\x19D6@\xaa2G6\xee\xbfp-\xc8\x15-\x80_Q\r/L+\xa4\x8a^!\x11\x92\xa8Ֆ\xac͟{١\xb7\xf5 \b\xc8\x01\xd2:\xc1r[B֙h\xf8\x15۲\xccM\xa7\xadT\x8d\xdcq2\xb0\x8b\xa1\xf8\xd4\xc7+\xd3\xecV\xd0R凉c\x05\xc8\xca\xf4ߘ\xe25\xbc\xaen\xaf{PB\x0f}\x7f\xad̪4\xe48i\x9f(3\xb6\xcfW\xb7\xd7\xe4\xde\n\xab\xf0\xb5\x15Z\x95&\xa6R\x02\xb9$\xd2\xd6g\xa0\xf9\xe1N\xfe\xac\x81\xe4\x15b\x9ed\n,\x1e\x96d\x03[\x9c\xb5\n\xf0{|\x04J!n\xb4\x15\x9a\xb22}\xc6\xc1\xebn\x0f\x88[Zq\xe3\xe7\t\xd3\xe4͟I\xc1De\x8eXm\x90\xea\xf8\x0f\xa9^\xc8GP\xa7 \xf1\x1d5\xf4o\xf8q\x0fw\b\x94X\xa8\x88\xbc\x8d\xc7\xe3\xe6`\x1fƨ\xed\xe7˶\x05\x91irqA\xa4\"\x17N\x03_,\xdd\xd7\x15\xe3f\xc5D\xbb\x8d'\xc6yhe\xde\xe0\x1d\x0e\x1dA\xf5\x9d\xfc\xa0\x1d\U000de10b\x01X-\xd4<\xed\xc1\xecA\x91R\xd6\x1ao\xcb8\x10}\xd0\x06\n?\r\x82\x16\xf1㉴\x84|H9\xf7 4\xd9\x1c\xc2@\x8e\a/*\xce\xe9\x86\xc3%1\xaa\x82\xa3\xc7\x0e7\x1b)9P1\x81\x9cϠ\r\xcb\u0381\x1a\a)\x82\x18\xe5\x1ft0\x80,d\xe8\x03\x10\x1a\x01\xedq\x86ڙ\xf3\x16b\xbbX\x89\xf6\xa9T\x90\xa1Ծ\xf4ڀ\x01\xb7\x1aHH¥\u0601r\xad\xa3\xa5\x12\x18L\x012uNP\xd0*\xe0\xa8MȶB}\xb9&8\xbb\ay\x80\tm\x80\xe6祏:|\xae\xc4I\xf4\xb0_F\xf0\xdfLO\"\x05Gӣ\x94\xca\xdb\x7f\xcc@\xa1\x975z\x11-{)\x1f\xba\xea\xc5]̐'K\xc1R\xc9\f\xb4^\x92'f\xf6(b\xab\x92K\x9a\xa3\x98\xa3\xe2`\xa7\xf0\x92\x18\xfa\x807\xb4\x97\xa7\x1a缪\x84\xc0\x9b\xb6\x85\xb3b\r\xbed\xbc\xca!\xbfr\xe6\xea-Z\xddy\xf05\xf4)\xd8|?\n\xd1\xdb4\x9ce\xd6t\xf6V\xf2\xcaZ\xfb}k\x0f\xafƴ9\x94`M~T*\xa1ۍ\xcd2*E5\x18\xfc\xe8\xe2O\x17K;/\xba\xadv\xdbЄ*\b\xf0\xf3dm\x03Ei\x0e\xc7o[.9\xc6\xe2\xa8\x14N\xa4'U\x8a\x1ez\xcfB\xb7k\xaf\xe9\x8c\xf4\x1c\x82٣\xa8\b\xaf\xbd0M\xfb\xed\xfe;S\xf5<t\xd4\xe8\x99\x19\xca\x04\xd2\x0f\xdd\xf5\x0e\xf9Pʡת\x80\bi\x16G\xe0\b\x13\x0e\x99(\xf4Ǩ\xf5\x1b!\xeb,<?\xc4\xe45oy\xe6\xfd]b\xca*\x93\t\xec\xfc\x80\xef4\xae$\xc9l,\x8al`O\x1f\x99T~草\x06_ \xabLt\xd6SCr\xb6݂Bw\xb2\xdcS\r\x1aQ9\x86\x90a\xa7\xa7-F\xa2\x0f{\xe3h\b\x89d\xb2#\x1f\xea:j\xff\xbe\x96\f\x7f\xd8Q\xd4\xc3ք\xc9\xd9#\xcb+ʭ5C\x05\x02G\xbb\xab\xee\xd7\xf1xF\x89\x9cƙ\xed`U\x18\x14\x12\xa9\xe3_J\x01h5\x14\xe8I\x1d\xbf:H4\xb2\xa1h\xe1ɡ\xd1\x13\xabiU\xc5A\xfb\xa6rk|72c\xd9\x10ņo\b\xa7\x1b\xe0D\x03\x87\xccH\x15\xc7\xc8\x14\x9dӅ\xe0\x00\"#\x92\xaf\xb1\xf5pH\xcd\x00F@\x12T7O{\x96흁\x8cLdmF\x92K@3\xd9\x10Z\x96<\xa2.\x12\x89\x9f0דg}\xca\xfc?\xc6m\xe0\x92\xf9\xa8\xad\xbflYшٚ\x1d⑀\xe6\xef\xdf\x13\xb1L\xf49/\x19\xb3#\xb3\x1f\xff]\x1fA\x1e\xe4\xe9A\xbeE\xac2\xd0kr\xbdu\x96Β0\x87k6=\x13:6\xd7Q\x88\xf1wD\x9b\xf9L\x9fH\x9a\x949\xf1\x95\bS7\xf1;\xa4\x8bU\x19\xb7^c$\xd3\xe4c\xfb\xab%a\xdb\x1a\xe9\xf9\x92l\x197\xa0z\xd8?I\xd4\aʜ\x03\x19)Z\x0f\xaf\x82\x9al\xff\xfe\vf\x9f\xea\xec\x17!\x89x\xe9\x7fLX\xdb\xda\xef\xaa\xe7\t\xb8hq\xfdZ1\x05\x85M*X?\xb8}\xc7\xfa\no\x7fz\x17\xf7\xaffr\xde\xdcI\xe7\x93Z\xbd\x11\xb5{\xecM\xf8\xf0\xc4\xda@\xb5\x03d=>\xbd$\x94<\xc0\xc1\x99.\x98\xde*A\xd1\xf0rB\xf3\nl&\xcb\xca\xdf\a8X0\xf1\xd4\xd4\xe9\xdc\xe0\xd3IpHy\xad\x87C\xec\x13\xd3>冔\xc7\x1b86{+\x99\r\xbc=\xef\xa6B$\x11\xf4,Y\x12\xae\x80\xfb\x13\x86\x99\xc4*\xed6\x1a\a\aY\xe4\x01\x0e\xaf0\xd1\xc5mJB\xefY\x89\xe2\x00Y\xc7ΙT\x82\xba\xeb\x9er\x96\xd7\r9\xf7\xe3Z,\xc9O\xd2\xe0\x7f\xde\x7faڧ\x7f\xdfI\xd0?Ic\xef|\x15\x8c\xba\x8e\x7fM|\xba\x16\xecD\x13N\xca#\xc2\xda\tL\xa7Ӑ\xdbj\xdc3M\xae\x05\xba+\x0e%\x89M!\bߜk\xa8\xa8\xb4A7NH\xb1\xb2:3ڒǷT\x1dt?\xbbQ\xdf\xe0\x1d\xaaq\xd7\x1d\x971\xe7X\xb8\x10\x92\\6\x95K\r\xecX\x96\xd8^\x01j\a\xa4D\x11\x9e\xc6\x11\x89\x82\xf5$\xf6I\xd3\xde\xed\xbf/\xab\x87\xba2b\x85*g\xe5!\x18Y$\xe0\xc0\xcb\xee^\xda<v\xadPj'\xbc\x158a\xf2ՁL\xef\xf3\x90\xf2\ftX-nM\x9cI\xea\xd2<\xb7\xd5A\x94\xdf\xcc\xd0(3xa\xaehh\xf5\xddJ\x06R\xd0\x12\xc5\xc2\xff\xa0\xa6\xb5\xb3\xe9\x7fII\x99\xd2k\xf2\xd6\x16\x02q\xe8<\xf3A\xb3\x16\x98\x84&Kl\n\xf9\xe7\x91r\x8c7\xa1\x00\x17\x04\xb8\xb5T\xb0\xf5\xbe]\xb4$O{\xa9\x01\x19\xa9I}]<\xc0\xc1\xe5Y'\x9bl\v\x99\x8bk\x81Ai\x91\x1f\v\x8c\xda\xe0\xb0\xf9\xa4\v;ċ\xe7\x98R\x89\x9c\x9a\xf8Z\x87E\vZ\xa6q(\xba\x81\x97\x8bD\x8eAW8\x18!\xf8a]`\x84\xee\xcfz\xf1L\x16-\xa56\x97\x83O\xe71\xef\x8d\xd4\xc6\xc5\xcb:6s4\xa0&C\x10\x8dЭ\xab\xfa\x92*\x94\xe8\xa0P\x9e\n\xfd\xb6\xff\xee\xf6\xa0\xc1\xe7+|`\xce\x01E\x97\xfb\xa2\x99\xdf.\xe8q\xe1\xf2%\xf8\xff\x84f\xf8\x04y\rB\xaeq\x9c\x83\x12\xf4E\ac\xc7c\xafc\x8e\xd4yI\x18\x0f\x9c\n\x81\xce7y\x11\xb9S\xef\xf4\xba\xfa\xfeK+ J\x85\xc5\xe5$\x8f\xcd\xed\x17^X\x9bD\xfb\xc5]I]\xbcr_\x86\xd9\xe0\x01Y\xc1AծBQ\xa5\x17\t@\ti1\xe0\xb7`(\x14L\\[\xce\"o\x92\xdeOס\xa1\xb2\x952\x11+љDy\x82\xbe\xf2\xf5P\xa1\x91\x86:\xf5\r7\x95\xb1\xb8\xe2i\x0f\n:\xc4;\x8e\xaa[;\x14\x83\x98M@\"\xb1\x0f\xbe\x95WX\x8c\xa1t\xed\xad\xba>ŋ{\xce@>)\xdec\xc9\xd5\t\xc8\xfd侬\a\x8a!\xad\xa7P\xd4\xe6\x10\x93\x04\x94\xb8\xfc\x12`\x14\x87\x19\x02\"\x93\x95\xb0\x01\x1c\x9cǶ\t\x87\\'aY\xea$I\x9b\xfdx\x81\xa8\x8a4\x04\xacȕ\xc4j\xcc\xd1HOs\xad\xc8\a\xca\xf8\xd7 \x9b/\x8f\xfb\x9as\"\x14\x06\x06\xa9\x8a\xfcY\xd0/\xac\xa8\nB\v\xa4\x91U\xe6X(\xd8!zS.\x88_ \x15P^e\xb2(9\x18\xf0%\x7f\x89}Ȥ\xd0,\x87Z\xb9zF\x90\x82P\xb2\xa5\x8cc\xed\xd1\xf9\xd1;\xc7\x15\xf1\x92`\xf2\xcdD\x93,\xb5\xf1\x95\xd5p\x8b3\xb4\x98\"\x8dK\x95n\xf1M\xf0\u05cd\x82\xf9VV\xa9\x98T\xc8Eg6\xb4|\xf9)Vc}\xb7\xb4\xbe[Z\xdf-\xad\xef\x96\xd6wK뻥\xf5\xdd\xd2\xfani\xfd&\x96\xd6T\x8f\xdc*\xc8ŉ\xbdHHU\x8fuq\x04\xbe/\xae\xf05\xe0\xc1\x8c\x89\xe8\xc1\xe9\xf9q\x1d\a\x15)\xd7\x1f(\xeb\x8e\t\xadFy\x842\x10[\xc9\x16x\xdef\xfe\xa6L\xc9gT݇F\xfd\xa0\xceP\xa5}=\n\xb1W\xbe\xdaET\x04\xda@\x85\xb6\xef\xf6\x14bN\xac\xb9\x0fH\x99W\x9d\xbd\xf4\x85\x1a\x05\xd0\x10V\xb7\xa9\xdb\xe8\xb8\x06:1\xd5\xfe\xa0\r7*ڒ\xf8#6\xb3X\xbf\xb6\xeb\x8c\xfc1\x04\xb3\xc7!ue\x97GU\x04\xe2sy$Jҋ?]|{\xe8?\x0f\xc2\aQ|\x8c;\xbf*<\x02\x15c\xfd\xed\xb2\xb0n\x15\u07b7\xc9\xc6g\xe1\xdb!F\xad\xb9\xb0\x8f\xc4\b\xac.K\xf6\xb0\xf8\xed\xca\x02W\xbeD\xf9\x89\xe8\v\x9fG\x14f\x83\r'81\x98\xe2\xadM;L\x9f\x13Eo\bӦٞ\x8a]T\x16h&2\x8c\xbfhRR[\xdf\xef\xa0.۫\xfbu\x95a\x98d[\xf1\xbaM\xcc\xfc\x01ј\x05\xc4\xdd\x06\xf2\x8a\xd7rC\xc7\xcd\x1a\xec!\xdd\x01\xe12\xf3\v\x86).N\xb4\v\xea\xecw!~Ԍ!\a\xb4}sL-\xa3\xb0ڃp\xf9V\xdf\td\xa7HCۊ\xd7\xfdd\x16\x9c\x82WX\x8c\xdc\x1d\xe1\xfa4J\x0fX\x05\x06\x8aO\xa5\xb7>\ue1bc\x8c\x04\xa2G\xe0$\xad\xe6\xa6\xfa \xb2\xbd\x92BV\xdaG\xa0\xae\r\x14om\xb0\xcb\xd7\xd1`\xd8+U\x9a\xff\a\xd9\xcb*R\xf5?2U&\xaa?\xa7\a\xdf)\x04\xc5NP\xbb\x9a\xff\xf1ͺ\xfb\xc4H_\x16jy'\x02\b\x97\x81\x10\x8c\x01\x8a]{\xb1Gر\xc3Ȩ0\x89\x00\xc2\x15\x12\x8c#\xa76_wd\f\xf9d\aD\xf9ln\x1a\x8f\x9f\xf5k\x1cb\xef\xf4P\xda\xffd\xac\\4\xb8KEl\x8f\x89pͭl\x18\x14\xafi\xd4\xff\r\xcb@\xe7\x17\x7f\xa6D?'\n=;\x18I+\xefL\xac#\x1f\xea\xf4\xc4\xfc=\xae\x88I\xee\xfe\xbfV\x8b\xa4\n\x9bs\x17k\x9e\xbfD3\t?\xd3\xe5\x98s\xb0\xf3\xd5K/_\xb0\xe0\xf2e\xca,\x13\x8b+G\x05\xd2\fr\x8f\x19y\x83%X\xa9U\x82\xd3a\xa2\xe1\x02\xc9ɲ\xc8\xc90\xd2\xd4\xc0f\x0f\xa9U\xeb\x17\x1fќ\"\xc7I\xea\xa4M\xb3V\x9f\xben\x19\xe3\x8b\x15/\xbel\xc9\xe2(\x17\x8d>\xec\xb0\xcfDQ\"\x87\x1d\xe5?H\x1e\x99\t\xd3d\xfe\x18>\x1ew\x950%$rP\xae1\xb2\x97<G\x9a\xfb\xa7\xfdG\x91v\x98\x16\xafL\xf0K\x96D\x00\xb3MX\x83\x13\xb30_J\xa6\xec\x8a\xd2\xfa\x9e\xf7b\xd0\ng\xf5\x1e\\\x90/I%\f\xe3\x034\xc6֑\xb6\n8\xd0h\x0e\xeb\x19\xbeJ|\x87\xaci\xab\x86\xbfԬ>\x95ߤ\xea\xf8\t\xfa\x14F\xfaԃ\x81T\b6\xf4\v9#E\xc5\r+\xb9\xad\x03}dy\xd4k7{8\xd4{\xf9\xfc\"\x99h6\xa5\xfa\xf4\xb9\xd6\n\xeb\x9eKE5y\x02\xce\t\xd5)#\xcfܦp\x99\\\x01Z\x02(\x06\xfdD\xf1\\\xbct\xa1\a\xbbd}\x8b\x9c\\D\xc0fT\x84\xed\x8f\u058bd\r=M\xa8\x88\xab`e\xbb\xbb\xf7k\x05\xea@\xec\x96Z\xb5AY\x87\x89\x82\x04\xd4\x15od\xb2\xd7\x0fCI\xa9#晴\x99\xe4\xadp\xe6M\xbf?\xf6\x1b\xd0m\xef\x11\xa5\rN\xddh\x1b\x03\x9f\vY\x7f\xbd\x98\xef\x89\xf4;\x1e\x7f\xab\x87\xf1\xb3\xfb\x92\xf3\xbd\xc9I\xf3-\x85E~C\x9f\xf2\xb4%\x85S\xd4L\\B\xd8\xc1\xcd\x19}\xcb)\xef2A\xb8w\r\x98\x19\xc3\x18%\xf1W\xf52\xbf\xceR\xc0DL\xa5,\xfd\x9b\x87\xa7\xaf\xeeo\xbe\xa8\xc7\xf9R>\xe7\x8c%}\x13\x82k\x16\xf9\xa7]\xb4\xa8\xad\x9d\xea}N\xfb\x9fSK\xf4\x12\x96\xe6\x8d\xdas\xa9\x83<ax-\xbd>4\xba9vk\x12\xcdR\xa7\xe2\x8b\xf9\xa4/\xba\xa4\xeee\xfd\xd2IΚx\xdca\xa9\xc9%sI\x1eW\x8c\x83\xa5\xcaA\x8d\xe6RS\xb9p\x94\xff\xa69\xefS\xaf#\xbdĒ7\xeemw;\xf62\xfe\xf0\xaffvw\xeb\x189\x90x\xc8i-k#\x00\xb0Y\xf2\xc6\xfc\xe9\x1a\x93~\xcbk|E\x13\r%Ealwص5bQ\xd5\xfc\x9ef\xfb\xba{\x0e\xfa\x9ej̃\x15Ԑ\x8b:\xab\xfe\xda\x01\xc7\xdf\x17kB>ȺШ\x19ܒhV\x94\xfc\x80\xb5\xa2\xe4\xa2\xfd\xc1i\x1c\x10\xe5\xb6\xd0ڍ\xe4,;\\\x8e\xd3.\xd0ǽ\xdc#\x92\x02\xbb\r[֮\xc3)\xf1Ÿ\xe9\x86&j\xf0\xda|\xe1\xd4Vr.\x9f\x16\xf3,OZ\xb2\xbf\xdaC\x04\"\xcfRX\xcfo[oa\x04\xf6\xd8\xd9\x1f\xa1\xe2\xb1\x1e\xcd\x06P-7\xe3\x8c1\x80/TjC\xec\x16\x0f\xb7\xf7\xe9\x86\xdc2mm\x16xљ\xe1\x16k\xb8\x91\xbf\xed\xc7P+\xc83\xb8\xa4@\xfaP\x12S\xf9\xaa\xa4\xca\x1c\xec\x84\xd7\xcbΨ\x82.]/N\xd0\x1e\xc7\xdb\xccG\xd1\x1bv\x97\xc7\x01\"\xc4\xf6L=\xc2\xdd)\xfd\x18^\x12<\xb9\x18\xf8\x8c\xfd\b\xa8<\xee\xc9\xcabj\x91XN9\xaa\x02\xe6(\x80\xb0\xa9/n\x12\xfe.\x1a=\xeb\xa0\xe7\xb6\xf7z$.\x19 \xda̓\xfd\xec<\x02\x8ae\xdevo\xf0\xfc4q\x14\x0f\x01\x86\xa6\xfd\xf6Η\x8b\xf93\xfa\xb6\v\"2\xbe\xb0\xd9uh,&\x9fp\xd7Eq 7\xf7\xaft\x8b]\x82u\xe3}4\x1f\xfd\xa8\xb3\xee\x118\xfe\x83\xbf\x9c\xbf\xb2×\xad|\xf4U+Sd\xef\xbe\xed\xa3\vv\xaa\x05\xab'\x14e\x87I\x13+a\xf1\a\x0f\xf4\x805\v)\xba\x12}\x83ǍȨ\xdc\x19\x99cƜT\x96tw\xf7эʰ\x02\xd6\xef*WW\x822Q\x03\xa28\x8c֡e\x83\xff\x8b\v\x1c\xb0\xd4'\x02\xad!Zk0\n\x10O\xae\xaew\u0590ܞܠ\xae\xa4ز\xdd\xc4\xe8~\xee\xbc\xdc\xe2_\xbf\x90e\xcbv~puU~\x80?\x9b\xc1ƕ+\xda<\x9c\x03\xff\xc08h\u05ed\xd8k\xbd\xfe\xdf\x1c\x7fU\xcb\xe3\xaa\u0600B\xe6\xc2M\xf9u\xdd@\x14h@\x9b\xad\x8b)A\xa1\x15\x85sX\x90J\a^\x1d\x1exC\x11<\x02f\aj\x8e\x04v;\xb0[\xf5\x19ĉ\xf5e~\x84\xc3\x04\xf1\ue1ff\xecQ\xb2\x15\xf2\x8amci\x95?\xb9\xb9\xbf\n\x99!J\xee\xffz;\x8b\xeb\x1e;\x87h\x84٪\x93Fp\xf4U\xcb8n\xc9\v\x94\x15\xb8E\xed\x11H2\b\xa7u$\x91\xaf\xb0c\xdaˍ\xe3\xd1\rF,F\x86=\xec\xf2\fPܝ.r\xb9\x18DI\x90z\xf8Z8\xa4\xc9O\xc7J\xd9\xdaD\x7f@\tj\x8d\xb0z&6\xa4\xe1鶩+\xe3\xea*;\xfd\xd6\x18\f\xdfC>A\xb1\xa88\xfc\xcb\x18\xc00\x1f\x8d4\x94\xb7f%\r/D\x00\xdaB\xbe\xb1\n>/\x8dF\xa896\x1fc\b\xb8\xf2\x8b\x8cΆ\x80\x1a\xe0\x10\x02\x9a\x82R~\xa8\xd78}#\xd8\xc0U\xfe\xe7\xe3\x05\am\x90\x11\x90أ\x90&\a\xec\xd7P\x80\xc8\xc3L\x0f\xeb\xff\xe6\xa1\xc2S\xc1\x97\x9djC\x8b\xf2\x14\x1c\\\x1d\x83\xb1\xa7\x87\xa9\xdcc\x00\xabWi\xddw\xaa\x1b\xf2\xafG\xc1\xb9\xbaW\xebde\x18\xa2\xc8\t<\x82 Rؽ\x03 \xaf\x8f\xbf\x9b\t\xc5/\x1bo\x8e\xf3h\x87B\xa2g\xa4\x85h\x87\xb6gq\xbd\xd25L\xccq\xda\xd9\x19A±\xf1\x8bz\x96\x9aK\xb4\xfea\x85 \xe6\x1a\x15#\xb29Ӭ\xab\x17\x9e'\xe4\xaen\xaf\x87\xc0\rrvx!\x0e\xae\xa7\xb6\x9e9\x8d\x8f\x87\xeb)p\xae\xe1\xd6\xe0R\x04Z\x04b\xcd\xe3\xe7\x1f{nO\xc0\xf9l\x93٧\f\xf6]\xeb\xfb&0\xfb\x14҃a\xa2\xdaБ]o\\\xef\x99\xe0V\x1eG@>Q\xbf\xf57\xc9Ձ\xa8J\xacɵA\xcc\xd9p/:uH\xed\\\x1dV\xaa\x12\xc3\xf3\xf6Y65g\x05\xeb\x1c\xaa9\x1f3x}l\xc0\xd8]\xc4Z\x98\xf1c\xb3;\x9dcQ\x10\xcd\xec^7H\xcd%Ѳ\xb5\x8c\xc1F>-\xcdc.\xb0w\x83[\xc8ƒ\tw\xdeBs\xa0\x8aĵ\xc4\fOb\xb4\xe7\x18\xad\x17\xb3\x13O\xa3\xa6\\\x02\xbe\xc7,\xben\x943\x05\xe3!ȉ\xf2\xbf\n\as\xda\xfe\xbb\xd4\xf4Sp\x06\x11'\x90\x13{\xb0h\xec\xda\x1c\xeavO@J\x1a\x17\x04\x85\\\xcf\x15\x1b:\xbe\xb2\xe4\xf6\x02\xa1\x11\x05\xb6=\xfc\x1f\x1a\xf26\xa1\x7f\x84\x06\xa6\x1fl\xa73\xdd\xf0\a\x89Ƀ\x94\t\x10\x04O%\xcc\xf0\xe3\x1e\x02\xc6F4ԋ)\xf9\xd4\xfc\x054$w' :\xf4\xc8\xf9j56\xfd\xa4I\xec\xdd\b\xe3O'Aq\xc1z%b\xb2\x0e\xafUݧ\x81\x17F\\\xe0\xb3L\xbd\x11\xf8\xf6\f\xc6\b\x83L3\xbeݦ\xc2''\xb3\xb0o\x01\x962Y\x90\xa4\x00\xad\xe9\xce:\xd9Ԑ'\x8cL\xed@\xa0\xd9[\xe7\xd6#@\x9b\x9d\b:\"o\xed,*\x9a\x19\\\xa7c\x1b\b\vmZo\xbd҄\xcb\x18\t1ʁ1C\x9fK\xf2!\xbb\xf5b\x0e\x9f\xda\xd2͔\x10\xdf\xfb\xfaEč\r\x94X\xc3%\x9c\x8f\xa7\tp\xb6c\x18\nC5\xb7\xa3jCw\xb0\xca\xf0@gk̯_\xd4\x14\xf4\xfb=|\x06\xaa'\x87\xf6\xa1\xfd\xae/\x10\xb1\xc4\xf0uQ\xd4Z\xb8H\x10wl\xa0\xa7\xcb\x11P,\x13\xb2f\xf9zVO\xadA\x1c=\x0f\xf9\xb8\xa7\xedw\x83|\xf0\xc2ӧ\x01\xfdq\xc8K\x1f6>n\x0f\xaf\x82\xfe\x82\xea\xba`\x02\xff\x83\x8a\xda\xd6w\x84\xb3\x94g\xf5\x1f\xf7츍\xc48\x8e:\xffC\xfdbcp\xe1Y\xc7\xd8md+\xba\xc1U\x7f8\xa2&\xde1T',\x1f\xcem9Y\x98#\xeeB\x9a\xf4\xc0\xeb\x87\x0e\xa4!ӹ\x0e\x86\f\x1e\u0088\xffnÙ\xbb\x9c\x1f\x96}ȭeE]\xcd\xd5:-\xca{\x89\xcd\x1eP\x03\r\x85\x82\x85(\x90\xb0]Q\xc7\xde?\xc6\xff\x94\xac\xa9\xd1<\x14k\x88\xb2\xccD,\xc1\x02lG\x03\xa2PI7FpB\xd7G\x94\x8d[_{\xfa\xc1\xb37\xad\xef\xfb\xb9\xc5\xd6\x04\xa7\xa2\xbd\xb6:ܵ\xbe#ʧش%\xe43\xb4\xf7\x9b\xf3\xdfx\xbd\xefJt\x9b\xfb\x18jm/\x89\xce\xf6\x94Y'\xe6U\x8c=\x91\xbd\x0f(\xbd\xda+\x8f\xf5,\xd1a\xcfT\x9b\xc0\xd8\r\xbe\x13\xb0\xd2\x0e\x88\xd6\xe8\x19\xca~\xc57jZ\x91\x9f\xe0\xb8\f`E\xfe^A\x15a\x1e\xb7\xfd%\xe4\xb6\x14\xd2ʩ\xc8+\xd7\xe2F\xc9\x1dV\rG\x1e\xfe\x832܌\xea\x83T7\xbc\xda1\xd1\x04\xc9f\xbd|C\x95a\x94\xf3\x83\xebO\xe4\xdb\x0fLP\xce\xfey\x8c\xe6\xee\xc3i@\xb5\xdb\x1fy\x96Ѝ\xa1\a\xefpY{\xbcw\xf6\x11\xe4\xb3x\xc7c\xfc\xa4\t翝\xd2C\xb5\xfd\xd5\xd8o\xa5\xfft\x8d\xe7aĄ\xa9\xaf0f]\x988KA\x9b\x15l\xb7R\x19\xb7\x80`\xb5\xc2P\x82\x8f磜\xb6\xa9(w\x12}<\xc2P\xd7n\xfay\xbc\xf5U\x1a\xcaZ.\xf6,\xac\x82\x1e0u\xce\x04\xcd2\xcc\xe3\xc1km(\x873+K\xeb|༃\xfc\xe7\x88\xc8K\xa3BX\xf8_\x03\xaae_-\xdc[\x9e\xb1\r\xc28K\x99\xe3\x10A\x90'ŌA;T\x8eT\xe7yT\x19\xb4G9\xc7\bŖFb\xc4\xd3\n\x00\xad;C\xf9\xf5\xb0_\x9d6\xe4\xbb\x1aʐJ\U000e359d8\x80/\xe8\xf5o!\x99\xdd\xe6\x16\x03\xad\x98\xbd\x92\xd5n\x1f8y\xc0\x01!y\x85͓\xd2\n\x1b\x8fiw\x92}\xabFtd{\xa2\x9a\x19\x10\x8aw\xdc\xdd\x1e\x1a\xee\xcc\xf75\x93\xaf\xfdY}+\f\xea\xac|\xbb6=\xb9\xf4\xc5q\x8a\xe1\xee\r\xb6\xd4h\xa0\x89\xe68,\xcb\te\x89k\x8b\xb4o9aGӓU\xfb\xaf\xa8\x15n\xa4f\t\x1eR\x94\xe2\x7fo\x03\b\x04/\xc3\xef.1\xbc\xd7gیg=\x83\x9e\xc6\xfd/\xacN\x97\x18g\\\xa2>T\xa87\b5\xe4\x8d\xd3\xd8M\xd4\xd1\xfbj1N\t\rk\xaf\xfe\u058b9\x98\xd3rk\xde\xf9U~\x13\xb8\xb9m\xbd\xea\x93\x10\x0e\x15\xf5*A\xf4\xb2\xeb\xfe\f\xf5\xd7\xeb\tg8\x9cY\xa6\r\x18#idƫc\xa9Xh=\xfa\xfa\x9c\v36T\\\xaf\xa6t\xb6\xabM\xf1\xa0\xad6~\x9ek\x9b]\\\xee~0\x894d\x01\r[A\xa3\x96P\xa254i\x11Ͷ\x8a\x9ek\x19\xf5\r\xa0\xc9\x17\xd2\x00\x0e[Ii\x96\x92ou\xec\xe1\xa0\xc54n5MXN\xf8\xaf\xac\xd4\x0e\xea,ݳ\xb8\xbe\x03\xa93\xb71\xbb\xe7\x8b\\3\\\x93\x88K7E.\x9fzӢ\xa4ZcA\xb2=2d\xa0\x95p\xb4a\xfd\x11j\x0e\xf4^\x98\xe9\xd4|ؚBT\b\xa3\xf3b:\xf0\x94 M\x12\xb0lf x\x02\x85~\xd8-\xc1\xf1[\x0emDcZUt7<\xf0i\xae\xba\xed@8FG\xad&\x10\x19\xb6\xb982n}U\xbd\xcb&])\xa8w\x97\xb2\x80\x97\xf5\xfeZ4l\x00\xe5,\xaa\x98\x04\x92\"Hg=?/\xde\x1d\x90^̧\xd9\x04\xbdFh\xf5XK\xea\xf7'\x87\xc4\x1bi\xdf\x0e\x8e\xd7\x1b\xda\xe14l\x9a\ta\xec?\xb0\x98\x01cw\xf2\xc9p(\x7f\\/\x92SV\xa3\xbc\x98\x84\x9bX\x02\xe1\x11\x94\r\xad\x9dj\xdaݷ\xbeo<I\xd3Yc\xdd\xdaM\xaeݜ\x7f\x14\x01Z\xbb\x9c\xa8\x83\xb6\xd4\xd5\xff\xfa\x99\x16\"\xfc\x84\xeep\x9d\xae\xb1\xb1\x9al\x0fك\xae\nRP\xc1\xb6\x10[~\xf9,\xb3h(\x93\x92\x86\xa3VF\xc5\xe5\x91\xfd\x12\x04U\xd9\xd8h3ȎJX\x92zu\x04:\xb6\x03p[b1\xc3\xc4!f\xa37\xe0\xc9\n\xf9\ti\xd1Q>K\xc0\xe48\xbf\xcd12;\xb6d\x97\x9d\xda|4\xdf\xec\xbb\xf7\xd8\x19x|\x15H3\xf0|Ė\x99@\x9e\xdf<\xe19\x8c\xf4ف\bh\xb1\xeb\xe7\x1a\xac\x04w\xb3\x8d\x9f\x95ou\xa0˄ؽ\x1e\xdaS2pT\x1f\xd3\xd6d\xaf\xa1\xd9h\xf2\xfa\x144\x98\xa0\x06\x12\x101\xa2\x03\x8f\xba7ZN\xf6\r\x98\x05>\xb3t\xb9\x18\x1dq\x94\xf4\xa3\xe9.\x9b\xc9\x1a\xce[\xa1\xcbX*\xc8\xd08\xbc$7v[\x19\xa2\x01\xba\x99\xb4Y^o\xb7\xee\xb9Iǜ4\xb4\x01XC\x91\xa1\xb1\nZ\xd7/\xa2\xcfS_\xd5\x1be\xed\xec\x9ca\x945\xacgW\x95\x9dw\xc8OTaչ>e\x88\xff\xf0\xdfF\xea\x06<\xd8sW\x0e\xb4\n\aB\xc7_\xb4t :\u05cfn\xdabѼ%O|K\x97Ĩ\n\x16\xff7\x009d\xd8%W\x9a\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xccZݓ۶\x11\x7f\xd7_\xb1\x93<\xf8\xe5H9I\x9b\xe9\xe8\xed|N;\x9e\xd8\xf5\x8d\xcfq_\x03\x01K\x119\x12`\x00P\xb2\xfa\xf1\xbfw\x16\x1f\x14E\x91\x12u\u05f9Լ\x99D$\xb0\xd8\xcf\xdf.\x80Ͳl\xc1\x1a\xf9\x05\x8d\x95Z\xad\x805\x12\xbf:T\xf4\xcb\xe6\x8f\x7f\xb1\xb9\xd4\xcb\xedw\x8bG\xa9\xc4\n\xeeZ\xebt\xfd\t\xadn\rǷXH%\x9d\xd4jQ\xa3c\x829\xb6Z\x000\xa5\xb4c\xf4\xda\xd2O\x00\xae\x953\xba\xaa\xd0d\x1bT\xf9c\xbb\xc6u++\x81\xc6\x13OKo_\xe7\xdf\xfd\x98\xffy\x01\xa0X\x8d+X3\xfe\xd86\xd6i\xc36Xi\x1eH\xe6[\xac\xd0\xe8\\\xea\x85m\x90\xd3\n\x1b\xa3\xdbf\x05\x87\x0f\x81B\\=p\xfe\xc6\x13{\b\xc4\xdeGb\xfe{%\xad\xfbyz\xcc{i\x9d\x1f\xd7T\xada\xd5\x14[~\x88-\xb5q\x7f?,\x9d\xc1\xdaV\xe1\x8bT\x9b\xb6bfb\xfa\x02\xc0r\xdd\xe0\n\xfc\xec\x86q\x14\v\x80\xa8\x1a/H\x06L\b\xaflV\xdd\x1b\xa9\x1c\x9a;]\xb5uRr\x06\x02-7\xb2\xa1!I\x16\x88\xc2@\x92\x06\xacc\xae\xb5`[^\x02\xb3p\xbbe\xb2b\xeb\n\x97\xbf(\x96\xfe\xdfs\f\xf0\x9b\xd5ꞹr\x05y\x98\x957%\xb3\xe9+ix\x05\xf7\xbd7nO\x02Xg\xa4ڌ\xb1\xf4\x9eY\xf7\x85URx\x91?\xcb\x1aAZp%BŬ\x03G/\xe8W\xd0\x10\x90\x8a\x10\x92\x86`\xc7l\\\a`\x1b\xa8\xa0\x98\xe4\xb4:Y+\x0e\rl\x13+\xf0e@%\xf0Oo\"\xf7=\xb2ɿsn\xb0#i\x1d\xab\x9b#\xba\xb7\x1b\x9c\"v\xa4\x8a\xb7X\xb0\xb6r}Q\xd9\xe6 \xec\x88X\r\xf2\\\x84Y\xf1k\x90\xe4\xedѻ\xb0\xeaZ\xeb\n\x99Z\x1cFm\xbf\xf3?,/\xb1\xf61J\xbft\x83\xea\xf6\xfeݗ\x1f\x1e\x8e^Ø#\r\x82\x82\f\xc7z\xb6)\xd1 |\xf1\xf1\x17\xecf\xa3h\x1dM\x00\xbd\xfe\r\xb9;\x18\xb11\xbaA\xe3d\n\x96\xf0\xf4\xb0\xa8\xf7v\xc0ӿ\xb3\xa3o\x00$F\x98\x05\x82@\t\x83_\xc5\xf8A\x11%\a]\x80+\xa5\x05\x83\x8dA\x8b*\xc0\x14\xbdf*2\x98\x0fH?\xa0!2`K\xddV\x82\xb0l\x8bƁA\xae7J\xfe\xb3\xa3m\xc1\xe9\xe8\xcc\x0e\xad\x03\x1f\xa1\x8aU\xe4\xac-\xde\x00SbqD\x18j\xb6\a\x83\xa4\x14hU\x8f\x9e\x9f`\x87||\xa0h\x90\xaa\xd0+(\x9dk\xecj\xb9\xdcH\x97\x10\x9a\xeb\xban\x95t\xfb\xa5\a[\xb9n\x9d6v)p\x8b\xd5\xd2\xcaM\xc6\f/\xa5C\xeeZ\x83K\xd6\xc8\xcc\v\xa2H|\x9b\xd7\xe2[\x131\xfd`\x9fѐ\x0e\x7f\x1eR\xaf0\x0f\xc1kp\x99@*\xe8\xe4`\x05\xa96^u\x9f~z\xf8\f\x89\x93`\xa9`\x94\xc3P;e\x1fҦT\x05\x9a0\xaf0\xba\xf64Q\x89FK\xe5\xfc\x0f^IT\x0el\xbb\xae\xa5#7\xf8\xbdE\xeb\xc8tC\xb2w>\x8b\xc1\x1a\xa1m(\x8a\xc5p\xc0;\x05w\xac\xc6\xea\x8eY|a[\x91UlFF\x98e\xad~n>\xfc\v\x83\x83z{\x1fRN\x9d0\xed(\x1a<4ȏ\xe2N\xa0\x95\x86\"\xc31\x87>\xba\x8e(B\x82\x8aQjGC\xc7A\x82\x1e\xc69Z\xfbA\v\x1c~\x19\xb0|\xdb\r<\xe2\xb1ASKK\x90a\xa1\xd0f\x98yX\x87\xe4\xfd'!\xde\xd0\xe0\x00\xa8\xda\xfa\x94\x91\f>!\x13\x1fU\xb5\x9f\xf8\xf4\x0f#c\x86\x98aH\xfa\v,>\xec\x15\xbfG#\xb5\xb8 \xfc\x9b\xc1\xf0N\x05\xa5\xdeA\xe1\xfd_\xb9jO\xd8e\xf7\x8aG\xf2'4=\xc2Fg\x89\xb1\x15\x033\xea*\x87\xdb\x18Ժ\x80\xd7 \xa4\xa5B\xc2z\xa2\xa7\xcaRm勎\x158\xd3^%>ת\x90\x9bS\xa1\xfb\xb5є\xc7\\ =\xd0ܝ_\x89P\x8b\xbc\xa31z+\x05\x9a\x8c\xe2C\x16\x92S\"(\xe4\xa65\xdeg\xa1\x90X\t\x9bO\x88r\x12e\xf4\xc7\r\nTN\xb2ju\x81\x93n -\xea\x98T!\xbb\x1d\bx\xac1uL\xcdʡ\x12]U\xd3\x7f\x9c\xf6\x80fQ\xc0N\xba2 e\xf2\xe9\x93\xf1ӱG\xcf#\xee\xc7^\x0fx\xff\\\"<\xe2\x9e0\x80X\xb6\xc8\r:\xefmXQ\xe2#W\xca\x01>\xb4\xd6\x11kl\x94b,\xf8\xd2\xecGܟ*\xfa\xa2qc)4:1\x16V+\xf8\xe6\x9b\xcb\"\x9dd\xb7\xf4P\xe9\x9e\x045X\xa0A\xe5\xc6\x19\x05\xf8L\x9a\xf7NC\x1e\x86E\x81\xdc\xc9-VT\x11\xfc\xde\x12x\xde\xc0\xbau Z$mQX\xee\x98\x11\x16\xb8\xae\x1b\xe6\xe4ZV\xd2\xedA\xda\xc5\bqBǪ\xd2;\x14\xd1\xe2X7n\x9f\xc3;e\x1dS\x1cmW\a\x91Ƃ+0\x15F\xc5(\xf6\x05\x1d38I\xbe\xd6\xd6\x01GC\xeeX\xedag\xb4\xdaL\t;\x92\x0ei\x0fh\x14:\xf4\xfbK\xa1\xb9\xa5\u0085c\xe3\xecRo\xd1l%\xee\x96;m\x1e\xa5\xdad\xc4`\x16\xc1gIV\xb4\xcbo\xfd\x7f\x9e\xe2\x05\xda{&\xabf8/\xe55Y\xecaW\xa2+}a\x81\xf0\x10|P\x1b\xa0\x02\x82\\\xbb\x8e\xbe\x1b\x90U\x9c\xe1\xa9_\x97\xf7\xff%\x93\x9f\xb2\x94Q\xf0\\\x03*\x00_\xb3\x83n\xb3\x9a5YX\x9b9]K\xbe\x18\xf7\xfb\xc5Y5\xa4͊TBr\xe6\xd0\x1e\xe3F\xda\xc4Eb\xd3)$\xa6\x8anb\xbe\xb8FM\x02+\xa4\xe5\xfef\x18\xc7Y\xb9o4Pߞ\x929ʉ\x95\x8e\xb5\xa8_\x0fE\x14'\x86Lo?\xca\f![Cj!W\x18Y\xca\x19f\xcb\x1b\x10-\xe1\x11\xecJ\xc9\tqq\x0f\x9c)\x02\xbbV\xc55n`\x8d\x05ň\xffʔ\xa0\xf0\xa5\xa1\xd2\x00\xb3VsI\x05(P\r7\x11\x93Mk6(\x86I\xd8S\xb7\xbd\xcaƂ\xack\x14D\xae\xda\xff/\xd32*n\xf6A\xedO\xb0\xc9O\xdd\xec.\xab\xc6\"-\xd4뙕\x02{k$[\xa4z$T\x93#\x84\xe3VT\xaa#\xd3\xe5\xf01N$\x1b\xfa1\x02Z\x15\xe9Sn,Q\x81t\xaf,\xd0\x16\xc0\xa2\xbbZU\xe7S\xa7\a\xe7_\x0e\vFvƆ\xceQ\x1f=\xb7\xe3$}\x95\xdc8{\xa4.W2G~\xa4^\xb9\xa4TJ7\x98O\x028iO+\xb4IY\xd1]\xe3\xe4x:\x03\xa8\xa8\xa6\x137t\xae$m\x0e\x1f\t4w\xd2\x06\xbf\x9e ]0Y\xc5,e\x90\x89\x1b:)\xe3\bL\xed\xb5\x8a\xa9iG5r\xac\xf7\xd3&{\xdd\xf2Gt\xc0iO>A\xd9`S1O\xea e\xd4A\x97\xf2\xfaV\xd7\ns\xa0R=\n\x02\xf2\x14\\ó+e\x85P\xcb\r\x15\x7fj\xd3?\tq:-6\np\x97@.\x16V!\xc9<\xc7\x1f~NDR%\xc2cD\xc5b\x8c\xa5D\x16c#n\xc8T:}\x84RW\xe24\xce\xd3?\xa2\xf4\xc3\xf7\xd9z\xef\"\xc5\xe4n\a\xaf\x885\xe6\r\x18\xb6\x03m`\xcd,\xfe\xf8\xa7\f\x15\xd7\xe2t7='l\xa2r\xa6>=\xab\xf2\x9c\xa4\t\xc0fV\x9f\x17 2=\xd3U\xe8\x9cJt\xbe\a\\[\x91\xbeDU\xfa\x02\x95\xe9\xf5\xd5\xe9\xcbW\xa83=\xe5|\xa5\xfa\xbcju\x92$\x9c\xadc\xe7\xe0\u05f9zv\xba\xa6\xbdX\xd7^[\xdb\xd2\xd3\x18\xdcJ\xdd\xda\x0e\r'pe^DݟP;\x80k\xc2\xd6t`ia\x87'X\x18\xf3\xe6\x04\xf9\x84ΔJ\r]\xa0QA\xf7\xb9\xc4\xfd+Cٷڇ\x8d\xbb\xd3 \xd0S=J\xea\xddJ\x13\xd4\xe3~\x1f\xeb\xf10\x90\x0e\xebI\xd0=v3\xafH\xafQ\xc2Qm\"\xa0\xd2\xe9\xee \xb9LE\xdce\x98\xbf\x00\xf4O\x87\xfa3$\x81\xf0\xe8\x1a\xb0\x9f\x15ė\x00\x7f\x1e\xe4\xcfuѧ\xc1\xfe\xcb\x00\xff\x8b@\xffS\xc0\xff\x8f\x80\xff\x99\xbes9\x05<9\t\x9c\xa1\b\x97\x8e3\xe6&\x82K\xa9\xe0\\2\x98\x91\x0e\xaeO\b\x177n\x87u\x991l\xbf\x98/Ov(\xdc\x17WHRK\xf5\t\xc9SQ<\xb4~\x8bS\xb4U8\xb5\x1fA\xc7\xcb(\xf0\xe1\f\xbdtR\xa3\xdaz\x8d&!\x84\xc2\x1d\xddW\xdan\xf4\xe0\xc8cd\x91\xc3v\xa7\xb7\x95\xdc0\xb3f\x1b\xcc8uz\xf0n\x1fM{?\xc0\xaf\x8d4\xe8ә4\xddQ\x0e\xf1#(\x83Ҟ\xb2UNV#k\x11{f\xc0\xd2a\xbf\xe5\xb9F1u\xfd@\xf2YV\xe0\xa6efd\xcfQK%\xeb\xb6^\xc1\xeb\x93O\xc1\t\xe8\x12w\x83f\xf05\xb8d\xbc\xb6\xba`\xa4\x8f\xfd\xb1\xe9\x8a\v\xe2-B\xe2\x10\x1d\xed#-($\x1303\x16\x11N\xd3ሢݫ\xd3\xc0\xba\x1b\x89Wvx\x15\xb3\xb8.߆\xad\xf4ؗ\x81(o\xfc\xc0\xe4Dq\a\xee4\x95%\xfe\x06\xed\x12\x1b3P\x8f\xb3;4sx\xb9\xbb\xa5\x81\x11\xa4\xa8\xf4\xb8\xbb\x85u\xabD\xe5\xf3\x13q\xe4\x9do\x8bF\x16\xfbi\x84\xfd\xfc\xfe!i\xd5_\x04\xc6Ӆ\xa4\xdbq\x19\xc2U\xcb\nh\xf7\xfb\x14!\x1b\x83\x85\xfc:C\xc8{?0)\xbca\xae\x04\xa9\xfcY\x18\x1bQ\xff\xe4)X\xef\xec5\x87\x8f1\xa3<\xc1<\xe7\xb0/\xb0s\r\xf0%\x1d\xaf\x16\x17t\x10\x86uZ\x88\xd3\x12\x12\x1c_\xd9\xe6\x8b+$\x8a]DR\xab\xbf\x92h\xa8\xf8\xfe\x023_Ng\x9c\xb9PM]J'4\xc3\xf9\t\xd7Ơm\xb4\xa2s\x96a\fO\xe0ف\xe5|qef\x9bTĸY3\xd0}\xe4\x1a|K\xc6[\xcc0v\xe8\xc8Z-&\xb5:\xda\x05\xf0\xe0gu\xda%\x85\xe9\xb5E\xb3\xed\xb5\x15\x1c\x91\x84\x97\xe9&\x18M\xba\xbd\x16\x03\xearQ\xd0*\xbfW\xf3\x17|\xf9bd\xc6[\xeag\xa1\xcb\x14\xb1\"g\xa0\xf2\x93N\x9bw4\xb9G\xcd\x13\x00\x9fh\xd1\xd7v\xd4F\x14\x1b\\\xe8\xd3\b坬*\xaa\xdf\f֚\x94E7Ć*y\xe6\xb3\xe7\xf6\xfb\xfc\xf5\x1f\u05fd@myԌ\x80\xe2\x13n\xe5i\x97\xd7<u\xbf?\xa1\x92С\x8b\x19\xfa\xf1kj|Y\x9a8\xecW(\xe8\xe46\x9eyξ\xa8\x1a\xe9Q|\xf3\xf0\xfe\x15]\xc6\xd2]{\xda\xecS\xaf\x03\nj\xfc\xd2\xf1\U000a2d4e\x92\xc8E\xfb\xf77_J\xfb;(4\xa9\xf1\x88\x0eO\x837i*\x9d\xa8/\x88\x00\x83\x97Lm(2\xc6 \xbf_#\xf5\xf9$\xef\x99t\x10\xa9&\xbcc\x96A\xa9\xc7\xf2yƜ\xee\b\xed\xf8\xd7őh'z\x1f\xa1\x7fd\x89\xf4r\x98\xca\t\xa63w\xe8\x12}>\xaa\x06_?$\x8c\xe7\xa8\xe7\x98ʸ\x8azy\xb0\xaf\x1f\xd6\xe5\f\x14\xffOʩ\xa9νX<\x7f\b\xa3Hb\x96\xa6\x00[\xeb\xd6\re\xee\x87뫱mw\xec\v\xbe\x86G\xdf\xed|\x81C\xdf\xff\x9c,\xc2[CG-\x87\xb67z9\x9a\x95\xe6#pנ=\xf2\xed\xb4e{\x86\\\xa3Y\xfa\xe4eȴ=\xbbF%\xf7ߴ\xeb\xaeit\x05\xff\xfa\xcf\xe2\xbf\x03\x00k\x01\xc0\xd5K0\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcUK\x8f\xdb6\x10\xbe\xebW\f\xd0k%7(Z\x14\xba5\x9b\x1c\x16m\x03c7ȝ&\xc7\x16\xb3\x14\xc9ΐ\xden\x1f\xff\xbd\x18\xd2\xf2C\x96\x9bͥ\x92.\"\xe7\xf1\xcd\xf7\r\x87m\xdb6*\xdaOHl\x83\xefAE\x8b\x7f$\xf4\xf2\xc7\xdd\xd3O\xdcٰڿi\x9e\xac7=\xdceNa|@\x0e\x994\xbeí\xf56\xd9\xe0\x9b\x11\x932*\xa9\xbe\x01Pއ\xa4d\x99\xe5\x17@\a\x9f(8\x87\xd4\xee\xd0wOy\x83\x9bl\x9dA*\xc1\xa7\xd4\xfb\xef\xba7?v?4\x00^\x8d\u0603A\x87\t7J?\xe5H\xf8{FN\xdc\xed\xd1!\x85Ά\x86#j\x89\xbf\xa3\x90c\x0f\xa7\x8d\xea\x7f\xc8]q\xbf+\xa1ޖP\x0f5T\xd9u\x96\xd3/\xb7,~\xb5\a\xab\xe82)\xb7\f\xa8\x18\xb0\xf5\xbb\xec\x14-\x9a4\x00\xacC\xc4\x1e>\xa8\x119*\x8d\xa6\x018\x94]`\xb6\xa0\x8c)D*\xb7&\xeb\x13\xd2]py\x9c\bl\xc1 k\xb2QLz\xf88`)\x11\xc2\x16ҀP\xd3A\n\xb0\xc1\x03\x02\xc9 \xefg\x0e~\xad\xd2\xd0C'|u\xd5T\x80\x1c\f$N\x0fo\xe7\xcb\xe9E\x00s\"\xebw\xb7 pR)\xf3\x04\xa2\xe4\xb5\xc1é\xec9\x80b\xdf\xc5A\xf1e\xf6ǲq+s\xb5ٿ)\xfb\xac\a\x1cK\x97\xc9_\x88\xe8\x7f^\xdf\x7f\xfa\xfe\xf1b\x19.\xb1.H\v\x96AMH\x85\xb8\x82\x1e!x\x84@0\x06\x9aX\xe5\xee\x184R\x88H\xc9N\xadU߳\xc3s\xb6:\x83\xf0w{\xb1\a \xa8\xab\x17\x189E\xc8E\xc9CS\xa09\x14Zɵ\f\x84\x91\x90\xd1\xd7s%\xcb\xcaC\xd8|F\x9dN\x00\xeb\xfb\x88$a\x80\x87\x90\x9d\x91÷GJ@\xa8\xc3\xce\xdb?\x8f\xb1YꖤN\xa5B\x89\xb4\x9dW\x0e\xf6\xcae\xfc\x16\x947\xcdE`\x18\xd5\v\x10JN\xc8\xfe,^q8#\xaa~\xbf\t\x89\xd6oC\x0fCJ\x91\xfb\xd5jg\xd34Rt\x18\xc7\xecmzY\x95\xe9`79\x05\xe2\x95\xc1=\xba\x15\xdb]\xabH\x0f6\xa1N\x99p\xa5\xa2mK!^\xca\xe7n4\xdf\xd0a\b\xf1Eګ\xee\xa9_\x99\x02_!\x8f̄\xda#5T\xe5䤂\xf5\xbb\xa2\xd7\xc3\xfbǏ0!\xa9JUQN\xa6|K\x1fa\xd3\xfa-R\xf5\xdbR\x18KL\xf4&\x06\xebS\xf9\xd1\u03a2O\xc0y3\xda\xc4SǊt\xf3\xb0we\xec\xca\x04\xc8Ѩ\x84fnp\xef\xe1N\x8d\xe8\xee\x14\xe3\xff\xac\x95\xa8\u00ad\x88\xf0*\xb5\xce/\x93\xd3S\x8d+\xbdg\x1b\xd35pCڅ\xc3\xff\x18Q\x8b\xb8¯xۭ\xd5\xf5Xm\x03\xc1\xf3`\xf50\x1d\xfe\x8b\xb8p\x1a\x14\x97\xfc-\x0f\x06yO\xe3v\xbes\xb3x(\"[\xc2Yög\xc1^\xc5K\x19\xaa_\xc9L\xf1\x99\xb8љ\xa84\xdfqΫ%\xa7\xd7r\x81D\x81\xaeVg\xa0\xde\x17#\x19ZIYϠ\xfc\xcb\xc1\x11Ҡ\x12<#!\xa0\xd7!˴B\x03&_\xf1w\xa0\xe5\xfcN\x8a\x144\xf2\xd5Q\x04\xb0\t\xc7\x05L\xff\xa1\x8e|>;\xa76\x0e{H\x94\xb1\xb9\xd8;*\xa2\x88\xd4\xcbl\xaf\xdc}_\xa0`-6K\x1a\xe0t\xd5~Q\x04\xf9\xd0\xe7\xf1:S\v\x1f\xf0ya\xf5ޯ)\xec\by\xde\xf2Ⲯ졹Q\xe9\x02K\x8bMy\xb5\xc82\n\xcd\x19\x8b\x9c\x02\xa9\xdd9\xaf\x9c7\xc7I\xdf\xc3_\xff4\xff\x0e\x00\xbeM\x1a\xea\xb1\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVM\x8f\xdb6\x10\xbd\xebW\f\xd0K\v\xac\xe4\x06m\x83B\xb7v7\x05\x16٤\x817ɝ\xa6\xc6\x12k\x8aT9\xa47.\xfa\xe3\x8b!%[+\xcb_\x97F9\xac\xc9\xe1\xf0ͼ\x99\xc7\xc9\xf3<\x13\x9d\xfa\x8a\x8e\x945%\x88N\xe17\x8f\x86\x7fQ\xb1\xf9\x95\ne\x17\xdb7\xd9F\x99\xaa\x84\xfb@\u07b6K$\x1b\x9c\xc4\a\\+\xa3\xbc\xb2&kыJxQf\x00\xc2\x18\xeb\x05/\x13\xff\x04\x90\xd6xg\xb5F\x97\xd7h\x8aMX\xe1*(]\xa1\x8b·\xab\xb7?\x16o\xde\x16\xbfd\x00F\xb4XBe_\x8c\xb6\xa2r\xf8w@\xf2TlQ\xa3\xb3\x85\xb2\x19u(\xd9w\xedl\xe8J8l\xa4\xb3\xfd\xbd\t\xf3C\xeff\x99\xdc\xc4\x1d\xadȿ\x9f\xdb}R\xbdE\xa7\x83\x13\xfa\x18D\xdc$eꠅ;\xda\xce\x00H\xda\x0eK\xf8(Z\xa4NH\xac2\x80>\xc4\b+\xef\xa3۾I\xaed\x83mL\x1b\xff\xb2\x1d\x9a\xdf>=~\xfd\xe9\xf9\xd52@\x85$\x9d\xea8\xa9%\xfc\x9b\xef\xd7a\x1a\x00(\x02\x01=\x1c\xf0v\x8f\x10\x84\x01\xe1\xbcZ\v\xe9a\xedl\v+!7\xa1\x03\xbb\xfa\v\xa5\a\xf2։\x1a\uf002l@\xb0\x97d0\xbaK\xdb\x1a\xd6Jc\xb1_\xeb\x9c\xed\xd0y5\xa4<}\xa3\x82\x1a\xad\x9e\x8b\x82?\x0e<\x9d\x82\x8a+\v\t|\x83C\xf2\xb0\xeas\x05v\r\xbeQ\x04\x0e;\x87\x84&\xd5\x1a/\v\xd3Gs\x00\x98\xbegt\xec\x06\xa8\xb1AW\\\x90[t\x1e\x1cJ[\x1b\xf5\xcf\xde7q\xc6\xf8R-<\xe7O\x19\x8f\xce\b\r[\xa1\x03ށ0\xd5\xc4s+v\xe00f0\x98\x91\xbfx\x80\xa68>X\x87\xa0\xccږ\xd0x\xdfQ\xb9X\xd4\xca\x0fm&m\xdb\x06\xa3\xfcn\x11;F\xad\x82\xb7\x8e\x16\x15nQ/Hչp\xb2Q\x1e\xa5\x0f\x0e\x17\xa2Sy\f\xc4p\xf8T\xb4\xd5w\xaeoLzu\xad\xdfqA\x92w\xcaԣ\x8d\xd8\x1d7\xd0\xc3\xfd\x92\xaa+\xb9J99\xb0\xa0L\x1d\xf9Z\xbe{\xfe\f\x03\x92\xc4T_b{S:\xc5\x0fgS\x995\xbat.\x96)\xfbDSuV\x19\x1f/\x90Z\xa1\xf1@a\xd5*OC\xad3uS\xb7\xf7Q\x8a`\x85\x10\xbaJx\xac\xa6\x06\x8f\x06\xeeE\x8b\xfa^\x10\xfe\xcf\\1+\x943\tW\xb15\x16\xd8ÿd\x9c\xd2;\xda\x18\xe4\xf1\x04\xb5\x13\xc9x\xeeP2\xb1\x9c[>\xa9\xd6J\xa6\x96Z[\a\xe2\xa0 }\xa6_'j^\x01\xf8\xf3\xc2\xd5觫\x13,\x9f\xa3\x11_\xff҈ׂ\xf5=\x16u\x01\xda\xd6\xd4\x03Iz\xf4Ô\xa8s\x18\xe6\v}\x16\xc9Pߜ\x06\xce+\v\n\x8b\xdd\x18\xd3\xf1\xd5\xfc\xa1\t\xed\xfc\x059\xfc\x1e1?\xd9:;\xda\x1c\xed\xdf[\xe3\xb9/\xce\x1a}\xb5:\xb4\xf8lDG\x8d\xbd`\xfb\xe8\xb1\xfd\xb3C\x17y<o:\xbc\xe6\xfb\xa7\xef\x8ca\xd0'\xef]\"\xbf x:\xd2\xde\xe0*/W`\xea-\xaf\n\xf4\xfe\xf9\xf1\x96\x14\x9e0\xbf\x81\xa4G\xb3\xb6t\x1e\xf8\xc1\xf0\xbc݃\xdb-\x83Ybg\xddyz.\x1a\x9eP\x96\xe1\x8bc\xc9\xe56\xe1\xc1fh\x13>\xc2m\xc2\x7f\xbf\x0f+t\x06=\xd2A\xfc_\x94of=\x02\xbc4J6Q\xcec\x8f\xf1\xbbBd\xa5\x9aS\xe9+\xe0\xb34)\x873}\x9e\xc7\xfe\x9fYf\xf0G\xcb'\x04\xf5\xd4\x05y/r\xd9\x15>\xc8\v\x1f&\x02uV\x96\xa3\xfd\x90j\x19\x9c\x8b\xaf^Z\xe5agz\xa0Ȯ\xd3\xc4\n\xa5\xdb\xc5;\xdf\xe3\xae\xccβ}4\x05\xf0\xff\x87\xb1\x83\x01\xe0J\x10\xbe\xfd9G#m\x85\x15X\x83\xb9W-\xc2\x06w\x91攨\x81\xed\x19\xafh\xa2W\xacb\xd9D\xc9\xefǼ\xf8\xea\xdc\xc1K\x83\x06\xf8\xc5\xef\a\xd7~b\x05m\xfb\xe7*\x10\xce9N\xf3BN\xaa\xe2Ab\x80^\xc0\xe7Q\xf1\xf59\xc1\nV\t.ŉ\x84\a\xbe\xe1\f\x1e\x17\x11\x80\xa8\x852\tp\x04\xbb\xc1\xdd\x1d\x90\x05\xe5A\nÓ\xc7\xc13[ِf\x98\r\xeeh\xe8\x9c\x01\x7f\x91\xddP\xf2Ë\xf4e\xf9t\x81¡J\xbe,\x9fx\xf0\xf5B\x99\xc4X\xe70'U\x1b\xac\x80\xf7R\xc2\a\xa2\x8e|\xc2d\xf2\xbf\x02#~\xebTz\x81.@|\xb77\xe4j\x8a<\xc7lN\n<9D\x8a\xacHa\x8e\x9cBJ\xb8\xc61\x91;\xf2\xd8\x1e\xe3^[\xd7\n_\x02υ\xb1R\x8f,L\xd0Z\xac4\x96\xe0]\xc0[\x02o\x91H\xd4x!\xea\x0f\xc9jh \x87\x82\xac\x89\x98\xa7q\xaf\x85\xd2Xݔ\xfb\xae\x11t\t\xc0'\xb6\x99\x13\x98\xbd\xa8O\x80\x14\xd9u\xa3O\x0e\x1f\xf1ef\xf5\x93\xb3\x12\x89f:)\x87?b\x8cׇ8\xab\xb2G\x8b\xb1\x8d\xab\x11\x83\xbdh\x94\xe0]\xc0\xec\xbf\x01\x00\x9b}\a\xb9\xfe\x10\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zݓ\x1b\xb7\r\x7f\xd7_\x81\xb9<$\x99\xf1\xae\x1a\xb7\xcdt\xf4f\x9f\x9bε\x89{c\x9d\xfd\x92\xc9\x03\xb5\x84\xb4\xcc\xed\x92,ɕ\xac\xa6\xf9\xdf;\xe0\x87\xb4\x1f\x94t''\xb1\xb43w\xe2\a\b\xfc\x00\x02 \xb8EQ̘\x16\x1f\xd0X\xa1\xe4\x02\x98\x16\xf8ѡ\xa4_\xb6|\xfc\x9b-\x85\x9ao\xbf\x99=\n\xc9\x17p\xdbY\xa7\xdawhUg*|\x83k!\x85\x13J\xceZt\x8c3\xc7\x163\x00&\xa5r\x8c\x9a-\xfd\x04\xa8\x94tF5\r\x9ab\x83\xb2|\xecV\xb8\xeaD\xc3\xd1x\xe2i\xe9\xed\x9f\xcao\xbe-\xff:\x03\x90\xac\xc5\x05hŷ\xaa\xe9Z\\\xb1\xea\xb1Ӷ\xdcb\x83F\x95BͬƊho\x8c\xea\xf4\x02\x8e\x1dan\\7\xf0|\xaf\xf8\aO\xe6\xb5'\xe3{\x1aaݿr\xbd\xdf\v\xeb\xfc\b\xddt\x865S&|\xa7\x15r\xd35\xccL\xbag\x00\xb6R\x1a\x17\xf0\x96\xb5h5\xab\x90\xcf\x00\xa2\x88\x9e\xad\x02\x18\xe7\x1e4\xd6\xdc\x1b!\x1d\x9a[\xa2\x90\xc0*\x80\xa3\xad\x8c\xd04d\xc2\x1fX\xc7\\g\xc1vU\r\xcc\xc2[\xdc\xcd\xef\xe4\xbdQ\x1b\x8360\a\xf0\xb3U\xf2\x9e\xb9z\x01e\x18^\xea\x9aY\x8c\xbd\x04\xd0\x02\x96\xbe#6\xb9=\xb1l\x9d\x11r\x93c\xe2A\xb4\b\xbc3^\xa9`\x85\xac\x10\\-섻\x1d\xb3ġq\xc8O\xf2\xe2\xfb\x89\xa2u\xac\xd5c\xa6zS\x03W\x9c9\xcc\xf1t\xabZݠC\x0e\xab\xbd\xc3$\xfaZ\x99\x96\xb9\x05\b\xe9\xbe\xfd\xcbI\x16tī\xf4S\xdf(9\xc4\xe65\xb5B\xaf9pB\xbaڠ\xc9\x02\xa4\x1ck>\x85\x11G\x04^\xf7\xe6\aN\x1e\xa8\x19\xfa\xed\x17Y!\xc3\x03\xb5\x06W#D\xad,\x9d2l\x83\U0003da82\x06w5\x9a\xa8\xc1U4\xabZu\r\x87U\x92\x18\xc0:e\xb2Z\xd4X\x95aV\xa4\x9bȎT9\\\xf3\xf7\xb0\xb4\xca \xcbZZ\xf2F\xa5\x1f!\x94̛۫\r>\xc9\xd4\xfa\x90J\xc5\xf1\x80\x1fN\xd8\x12\x16\xb4Q\x15Z\x9b\xc5\xceo\xba\x92h\xc4\xce\xc0\xc8\xdbc\xc3E\x80j\xf4 &~:\xdd(\xc6рSP3\xc9\x1b\x04\x92\x1c\x9caҮ\xd1d\x98 \x05\xa6i\x0f{=d\xe5}\xec8\xc5N\x18\xb5\xfd\xc6\xf7۪\xc6\xd6\xfb|\xfa\xa54\xcaW\xf7w\x1f\xfe\xbc\x1c4\x03!\xa2\xd18\x91\xfcr\xf8\xf6\xa2N\xaf\x15\x86\xe2\xfe\xaf\x18\xf4\x01\xd0\x02a\x16p\n?h\xbd\x1e\xa2\x87E\x1ey\n\xf0\b\v\x06\xb5A\x8b2\x04$jf\x12\xd4\xeag\xac\\9\"\xbdDCd\xd2^\xa8\x94ܢq`\xb0R\x1b)\xfe{\xa0m\tkZ\xb4a\x0e\xad\xa3-\x8eF\xb2\x06\xb6\xac\xe9\xf0\x050\xc9g\x03\xc2в=\x18\xa45\xa1\x93=z~\x82\x1d\xf3\xf1\x832\bB\xae\xd5\x02j\xe7\xb4]\xcc\xe7\x1b\xe1R,\xaeT\xdbvR\xb8\xfd܇U\xb1\xea\x9c2v\xceq\x8b\xcd܊M\xc1LU\v\x87\x95\xeb\fΙ\x16\x85\x17D\x92\xf8\xb6l\xf9\x17&F\xef\xe4QN(:<>\x84>C=\x14TAX`\x91T\xc0\xe4\xa8\x05j\"\xe8\xde\xfd}\xf9\x00\x89\x93\xb0ÃR\x8eC\xed)\xfd\x10\x9aB\xae\xc9\xe6i\xdeڨ\xd6\xdb\x00J\xae\x95\x90\xce\xff\xa8\x1a\x81ҁ\xedV\xadpd\x06\xff\xe9\xd0:Rݘ\xec\xad\xcfW`E{\x89<\x00\x1f\x0f\xb8\x93p\xcbZln\x99\xc5?XW\xa4\x15[\x90\x12\x9e\xa4\xad~\x16v\xfc\x84\xc1\x01\xde^GʡN\xa8v\xe4ٖ\x1a+R,aK3\xc5Z\xc4`\xb2V\x06\xd8\xd8\x11\x0eq\xca;\x00\xfaf\x03\xc9x\xd0%\xa3\xa3\xef\xeb\x1c\xa1İ\xec9\xf0\x14\xf0bLl\xe2\xd0\fɣ\x97\x8fs\fje\x85SfO\x84C\x80\x1c\x1b\xc4I\xdd\xd0S1Yas\x8dx\xb7~&\b\xc9\tv<\x184\xb9\xa2@\xd5[\xbd\x92\x1bE[l\xac\r\xb8sP1IFn\xd1\xcd&\xe4)\xa2\xc9S\x01MH8\xa6\x98\xd0O%\x8f\x9f \xf4J\xa9\x06\x99\x9c\r\xba\x80\xc2\xdd\x05\x99)\x00\xe6\x94ES\xc1\xd5\xcc%\xdeh\x90餜bK_%\x9f\xa5\x0e\xad\xf8\x05\xbe\xe2\x8a\f\f\xaeѠ\xcf{\x83\xef\xd7\xcaG\bǄL>-\x1cV\xc0\xa9\tM \xe0Ɉ\x90\xc3xo\x9c\xdf\x1f\xe7\x02e\x96\xe3W\xf7w鸑@\x8c\xbcO\xe2\xddE|\xe8Y\vl\xb8O\xab.\xaf\x9d\xb5\\z\xee\xd6\x01@Z\x83,\x96\x81\x16X\xe1 \x1a\x83\x90\xd6!㱑\x9c\xa0\xc1\xd8\xf7\"x\xfa\x93L\xd2s\x8cڤ\x13`\x14y\x04\x87\x7f.\xff\xfdv\xfe\x0f\x15\xe4\x00VQjFG\x14\x87-J\xf7\xe2p\x90\xe2h\x85AN\xc7\",[&\xc5\x1a\xad+#54\xf6Ǘ?\xe5\xf1\x03\xf8N\x19\xc0\x8f\x8c\x8e#/@\x04\xcc\x0f\xc1,\x99\r\x197\t~\xa0\b;\xe1jϨV<\n\xb8\xf3\"8\xf6\x88\xa0\xa2\b\x1dB#\x1e3\xfb'<7\xe4\x8b{l\xfeB\xbb\xe7\xd7\x1b\xf8*8\xaf\x1b\xfay\x13\xd88\xa4-\xfd\rvd'\xec2#6\x1b<\xe6\xfd\xe3\x0fM\xc1-J\xf75(C\xb2J\xd5#\xe1\t\x93g\f\xf1\x01\xf9\x84\xbd\x1f_\xfet\x03_\x1dg\x10\x06'\x96\x12\x92\xe3Gx\t\x82\xfc\x12\xa5Պ\x7f]\u0083\xb7\x83\xbdt\xec#\xb9\x82\xaaV\x16%(\xd9\xecI\xba\x9am\x11\xacj\x11v\xd84EH\x109\xec\xd8\x1e\xd4\xfa\xc4:IEd\x9a\f43n`\x96Wm\x9ai\xd6\xf4\xbc\xfd⳨'\xed\xdeϖ\x81<\x11\t2\x89OA\xa2\x7f\xf4\xba\x02\t*5\x19\x89\x0e}\x19\x8b\xab\xcaR\xd6\\\xa1vv\xae\xb6h\xb6\x02w\xf3\x9d2\x8fBn\n2\xc6\"l\\;'\xc6\xed\xfc\v\xff\xe7Z\xc1}\xfd\xe7S\xa5\xf7D>\x1f\x04\xb4\xba\x9d_\x83@\xca\xee\x9f\x1e\xbbNⰌ\t\xe7\x98&\xed\xf9]-\xaa:\x9d\xf5z\u07b6e<\xb8c&\xf7\x9fi\xef\x10Ν!\x8e\xf6E\xac\x81\x16Lr\xfa\xdf\n\xeb\xa8\xfd\x1a`;\xf1I\xce\xe5\xfdݛϹ\xa3:q\x8d'9q\x86\t\xcf\xc7\xe2\xc8U\xd12]\x84\xd1̩VT\xa3є\xc3\xdfqR\xd2Z\xa0Y\xcc\xceb\xf8n08%\xa8\x99\xd3\xc0aL9{\x86X\x8em2\t_\xbf<|.-<\x8b\xd7eSx`\x1b\v\xcc 0h\x99&\x8bx\xc4}\x112\x0ë́!Y\x99Ki\xd5\n\x81i\xdd\b\xe41\x8b\xc8P\x8c\xf9o\x84\x87Y/_\xf9\x1cU\xa6\xaa\xd4\x12\x9d\x13\xf23\x82\xf3~\xc4\xc8o\vT\x12\x93R\xa7\xb5\xd8\xc4j\xe7\x14)\xd95\r[5\xb8\x00g:\xbc\x06H*\xef-\xce˟D\xa5\xa1\xc9\xc2/\x14\x18\xf3R\rʎSaPv픕\x02\x1e\x95\x16,\xd3nк\xc9\xee\xa5\t77\xb3gh;\x18\xe5\xe2\n\x1b\b\xc7\xe0ܩ4\x1azL\xe0\xd3\xc9ԩ\xe3)/C.w\xee;\xc97\x9d\xee\xe982什U\xae\xca1\x1aӫ.\xa7&\xad\x86\x1c\x15#78\xea\f\xf2͞`kt\x90\xeaF\x1bp\x80쨜@ǫ\xce&LCpt\xe9N\x8b\xd2\xeeI\xe5b\xf6\xb4s2\x1d\xec\xb4C~(\xf4_\xa3\xf1Wc\"\xbe\xf6kx\xdc\x14t5\x91\x8e\xfeC_G\xf2x\xedk\x83\x9ae\xabB\x00\x0fT9\xf3%\xe6/m &,t\x169\xdd\x15MמPH7JT\xa3,h\xfeu\xfe\"\xbbK\xaap\x99ֿ)\xb9\x06\xc0\xdb)\x99)\x84,\xa1\xe6\xafp\xd2-^y\x96\xdc\x01\xaf@\r\xb9?\x85\xd2!y\xcdDCw\x80\xf1\x8a\xf8\x99TV\xb8\xa6b{\xf0q\xa9\x8e\x13\xd9;}\xfe;\xaf\xc9\f\b\xf6\x0fUf\x8bֲ\xcd%\x9f\xf7C\x18Ep\xb04\x05\xd8Ju.o\xe4_ڸO\xcb\xe7𢳕\xa3\x01#T\\J\x1ea\xdd5\x8d\x9f\x93\xaa#\xa9F\x11^\x1f\xa0\"\x00\xacp\xbaL*%\x9f\xa8\xaf\x9dc\x90ʙ\x978\xa419\xa7u\x88\bg\xbdֹ\xe8\xf7\x16w\x99\xd6\xe4\f2]\xf7\xd1\xc3d\xba&\xef\x01\x1c\xbfE, \xe7\x90K}Y\x9aј\xb3}\xdf1\x91\x9bt\x0e\xec\xc8\xdf5\xbe\xe5P\x80\xaeU\x93܉\xbf\x1d\x97]\xbbBC\x9a\xf0\xf7\xef\xa3 \xcd$\xef\xab-C\xb87?YP\xb8ɏզX7\xf7\x9e\xda)\xe0\xc2\xea\x86\xed\x0f\xb2\xf8\xf3\x91i\xa7\x918\x06\xa6ÎJnE\xe3\xa9|\xef|\x19\xf8\xf0\xaeB\xae3\xff\xc2\xc1\xf03}u`\xf89\xbe\x83\xf0\xfb\xacp&_\xb5\x92i[+w\xf7\xe6\x82i,\x0f\x03\xd3~\x14\x87,\x86 \xf0H'j\xd1\x14&\x14\xa1\xe7\xdd\xca\xe7\xd8\xef\xf0Օk\xacx9\xa0p!8\xc67i\xa6,\x02,\xc9\v\x90\x03\U000b77f7\xe3\xd7\x1c^\x1c^\x9d`.V\x91\xab\x9a\xc9M\xb6\x96\xa5$\xd5U\xe86\xcb>?\xda\r\x05\xb2\xb3SF\xf3\xdb\a\xba\xac9M\x1a}\x9c\xe6=\xda\xf1\xe2\xaf\xdfҭR]\xc9.\xe0\x97_g\xff\x1f\x00\xd9H\xdbA\x14'\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zݏ\x1b\xb7\x11\x7f\xd7_1\xb8<$\x01\xbcR\xe3\xb6A\xa17\xfb\xdc\x14\xd7&\xee\xc1:\xfb%\xc8\xc3h9\x92\x98\xdb%Y\x92+YM\xf3\xbf\x17\xc3\x0fi\xbf$\xddɈ\xbdZ\xc0^~\f\x7f\x9co\x0e\xaf(\x8a\t\x1a\xf9\x81\xac\x93Z\xcd\x01\x8d\xa4\x8f\x9e\x14\x7f\xb9\xe9\xe3\xdf\xdcT\xea\xd9\xf6\xbbɣTb\x0e\xb7\x8d\xf3\xba~GN7\xb6\xa47\xb4\x92Jz\xa9դ&\x8f\x02=\xce'\x00\xa8\x94\xf6\xc8͎?\x01J\xad\xbc\xd5UE\xb6X\x93\x9a>6KZ6\xb2\x12d\x03\xf1\xbc\xf4\xf6O\xd3ﾟ\xfeu\x02\xa0\xb0\xa69\x18-\xb6\xbajj\xb2伶\xe4\xa6[\xaa\xc8\xea\xa9\xd4\x13g\xa8d\xe2k\xab\x1b3\x87cG\x9c\x9c\x16\x8e\xa0\xef\xb5\xf8\x10輋tBW%\x9d\xff\xd7h\xf7\x8f\xd2\xf90\xc4T\x8d\xc5j\x04G\xe8uR\xad\x9b\n\xed\xb0\x7f\x02\xe0Jmh\x0eo\xb1&g\xb0$1\x01H\xfb\f\xd0\n@!\x02簺\xb7Ry\xb2\xb7L\"s\xac\x00A\xae\xb4\xd2\xf0\x90!Dp\x1e}\xe3\xc05\xe5\x06\xd0\xc1[\xda\xcd\xeeԽ\xd5kK.\xc2\x03\xf8\xd5iu\x8f~3\x87i\x1c>5\x1bt\x94z\x99KsX\x84\x8e\xd4\xe4\xf7\x8c\xd9y+\xd5z\fŃ\xac\tDc\x83h\xc1IU\x12\xf8\x8dtCx;t\f\xd1z\x12'\xc1\x84~&\xe9<֦\x8f\xaa55\xc2\x12\xe8i\fԭ\xaeME\x9e\x04,\xf7>\x89\x06`\xa5m\x8d~\x0eR\xf9\xef\xffr\x12\x82I\f\x9b\x86\xa9o\xb4\xea2\xe75\xb7B\xab9\"ai\xadɎrH{\xac>\x05\x88g\x02\xaf[\xf3#\x92\an\x86v\xfbE(\xacz\xa0W\xe07\x04\xaf\xb1|l\f,\xbc\xb6\xb8&\xf8Q\x97Q\x84\xbb\rY\x16!\xc12\x8e`\v\x06ɲ\xd3vTt\x86\xcai\x1c\x9b\x88eZ=\xf9u\x17\xfaC\xf4\xab\xb4\x84\xa3\xfa\x95]\xd14\x8c\x90Z\x8d+٫5=I\xc1ڌTZP\x8bk\x03\\ҁ\xb1\xba$\xe7F\xb9\x17\x8cm\xcaDRgD\xf2\xf6\xd8p\x91E\x1b\nl̀\x1aSi\x14d\xc1kؠ\x12\x15\x01o\x1d\xbcE\xe5VdG@\xb0\b\U000f41fd\xe9By\x9f\xe9\xb5z\x06\x98\xe2\xd0\xedw\xe1Õ\x1b\xaa\x83\xdb\xe7/mH\xbd\xba\xbf\xfb\xf0\xe7E\xa7\x19\x98-\x86\xac\x97\xd93\xc7_+\xf0\xb4Z\xa1\xbb\xe7\xff\x15\x9d>\x00^ \xce\x02\xc1\x11\x88\\\x90F\xf2\xaf$\x12\xa6\xc8#\xe9\xc0\x92\xb1\xe4HŘ\xc4ͨ@/\x7f\xa5\xd2O{\xa4\x17d\x99\f\xb8\x8dn*\xc1\x81kKփ\xa5R\xaf\x95\xfc\uf076c\x86\xf3\xa2\x15zr\x9e͛\xac\xc2\n\xb6X5\xf4\x02P\x89I\x870Ը\aK\xbc&4\xaaE/Lp}\x1c?\xb1\x17\x95j\xa5\xe7\xb0\xf1\u07b8\xf9l\xb6\x96>\x87\xe3R\xd7u\xa3\xa4\xdf\xcfBd\x95\xcb\xc6k\xebf\x82\xb6T͜\\\x17hˍ\xf4T\xfa\xc6\xd2\f\x8d,\xc2F\x14o\xdfMk\xf1\x95M\x01<{\x93\x13\x82\x8eo\b\xa2\xcf\x10\x0fGUv\"\x98HE\x9e\x1c\xa5\xc0M̺w\x7f_<@F\x12\r=\n\xe58ԝ\x92\x0fsS\xaa\x15+>\xcf[Y]\a\x1d %\x8c\x96ʇ\x8f\xb2\x92\xa4<\xb8fYK\xcfj🆜g\xd1\xf5\xc9ކ\x94\x05\x96lP\xec\aD\x7f\xc0\x9d\x82[\xac\xa9\xbaEG\x9fYV,\x15W\xb0\x10\x9e$\xadv\"v|\xe2\xe0\xc8\xdeVG\u03a2N\x88\xb6\xef\xdf\x16\x86J\x96,3\x97\xa7ʕL\x91d\xa5-\xe0\xc0Ow95\xee\x02\xf87\x1aQ\xfa\x83.\xa9\x1d\xff^\x8f\x11ʈUˑ\xa7x\xe7RD\xac\xd2\xd0\x11\x92\x83\x18i\xc9h'\xbd\xb6\xfbc\xa4\xec\xab\xc4I\xe9\xf0[\xa2*\xa9\xbaf{\xb7a&H%\x98\xeftPivF\x91j\xd0{\xad֚\x8dl \x0e\xb8\xf3P\xa2b=w\xe4'\x03\xfa\x1c\xda\xd4\xc9\xc8&\x15\x1csLh\xe7\x92\xc7'n{\xa9uE\xa8&\x9d.N\x93/l\xfa^'\xc7aiE\x96B~\x19ݬ\xd1\xc1\x19{\x94*\xbb\x8fx4\x00\xaf\a4\x817h\xe9\x94hN\xebṐ4\n\xf8\xd5\xfd]N\xeb\xb3f%\xe8\x83\xc8rQ-\xf8]I\xaaDHc.\xaf=\xaa!\xfcޭ\"\b^\x835\x03\xc1H*\xa9\x13\xf7@*\xe7\tEjdwc)\xf5\xbd\x88>\xf5$H~\x8f\xf1\x91E\x02\xc8>^\n\xf8\xe7\xe2\xdfog\xff\xd0q\x1f\x80%gB|\x10\xf0T\x93\xf2/\x0e\xe7\x15ANZ\x12|\xfa\xa0i\x8dJ\xae\xc8\xf9i\xa2F\xd6\xfd\xfc\xf2\x97q\xfe\x01\xfc\xa0-\xd0G\xe4\xa4\xff\x05\xc8\xc8\xf3C\xd8\xc8Z\xc3\x16\xcf\x1b?P\x84\x9d\xf4\x9b\x00\xd4h\x916\xb8\v[\xf0\xf8H\xa0\xd3\x16\x1a\x82J>Ҹ\xe4\x01n\xd8\xe9\xb5`\xfe\xc6.\xe5\xf7\x1b\xf8&:\x89\x1b\xfe\xbc\x890\x0e\tB\xdb\xeb\x1c\xe1\xf8\rz\xf0V\xae\xd7tL\xb4\xfb\x0fO\xa1-)\xff-h\xcb{U\xbaE\"\x10f\x0f\x14\x1d1\x89\x01\xbc\x9f_\xfer\x03\xdf\x1cg0\x0fN,%\x95\xa0\x8f\xf0\x12$\x9b?g\xb1Z|;\x85\x87\xa0\a{\xe5\xf1#\xdbe\xb9ю\x14hU\xedyw\x1b\xdc\x128]\x13쨪\x8a\x98\x8a\t\xd8\xe1\x1e\xf4\xea\xc4:YD\xac\x9a\b\x06\xad\xef\xa8\xe5UF3\xccO\x9eg/!_y\x92\xf5~\xb1X\xffDN\xb0J|\n'\xdaG\x9d+8\xc1u\x1d\xab\xc8S\xa8\x19\t]:\xceOK2\xde\xcd\xf4\x96\xecV\xd2n\xb6\xd3\xf6Q\xaau\xc1\xcaXD\xc3u3\x06\xeef_\x85\x7f\xae\xddx\xa8\xb3|\xea\xee\x03\x91/\xc7\x02^\xddͮ\xe1@Σ\x9f\x1e\xbbN\xf2a\x912\xbb>M\xb6\xf9\xddF\x96\x9b|\xaajy\xdb\x1aEtǨ\xf6_\xc8v\x98ύeD\xfb\"\x15\x1c\vT\x82\xff\xef\xa4\xf3\xdc~\rc\x1b\xf9I\xce\xe5\xfdݛ/iQ\x8d\xbcƓ\x9c8-\xc4\xf7cqDU\xd4h\x8a8\x1a\xbd\xaee\xd9\x1b\u0379\xf2\x9d`!\xad$\xd9\xf9\xe4,\x0f\xdfu\x06\xe7\xac}$\xeb>\x8c\x99N\x9e\xb1-\xa7и\x8d\xf6wo.\xe0X\x1c\x06f\fG\x19\xa6\xa43\xd3b\x938\x9bk\x9e\xc3\x13l\xeb\xe0t.\x81\xea\x8e\xceȴ\x95k\xa9\xb0:z@\xae8\xf2\x17\x06\xaa\x03\xa2\x005\x1a#\xd5\xfaYXs\xbdhA\xdeK\xb5\x1eI\x9c\xdb\xe5\xecs\xe9\xf5\x99E\x9ebR\xef{@\x00-\x01\xf2\x9eXB\x8f\xb4/b\x16gPZ\xe6\x10\xfa\x9c\xaa.\tИJ\x92H\x99\xd9\b\xf5\xbcMβVr\x9d*\x91CN\xa9\xa6\xaapY\xd1\x1c\xbcm\xe89\xe6\x93W\xe0\xf2\xda\xfc\xfc\xfe\xf3Vyh\x16\xf7\x85\xd2\xdf\xf8\xae:\x05\xc1\xe1fH5\xf5\x10J\x01\x8f\xdaH\x1ci\xe7\x83\xd5\xc0\xd0y\xc2\xcd\xcd\xe4\x19Ҏ\x96t\x81\a\xf1\f:v\x80O\x86\x98\xd2\xfatdeV,\xc7Xp\x85\x81\xf2њ\xcf(]\x84\x05,\xc7J\f\xbd1FwS\xfa\xa2\xe7\b{\x9dG\xcf\xd4\xef\xe8\x1a}\xaf7\xb2`\xf2\x04\xcd\xe3\x13X\xd33\xc7\x0e\x9f\xfb\xc7}>\x985.\xf3=\x86U\x9f/\x9d8a\x1f\xd6\x16&O;b\xf3\x99\xd0x\x12\x87\x9a\xfc\x05\r\x18\xf5\x02\xaf\xfaDB\x81֊d$|\x8d\x90\x8b\x06IO\x92\x02\xa4\x83\xe1\x92\xc0X28\xaa\x11\x00\x0f\\\xde\n\x85\xe0\xaf]\xa4&\x1d4\x8eD\xf0\xad\x83\xc5\a\x14\xf2\x9d\x0fW\x12\v\x9e\x7f\x9d\x03\x195\x9b2^w\xb5o5\xae\xe1\xe0\xed\x90̐\x87\x98\xed&\u07b7䋶\xe9Yz\a\x86Er$\xc2\x11\x96O\xd8+\x94\x15\x89L\xd3=\x9f\xf3#\xa0\xddge~M\xce\xe1\xfa\x92\xd3\xfa)\x8eb蘧\x00.u\xe3Oh\xe5\xd7.\x99\xd6\xf49`\xf8\x1a\xe9\x02\x12\xbeXb\x18\xea\xf4\x15\xd6\x10̀$t\xae\xb5\x9e\x851\x14\t/\x80\xbc\xe71c\xae\xe6\x00\xf9\xbc\xaf9\x17\xc3\xde\xd2n\xa45[\xf0H\xd7}r\v#]\x83\xfb\xf5\xe3\xafH\x95\xd9!\x03\x8e}\xa34\x93F\x8f\xf6\xfd\x80rl\xd29n'|\xd78\x84C]w\xa3\xab\xec\x03¥\xb3j\xea%Y\x16E\xb8\xd6\xce29d\xc0\xa8D[r#\xa4\x8f\x14\x92\x86\xa5\xab\xfaT_J\x05\xe9`\xe5^\x83\x90\xceT\xb8?l&\x9c\x88l=\f\xb3)\x9e\x1c\xec*\x11\a\xce>O\xe4m\xe7+\xbf\x87?\x02\x18\xeb\x1c\xbf\xc9\xef>\xc3;\xf9\xees\xbc\xdc\xffcV8\x93wv\xff\xd8\xe2\x1a\x05Yt(\\\n\x16\xe9\x8f?\x9e\xef\xe3\xbb\xcb|N\xf7>ʽAc@.Z\xb4\xd3\x15R\xbb\xa5Y\xe6ʉ\x9b\xc3o\xbfO\xfe?\x00\xec\xe3\xc3\ac%\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVK\x8f\xe36\f\xbe\xe7W\x10\xe8u\x9ctQ\xb4(|+f\xb7\xc0\xa0\xedb0Y\xec]\xb6\x99D;\xb2\xe4%\xa9̦\x8f\xff^P~ı\x93I\xda\x056\xd6%\"\xc5\xc7G\xf2\x93\xb2,[\x98\xc6~Db\x1b|\x0e\xa6\xb1\xf8E\xd0\xeb?^>\xff\xccK\x1bV\xfb7\x8bg\xeb\xab\x1c\xee#K\xa8\x9f\x90C\xa4\x12\xdf\xe2\xc6z+6\xf8E\x8db*#&_\x00\x18\xef\x83\x18\xddf\xfd\vP\x06/\x14\x9cCʶ\xe8\x97ϱ\xc0\"ZW!%\xe3\xbd\xeb\xfd\xf7\xcb7?-\x7f\\\x00xSc\x0e\x84,\x81PO\x16\xa6|\xe6\xe5\x1e\x1dRXڰ\xe0\x06K\xb5\xbd\xa5\x10\x9b\x1c\x8e\x82\xf6l緍\xf9\xa95\xf3ԙI\x12gY~;'\xfdݲ$\x8d\xc6E2n\x1eD\x12\xb2\xf5\xdb\xe8\f\xcd\xc4\v\x00.C\x839\xbc75rcJ\xac\x16\x00]\x8a)\xac\fLU%Ќ{$\xeb\x05\xe9>\xb8X\xf7`eP!\x97d\x1bU\xc9\xe1\xc3\x0eSJ\x106 ;\xec\x1d\x82\x04(\x10\x14\x1b\xac`\xc8\v\xe0\x13\a\xffhd\x97\xc3RAZv\xfa\x1aN\xa7\xa1ֆ\xbcG\xfbrиY\xc8\xfa\xed\xa5HX\x8cD\x1eb9f=u\x9d\xf4\x96\xcd\xce\xf0\xa9\xdbu\x12\\\xf2\xd8\xea\xec\xdf$9\x97;\xacSC\xe9\xbfР\xff\xe5\xf1\xe1\xe3\x0f\xeb\x93m8\x8d\xf1\xefl؇ii\xc12\x18 \xfc\x1c\x91E\xf1\x8b\xbe\ni'!q\a\xc5\x01*t(\xd6o\x13\xd4V\xb0f\xb0\x02%\xa1\x11\xac\xc0\xf8jd\x9ep\x8f4W\x8eM\xa5\xca\xcbA\xb3\xa1Шbߕ\xed7\x9a\xb9\xd1\xeek\xe9\xe8\xa7\b\xb4\xa7\xa0\xd2\xe1CNλ\xfeª\x03\xad-\x90e l\b\x19};\x8e\xbam<\x84\xe2\x13\x96r\f\xb0\xfd\xd6Hj\x06x\x17\xa2\xabtf5= ,\xc3\xd6\xdb?\a۬ЩSgD\x81L\x1d썃\xbdq\x11\xef& \xe9\xaa\xcd\x01\b\xd5'D?\xb2\x97\x0e\xf04\x8e?\x02!X\xbf\t9\xecD\x1a\xceW\xab\xad\x95\x9e\x89\xcaP\xd7\xd1[9\xac\x12\xa9\xd8\"J ^U\xb8G\xb7b\xbb\xcd\f\x95;+XJ$\\\x99\xc6f)\x11\xaf\xe9\U000f2bbe\xa3\x8e\xbb\xf8\xc4\xed\xac\x13ە\b\xe4?\x94G)\xa5m\xb3\xd6T\x8bɱ\n}\xb3<\xbd[\x7f\x80>\x92\xb6RmQ\x8e\xaa|\xa9>\x8a\xa6\xf5\x1b\xa4\xf6܆B\x9dz\x00}\xd5\x04\xeb%\xfd)\x9dE/\xc0\xb1\xa8\xadp\xdf\xf4Z\xba\xa9\xd9\xfb\xc4\xd6\xca%\xb3\xc6m׃\x87{S\xa3\xbb7\x8c߸VZ\x15δ\b7Uk|\a\x1d\x7f\xadr\v\xefH\xd0\xdf \x17J;\xe1\x8eu\x83\xa5\x16V\xb1Փvc\xcbv\xa46\x81\xe0eg\xcb]\xcf#'6A\x87EI:Q\xf4)|\xe7yA\xbfΒr\xf3Tt>L\xd5\xecûpY\\\b\xe2\x1585\x8e\xcf\xd1\x12NF \x1b\xc7w\x13ԉ\xf3\xf3ŵ,\x06\xb0\x93~\x9fO\x19\x89R/\x0fW\x8f\x99\x1e\xb8\x15\xd72ԍ\xf2{\xf0\x1fl\x8d,\xa6n\xae\xe0{??\x91\x18\x91\xaa68\xb15\x9e\\\x85\xf0bN{\xf5\xc4\xf3|\xba\x006\x81j#9\xe8\xf0ejo\xa6\xe1\xa3s\xa6p\x98\x83P\xc4\xdb\xcb\a\x80D\x81\xf8J\x8e\uf492\x12\xbe\x18\xeb\x19\x8c?t\aAvF\xe0\x05\t\x01}\x19\xa22=VP\xc53\xaet\x8d\x81\x98'\x9a\xae\xd3y,\xaf&pc\xf2\x86\xc8\x1c&\xb2\xe4\xee\xad\xde\xe7X]A\xe0a\xa4:\x8cQ\xac\v$\x1d\xa4dhx\x03\x14\x87\xf1d\xcd\xec\xc2\b\xb3\n/\x94\xbcMX/\xce-\xd2D\x9a\xbc=\xa5\xa7\xc5ոg\xf7\x8f\xae\x87\xb1\x81K\xd9t\\?\xc9f\x14;\xf5\x06$\x9c\xf1!;\xb4\x94\x1e\x83\b\x05nڣ\x83\x99\xff\x91\xf0\xfa\xd96\xcdW\xe4\u06dd\xbf\x94\xee1/\x87\x1b\x01\xc3`\xf9\x0e\n,M\xe4\x14\xfa\x01|\x00\x17\xfc<<\xfd\xf0\x8be\xb9\x83@\xa7G:\xa0\x1a\xa7\xaf|`\xeb˯\x80!=\x95\xaf\xe4\xff\xa8:\xe7xq \xfcW\x89Q\x17\xfaXϽd\xf0\x1e_\xce\xec>\xf8G\n[B\x9esZ\xd6s#N\x9f{*{4$\xd68w\xf8\xd5XwV\xe3\x82\xe0\x15.`1$\xb7\x12\xf7\xfaD\xf9:gk3\xd37\xe6\xe7\xb3\xd7\xe5l\x93\xf5\xddW\x8dlk\x85\xcdv\xec\x8dc1<ks\xf8\xeb\x9fſ\x03\x00\xd5c'A\xd5\x0f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[s\x1b\xb9\xb1\xf0;\x7f\x05J_\xaa\x9cl\x91t\xb6\xbeK}\xa57\xaf/Yewm\x1dɱ\xdfR\x05\u0380$\xa2!0\v`$3'翟\xea\xc6e.\xc4\xcc`\x86\x92l\x9f\x8a\xb8U\x89əF\xa3\xef\xe8n\x00\xab\xd5jAK\xfe\x89)ͥ\xb8$\xb4\xe4\xec\x8ba\x02\xfe\xa5\xd7w\xff_\xaf\xb9|y\xff\xe3⎋\xfc\x92\xbc\xae\xb4\x91\x87\x1b\xa6e\xa52\xf6\x86m\xb9\xe0\x86K\xb180Csj\xe8\xe5\x82\x10*\x844\x14\xbe\xd6\xf0OB2)\x8c\x92E\xc1\xd4j\xc7\xc4\xfa\xaeڰMŋ\x9c)\x04\ue1fe\xff\xf3\xfa\xc7\xff\xb7\xfe\xbf\vB\x04=\xb0K\xa2\x986R1\xbd\xbeg\x05Sr\xcd\xe5B\x97,\x03\x98;%\xab\xf2\x92\xd4?\xd8w\xdcx\x16\xd7\x1b\xfb:~Spm~i~\xfb+\xd7\x06\x7f)\x8bJѢ\x1e\f\xbf\xd4\\쪂\xaa\xf0\xf5\x82\x10\x9dɒ]\x92\xf7\xf4\xc0tI3\x96/\bq\xa8\xe3\xb0+\x87\xf5\xfd\x8f\x16D\xb6g\a$\a\xfcK\x96L\xbc\xba\xbe\xfa\xf4\xbfo[_\x13\x923\x9d)^\x02\xb1.ɿV\xe1{\xe2\x11%\\\x13J>\xe1D\x01\x1b$<1{j\x88b\xa5b\x9a\t\xa3\x89\xd93B˲\xe0\x19ҝ\xc8m\x03\x92\x7fK\x93\xad\x92\x87\x1aچfwUI\x8c$\x94\x18\xaav̐_\xaa\rS\x82\x19\xa6IVT\xda0\xb5\x0e\x80J%K\xa6\f\xf7T\xb6\x9f\x86\xec4\xbe\x1d\x9a\x18|\x80\x16\xf6-\x92\x83\x101;\x05GO\x96;\xf2\x11\xb9%f\xcfu=U?=B\x05\x91\x9b\x7f\xb0\xcc\xd4\b\xda\xcf-S\x00\x86转\x8a\x1cd\xef\x9e) V&w\x82\xff3\xc0\xd60q\x18\xb4\xa0\x86iC\xb80L\tZ\x90{ZTlI\xa8\xc8;\x90\x0f\xf4H\x14\x831I%\x1a\xf0\xf0\x05\xdd\xc5\xe37d\x9e\xd8\xcaK\xb27\xa6ԗ/_\xee\xb8\xf1\x1a\x95\xc9á\x12\xdc\x1c_\xa2r\xf0Me\xa4\xd2/svϊ\x97\x9a\xefVTe{nXf*\xc5^Ғ\xafp\"\x02\xa6\xafׇ\xfc\x7f\x05\xa6\xb6\x865G\x90Qm\x14\x17\xbb\xc6\x0f\xa8\x10\x13\xd8\x03\xaab\x05ς\xb24\xa9\xb9\xc0\xc5\x0e\xf9u\xf3\xf6\xf6cS(\xb9vL\xa9\x1f\xd5}\xfc\x01jr\xb1e\xcar\x18E\x13`2\x91\x97\x92\v\x83\x03d\x05g\xc2\x10]m\x0e܀\x18\xfc^1\r\xf2.\xbb`_\xa3\xd5!\x1bF\xaa2\xa7\x86\xe5\xdd\a\xae\x04yM\x0f\xacxM5{f^\x01W\xf4\n\x98\x90ĭ\xa6-\xad\xff\x00ȥ#o\xe3\ao\x11{X\xeb\xac\xc8mɲ\x96\xa6\xc1k|\xeb\xcd\xc5V\xaa\x96\x91\x01\xc3ӦQ\\\xf9\xe1c\xad\b\x98\xc5\xee/cR\x06\x9f\x9f\xc2\xdb o\xc0\xf2J\xf0\xdf+\x86\xc6Ԫ?;\xb5W\xb5U\xee\xfe\x81\x18u\xb9\xdbK\xe8\x1a\xfd[V\xb0\f\xf8u-\v\x9e\x1d\xe7Ϥ\x03\xc8әi\xf2\xb0\xe7\xd9\xde\r\xa7\xfd\xcc\xc0\xcc\xe5U\xc1HF\x05Ȯ\x9bX\xde3\x0fB^\xcbCY0\xc3\xf2%\xb21g[Z\x15fI\xa4(\x8eD\xe3\xe0\xba~\xc8\x0f\xb7&\u05cam\x99\xaa\x7f\xf0\x8f\x9a}\x8c\x8a\a\xa9\xd1b\x82\xeeu\x81-ɖ\x16\x05X\x00\xf8\xb77\xa2\xcd7\xae\xa92\x9c\x16\xc5\xf1\x1d\xe5Ex/2\f\xef\x10aO5\x11\xf2d\xc45yU\x14\xf2\xa1\v\xb61\x85\xe6\xf0\x91qj\x80R\xf5`\xb7&W\x06\x99\x80\x84\xdc\x04\x05a9y\xe0fOn\x1d\x8e \xe7\xa7|a\xa2:\x9c\xca̪\x9eI\xe4\xb7\x0eG\"O\xc4f=E\xb4su\xbc\xa9\xc4\x1cY~\x83o\xb6\x84\x97\x99=\x9a\xea \xa3V\xe4\x14+\xa52 \xdd\xd4\x10n\xc8\x03:\xdd\\z\xb9\xe0\x86\x1dt;\x1c\xf1\x1f\xf8ً\x94f\"\xf7N%S\f<28`RR\x93\xedYpկ\xae\xaf\x88F\xffa\xb9b\xff\xffJ\xf3\x9c\x91\\\x1d\x89\xaa\xc422\x12<++\xe30\x87q\xeeeQ\x1d\x18\x01+K\xa4\x82\xf7\x04|\xbd\x97\xf2\xee\xc4a\x11\"\xaa\xa2\xa0\x9b\x82]\x12\xa3\xaaS}\xb1\xd6e#e\xc1\xa8\xe8\xfcʾdE\x95\xb3<\x84\x8dz\x0e?ޞ@\x81\xb8\xc6P.\xc0GCp\v\x06EԿb|H\x15#B\xc6\x14\x82\v\v\x8fp\xd1d\xe9\xe9̑}\xa7\x18\x0f\x8a]\"\xbd\xa8R\xf4\xd8C-\xbf\xc08\x8bX\x01\x88\x8bd\n\x9e1 S\x88W\x90^\xdf/\xa9\xb86\\\xec\xfc,\x93\x1c\xd7\xdb\xe8K\r=o̐l؞\xdes\xa9N@\x12\x8c\x17\xe0\xd1\xc6r\xa1\x8e\x02eӑ͛p\x94X\xa8\x9c#\x13\xfc\x19\x9e\xa9\x83O\x92\xe1z5L\xc5)\x86[\x1al\x18a_XVŬ/!y\x058\x80u(\xads\xe9\xe1{\x7fd\x04\x9fR\xb1\x9f\xe3x\x9f\xe0~\xed\x1e%\xbc\xa9\xd4.\x80s?B\x1c\a\xc6Vjf\xe9\x11\x05K\xc0\xa0\x91\r\xdb\x02\x1bk+LU͗\xd3y\f\xcap\x9a\xe6\xb5\x16\xae\r\x8cC\xe4)\x05\x03\x82\x1e\x00\xaf\xf6c\x8e3Q\xbcm\xac\xd4;\x9e\x9b\xd2\x12 \xbb\xb0\xea\x00n\x03\xb8W\x9b\xc4e\xc2\xf4ǘ\x99nҧS\xad\xc7̷Uӭ\xd2[\x86\xde\t\x02ɥxa\x90\xf1\xa0\x9d\xe0\xf2\x06\xa9\x06\xff\x85qlr\xa3\x8f(\xa3\x921\xaa\xba\x13\f@\x8a\xed\x1b\xb5\t=\xe4\x1fU/\xbdD\x02r\xb1\x88\x02s\x1f\xa9\xf2f^d\x16\xb1Zx\xb5\x91\b\xdaB\x91\xb3A1\xb4ӌA\xb8$Yק\x88\xbc\x17\xfc\xeeRstfo\xbf\xb0\xac;\x1f\x00S\x81\xeb\"\x94\xc0\xd2\xfa4\xd1\x12\xfb\xe3\x82PR\xcaܫ\xf8Iz\xea\xfc\xf9\xc1\xc7!\x94\xf2hg\xaa\xaf\xed\x9b~\x19\xeb\x00a\x14Kծ:@\x9e.\t*\x01\x17\xea\x1c\xd3\xf8\xf4\x12\xe5m\xb2\x9a֟\x03\x17W`\x87/ɏIϧ\xe8m\xfd\xe7\xe2X\xa6f\x90\xfc_\xab\xa4w`\xd5\xec\x06\xa9\xb9\x13\xbe\xb0a\x1dH\xd6Þ)\xd6b\xdei\xa0\xb0&W[\b\xaaC̔/\x17#\x83\xbb\x8f\x1b\xe5\x85&[\xae\xb4i\xa2\xa0I\xa5\xc7\xd4t&\xfb\x82\xabxJ\xf2\xd6~đ7\x8c굵\x94\xf9\x9a\xbc\xb1Ɋ\xb0\x9a\xab\x9f\xf2^\f\xac\xaf\x0e\xfe+qtx\xb9\xe3ɖ\x98)\xe4ʯ\xde\xe1\x11gd\xc7]\xddlZK\xf1V)9G\x90?\xd87\x1b\x81\xf8^>\xf8\xb4\x97\x15\xc2$\xa0\xc4F\xba\x8c\xf0-,ƙ\xc8d\x05im\r\xe9r\x86C\xd4\xd6\x17Ү\x89P\x817i$\x8bgBb\x7f\x90\x1d\x81L\xf2`\fP\x7fV\x04\x12 O\xc1\xb6Rvr\xe3I,\xbb\x96\xc1\xd47S\x95 \xe8O\x84\xa4M-J\xf5\x94\x9a|]\x0f\xd3ʯ\x81y\xdc\x1c\t\xe4\xe0\v\xbaa\x85\x06\t\xb3$\x10/\x1a\xc6pM>6\xcc'\xd7\xc1n&\x8e\xef\x16\xd9\xd6B\xfa\xac\f\f\u038d\r\xeaY$=sV\x9497P\x80\x0fb\xf4\xf6\vT\xe1B\x19\x90\x90\xc9\xdc\xe9\x82iE\xa8\xc9 \x89\xe5\x8cc\x1b$\xb5\xac\t\xc4\xc0\xc3\xf2\xa5\xf9\xcd\x04\xb8\x10K\xbez\xff&\xd5CM\x8eH拫+&\x0e\xcc\xdc\xe5~\xfc/\x18K;ϫmUK/\t%w\xec\x88\x15?\xb0\x93 \x05\xd4?<\t\x11Ű\x96\x88\"|ǎ\b0^\x1c|\\9tE>\x16I\xffL\xa0:`\xec,\x9a\xa5'|1\x99\x06\xde#\af`Y\x9a\xc5Jv\x8fl\"\xeb\x8f\xe7\xe0Y\xe4\x98(\x84\xcdq\x1b\xd5O+[/\xa0tY`\xadM\xef\xb9+\xb9k\x86+\xd0\xe9\x02b?\x9fh\xc1\xf30\xa4]\xf1]\x89%y/\r\xfc\x0f\xa6\xfa\xc0\xef\xe7\xe4\x8dd\xfa\xbd4\xf8ͳ\xf1\xc0N\xeb\xb99`GE\xa5\x17v\t\x02$n\x16\xb15F\xf0 \xa1\x81[\\\x93+\x01\xd9#K\xbaɃ\x0207\xb0\x1d\xf2Pi\x03\x8b\x06!Ŋ\x1dJs\x8c\x8e\xe98$U\x8bA\x8f8\xbc\x1b\xfa#\x94\xd7-b\xb6\x93\xa2\x80\xee\x15\x9f\xdf\xc4\x12?5lǳ\xc9#\x1f\x98\xda1[\xa3\x99*W\x93\x1dę\xe28uY\xda\xfc\xfb\xb2\xba\vy\xee\x15\xb8啃e\xe4a\x12՜_J\f7}\xdc{Ǧ \xbc\n26ᥞނ\xc7'裐\x12\xe3\xa5_\xc1EM\x90 \x9a\xe7ةF\x8b\xebY\xfeu\x96\xe4\xcd7g\x8d9\xa25#\aZ\x82)\xfbO\x88TP\xdb\xff\x8b\x94\x94+\xbd&\xaf\xb0]\xad`\xad\xdf\\ \xdd\x003i\xf0\x12\x06\x05i\xbd\xa7\x05DQ\xe0\xb0\x04a\x85\x8d\xa9\xe4\xf6$\xf4]\xba\xa2\x04\xc4\f[\xce\nX\x19\x90\x8b;v\xbcX\x8e\xa6\xa1\xdb\x7fM\x13yq%.l\\vb\xe4B\x10\x87e\xe8\v\xfc\xed\xe24̝\x13\xbcNֆ\xc9/\xb4\xd4\xe0@˩Z`\xf8\x81\xc9\xca\\.\x9eN\b?\xda!B\xf2\x16\x18p\xa0_\xf8\xa1:\x10z\x90\x95\x15\x03@\xa4\x9d\xa7 \x0f\x94\x9bP \x84\xc4\x01D;\x99ksHKa\xfb\xbfL\n(\xed+\xdf\x19\xe0r\x17\x12R\xc1[ʋ*V\x8f;[yӭ\xf4ʯt\x17\x8f(!\xff\x90\x9b\xcb\xc5$\x9e\xfeUn\xba9v\xbft\xa6\xe4\xafr\xb3^<\xee\x92\xe3@\x05\xdf2=G\xfc~s\xaf\xfa\x85\x86\a\xe5\xd3'I؞\xafr`\xb6\xa0udU\x89;!\x1f\xc4\nM\x96NN\x16\x84\xcc\xe5Sj\xe0@VՑ\n\xa8h\xbber\xc2Œ\xc8{\xa6\x14\x0f\x8d4\xf5\xf3\x12ҁ:P;\x8d\xc4dr\xc26\x96\x8a}\x02\x05\xfdN2\xad^\a\xff\x9dg\xb5y\xd6\xef\xc6i\x81f=\xa9\xcf\"\x1f\xeb\xd6M\xd7Z\xcd5\xf9\xf1\xcf\xe4\xc0Ee\x98~\x02\x9d\x99\xe2Լ\x99X<\x9a\x11N|0eE\xe1\xfb\xb1\x82\x99\x19tYS\xc4\xe8\xea\x04\xf2\x84\xee\x8bn\xdfEm\x06\aǴ\xc5(\xc8\x0e\xe0b\x1dc\xe5ch\xe2\xa0E\xd1\x18m\xbd8k9\xfd5\xda3\x00\xf9d\xf64\x9b\xc0\xeb\x92\nףV1ifH\xe9\xc7\x12\x95[\x00\xd6\xd3\x1e[˃\x14\x19\vF\xc55c@\x9a\t\xbeb4\xdbGڔ\x86\xa6I\xe2f\xc3\xd55\u05cb\xf9\xceb\xe5\x81\f>\x93\"\xd1\t\xac\x18\xb3D\xab\xc1\xc66\xbb\xcbj1\xd3\xcc\fˬoa\xecQ\xa4A\x1dK\x95\x1eGh߀\x99\xd2!wô\x92U\xd6l\x93;\xedK \x1b\xaaYNd\x7f\xe7\x12\xa8\x95\xaa\n\xa6\xddX9\x8afm^\x96\xf5\xfc풻]TY/\xe6/\x1d\xce\xe8\x98\x1bm\x89\xab'0\x00\x12[j\xec\x06\x8c`Q\x10\x0e\xc9%\x83=\a\x06w\xcf\x1d\xbfC\x13\xebi\xeb%j:iÛ\x1d\xca\x06q F\x0e\xc0$\xffC\t\xfb\x15\x03\x8dZ\xa6{\xe5\xd6U՚\xa1\x03\xb7B\xcc\xc75ỏ+\xb8\xe8\x88\xeec\xb3&E'\x9e\x881a\x88\xef\x90/\xe82R\xfaTZ<\xf9\xb5\xf9\xd6\x12VԞ\xe8\xf9\x92ly\x81\rL-\xea\xcf2\xf5\x9e3\x8fA\x8cԄY7M>\xfct\x87.\x83}!\x1d\xf7\x9c\xba\x00\xec\xe9\x06IO\x93'H\xdeT\xa5\xfb&\xba8\xce\xe9\xddH\x95\x86\x89}\x1ai\xdd\x19\xadn\x8b$\xb8drOF\xa2-\xe9\x96pfL39\xd5\xf3\f\xbd\x16O\xd9a1\x91\xa2S\xba)\xe6\xd1\xf3\x19;'\xbeJ\xbf\xc4swIL\xee\x8dH4\xac\xb3\xc4'\xcd{\xf7\x96K\xa6\x17\xea\xc7\x16\xf9S\xfb\x1b&t5$\xe6\x1a\xa7\x11\xe5\fr4J𗋧\xe8Q\x98 \vSM\xc3W\xe8B\xf8\n\xbd\a_\xab\xe3 QR\x13\x1fk\x89hrO\xc17\x94\xa1\x85m\xb6\x03{e#\xf8\\\xfb7ڑq$\xc76\xba\xf2ry\xb4`\xefa\xd7\xd6\xd60\xe56\xd1\xe1wa\xfd\xb1^\x9ce\xc6[s\x88 \x1b\x92\x81\xd4o\xe1\xc3U\xcc L\xe2\xcepIA\xf1Y\xf7\xfc\t\xac\x80\xb5&\xf2\xd8\x01\xb5k\xc2Hy\xf49\xf6\xec\xe1\xd9-X\x0f\xc7=\x8b\xcel0\xe5\x04\nw1&\x02\x85\x03Q6\x8c\tO\xbe\xfc[\b%\xfe\xbd\x1f\xf0;\xde\x0f\b\xca\xf8\xf1\xe9\v\xf1o\xeba\xce,\xc6\x7f\x8f\x1dd\xff\xde\n\xf8\x9dn\x05\x04\xc9{'\xd5\r\xa3\xf9\x9c\x1c\xcd\xe7\xc6\xeb\x84\t])\xa6\x83\xedx\xe0E\x1a\xce\xc09R\xd0J\xc0\x91S`\x84D\xdb6X\xf0\\h\xc3h\xaa,\xc0I\a\xb6\x1d)\x8dwɉдS\x90b\x7f@kg\"\x9e\xd2\x12}\xae\x879\xd3\x12\xd5L\xb0G\xdc \x1f\x12\xb1pg\x9aPc\xa0&\x80\xd6Hb\x9bGû\xac\x1f_\xa2\xa7,\xc3\x1d\x16\xa3O&.G\xe0?8\x1c\xf6r1\x89\xafW\x82\xd7\xed[T \x88'\r\x1ea\x80\x10\x0e\xe8\x19\x92x\xd5\x02\x00\n\xea\xd7!\x00\xbaV\xdd\t\x81\xe4\x86\x11\x9a\xe7,\a\xbf\x87\xe1\xa2_\x96@\x13\xa7#ƓE\x82I\x9c\x8d.:\xcf\xed\xaa\x9d\x1a*>\xf2\xf0\xf3\xfb\x13\xc7\xedK\x12L\x92b\x85\xda\xf2\x9a\b\xb7\x11?=\x81\x95I\x96\x9b\xc4\aǥ`̮=]\x97\xd0\xc0ˮ(\xfd\xda\x1eL\xe3\x17\xf4\x11\xed\x1bwdWqP\x91\x8e3w\f\xce\n\xdb\xdb\xf2\xb0\xfc\x8f\t\x86\x93\xa6\r\xabϴ\x03\xa1\xf2!2\xeeN\xf5\xcb\x1fodpuS\x15\xc5\xd2\xf7\x9d\xc5\x00Cw\xb8\xaa\"\x81\xf4\x19\xe7&\xf2\x93\x1e\x893\xe8\xd8\xec\xb4h\x9f\x05\x18\xba \xfca\x80\xd2\x13\xc7\xf186_X\xdf7\xeb\xfb\xedv\n\xcc\xffy\xf4\u05cbd\x8b<\xa8rI\x94\x8cI\xacG\xe41\xc41\xf9D\xc5@\xc4\b\xac\x88\x805\xc8\x18\xe4\xd7\v\xa2;\xf3\xf7ۢ\xa9a\x87\x0f\xa5\xd3\x18g\xfbg\x915\x02\xa7\xa1\xe20}\xf4\x06\x90\f\x00\xc9\f~\xc0\xe5\f\xaf\f;\xbc\xc2Æ]u\x04\x9a\x04\x16\x89m\xa3\xff\x87\xece\x15\xe9\xea\x1b \x19\x90\xf9\xb3Twpjm%fO\xb9\x01§_Du\xd80<\xbd/\x9c\xf8W\xa72\xeb\x13:\x9d\xd0\xc0f\x17RRE\x8b\x82\x15\xa73 \xa0\x9a\x95\xd0\xcc,\xc3!\x82\xe4\x01\a%Y\b\xf6\xebc\xa51h\x18Ⱥ\x1c\xb8\x80\x95\xc2%\xf9\xf3\xc9O\x96Xpr\xfc\x8e\xa9Ť^\x98qZ\xb5\xdab\x00=\x8a'\x83\xdf\xff\xb8n\xffb\xa4k\x92\xe9;4\t\x97\x90u\x1e\x9b\x8b\x9c\xdf\U000fc885\xb7q\xf5\xe1\xeb\xe10d\xa7\x95\x11h\xd04\xca\v\xab\xae\xfe\xfd\x96z\x92\x0f8+Z\xac\xa7\xaa\xdcp\xe4\xde-\xfbĞ\xe9\xd0uJ\aM\xab\x88\xb3^\xf4w`O)\xf6\xf4Z\xa64\x11\xf8\x8a\x9d1\xd3\xfbaR\xd6]#\xbd/-\x8a\xa4u\xbc$\xb6\xd6\xf5!=b\xf2N\x8b\x84\xc9\xe8\xffk\xb5H*:>v\xff\xca\xe3w\xad$\xd1g\xbcCe\nu\x9e\xbc\x1b\xe5\x19{P\x9e\xa7\xf3$\xb1\xdfd\xd0 M`\xf7P|ԻBOm\x9c\x18_\xde\xf5\xf7\x8c\x8cv\x8a\x9c\xb5\xfc\x9b5\xa5F\xfb\xc3\xe5\xe2ܾ\x8fQ\ue929Y\x03\xa7\xa7\xed\xecx\xb6~\x8e\xe7\xed\xe2\x18\x94\xa2\xc1\x1f[\xe23ҧ\x01\xeb\xa9\xdfhYr\xb1\xbb\\Lg\xf4\xfb\xfau\xa2\x98[\x9cu\x8e\xd5\xf6+,\f\x12a\xf7\xe1\x8bhq͇ޖ\xaa\x8a=(\xee\xa3\x03\xbcǂ\t\xd7\x14o\xbf\x81\xb1\xe0\xd0>vЏ\x1c\x05\xf2\xeeZ\xf4\xf2\f-\x98\xb8\xb0\xb5G\x9c\x84\x03\x96{\x80\xba\xd97\x97\xb6\xad\xb3\xcc!pn\xed\xf2h\xa4mR\xc0~l\xbe\x8b\x88\x10\xc1\xe0J\f\xf7\x04\x0ew|\xa1@;\xcb\xd2\x1d\x81\xda\x03\xb4\x85\a>\xcfŮ'\xc0\x18t\x1d\xa3fi\x84\xe9\xe3\x86\x17c\xe0_\xd8\xf1,\x86\xff\xea\x81t\x18\x1d\x02L\xcf\xe4`3ji.\xf8]\x1fo\xc22\x13\x9e\xd4Ko\x1d\x11\xaa^\x86\x86\x02(\xfe@T\xed\xcf\xd0t\x06\xaa\a\xa8\x8fp\x83\xa6\xc2\bzI\xb4\xac\xa3`\x8f\x1b\\z\xc63wi\n\xacu\vI;\x97M\xd5\x1f\v\xb8\xf5~)\xf3o\x93\xeb\x8d[\xfd\xbe\x01\xaf\t\x06\xb5\xed/\x9d}\xa8\x99\x0f\x89\x1a\xb7Q\xbc\xfe\x12n\x17\xea\x01i\xe8\x1dӤ\x84\xbb\x8br0\xa2x\x88\a^\xd7Ŀ\xa0\xad\xbd\xad\xb6[\xfee\x86\x17\x02Kʶ\xfc\xcb\xe5\xf8\x84\xddp\x1c\x11)\x99p\xb5\xa7`\x1dZ\x12\xd8sQJ\xd38\x83\x02 \xad\u058b\x19\xdc\xd0\xd56\rmK\x1a\xe4G\xf9\x95\xb1\x1e\xe0D\xb0\xaf\xbd\x9e<U\x92\a1\x18\x97\xe0\xf7\x1dDb\x82\\;\x03\xfc\x7f\x11(\xb5|w\x9em\\\xcc\x06\x97)\xca5y%\x8e\x0en\x04Nx\xdb\xee\xbfm2\x018\bhA\xcbD\xebV4\x00;\fʱ\\Cw\xaa\x88\xde\xd55\x81S7U\x11c\xc4tJ#\xa0v\xf2\xc9\xea\xe6\xd2\t\xbb\x8b\xaa\xf0\xd2\xd1\b<\x16\x82c\xb7\x85\xdby\xea\x1e\xae\xd56(\x02k\x94k\x1f\xc3Fq\b-\x18xBw\xc2P\x04\x1aޅ\x11\x8a\x93]tNYۥLl\xe9\xec\xe3v\xdb\x17\x17\x0eI\x00\x9c\x9c\xae\x17<\x16\x97\xf7\xba\xaa\x16\xc3b\xbc\x01k\xae\xa33\xe8U\x03\xb7\x84\x02&P8\x02\x13.\x0fm\xd8\xfe\x0e\x80\xf5bz\xbe̢\x12\xff-E\b\xddQ\x15\xceA\xb9s\xbc=\xa2\xbdSŐ\a\xa7\xc6rBw\x90G4\xb0\x06tS\xec\x1dG\x1b\xb8\xa8N\xec0\xda$\x17\x7f\xbf@Vy\x99nJ0\x06/xD*\x0e\x83\xb8<\xece\xd1E\xa5?\xab\xa2\xablO\xa8&\x17\x7f\xff\xe3\xfa\x87?\xfd\xe1bM>@1\xf4\x81k\xb6lM\x13QhC\xb5\xf8Q\xbf\xa6\xbd\xf8\xe1\xa2w\x98\a^\xe4\x19U\xf9\xb2\x1e\xd00zX\xfdpᚭ\xad\x0eC\xc6\xe9\xe2\x87U\xa9d\xee\x7f\xd0\x03>{Č\xc3\x7fV\x86\xce\xe5\xfcG\x17\x85t\xfb\xf5\x9bt>Q\xfew81?sOHK\xd5!Ze{\xaah\x86;u\xe5\xd6\x0f\r\xa2\x14\xf2Y\xe1d\x9c\x92\xaaP\x82\x89ʠ;齿\xb7m\x03;\x1fY?\x7fV\xb9\xba\xf0S9\x15\xc0\xa5G\xef@\x8f\xf5\xe2\xb5w0\xe8\xb9\xc9h\t\xf7\xf0\xdak\xa7uc\xbc?\xfc\xb8r\xf4\xcb/f\xb2{(ٵrJ\x1a\xfd\xa9\xd7\xc2\x0fx\xb8ш\xbc?\x1a\x97\xaaUv\x8a\x18\xadq\xc1\xfcЁ\xd1\xec\x96z\xce\xda֡*\f/\v\x06\xbdb\xf7<\x8f^O\x00\x8b\xe8\x10\x81\xfcC\xe2\x89)N\xf0>܄$\xe3\xbaS\xa6\xa3\x9a<\xb0\xa2 T\xa7L?\xb3\x97\x16gr\xc5 \xb1\f\x0eҫ\xa3\xbb\xea\xd8\xdd\xec\x8a\x17\xa7\xa1\xf2\x1e\"p\xdd\xe5\xb1P'\x9e\xe9\x14{\xcc\xc8I\xe5\t\r\xaa\xfd\xee\xf7\x8a\xa9\xa3]\xad\x84\xfaD\xc8c\xf8\x84\x9a\xae\x8a:\xc5\xe7ҍ}M\x86'ź:\x05G^\t\x9bJ\xe9\xe2\xe3\xee\x84h\x16#\xc1Y\x81\x90G\xc7\xe8y]\xc8\xf0\xf6\fG\xddE<\xfeT\x87\xe2\x8f^\x9a\x9c^\x9c\x1c\x10\x8et\x11\xf9\x8a%\xcay\x9b\xf6Ǹ\x99\xb8I\xff\xa9J\x95c\xc5\xcaQ\x7f\xe2?\x9e\x86\x13\xa61\xc8\xe2'-Z>\xcdf\xfbDJ\xa5l\xae\x9fF\xa7'/_>k\x01\xf3\xb9J\x98\x136͏\x18\xaeI\xec\x1f\nz\x06J7\xa9\xc5\xcc\xf1r\xe6\xd8&\xf8\x84\xcd\xef\x83!_\xea$gL\xaf\xe1\xd7\xfbf\x97\x9a\xdcJ\xe6Y\xaa*6c\x8e'-q>\xeb\xa6\xf5\xe7-s\x8eJ\xd6\xc8\xcf-\x91\x1aݔ>{m\x02\v\xf1\x82\xef\xf6&\xe9\x16\xec\xa8\xcc\\\xb7ADZ\xad\x1bm\xab$۳\xec\xaeu*\xack\xc4v\xdb\x13݃q!\xa6\x02nRc\a\xcb:\xd8\xde\ap`;\"\xcb=dp\x7f{*\xf2\x02*~\x9f\xa9\x82\x85\x81\xbdi\x1f\xd6\x00\xb0\xd6}\xa0\n\xf6s\xf9\x94gd\x1c\x87욼\x15[\t\xa9\x1e\x18B{Y\xe19\xf6\x18\xb9\xd7\xc3\xcc\xf86ܴ\x0fw\xc0\x19\xa9\xe8\x0en[\xa5Z;\xdc\"#\x01`_\x19\x0eX\x12\x89d\xeb̫F\xdc\xdd\x15W\xcfW\xdfq\xacWn\x8e\xbe]u\xbdH\xdbT\xb8B\x12E\xbev3_L03~\x1b\xc9{\x99\xb3k\x98ˈ4]w\x9f\x8f\x89N\x9df\x91EN\x84\x7f\xf4\x04\xb2m.\xf7K\xd5y\n\x12o\xa8W\x8c\xe6\xb0\xf9M\xbf\x06\x82\xcfѐ\x9b\x16\x84\xc6,\x1b\xd5H;GhT\xd6!)\xec\xbem\xd4%\x81\x1e\x1b\x96\xc9\xe8\x16\r@\xf4h\xcf\xcem\xc2\x04\x85q~0,\x0eÞ\x16\xf2*<g˷\xf5P\xf1\x82:\xac\xba\xed@n\x9f\xbeo\xb6\x86\x16l\xae\xc95$3iQ\x1c\xe10t\x96O\xe6\xc4\xf0\"cp\xa7\xd18#\x9a'\x9d\xe3\tw\x0f\xa4\x90b\xd7j\x11\x1f#\xbc\x9d\xfd\xba\x0f\xfa\x9c\xf3\xc9\a]\xf7\x80\x9fP\xcc\xdea0\xd0ё\"\x9c\x1d a=\xc6u;5\xe1\xfc.ȓ\x13ݐ{i\xdc+\x8d\xc6,\xe7\xdb-S}J\xeasJ,_U%\xb9g\n\xfc:\xcae\xce@*sg\x10\xfd\r\r\x98\xaa\xc2p\x1bJK\x0e\x1d\xe7m\xf0b.\xfb`\x8c\xb8\xf5\xac g\xb9a\xb0?U\x99\xac2\x9a\xfc\x11Ԍ}\xa1\xa0\t\xe4E\xce\xcaB\x1e_\xa0\b\xb8\x7f\xc0\x12\\\xbf\xf8\x13,,\xb6UQ\x1cW\xbfW\xb4\x80\x9d\xe5\x11\xa9\xee\r\xab\ay;\xdbm{\x9e\xfc&s@H\x8d0\xfe\xa6\xf3x\xcb\x045\xfa\x90@\xca\xffz\xfb\xe1}\xe0\xf9\tX\x02\x89m\xcc\xfct\x8eSv\xb5%g\xb0\x1d\xcd[.\x1d\x9d\xe6z*\r\x86\xed\x01-\xf9_ \xb3\x1c\xfb-E\xf8\xe1\xf3\xea\xfa\nax\xb9\xc7Tu\xd3\x14\xe0dȆ\xc1\x8a,\x90*_\xf7uFm[\x10\xdb'\\ \xc8\xf0O\xf2\v\x17yX\x11z5\x02\x9b\rA\x04\xe2\xd17\n\xa6\xe8\xc5\xd1E\nf\xcfU\xbe\x82\xf2\xc0\x11\x85F/[8\xf8e\xd4\f\xebC\xc8\x1d\x17y\x02yq*\x8e\x82\x00\xb1i9Nh7\a\x8f\xfe\xf3\x96FOZzD<<)O1Y!\xa5\x16\x89\x1b*\a\xa3\xff)\xb1\xbf\x9f\xdb\a('\xcf\xecv\xbc\xe9\xc0h\x98\a\x1fc\xdbj5\x17\xe1\x80\xd8ƙ\xb2\xaeZ\xe5\\&ܬ#\x0fee\xe2\xf2v\xad\xb8Tܷ\xf69W\xb9$[Y\x14\xf2\xc1\x9b#\x9f\xc8wl+\xed;\x9c\xe9\xe8\x06\xa4\xd80o\x18\xb6\xb5\x88\xec\xf8\x17E˽G\t\x16\xb3F\x96\xb2\x90;\x9e\xc16\x1e\x9cVpJA2\xe0\xf0 \xf3\x00\xe7\a\x856\x98\xc8 Nc\xc1\x95U\xe5\x92liQ\x80\x8d\x80\x7f\xfbv\x9a\xd8\x1c\xc0\xb4T\x02\xb3~\xcd\x0e\xc6\xf4\x90\xdd\xd30\xf2Sgދ\t\xc2\xed\xc8\xfeJ\x7f\xd8\xce\x14\"\xff\xba\a\xd5(!\x1d\xa4\x86\xb81\x835\xbd\xeb\x9bu\xac\xd4P\xb0\xac\x8a:&\xcd\t5$\x9a\xb1q\xee\x04O'\x86Hp\xe9\xcf\xf2\xf0b1>\n\xf4\x93a\xd5\aW\xf7\x9bS\xc5$\xb5\xb5\xc6\xca\x19\xb9uo\xbe\x8f\xf6\xc4l\xa5:PsIrj\xd8\np\x9a\xea\xdf\xc6\x19r\xfdI\x9f\xc1\x8f\xebO#\xcb*(\x00\xf9>\x93\b\x18x\x1f\xb9\xa8\x05-\xf5^\x9ay\x13\xec[Z\xa1\xc8\xdd\x1aj\xaas&i\x01\xb4\xe6\t\xc7X\a\xd5\"\x0ḟ*~\xda(\x14\xf8Z\x04,\x9e\x7f\x80Y`\x01\xcbs!\x9fw\xbf^\xe2\xcd\x04-\xf2L\xb9\x93\xc0\x92'\n\x93\xd8\xc2-D-\xa7\x94Z/&g\x94\a\xc4;\x89P\xc3ap\xb3\aq\n\xb1&\xb4\xb5\x8fQ\xd1\xd2+\x95V$z\xb8}\xe2\x01\xf6_\x95\xd0\x03\x01\x8b\xb7\xad\xef\xa3\x11\xda8\xe1\x9b\x16ևn\x95\xe0\xbfWu\x04\xd7\xf4\xf9\xee\xe9\x86\r\x1b:i\xc0\xf3\xcf\xed\xbf\xf8\t}\x8f\x1f\xc9q\xc2Anr\xb2\a䉗\xd1U\x961\xad\xb7U\xe1\x1d\x8e_\xb4\xbaǹ\xae}\xcfb\x02Ӫ\x12\xb20\xb0\xdb[l\xf9XT\xf7\xb7\xd6\xc3\x1d\xcd\xcf\xf0\xcb\xca\x1dS\xd1Iq\xac\x17\x13\xe5d\xd8r\xf9\xbd\xe5\xefx\xc1\xf4\x1b\xf9 \x00\xaf\u0603\x9d\t\\\xc7\xde\xf3\xb2\x90I\x91U\nⲣ\xdfﮙ1}\x82\x8eN\xb9\x7f~c\xbb\xcf\xe1\x83{tnK\xaa4Ù$\xcc\xe0s\xe7\x15@\x9e\x92mA1\xbb\x04;\xc73ؿ\xe0\x1d0\x8e\x10\x85J`O:\x1a\x1e\x80\x05\x1d,\nzA\xd7\xe7)u\xdc\xff\x0e\xa8u\xcf\x0f:\xe2\xaa[th{d\xd7\xfe\xe5\xf8\x88L4\xce@\x82ZS\xafԎ[\x8b4I\xb3\x9a\x06\x06\xbf\xc0#\t/\x17\x83\xac\x89\x1a\x9d\x9f:0 l\x94*\xafW<N\x9d\x1b\xba\x02,E\xad~\xa0ڵ&@\xa7\xe7\x013\x88\xd1:\x82\x85\x11V-\xc1\x10@\x10\xca]\x85\tj\xfd\r\x81uC\xd0\x01\xabq\x96\x86n\x82\x01\x8c\xfdڡ\\\xdbZ6\x97\xd4uw\x06\xcb{\x93\xee#&\xaeF\xe7zOu:>\xf8\xb4G\xa8\xc4\x7fL\xc1(\xbe\xaar7\xb5\xb1\x87\x9e_\xfe\xa3bUO\xc2\xc0^\xfc\xc9\xf2O\xa14\xd4\xf3ؕ\xb8Vr\a}K=\x0f\xc0\x91{\\\xec\xdeIu]T;.\xc2\x19'\xd3_\xe8\xe4\xe1{\xde\x7f\xc7\x05-\xf8?\xfbLi\xf3\x814\x80\xaf\xfd\"\xae\xe7\xf7D\xb4\x86~|\x039\xe2~\x8c\xf1g\x96\xcf\x17\xc6[\xe8ǆ*\x816\xf4\x90\x92Y\xfc)\xf2\x9a\x17OX\x12\xc6D3\n\x15\x8ev\xd4`\x1eUO\xf2$e\xbd9\xc9-\xf4\x92b0\x19\xd0g\xf4q\xedߝ\xb8\xb3\xa3m\x9b\x19\x97gB\xe4\x16o1\xa2\xe2\xf8u\xa7o1\xe5R\xf4\x95\xc5OHp\xdb~\xc3\xf3\xdf\xcd>\xc0#\xa5\xfd\xb9\xbfQ!F/HD\xac\xa7\xcfc(YY\xfb\x81\xf4\x98\x80\xf8\x1c\x8d;u\xa9GAƝ\xef\xebS0\xc1\xff\xb6\x84ǉa]\xbeD\xba\x84L\xd1z\x10\xb6\x95A\xcc\x7fg\x90\xbf\xcc\t\xbbg\x90\xf9\xf1\x1d\x03\x0ez\f\n\x14\xd8mv\xf1\x85\x0ep\xa0M\x18D\x90\xb4u]/\xa6\x8b鈈\x0e\xb05WǛJ\xdc`\x83\xf0\x1cڿi\xbcOtu8P\xc5\xff\x89)\x93n-\x1a\xf3%x\x12r\x0e]\xd4x\x1cr\x04 p\x04\xf2\x05\x94\xe4\xea\bG\xb3FÛ\\\x1dWpl\xab\x83\xee\xf6\xf6ڦ\x87\bP\xe7\xd0q\xc9\v\xb0|rY8\xb9\xf4\xfd\x15메\x1d\x8e\x8e\x18\x14\x19\xf5\x1b\xac^F\x1fH\xa10|\xde6\x01\xf5\x1dօ%4Z`A9ZK\xed\xeb.\x84\x84\xbb-\xb1B\xa63,LA\xa7YN\x9a5U8>ٗ\xe8\n\xb65\xd0\xff\xc2\xf5\xbcU\x11b\xa8\xff&\xb2=\x15;\x96\x9fO\x9e\x00j2\x81z\xc06K\xd0ԧc`\xadOu\x9c@\xf3\b\xe1\xdam\x12\bp\xeb\x1as\x86\xe6\x17\xf8\xe3\xc0\xce\xc3\t\xa1\xbc\xc6\xdcC\x02^\x9f맓p\x8bB$>\xd7q\x06\xc6\x7f+\xf3\t\x18ۧO1f\xae7\xa0\x81z\x14\xa2\x1b\x14\x94\xa1*\xf3\xb9\xa8\x0f\xf8G<\x1e>b8Ƶ\x02Ϯw\xed\x96\xe1\xac=H\n\"Hr`Zӝ/\xbb?0\xd8R\xc5\x04\x04\xfb\xa1[8\x02\xb4>\xb4_n\x9b\xb6\xdd6\x90\xd1\xcc\xc0v\x1f\x1c\x00\xdb}&X\xd9!\n\xb9\xeb\x01n\x18գk\xf3w\xcdg]\xdb7\"\xe4v;P\xf4\xb9\xc0m&\f\xaf\xeb\x8c'P\xa1\xfb\x1f\xfd\xfaz\x8a3\x853\xf9\x93\xaa\x0f?\x87\a\xeb\x06Q\x0e-r\a\\n\x11\xba\x81\x9e\xa2:\xfd\xeb\b~\x02\xd4^\x03\xa0\x1f\xd9m!\xccW\x06N\xc90\xe7\x19\xe6\x9f[\x90\xbc\xa6\x19ih\xd1\xd07w\x1a;\xcb\ao\x1c\x87\xeb\xb9\xf9\x16\xea\xac\xc5qم\xdc\xd8\a\xd1\xd6\xe5}}Y\xb7\v\xd3\xea\vbz\x06\xf2}\xbcQ \xfe\xbe\x91F\xaa\xb68\xceQ{Gf\x90\xd8$\x1a\xff\\?\xddGG\x04\xe8\xea\bP\xae\x8e\xafZ\x89\xdby\xeb4c\x06\xea\x03\x16K*\xbe\x83$\xc0\x15X\xce\xf7\xd2\xdc\xd2\xfb\xd8\xecƥ\xe7C\f\x90\x9fx=ek\xa1\x9d\xf5\xf5\xfbQ\x9dR\xdb\x06\xb3\bl.V\u07b5\xfb\xc8&Ԃ\xeb\xb73p\x11\xe2\x056\xd3h\x1c\xdd\xc8QC\xe66\x7fɢ\xc0\xb2}\xdbNB\xa5\xf8\x05\xe4\xfa\xef\x99B\xed>\xac\x17S(_\xc6\xf3Z-b\xb6\xb2Y\xcd\x14i\xc8j\xb9\xd5\xf5\"-\x91\x15Ob%\xe4\xa8\x06\xf3S\x93rS\xe7䥆RH\xe3\xe9\xa3\xde\xd4\xd1`\xaakJ\x9ak\xc0\x99\x94\x8exs\xb4\xc7\x13~̻8\xc1|\xa1\xfd\x1d4R\x84q\xd7佌\xdaH\xd7f\xcc\xdb@94\xb9h\xb3bۭ\x84}\xe5\xd0\x10\xb1ZA\xfa\xc5%\xe5\xc1\xfcb\xa1\xb3raW\xd7v\xc0'\xecT\xf2*\xb3u\x9di\n]:\x969]\xeb%\x174ˠ\x0e\xc5^jC\x1f=\xb3\x8d\x96\xc5\xe9J\x8a}\xbej>\xdfg\xa8\x90t\xb8\x06\xb6\xd1R\x11+Açu\xd9\x18\x9c4\xb5\x8d\x9eG2l/\xe0\x83>⪿\xd4;.K\xf0\xf9\x18\xa0\xf4\xf9\x1e7?\xd9<&\xc6\xedGs\x0f\x01\xdb\xec\x02\xadg\x10\xb3W\xb2\xda\xed\xbdl\xf6E\x9b$\xaf`xRbN\xdb\xd1T1S)\xd1\xd8\xe3䶤\x9ej\\\x83\xbb\xc35\xdf3\xbc`\xe8\xf1\xbf\\L\xa7wh\xefo\xe5\xb0\x02\xc8\x0e5\x1aM\xe5\xb1DI\x04\xbe{Q\xfb\x038jȸ\xad\xe4\x91\xd5(`\x97 ~\x9f\xfd\xb3\x84G&\xcdh\xb6?\x9d\xf5z\xd1\xcb\xde\xf8\x88\x9d1\x9d\xc6\xfa\xa1k\xe2\xc7P\xa0=\x10I*^c\xd4\x1a\xeb\x91\x1d锅\x17\xbd|xD\xeaC2ބ6\xf4>\xe4F<R\xfdq\xeb\xc7d$\x7f\xb3ϻ/7\x98\x1f<\xb6\xd0\xf4\xcd̓\xdb!\x92\xf1{\x84\xdbTk\xd4\x1e\x05\x1b<Ue\x12J\xf8F\x13/\xfb\xc5c#\xa7\xc6\xeeXk!\xe6\xee<k\xb4\xfe5y\xb8a\x19\x1d\xdb\xd22\xdeD0Vm\x18l\x90\xf6?\x8aX)\xc2\xff\xa8\x06n\f\x1b0\xebI\xe6p\xac\xf3\xa9a\x12\xdfKs\xd3O\xfdqO\x01\x9f\xcf]`\xa7\xa1ǉmr>3\xe7nyS\xefC\xea\x19\xe4to\xd6z\x86\xc3l\xcc<u\xdaav\xc9S\x8b\xc2t1\xeb\xb8x\x9e\xe1\xf1\xc3M \xf5\xe2$\xa4'.\x17\x83\xb3\xec\t\x03\x86 \xf6\x85a!\x95\x12\x81H\xf5QdM\xb8'w\x96\xb8m*|\xe0r\xb3!\nE\x89\x10\xd6_\x8fF\x84\x00\xb1\x8f\b\xcd\xd4L\xdd\a\xfe\xcdP\xa4/\xe53\x93\x1c\xc39!d\xfa0\xa8\xf1I7sJ\xed\xec\xd14r\xe8V\xa1s\x0e\x05:m\x11\x13\xaa\xbc\x03}\x10\xdfnu\xb6\xde\x1e\xfevv)\xa0\xce\xd14\x8b\x02\xe1\xc4e(\n4v\xa1\xbb\xf4\xfd\x1f\xf96\x02\nw\xe5e0\x95?-\x92C\xee\xc1($\x8941G\xea7\xafϡ\xc8g\xf7n\xa4<\xe2\xc0>e\x81\xc4c\xfeh%\x92\xa8[:\xf9\x12\x05<o\xd0ٍtI\x8c\xaa\xd8\xe2\xbf\a\x00e\x8b\xed\x88\x7f\xca\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=ko#\xb9\x91\xdf\xf5+\b߇M\x02I\xb3\x8b{\xe0`\xdc\x1d0\xeb\x99M\x8c\xcc\xee\x18cg\x82\xbb/\x17\xba\xbb$1\xee&;$\xdb\x1em\x92\xff~\xa8\"\xd9/5\xd5l\xf9\xb1\xbb9[\x83d\xd5j\x16\xeb\xc5z\x90Er\xb5Z-x%>\x836B\xc9s\xc6+\x01_,H\xfcf\xd6w\xffn\xd6B\xbd\xb9\xfffq'd~\xce.jcU\xf9\t\x8c\xaau\x06\xef`#\xa4\xb0B\xc9E\t\x96\xe7\xdc\xf2\xf3\x05c\\Je9>6\xf8\x95\xb1LI\xabUQ\x80^mA\xae\xef\xea[\xb8\xadE\x91\x83&\xe0\xa1\xeb\xfb\xaf\xd7\xdf\xfc\xdb\xfa_\x17\x8cI^\xc293\xd9\x0e\xf2\xba\x00\xb3\xbe\x87\x02\xb4Z\v\xb50\x15d\bt\xabU]\x9d\xb3\xf6\a\xd7\xc8w落\xf6\xed\xe9Q!\x8c\xfd}\xef\xf1\aa,\xfdT\x15\xb5\xe6E\xa7?zj\x84\xdc\xd6\x05\xd7\xed\xf3\x05c&S\x15\x9c\xb3\x1fx\t\xa6\xe2\x19\xe4\v\xc6<\xfe\xd4\xf5\x8a\xf1<'\x8e\xf0\xe2J\viA_\xa8\xa2.\x03'V,\a\x93iQ\xe1+\xe7\xec\xdar[\x1b\xa66\xcc\xee\xa0\xdb\x0f~\xfel\x94\xbc\xe2vw\xceֆ\xde[W;n¯Hm\x00\xe0\x1f\xd9=\xe2f\xac\x16r;\xd6\xdb[v\xa1\x95d\xf0\xa5\xd2`\x10e\x96\x93\x00\xe5\x96=\xec@2\xab\x98\xae%\xa1\xf2-\xcf\xee\xeaj\x04\x91\n\xb2\xf5\x00O\x8fI\xff\xe1\x14.7;`\x057\x96YQ\x02\xe3\xbeC\xf6\xc0\r\xe1\xb0Q\x9aٝ0\xd3<A =l\x1d:\x1f\x86\x8f\x1dB9\xb7\xe0\xd1\xe9\x80\nʻ\xce4\x90\xdeވ\x12\x8c\xe5e\x1f\xe6\xdb-$\x00C\r]W\xbc6\x90\xf7Z_u\x1f9\x00\xb7J\x15\xc0\xe5\xa2}\xe9\xfe\x1b\xfa\x82T\x974\x96\xf0\x9b\xaa@\xbe\xbd\xba\xfc\xfc\xcf\u05fdǬ\xcfѿ\xad\x9a笑\x06\x13\x86q\xf6\x99F\t\xd3~\xd82\xbb\xe3\x96i@5\x00i\xf1\x8dJ\xc3*\xb0:gJw@U\xa0\x85\xcaE\x16DD\x8d\xcdN\xd5E\xcen\x01\xa5\xb5nޮ\xb4\xaa@[\x11ơ\xfbt\xccK\xe7\xe91\xf4\xf1\x83\x14\xbbVNM\xc1\x90f\xfa\xd1\x069\xa9F\xc9\xdd\xe0\x11\xa6\xa5\x87$\x88\x8f\xb9d\xea\xf6ϐ\xd9\x16A\xcf\x1d\xd0\b&P\x91)y\x0f\x1a9\x92\xa9\xad\x14?6\xb0\r\x0e\t\xec\xb4\xe0\x16\x8ce4\x9e%/\xd8=/jX2.\xf3E\x0f0+\xf9\x9ei\xc0>Y-;\xf0\xa8\x81\x19\xe2\xf1\xbd\xd2\xc0\x84ܨs\xb6\xb3\xb62\xe7o\xdel\x85\rF7SeYKa\xf7o\xc8~\x8a\xdb\xda*m\xde\xe4p\x0f\xc5\x1b#\xb6+\xae\xb3\x9d\xb0\x90\xd9Z\xc3\x1b^\x89\x15\x11\"\x91|\xb3.\xf3\x7f\n\xf2\x0e\xf6!22\xdd?2\x993ă\xb6\xd4i\x97\x03\xe5x\xd2JA\xc8-\xc9\xeb\xd3\xfb뛮\xe6\t\xe3\x85Ҿz\xc0\x97 \x1f䦐\x1b\xf0\xb6`\xa3UI0A\xe6\x95\x12\xd2җ\xac\x10 -3\xf5m),\xaa\xc1_j0\x16E7\x04{A\x8e\t\x95\xb6\xaep\xec\xe6\xc3\x17.%\xbb\xe0%\x14\x17\xdc\xc0\v\xcb\n\xa5bV(\x84$iu\xddm\xfb\xe7^v\xec\xed\xfc\x10|fD\xb4\xc1V\\W\x90\xf5\x86\x1a\xb6\x13\x1b\x91\xb9\x01\x85&\xb91%\x03\xb3|l\xf4\xe3\x87\x17\x85z\x80\xfc\x8fB\xe6\xea\xe1\xe0\xd7)U\xc3\xcf\xdb\x1e\x04\xc65\xea\x12\xb0\a\xff\x1d\x8d\x00:\x12|vKv\xea\xc0\xab\xb2\x8cKf,\xd78\x8e\xd7\xec\x8f;\x90#\xfd\x18\xb0Kj\xa6k\x89\x06\x87[\xea+\xaf\x81\xa9\xda\x1a\x91\x83\x87[\xd2\xf3\x1d\x979ZL\x9eeJ\xe7\xa4\xf3\xceb|[\xf0\xecN\xd5\xf6J\x15\"\xdb\x0f\x95\x891a\xa1\x1caD\n+Z\xeb\xee\xb8\xe1F\xa1\x86\xac֨%\x9e'\x81%K\xf6\xb0\x13\xd9\xceQn\x18w\x83\x06\x7f!\x0e\xf1aL0\xda!\x979yk\xe3\xb5 \xaf5)\xc5!]Ǵ\xc0\x13\xe8ێ\xff:`\xc0;\xff2ҸS\x0f\xacPެx\"\t\xa91,\x8e\x8c\x9d\xf6\x13T#\t\x95\x88,\x0e\xbd\xedD\x90\xd5\"\xefD\xb2f7^ \xecG%\x83zE\xfb\xf2mQ\x99o\x9b!\n9{\x10v\x87\r\xd9ŧ\x8f?\xfc\xef\xcd\xff\xfc\xe7\x7f H\x84\xf8_\xacҰ\x11_\x96\x8c;\xf9uGŉ\xbcC++4\f<\x86\xfb\xb7jD<\xfac\xe8y\xe4ǈ\xfd\xea\xfeȵ\xe6\xfb\xc1o\xb7\xbd\xb1v\xbe\x98/\xc6\xfeh\r\xca6n\x06\x84d\xbc\xe9ҋrɔ\x1e؇\x91^\xbc\x15\xf4m̲kA\xd6\xec\xfaNT\xcc܉\n\xcd\x0e\x94\x14]\xf4\r\x98\xaee3\x80%|q\xb1\xf3H?j\xc3\xd0\x13\x0e\xf4p\xcd\xde\x01\xba\xd2\x1c\xff\xd7\xf5\xc1jiE\xd1QI\xe3p\xec\x98Q\x8c\x80HO\xc7\x14\xe5\x1dlx]X\xe4\x17b\x7f\xf8\nȺ<\x94Ǌh\x1dyL\b.f\xa8b\x10\xc3#\x1c˷}\x10\xa7z\x96\xafl\xe3[\x96\f\xd6\xdb1v\xdd\xd6\x06}+*W\xad\r\xaaL\xb6\xe3r\vl\xa3\x01~\x04g\t\xf6\xcc\xf2;\xc0!\x9bA\x0e2\x03\xa6\xee)\x00\x82\x81\x0f|\xf5)\xaf>\xe5է<\x97O)\x85\xfc\x04\x96\v\t\xf9u\x9de`̦.\\\x06<\xa2\x83\xd3B\xfd\xfe\b<\xb4\x9f\xc8?Y\x97\xb7\xa0\x83u\x91\xf0\x809\xa7i\xde\x1e\x98\x9f\x91N\x02\x1b\x1a\x87%\xbf\xb2l\xcb\xf5-\xdf\xc2*\xc3\t\xb8\xccB\xde\xe8\xcc\x1e\x87\xa8\xd0@\xda\"\xd03\x14\x10\x06\x06y\t\r\xb9s\x11#}!z:j\x11\tk\xf4jo}^\xa86\xeck\x96\v\xc3o\x8b\x90]\xf0\rlk\xae\x0f\xb20b\xbe(\xeb\xf2\x9c}}\xf0\x93\x93\x18&\xe2\xdb\x03W\xe1&]&\xa4\xe3\xa6a\x1a%7\xc8\r\xbb\x03\xdd\xc7_\x18\x0f\r\xad\xb4T1\xcd\xe9N\xe0\xb4\x7f\x1a\xac\xcb\xecNQ\x94O\xa1qЊ\xad\xe62\xdfp\xc4q\xe5\xff\xcf(\xd9v\xc2*\n{\x82\b2UV\x05\xa0\x98\x93\xd5\xc5%Cm\xea\x13\x1a\n\xcb\xee\x00*\x93\xaeIM\xd02қB\xd4G\xd0s-\x99\x86-\xd7y\x81\xee\xd1!,4\xbb\xb9\xf9p\xa8\x1c\xb2.\nԢsfu\r\x8by\xae\"\xe7\xa2؏\xfd0\x10\xcd;|\xefp\\\xe6|\x8f\n\xa3L3<}\x94$\xccb\x04$\xceDCu0\xfd4\xa9\xe4S\x8a\x8e\x1f\x8c!\x92H\xf9\x1d\xbdxH\v\x02\x18%f\x14$C\x00\xcfFL\xa9\xa4\xdd%Q\xf3\xbd{\xf3\x90\x1c\x02\xf1s\xa1\xe7\x01\xe0.\x89\x9c?ҋ\x87\xd4 \x80\x9f\v1{\xe0i\x9a\xf6\xdf\xf4\xe2!1\b\xe0\xe7Ȃ` \x18\xc3\xf3\xc5Q\x1aGm\xf6\xacX\x8d\x16YF\x80\xb4\xcb.\xebŌ\xc8\xc9܉\xea\xb2,!\x17\xdcB\xb1?\t\xfd>\x881ߨ(3\xf5bcb\xd3\xf3\x94\x94\x15w\xda\xd3<\xed\x9f\xc2\x1b\x87\v5\x7fr\xa9\x02\xae\xaf`\x0f\xb2\a\xac\x96\xad\xe3\x1d\xf4#\xe1aL'.7\xe4\t\x96\x01\xbb\aQ\x148ɋ\x18W\x90\xf7P\x8bw'0i\x0e\xd4\xdcr|\xa4$[\xbb\x05\xb6u\xbb\x9c\xd4,\r!\x82\x03\xec\x9c\xfb\xa3\xfeq\x11\x8b[\x97\xa67o!\xd9\x11\n6\xbc0\x03\x12\xfc\\\xf5,2\x96춶\xa7a\x00ee\xf7K\xd7v\xa30\xd5d\x86\xe6\xe1q\xf9v#\xb6!k\xfaU\xee\xb2\xfes\x87\xf3\xaf׳b#\ve\x85\xab)\xa7\xe8\xe9\x8do\x1b,L\xde,?\xfb\x90\xa1Y\xa2R~ej\x04\x88\xa2\xd0\x17g)\xefE\x0ey<u8\x1eHdF\\K^\x99\x9d\xb2\xa8\x11\xaa\xb6co\xa5P\x85\x9f\x8b\xeb\xcb\x01\xb4\xce \f\xb95\xa3aa\x15{\xe0\xc2\xd2D\xde\xc5\xf5%\xfb\x8c\xcb\xcb\x10ZcJ\x8e+ʶ\xd68o\xa5\"\xfd}\x02\x9e\xefo\xd4\x1f\fNi\xa1Qaa\xe5s\xc9na\x83\xcbR\x1a\x10\x06\xfe\x04Z㤯!\xe5Qu\xc4.3L\"\x98\xd7\r\x1f\xf4\vþ\xf9\x1a-vmG\xb5\xee\xa8a\xc3\x7f\xb8\xc4Q\xe2\xfc\xc7c\x98\xfb\x8e[\xfe=\x02\x19\xf0\x14\x813\x82\xee\x15\x86\xf8{\xbb\xef\xc4\xc01R/7\x1d\xa8°\xb33\xb4\x06g\xae\x1a\xe1\xccGѵ(\xecJ\xc8n?\xc14aO\xa71\xc4\xf1\xd7\t\xddܨ\xef\x8cS\xf9G\xf1'\x02s\xc4\x0fT*g\xf7\xd47\xdb\bL\xf3\xf6\xc6B\x19\xacV\xbb(\xdcY\xe9\x1e~PoyQx0\x86\xdd\xee\x03Q\xe3\f\x99\b\xf7\xa7\xec\xcd\x18\xd3>\x81\xb1b\xb0\"\xf68\x969\x88#\f\xd3\xfe\x87\x1egP\xddh\x92\x8fG\xc0{~\xe2\xf2LQt\x98\xde\xe7V\x147\x9c;\xc4\xe5\xcds\xbfl*\xa0\xc8\xd1fJEs]\xa0\x1d\x16\x8d\xafB[\t8\x10r\x86)\xa5F\x0f#$\xdbԸ\xb0\xbcfh%\xa2:\"\xa4\xb1\xc0\xf3瓝\xde\x7f\xaa\xe5\xa3dE\x10Fd\xd3\x0es\xa6d\x81\xeb\xf6\x95\xc2\xe9L|NS\xa9ˆ\xedȪ\x9dRw\xb1,OX\xf6@\x12\xae\xb4\xc2\xd9\x1at\xa3v\x87f\xbc\xae\n\xc5ia\x90\xcb=\x99\x82%N\xf0\xe2\x03\xe3m6\xcd\x04\xebZR\x8cH\xbd<\x1b7\xe1KV\xd49\xe4\x17Em,\xe8k\xacf\xcaC5\x97y\f\x97\xdf\x1f\x85\xec\v\x05\n\x81\xd3\xd9\x1b\x96\xb9\x97VTM\x153\x14m\xcd\xc0\xbe\x02*\x8fA\x87\x16Hh\x8b\x01&-\xb5\x01\x8b\r\xcf~s\xb6\xa4\xf1\xd4\xef\xbd\xdf\x0f\xcdu\x84>\xf2Y\x9e\x8e\xe2\xa7\xf1\x16щ\xf9\x04\x8b?C\xeec\xf3\x98]\xa97Uk\xcf \xf7\x18\xec\x81\xe4ex\xed'\x92\xfd\xb0\xff\xff\x8f\xd2\x7fZy\x1bL\x0fpb\x1b\xe5\x8cE\x96=1w\xd6N\xc7fQ=\x83\xa4c8\x13rR\xaa?\x13f>\xe9؉\r\x96F7\xfd\x00\xf8\x87\xe2$9\xba\x04\xee\xfd\x0e\xdfkk\xc5XF\x15\xc8\xec\x16v\xfc^(\xed\xd9҆\x9e\xf0\x05\xb2\xdaF-\v\xb7,\x17\x9b\rh\xac\x19\xa3z\xda0\xdf|\x94YǓ\xc1\xaeɊ\xbe0\xa0\xab\x15:\x8a\x94\xb8\x11#\x05#\x961o\x1e\xfe\x10q\x8c\x1d(\x1c\xcbŽ\xc8k^Pd\xc6e\x16\x96d\x03~\xe3\xf4M*D\xbaV\xbb\x8f\v\x0f\x03\x91(\xc4^y\x19-JjVb\xa6y\xf8jT\xa8\xcd\xc4\xccѾQ\xf35֍\xfb\xeerJ:Z\x9b\xb4l\x85\xb5\xf4\xcbзP0\x03\xb8>\xa6t\x9cC)z0\xcf\xe8F\x98;be\xdb\xf8\x15\xc9k\x89\x99\x00\xcb\xd0\xfd\xb9\n0J\x06P\xd1(\x16f\xb9\x02L\t,\xe3UUD\\\xd7\f\xe5H\xb4\x1b\xb3,H\xaa-9\xe4{Ц\xd3\xd8\u07b4\xeed\r\xc8\xf5Fm^\x99\xdee\xba\x90Cm\x9d\xc5\xf5\tK\x82\xff.\x0fz\x88\x8e\x87(\xeb\x91\xe3\x02\x8blڹN\xe1\xe4 \xd2\x04ڋ\x1f\xa3\x05\"\xbfPٝ6`f\x88nrL=\xaf\xe0\x9an\xfeA\xe4F.\xeb\xda{\xacY2\xfb\xd0m\xb9\xa4\xb5\x1c/\x90|\x89\xb3z\xd6W\aN\xc0d3$\xf7\x94\fJ\xf5\xc0\xf8)\xb9\xcdv\uf6d5\xb8\x84\x16\x03^\r\x010\xd1\xcdrH\x06\t Y\x13Z\x84\x1a\xa7\x12d(\xd2\xea>\xa1<\xe9\xed\x0f\xef\xe2\xb9\xe7\t\x9azʠ\xf55\xf7\x83\xc0\xa8\x8b\xbdOU\xc2/\x14\xaf5\x89 e\xc5X\xdf\xca\xee`\xefB,܋S\x81\xe6\xe1\xe5D\x144\xe0b\x11\xe9#\xc2\"P\xe3{i\x1e\xaf-\xa1`#\xb2\xd4=\xc9W\xc4ϯL9\xbe\xe1\x03\xa45i4\x8d(\x8b\x1f>#;Y\x9e\xc4.\x85O\x90ˉd'\xabS\xb7\xaf6\xa1C5\xba\x83\xfdW\xb8s\xa7\xa0\x15F\xb3\x13\xb4\x84\x87\xeaE\xe3l\x8e\xc0\xdd\xe73/D\xdet\xe6R\xacK\xb9d?(\x8b\xff\xf7\xfe\x8b\xc0\xbaST\xa6w\n\xcc\x0f\xcaғg\xe5\xb2#\xe2%x\xecz\xa2\x01*\x9d'A&vwi\xb9 \b\xc7T#\x0faإĉhǢ\x19\xdd!\x18ߥ묬\xb1\xc0\x03\xa7)\xe4\xcaM\x8a\x8e\xf5\xe6e\xa0tO\x04Oұ\xef\xf4\x06\x9d\x91C\xc9m\x0f,p\xc3nX\xf0\xa4}k\xdc\xc2Vd3\xfa,Ao\x81U\xe8\x16ҵe\x86\xa1>Y\xbd\xd2#\x87\xeeߗ\x15\xee\xc5\xd6\x12,\x98\x15\xba\xb5\x95\x87bU\x99ȗcU\xbb\x87\x7f+\xb4\xe2\x89o\x06mIz\xfdh\x99\xefc\x99\xf5H6Q\x14AaW\x92\x16tw\x90\xcf\xf3^3\xf5\xe6\x14\x13ӡ\x05G1g%\xafм\xfc\x15==\x8dƿ\xb3\x8a\vm\xb0\xf4\x17\xb7\xd0\x17\xd0\xfb\xcdOLv\xc0$v[aw\xa8k\xf7\xbc\xc0\xb9;t\x10\x92AA\x91\x13b0\x8cՖ\xbe\xe2\f\xbdp\xb3\x04zv\a{\xb7>\x9f\xd4m\xd7`\x9d]J\\D\x90\xf9\xa1\xe1i\x02\x1fZG<\xa3\xdf\xce\x1e\x1b\xde\xcd\xd0\xe8\x19\xaf\xf6T\xb9\xe4U\xba&c\xea{\xbe\x98\xa1Q8\x1d\x10\x02\"l\xdc\xec\xd4\xc6\x04a\xbdx\"U\xae\x94\xb1\xe7Gߘ\xaf\xe8W\xcaX7\x0fً\xf7G'*U\x98\x9cd|c\xb1\xc6\xc4*\x1d\xf6>\xa3\xe1O\x99\x8a\xef\xfe\xdd\xec\xc0\x80_\x87\xf2\x93\x9e\x0e0f\xb1g\xadmp\x93Cgn-\f\xff\x9b\xf1\f\x7fA\x97\x87ۇh\x1dzZ\xd3\x12}S\x8f\x83\x87|h\xe6u\xb9\xcb\xdb7IV;eR\xfa\xb4@\x1eE\x92\xf2ހ\xb0\xf7_:S\xd4\x1c7\xdc@\x96\xa4\xad\xa7\xe0\x88\x1f\xdc6·\xfb\xee\x93ѽp\xad\xc3\x18\xf3\xc0\xc8Dq\xbd\xad\xd10\x9aE\"`\xc6:\xaa\xfcs\vmJ!/IO\xd97\xc9m\xe6y\xf8pJ\rn\xf8y\x91D\xe8\"t\xd6J\xafy\xe0+\x14\x15\xed\xaf\xd0\xd0\x13\xee\xe1\x9a\b\xc5\xf28\xa5\x1c\xe6\xd5\xf2\xb9At\xa5\xf2\xaf\xb0LH\x9b&\x87wx\xc5\xcbԞH\xb4J\xbe\xc7\xe2\xc2\x13\x19\xfeѵn\bǩ\xa7\a\x7fBA2D\xd6.3\xed\xf8=\xf8:`\x90\x99\xaa\xf1\xb4\x0fJ\xa2\xa8\x02r\x06D'\x1a\xe7\x05\x12\xfd\xddԞ\xd9\xd8ߊ4I\xc8\xc9y\xb3\xf6\xb3b\xdf\xf1\xd1-]O&V_(\xfa\x12\xe3(\x94\xcb\x06\xab\x8d\xfa\\\xf2/\xb8E\x80\xf1\x12e\xd8\xec\xe0\rGW8q7E\xb4\xd8\x02m<\xb3\xaaٝ\xe4\x8b`g\xe0\x91)\x89\x9b\xbf\x1b\xd7\xefU@\xe1f\xf1\r\x17\x05V\xd2=\x1f\xcb\xe7&aޚ$\xbd=#\xb8\x9c\x83Ȋ\xbc\xeb\xe2\t{O\xb5\xf8\x95\x9e\x17\xc7&\xe8㕆\xf9\xf1b\xa5\x05\xaa\x9fz\x8e\x90\xd1\x17qc\xcd\xe1k\xcc\xf8\x1a3\xbeƌ\xaf1\xe3k\xcc\xf8\x1a3\xbeƌ\xaf1\xe3k\xcc8;fL\xc1pE5H\x8bGb\x95X\n1\x85\xf6D_\xbe\xe8\xc7\xef\xd5\bAY\xc4'\xa7\x8d\xb3\xcbq\x90#\xdbn\"\xdb/\xccb\xc2\xd26\xa5J\x94\xb5\x85\xb1\xe3O\xf2\x99\x0e\x98\x9f`\xf7L@\xc0\x13\xf9\x84\xbb(.\x8fB\x1e\x94\x85\xf7\x19\x18\x81\x18\xd9A\xe1IHa؉{g\x02\x93\xe6\xef\x9e\b\xa7J\x95\xc0\xc3R\n\x95\x04Di\x8c \x93\x82\xc7\xd1\x18tҔ&\xebRl\x84\x8aa=\xe33\xe8R\f\xf6@\x9b\x9a\x8aF\xcf\xc6\bԧЧQџ\xfd\xe6\xec\x97!\xa2\xa7\x15JT\f\x87\xbcuf<f\x1fq\xfd\xa7[\x1aٯR\xfd\xe5\f\x85'\xd5\xfd\x98\xb27Z<dr\x04^_\xad\a\\\xfee\xd9\x1bW\xb6ǋG\xb27\x80\x19q\xec-\xa7\x9c\xf1\xc6i-\x1f]\x13\xf9~=\x1e\xb3E\\\xb2wg\x1f\xc6l\xbb\x11x\xf4!\x1ecY\xe1\xe9S\xd6C^v\x8f\xe3>8\x1a-\xec\xe41\xb8\xdaܜy\xe1\x85h\xe2\xe1\x19bʷ\xc0\n\x95\xf9C\x108n\x94nϿ\v3z--tV\x1a\x1e\x1fAx\xda\x1dH\xb7\xde\xef\x11A\xb5\x8bt\xb6\xa9\x8b\x06_A 5|\x85\x9b\x02\xfa\x94\xae\x1f\xa7\tG\xa2\x18\v\xe5\xc7\xcaGN7ǲ\xaeD\xa5\x18\x81\x97tz\x057{\x99\xed\xb4\x92\xaa6~~\xf0\xd2B\xf9\x96\xa6$}\xad\x18NN\xce\xf1&\xffBgk\xae\x17'\f\xb3\x84\x8a\xea4\x86\xf4\n\xac\x11)N\xc7u\xdf\x7f\xb3\xee\xffb\x95/\xb7&=\x8b\x00í_t\xa7\x84\xdcv7wy\x9f\x10N\x9b\x1e\x1a\xa8\b0\xdc\x05%\n\xd4\xee\x16B\xcfv\xb1\x8fD\x1c/N־\xe9\xf9\xcca\x9dN\xec\xbd\x01\xbb\x87\xcd\xfaS\xed\xfdB\xe5\xe9T\xee\x11\x05\xd8GMy\xba\x96\xfc\xc4%֧\x15V\xa7\xceV'\x14Q\xf7\xb8t\xb4t\xbaa\xc1\x04D6\xa3`z\xd2\xe5\x0e+\xc0f\x91\xf3\xb7\xd5\"\xb9\xb2\xec9\n\xa1\x9f\xa7\xfc9\x99gi\xa5\xces9\xf6\"e\xcd/\\\xcc\xfcr%\xcc3\n\x97'\r\xdcLu\x98\nN\xa3\xe5\x89s*mӦ\xe8\x8e\x17\x1f'\x95\x1c'M\xe3\xa5\x10|\x12\xa9\x9d\xba\xd98\xa5s\v\x88\x93$\x99>\\;8>\x7f\x89\xf0\x8b\x16\x06\xbf|9\xf0\xa4\xb6M\xbe\xd0S\xb3\x84\x82\xdf\x02\xb6\xbc\xf8\x9d*\"#)M\r>\x04 \xc7\xd3D\\.\x949h\xd7)۩\x82N\x8c\xf6\xbf\x0e\x7f\x8a\xf4%\f\x9eM\xec\xf3\xb1%\x93 \xa8\x1b\n\x9cqU\x8e\x8e\x17\xc6\xfc\xaayfz']\xfb\v\x83 _\xb6\xd7!D\xbaB,\\\x93\x02xt\x9d\xf3\tr\xb4\xf1\xeb}ң\xb0⧰\x10\x8f\xd5U\xa5{\xf9\x91y\x8c\x02~\x1c\xc0B\xa9\x85\\\xe1\x05\x93\xb1\xb2.\xac\xa8\x8a\xf6\xb8\xc9\b`:\x8c=\x9c\xc5\xf6g%d{\x10\xe1\xc7O\x8dWZ\x0fRKn\xd8\x03\x14\x05\xe3&\x95\v\x99\xbb\x01+S+\xc0\x88\x05M\xad\x1fl~\x14,\xdd\xd4M{g@\x19\x01\xed\xef\n\x88\xaf\xf6\x1f\x8d\"҄8\x92\x1e\x91?q\xcf\xfeR\x83\u07bb\xcb1\x9a\x00\xb9\x99\x92\v\xd6\xd6\xd4E\xeb\x03\xbcO:\xb6\x88y\x90e\xb66\x9a\xbd\x95.,\x1b\xe2Dm\xc0t\xb3j\xb4bh\x06\xa2\xfdD@H\xd5@X\x9c\x9e\x81\r\x89\x88\xbf9\x90\xc4\x13\xe5\xd8O\x91e'\x85\xa1\xa9j\xf4\x13\xe7ڧocN\x91\xf6\x8cm\xcb=~=Q\xce='\xebNt$\xfd`k&Y\x93j\xf0\xec\xd9\xf7\xf3m?\x9e\xc1\xbd\xd4\xed\xc6\xf3y\xf7\"y\xf8\x8bg\xe2/\x99\x8b\xcf\xdcF\x9c`\bg\xabGZ\x8a:\x9aC\xcc\xc9\xca\xd3\xf2\xf2\x94m\xc1\x89ہ'c\xd09ğHv'\xd68F\xf5\xdc\x18<Y\xbes\x86\xf4\x8b\xe6\xea/\xbe\x8d\xf7\xe5\xf3\xf5$\rLx\xa5\xa7zI\xdbt\x93\xb3Θ\xd6+\x9d\x83\x9e\\\x87\x9f\xa3\xb5\x93\xfa\x9a\xa6\xa9\x1f\a\x88\r\x16\x17}\x02C\xe8\xf7r\x00\xfc\xe2_\xcd\xe8\xba\xe2\x98\xd8PШ\x99\x9d\x88(\x00\xa1j\x8c6\\\xeb\a\xc4\xfe\x1ec|\xc50\x03\x15G\a@\x89\x1b\xd5JFC\x85\xf7<\xdb5h\xba\x1ev\xeejΒ[v\xd6To\xbcq\x1d\xe0\xf7\xb35cߩ\xa6x\xae%rɌ(\xabb\x8fu\xd7\xec\xac\xdb\xe0qZ\x12\xd5\xce\xd0s\xec\xb2\xcd\x03\xb9\x06\xb9\xf9\xbb5\xfb\xc2\xd3x\xcd\x18\xderؖo\x8dBd\xee\x96+\x11\x8eA\xf5B\xf7Ł\uee8a\xc5i\x114\xaf\xc4o\xb5\x8a\x1d\xa1\x9f\xae\xa6\xfe\xcer\x82\x15\xd4hK_B\xc5p\xa0\x90\xdd\x02\x86\f-\xed1E\xf1Ex]\xa8\xfd\xa2\xfd\xee5͐\x93\x927a\x8b7\xcd\x19\x1e\xb1\xf9\xf6\xea\xd2\xe1r\xac'\xd4/\xdc0\xa4\xfc4\x9d\xd0\xf9\xaa\xe2\xda\xee\xc9p\x98e\x8f\xba\xe0\xd7\u05cbGx\xab\xc3;ǣl\x0f\u05cd#\xc1\b\xb9;\xd2\x0f\xf8\xf9\x18\x9c\x8e\x1fs0y\xc0\xc13\xe0\x14X=\x8eՊ\xb8\xb8\x98Y\x92<\xe9\x82\xe6:\xa0p\x98=^\xb4\xf1.:s\xd9c\xdf\xf5\xa0\xc9\xc8\\q\x80J\a\xe7O\x16\b\xd3\x15\x06\x8f3{\xf1)ـ\x8a\xbf\x02\xe1|q\xba\xa5\xb8\xee\x83\x1a\xa1;\\\x10\x11:\x8dEUx\xb2\xafܳ\xab\xcf_\x99\x8e\xaa\x85\xa8\xcc\xe7\xad~F\xa9\xa9\xf2\x88\xc0\x12\xf2\xe8\x15TO\xc5FWj\xf5\xc1WZ\xa5\xa8I\xbf\x85\x9f\xa9\xa1!\x1c\"\xb7\xb0\x81\xc2\x0f\xc2Q\x98\xb8u\xd0\x15\x19\r\x01\xb6\x1b\xa6\xfa^\x05\xef^\xb2*j\xe3&ƭ\xb5\x8f*\xb5\xbb\xb9\xf9\xe0(\xa5\x1b\x9b\u0095\xb5h\x8f\r\xa0\b\x02\a\x1c\xabn\xf1?\xc3]\xb6\x11\x88\x9d\xfb\x91Z\x02\xb5\xbf\x93\x14\x83\x8f\x93\xc8t\xf7[\x80\xbe\xa0;\xa2\x12(\xfeC\xafAG\xf7\xfd\x86\xb6\xceMS\xdeo\x8e\xc2l{>YU\xa7C\x03\x8c\xe8\x8a\x02\x8a\xefD\x01\xc6!\x1e{u@\xe5\xd5a\xcb\xc3{\xf1\xf0\n\x1d\xd3t\x12\x05\x1cH\xc5\x196V\x81\xde(]\xa2\xa5\x90\f\xef\x93v\x9a\x7f\x9c\x19\xd3\x17\xe3%\xf8\x04w\x17\n\x05\x00\xc1\x80Q\xc6\xf7{\xd8'\x88\xfds\xbc\xf5@\a\x9a\xc9\xc8Q\xa0tL\t\x852\xec\xea\xf3EX?\xe4\xec\xf3o\xafO\xd2\xdf\xfb\xde\xf5Y\xc1&\x98d\x8a\x0eZvR\x84\x8euB\xcbtĈ\xc7`qcT\x86W\xd75w1\v\xe3\xad\xd48\xb5G\xe7\x8a&Xq<A<\xa2\x1d\xb5\x81\x8f\x0f\x12w\xfdx\x0fd.e\xecZ\xaai\xeb\xf7\x87\x03h\xc1j\x8d\xb9\xc9ڌ\r\xee\x01\x00\xa6\xc2:\x97q\x17\x9d\x85\xe55a\x9a{\xb6\u05cb\x99&$\xee\xe9\xc6\x03\xb6\xc8-ի\xe6J\xbcE\x02\xbb\xdd\xf5n\xe7\x8b(K\x039\xee\xdeB\x96\xf1\n/q\xf2ֵ\xd6TJ\x8d@(X\xe5\xcd\xfe\xc61\xcc\xe2\xf6\xb1\xbd\xca\xf1\x14\x01\xb7w)\x06\x93\x88\xf0\\\x91p\xf0\xd1\xec\x81\x1b\xbc'\xd3\xef\xb6\x1c\xbd^7\x90:\x8e\xbd\xbfk\xac\xe4\xf6\x1c\xa3GX!\xfc\xd3d<:b\x10\xe7o\v\x9eݍ\x96O'r\xc1\xb7\xef\xf1\x01\xa9\xf6\xa5\xe6\x810\xaaYp\xb7\xd3\x1b\x96\x8b\x1c\v\x1e8\xa6\xbc8\x02\xe8\xc6\xf7\xb1\tn\x9c\xb5\xc0\x98\x00/\xa7\xe2\x86\xed\xb8\xcc\v\xc8g\xeb\xf9q7Y\xa5N\x03\xf8\xf4\xdf߹\x8f\xd4\"\xa1\x1dĖ\xec\x1a\xaf\x18ř{\x1c\xb8\xebż\x8d\xce+j\x1e\xf9\x89 .N\xb0\x89\x1a\xb8I\x8aR?ыA\x90\xfeB}\x9a\xaa\xe8\xcb\xcbѽ>\x05\x97\xa0\r9\x16\xd9'\xa0\x14\xc6\a\xbd\x1f0\xc3Q\x10\xb0 \xb5\xc0\xebNyd\xediz\xfc$\xe8O\x02e\xcdu\xb0\tT\xb5\xf7\xaf\xc6(\n\x17\xa6*\xedδ\x1a\x85\xc9\xda\x1b\xee\x7fZ\xea\x8f\xf8U4\a\xa8\xd4\xd5X\xee\xdfcʇ\xf6\xcd1\x9b\xdaX\xca\x0e{\x16\xf3ɝ \xf5\b\x99t\xd1\xce\x04\rW\xf8N\xc0>\xb8*j\x18\xcca c\x91f\x15V\xec\a8\x9c\x14\\\xb1\xf7\x12\x898\x9c2q\xe7bAN\xab\xb7\x94\x1a\xce!\xf1\xbeiE\aL\x98\tjG}B۳\x831ؽ\x86\x05&m7\xee\x84\t\xc3~%6#\xa0hQ>CB\x7f\xbdH\x0e\x12\x8f\x90\x17\x0f\x0eG\x15\xf8\xe0!]%\x9cw4\xc7O\x04t\x9fԷa\xf6̜\xb3\xbf\xfe}\xf1\x7f\x03\x00}n\xb2\x96\x9b\x96\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVϏ\xeb4\x10\xbe\xf7\xaf\x18\x89+IyB \x94\x1b*\x1cV\xc0\xd3j\xfb\xb4w7\x99\xb6\xc3&\xb6\x99\x19w)\xe2\x8fGc'\xdbn\x9b>\xfa8\xd0\xe6\x12{~|\xfe\xbe\x99q\xaa\xaaZ\xb8H\xcf\xc8B\xc17\xe0\"៊\xdeޤ~\xf9Aj\n\xcbÇ\xc5\v\xf9\xae\x81U\x12\r\xc3\x13JH\xdc\xe2O\xb8%OJ\xc1/\x06T\xd79u\xcd\x02\xc0y\x1f\xd4ٲ\xd8+@\x1b\xbcr\xe8{\xe4j\x87\xbe~I\x1b\xdc$\xea;\xe4\x1c|J}\xf8\xa6\xfe\xf0}\xfd\xdd\x02\xc0\xbb\x01\x1b\x10\xe4\x03\xb2\xa8\xd3$\x8c\x7f$\x14\x95\xfa\x80=r\xa8),$bk\xf1w\x1cRl\xe0\xb4Q\xfc\xc7\xdc\x05\xf7:\x87Z\xe7PO%T\xde\xedI\xf4\x97[\x16\xbf\xd2h\x15\xfbĮ\x9f\a\x94\rd\x1fX?\x9e\x92V \xc2e\x87\xfc.\xf5\x8eg\x9d\x17\x00҆\x88\rd\xdf\xe8Z\xec\x16\x00v艼j\xe4\xe2\xf0\xa1\x84k\xf78d\x92\xed-D\xf4?>><\x7f\xbb~\xb7\fС\xb4L\xd1$h\xe0\xef\xeam\x1d\xe6\x8e\t$\xe0`\x84\x04\x1a\xc0\xb5-\x8a@\x9b\x98\xd1+\x14\xc8@~\x1bxȲ\x82ۄ\xa4gQu\x8f\xf0\x9c\xf9\x1f\x8fY\xbfmF\x0e\x11Yi\xa2\xa6\xfc\xcf*\xeel\xf5s\xc0\xedog-^\xd0Y\xe9\xa1\xe4\xcc#_؍\xf4@\u0602\xeeI\x8012\n\xfaR\x8c\xb6\xec<\x84\xcd\xef\xd8\xea\t\xe09/\x02\xb2\x0f\xa9\xef\xacb\x0f\xc8\n\x8cm\xd8y\xfa\xeb-\xb6\x18A\x96\xb4wjt\x91Wd\xefz8\xb8>\xe1\xd7\xe0|w\x11ypG`\xb4\x9c\x90\xfcY\xbc\xec \x978~\v\x8c\x99\xea\x06\xf6\xaaQ\x9a\xe5rG:\xf5a\x1b\x86!y\xd2\xe32\xb7\x14m\x92\x06\x96e\x87\a\xec\x97B\xbb\xcaq\xbb'\xc5V\x13\xe3\xd2E\xaa\xf2A\xbc\x1d_\xea\xa1\xfb\x8a\xc7Εwi\xf5h5(\xca\xe4wg\x1b\xb9u\xbe@\x1ek\xa4RL%T\xe1\xe4\xa4\x02\xf9]\xd6\xeb\xe9\xe7\xf5'\x98\x90\x14\xa5\x8a('S\xb9\xa5\x8f\xb1I~\x8b\\\xfc\xb6\x1c\x86\x1c\x13}\x17\x03y\xcd/mO\xb9p\xd3f \x95\xa9\xb4M\xba˰\xab<\xab`\x83\x90b\xe7\x14\xbbK\x83\a\x0f+7`\xbfr\x82\xff\xb3V\xa6\x8aT&\xc2]j\x9dO\xe0ӯ\x18\x17z\xcf6\xa6\xd9yCڙ)\xb1\x8eؚ\xb8ƯyӖ\xda\xd2V\xdb\xc0\xe0\xe6\\껐d\x8f/\xc42N\xa4\x82\xe6bN\x85\xed=h\xe6ǒ\xfd\xe3\xde\t^.^`z4\x9b\xcb\xfc=m\xb1=\xb6=\x96\x106nl\xfb_\xa1\u0603>\r\xd79+\xf8\x88\xaf3\xab\x8f\x1clB\xe3娹Y\x1b\xe3%\xb6\xa3\xe9F\xbe}\xb2b\x95/\xc6둟\xf9\x1e\x03\x01'ﭥ\x83\xbf\n9s#\\ِ\xe20\x83f\x16σ\xdf\x06\x9b\xc9\xea,\xb1\xd3\xd2N8\x8a=\xe6)\xb8f\x02\xde\xd6\xfa֜\xbb\x8b\xd0\xf2\xe4\xeb\xf9\xbf9\xdb\\\"\xc6\xd9\xdcUF5\xbba\x19g6n\xf4\u05c82\xf5\xbd\xdb\xf4\u0600r\xba\xf6.\xbe\x8e\xd9\x1d/\xf6\xe2Tj\x9fh@Q7\xc4f\xf1Y\xc1\xaen\x05{\x1e\xaf\xa2X\xf3\xbc\xee\xd1\xdfj\x11xurJ>\x13rs\xbc\xe5\xbaz\xfbڼ\xee\xb3\xf2\tӀ\xcd\xfaJi\x86Ȼ\x98\x9a\x95\xb4|\xf9\xcc~\xd6\\\xb1\xb4>\xb7\x9d\x06ɻ~\x99\xbej\xea\xfb!\xccV\xc0\xd5b\x86ٝ\x1dO4\xb0\xdba\x03\xca\t\x17\xff\f\x00\xef\xf8\xa6>\x10\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcVK\x8f\x1b7\x12\xbe\xebW\x14\xec\xab[Zc\xb1\x8b\x85n\xc6l\x0eF\xec`\xe0q\xe6N\x91\xd5REl\xb2],\xb6\xac ?>(\xb2{\xf4\x9e\x19\aAF\r\f\x9a\x8f\xaf^_}\xd5M\xd3\xccLO\x8fȉbX\x82\xe9\t\xbf\v\x06}K\xf3\xed\xffҜ\xe2bx?\xdbRpK\xb8\xcbIb\xf7\x05S\xccl\xf1\xff\xd8R \xa1\x18f\x1d\x8aqF\xccr\x06`B\x88bt9\xe9+\x80\x8dA8z\x8fܬ1̷y\x85\xabL\xde!\x17\xf0\xc9\xf4\xf0\xaf\xf9\xfb\xff\xce\xff3\x03\b\xa6\xc3%\f\xd1\xe7\x0eS0}\xdaD\xf1\xd1V\xcc\xf9\x80\x1e9\xce)\xceR\x8fVM\xac9\xe6~\t\x87\x8d\n1\x9a\xaf\xae?\x16\xb4\x87\x11\xedӈV\x0exJ\xf2\xf33\x87>Q\x92r\xb0\xf7\x99\x8d\xbf\xe9Y9\x936\x91嗃\xf5\x06\x86\xe4\xeb\x0e\x85u\xf6\x86oݟ\x01$\x1b{\\B\xb9\xde\x1b\x8bn\x060\xe6\xa7\x04\xd3L\xa9y_\x11\xed\x06\xbb\x92s}\x8b=\x86\x0f\xf7\x1f\x1f\xff\xfdp\xb2\f\xe00Y\xa6^m\xdc\n\x11(\x81\x81\xc9\x13\xd8m\x90\x11\x1eK>!IdL\xa3\xd3O\xa0\x00\x93\xffi\xfe\xb4\xd8s쑅\xa6\xe0\xeb\xef\x88_G\xabg~\xfdќ\xec\x01h(\xf5\x168%\x1a&\x90\rN\xe9@7F\x0f\xb1\x05\xd9P\x02ƞ1a\xa8\xd4\xd3e\x13 \xae~C+\a\a\xeb\xef\x01Ya mb\xf6N\xf99 \v0ڸ\x0e\xf4\xfb\x13v\x02\x89Ũ7\x82I\x80\x82 \a\xe3a0>\xe3;0\xc1\x9d!wf\x0f\x8cj\x13r8\xc2+\x17\x8e\x12U\x9fϑ\x11(\xb4q\t\x1b\x91>-\x17\x8b5\xc9\xd4u6v]\x0e$\xfbEi Ze\x89\x9c\x16\x0e\a\xf4\x8bD\xebưݐ\xa0\x95̸0=5%\x90\xa0\xe1\xa7y\xe7\xde\xf2ا\xe9Ĭ\xec\x95bI\x98\xc2\xfah\xa3t\xc9\x0f\x94G\x1b\xa6\xb2\xa6B՜\x1c\xaa@a]R\xf7姇\xaf0yR+U\x8br8\x9an\xd5G\xb3I\xa1E\xae\xf7Z\x8e]\xc1\xc4\xe0\xfaHAʋ\xf5\x84A \xe5UG\xa24\xf8\x961\x89\x96\xee\x1c\xf6\xae(\x13\xac\x10r\uf320;?\xf01\xc0\x9d\xe9\xd0ߙ\x84\xffp\xad\xb4*\xa9\xd1\"\xbc\xaaZ\xc7z{\xf8\xab\x87kz\x8f6&\x99\xbcQ\xda\xeb\x8a\xf0У=i<E\xa1\x96F\x85h#\x9f \x02\x98I/\xae\xe3\x9d\xe6\xf3\xbaP\x8câ\xa5\xf5\xf9*\x80q\xae\x8c\x1a\xe3\xefo\xde}&aW⾋\xa1\xa5\xb5r\xb8\x8d\f=ǁ\x1cr3\xc59z\x92y\f\x98л\v\xa6\xde̹>\x96\xd1i\x89\x8d_\xbe\xe0\xc9\xd3A5*\x86Bպ\x03@a\x1ew\xa3V\a\xc1\xe0\xf0\\{\xf4\x91X\xe8\x9d\xd0\xc1\x8edS\xfb\xe6h\xc0\x00\xbc\xae\n\xfa\xdb\xe2\xfe\xda\xf2\x99\xef_7\b[ܫު\xcb\t-\xa3\xa8n&\xf4*\x83ڴs\x80\xcf9\x89\xbaf\xae\"\x82\xaa\a\xb9\xe9\xf6\x16\xf7\x97\x89~\xb1\xb8\xe3w\xc3Ջ\x0e[\x93\xbd,\xe1͛\x97C\xbaк\xe9\xa7sy\n\x94\xb1E\xc6 \xf3\x1bg\xbfj\xe6\vi\x94aضh\x85\x06\xf4:\x1f\xbeebt\xef`\x95\x05\\F\xcd\xd6\xca\xd8\xedΰK`c\xd7\x1b\xa1\x15y\x92=P\x9a]\x01\a\x00\xe3}ܡ\x1b+\x8e]/\xfb9|\fIL\xb0\x98\x9e\xa6\xa2f\xacR\xc1\x84zj\x14\xea2\xe1\r\xe3M\xf8.&\x01\x8b\xact\xf4{\xd8q\f\xeb[\xc1^\x11G\xfd\xca。\xe5\v\xd2E\x9bt\x8cY\xec%-\xe2\x80<\x10\xee\x16\xbb\xc8[\n\xebF\x1dlj\x0f\xa5\x85V1-ޖ\x7f\x7f\x85\x05\xb10\xd3\xf8W\x90WE\x8e\xda=\xec6(\x9b2f\x10\x1e*\a#\x83\x8e\x13\xa5v7r\xb7\xaa\xa1{ƧU\x8c\x1e\xcde\xa3M%\xbft\xa9\xd1\xe6\xf9\x11Q\x01\xf8\xde\x1cr\xdbt\xa6o\xaam#\xb1#{vzR\xb5\xe5\xec\xd9<\u070fǔ\xaaJ\xee\xe9\xdaD\xf6\xfa\xedW\xbe\x04\xcd\x1a\xe77\xfc\xbdR\x91\xeb\x817O\x06f\xaf\x88:\x89\x91|\xa6P\xaf\x19`\xe5\xda\x18\xe7j\x1cb6\xb36\xed\x88y\x02\t\x1a\xec\xdf4\xc4\xfa\x8dI\xf8Bί[\xb8כS\x19<\xb5h\xf7\xd6c\x05\x84\xd8^@\xfe\xe0\xdc\xd5\aC\xee.}k\xe0\xc3`ț\x95\xbf\x94\x84\x06~\r\xe6\xe6\xee\xcd\xe2_\xad\xe7\xc5bB\x1e\xd0-A8W\xcb#˖ \x9cq\xf6\xe7\x00Ny\xc1Q\xa1\x0e\x00\x00"),
 }
 
 var CRDs = crds()
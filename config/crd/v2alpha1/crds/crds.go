@@ -29,8 +29,8 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYɒ\x1b\xb9\x11\xbd\xf7Wd\xc8\a]D\xb6\xe5e\xc2\xc1\x9bĶ#:<j1\x86\xed\xbe\xa3\n\xc9\"F(\x00\xc6B\x0e\xbd\xfc\xfbD\x02U\xc5Z\xc0u\x16\xde\b$\x12/\x17d>\xa0f\xb3\xd9\x033\xe2\r\xad\x13Z-\x80\x19\x81?yT\xf4\xcfͿ\xfd\xcdͅ~\xdc}|\xf8&\x14_\xc028\xaf\xeb\x1f\xd0\xe9`K|\u008dP\xc2\v\xad\x1ej\xf4\x8c3\xcf\x16\x0f\x00L)\xed\x19\r;\xfa\vPj孖\x12\xed\xacB5\xff\x16\n,\x82\x90\x1cmT\xden\xbd\xfb\xe3\xfc\xe3w\xf3\xbf>\x00(V\xe3\x02H\x1f\xd7{%5\xe3n\xbeC\x89Vυ~p\x06KR\\Y\x1d\xcc\x02\x8e\x13ia\xb3i\x02\xfc\xc4<{jt\xc4a)\x9c\xff\xe7d\xea{\xe1|\x9c62X&G{\xc7\x19'T\x15$\xb3ù\a\x00Wj\x83\vx\xa1\xad\r+\x91\xc6\x1a\x9b\"\x94\x190Σ\x97\x98\\Y\xa1<ڥ\x96\xa1n\xbd3\x03\x8e\xae\xb4\xc2\xf8\xe8\x85>,p\x9e\xf9\xe0\xc0\x85r\v\xcc\xc1\v\xee\x1f\x9f\xd5\xca\xeaʢK\xb0\x00~tZ\xad\x98\xdf.`\x9e\xc4\xe7f\xcb\x1c6\xb3ɕ\xeb8\xd1\f\xf9\x03\xe1u\xde\nU\xe5\x10\xbc\x8a\x1a\x81\a\x1bCHv\x97\b~+\xdc\x10ڞ9\x82g=\xf2\x93@\xe2<\xa9s\x9e\xd5f\x8c\xa8\xb74A\xe2\xccc\x0e\xd0R\xd7F\xa2G\x0e\xc5\xc1ck\xc6Fۚ\xf9\x05\b\xe5\xbf\xfb\xcbi_4ΚǥOZ\r\x1d\xf3\x99F\xa17\x9c\x90P\x94*\xb4Y\xefh\xcf\xe4/\x01\xe2I\xc1\xe7\xde\xfa\x84$\xe9\xed\x8f_\x84B)\az\x03~\x8b\xf0\x99\x95߂\x81\xb5זU\b\xdf\xeb2\x85o\xbfE\x8bQ\xa2H\x12\x94\xbd (v\xdafCg\xb0\x9c'\xd9FY\xabk\x14\xbf\xe1F\xbfzn\x95\x16Y6\xb7\xdaR3\x8f\x12B\xab|\x82}\xaa\xf0\xaa\xe4\xea;Qi\x8e=\x8f\r0\t\a\xc6\xea\x12\x9d;\x93\xf0\xa4`\x80\xe2\xe580qM\x92\xd8\xfd\x89I\xb3e\x1fS\x91)\xb7X\xb3E\xb3B\x1bT\x9fV\xcfo\x7f^\x0f\x86\xe1L\xc1`\xa5wT)\b\xbe\xb1\xda\xebRK(\xd0\xef\x11U\n}\xadwh\xa9\xceUB\xb9N#Um\xde\x178\xd6l\xca﨏fӤŘ=\x04\xd0\xf6\xa3\x0f\xb4\xa7A\xebE[\x85\x1b\xdd\xc7\x06\xd3\x1b\x1d\xd9\xf1\xbf\xd9`\x0e\x80LO\xab\x80S\xa7\xc1dVS[\x917\xdeJ\xc1\x13\x0e,\x1a\x8b\x0eU\xea=4\xcc\x14\xe8\xe2G,\xfd|\xa4z\x8d\x96Ԁ\xdb\xea 9\x19\xbbC\xeb\xc1b\xa9+%\xfe\xd3\xe9v\xe0u\xdcT2\x8f\xce\xc7\xc3h\x15\x93\xb0c2\xe0\ar\xdaHs\xcd\x0e`\x91\xf6\x84\xa0z\xfa\xe2\x027\xc6\xf1\x85\xbc(\xd4F/`\xeb\xbdq\x8b\xc7\xc7J\xf8\xb6햺\xae\x83\x12\xfe\xf0\x18\xa3!\x8a\xe0\xb5u\x8f\x1cw(\x1f\x9d\xa8f̖[\xe1\xb1\xf4\xc1\xe2#3b\x16\rQ\xb1\xf5\xcek\xfe\a\xdb4j7\xd8v\x92\x88\xe9\x17\x1b\xe6\r\xe1\xa1.J\xa7\x825\xaa\x92\x89\xc7(\xd0\x10\xb9\ue1ff\xaf_\xa1E\x92\"\x95\x82r\x14\x9d\xf8\xa5\x8d\x0fyS\xa8\rڴncu\x1du\xa2\xe2F\v\xe5\xe3\x9fR\nT\x1e\\(j\xe1)\r\xfe\x1d\xd0y\n\xddX\xed2R\x13(\x10\x82\xa1z\xc0\xc7\x02\xcf\n\x96\xacF\xb9d\x0e\x7f\xe7XQT܌\x82pU\xb4\xfa\x84k,\x9c\xdcۛh\x19Ӊ\xd0\xf6+\xc8\xda`IQ%\xc7\xd22\xb1\x11M'\xa12\xc0\x06\xb2C\x0f\xe5\x8f>\xfd\xb2\xddd,t)\xdd\xe8\xf79\xa7\xa8E\xabz\x85\xbc\xe9u\xaeiRrؤ\xfa\xbfI\x7f\xb4h\xb4\x13^\xdbñK\x8eS\xe1dT\xe8W2U\xa2\xbcǼe\\\tBq\xf29v\xa9LE(i\x8d@\xb5\xaa4\x1d\xaeA(\xe0ٓ\f\xe5\xb6C\x9f7Te\xbb\x9aPp\xe4\x94\xd0\xe7\x8ecs\v\xad%\xb2\xb1\x17)\v\xbfP[Xj\xb5\x11\xd5\xd4\xf0>\xfd=\x95\"\x17|\x9aI\xd8ޖd\x05e'!\x99\xc5\x0e5kS\x97J\xfbFT\xc1\x9e\x8a\xffF\xa0\xe4\x93\xfas\xf2$\xb5\x06\xc7]\xee\x89q\a\xbd=]MW\xeb\xb5^\xafc\x85r\x91\xef\xf6Rs\n\x12\xe0y\xd3\xd3(\x1c\xbc{\a\xda»t'z\xf7!\xad\x0eB\xfa\x99\x18\xf4\xff\xbd\x90\xb2\xdd\xe5\xa6\xec&\x86\xf3u}\xc1\xf2\x97(Dx\xbe\xaeo\xe5VS4\xa8B=\xddp\x06,x\x9d\x19\x96B\x85\x9f2\xe3{\xa1\xb8\u07bb[\x8c\xed\xf8\rQL\x1d\xfc=\x01\xff:\xd21\x8a\xbb'B\x1cc\xed5\xec\x99\xe8q\x8cnw\xf7!\xa3\xb7\xc0\r5$\x8b>XE\xe5\x00\xad\xa5\n\xed\xa2J\x1d&\x9c笥N1\xe3\xb6\xda??]\xb0q\xdd\t\xb6u\xf7\xf9\xa9\r\xf1[̺\xae\xf86\x92\x90\x89\x12\xc1oY$\x8fm\xfd6\xb4\x91Kt7\xee{²\x1e\xaah\x8d\xd1VT\x82\x9c\xaf\xba\x99c\xce\xee\xe8\xda\x1eE\xc9D\xe4\x10\xcc\t\xec@\xe5\x98\xc8K\x81\xc0\xc5f\x83\x96\x18J\xa4/i\xe3\xd5\xdb\xf2\xbd\xebm\"6\xfd?T\xf9kf\fr\xba+Qp\x1b_\xdd\xe4%\xcfl\x85\xfe-\x82\xbe\xe0\xa2מh\xeb\n\xa2:t\xb1m\xb8tL\xd6(\x06\xab\xb7e\x86\xf9\xd2o\xf56Ex\x9a\x17@s\t:\x11\xc4\t\xcaI\xb4\x1a<\x9d\x8e\xac\x8a\xb3m\x05\xc0\xec\xae\xd8y\xf5\x96c\x19\x9d;\xc0o\x99'\x89\xe6\xd2\n\xc5!\xab\x13\xda#҄\xf3>\xbc\xe5U\x80\x97g\x11/ǐO\xe0-\x0e\xbf\x182\x91\x18a\x91\xe7J\xf8\xe9\xc8\xcd\xc0첃\xe5\xf5\xad:\xbf\xf3,\xcfGG2\xe3\xd2?\x9a>\xd6\xcb\xf1İ\xae\x8cf\xfbG\xf2*\xe2\x1e\x9f\x15\xae\xa5\xee鱰\t{\x19l,:\xcd\x13\"݆\xef\"\xef\xac,\xd1x\xe4\x9f\x0f\xd4կh\xfc\x04@\x9d\x7fT\xf9\x979\xb6}4\xecV\x86\xddB\xea\x1e~\xeei\x00\x9f\xc6J\xe2\xed\xdf\xf2^[\x9e\xc2M\xd4\xec4h\x80W\xba7\xc5\xdb\xeb\xfbԉiY\xec\xef\xc4P'\x9bN4\xb4\x8f\x89t=\x9d\xd1\xfa\x89\x84\nR\xb2B\xe2\x02\xbc\r\xa7\xa8z\xfef\x92\xdeQ\xfbOfw]S\xa6j\xa6\xbec\xdd#Q|\xcck_ps.;\xea\xeb\x1c\x96\xd4!\aܡ\x02\xba|2!\x91\xb7:3|\xfd\x92\xe73\xa0\xa7T\xf0\xb7t~\x8dα\xea\xd2\x01\xfa\x92\xa4һJ\xb3\x04XA\xbcq̚\u07fb\xe6l\xdf\xcc\xdf\x7f\x9dC|%{?\x83%\xde5/\x80Y\x91L\xae\xa6u\xd0N\x1758syx\xc1}f\xb4=\x9f\x99\xa9Us\xe83S\x93O2\xfd\xc9t\xa9\xcf5\xc6v.\xab\xb3\xfb摙\xfbG<\f7y\xba\xc1w\xcfq\xef\x9e\x06\xb6Z\xb6'<~\xabP\xa1.\xd0R\x18\xe2א6\x1e\x1d\xefg\x8a\xf7\xa3\x96#\x7f\x9d\x86\xee.\x10U\xcd\xe1uK\xd4$\xbdg\xb4\xb7#.\x9c\x91\xec\xd0\x19\xd3g\xa8\x19\xe5\xc7S3y\xae\xbe\x95\xa4vߎ\xf2\xcc+\xf7\x01h\xf8\x9b~\xca\x19\xcdw߄~\x9b\x1dμf\f\xbf\xd1\xddu\x95\x1ah\xb8\xd4\n\x9ao\x86\xb7W\xf0\xe16\xbfg\xf1\xcezo2\x18\x91\xf3\x9e\xee\xe6\xf5\xb1?\x12\x8a\xeeI~\x01\xff\xfd\xff\xc3\xcf\x01\x00\x00\xff\xff\xb1\xea?f~\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcZIs\xe3\xb8\x15\xbe\xfbW\xbc\xea\x1c\xe6b\xc9\xe9,S)\xdd\xdarR\xe5ʴ\xdb\xd5r|\x87\xc8'\x11c\x10`\xb0H\xe3,\xff}\xea\x01\x04\x05\x92\x90(i\xa6\x9b\x87\xae\x16\x96\x87\xb7\xe1{\v<\x9b\xcdnX\xc3_Q\x1b\xae\xe4\x02X\xc3\xf1\x17\x8b\x92~\x99\xf9\xdb\xdf̜\xab\xbb\xddǛ7.\xcb\x05,\x9d\xb1\xaa\xfe\x8aF9]\xe0\x03n\xb8\xe4\x96+yS\xa3e%\xb3lq\x03\xc0\xa4T\x96Ѱ\xa1\x9f\x00\x85\x92V+!P϶(\xe7on\x8dk\xc7E\x89\xda\x13\x8fG\xef\xfe8\xff\xf8\xe3\xfc\xaf7\x00\x92ո\x00\xa2\xe7\x1a\xa1Xi\xe6;\x14\xa8՜\xab\x1b\xd3`Ad\xb7Z\xb9f\x01\x87\x89\xb0\xad=2\xb0\xfb\xc0,\xfb\x97\xa7\xe0\a\x057\xf6\x9f\x83\x89\x9f\xb8\xb1~\xb2\x11N3\xd1;Տ\x1b.\xb7N0\x9d\xce\xdc\x00\x98B5\xb8\x80':\xb2a\x05\xd2X+\x89ga\x06\xac,\xbdn\x98x\xd6\\Z\xd4K%\\\x1du2\x83\x12M\xa1yc\xbd\xec\a\x86\xc0Xf\x9d\x01\xe3\x8a\n\x98\x81'\xdc\xdf=\xcag\xad\xb6\x1aM`\t\xe0g\xa3\xe43\xb3\xd5\x02\xe6a\xf9\xbc\xa9\x98\xc1v6\xa8o\xe5'\xda!\xfbN\xdc\x1a\xab\xb9\xdc\xe6\xce\x7f\xe15B\xe9\xb47\x1b\xc9\\ ؊\x9b\x94\xb1=3Ĝ\xb6X\x1ee\xc3\xcf\x131cY\xdd\f\xf9I\xb6\x06\x86Jf1\xc7\xceRՍ@\x8b%\xac\xdf-F!6J\xd7\xcc.\x80K\xfb\xe3_\x8ek\xa2U\xd5\xdco}P\xb2\xaf\x96{\x1a\x85d8pB\x16ڢ\xce\xeaFY&~\v#\x96\b\xdc'\xfb\x03'\x81n:>\xc9\n\xb9\x1b\xa8\r\xd8\n\xe1\x9e\x15o\xae\x81\x95U\x9am\x11~RE0\u07beB\xdd\x1ao\x1d\x96\x98J9Q\xc2:J\f`\xac\xd2Y+6X\xccî\x96n$;0e\xff\xcc\xdf\xd9\xc9\n\x8d,\xebd\x11e\xe6~\x05W2\xefi\x9f\xb6x\x96\x97\xa5ڔ\xaa\xc4Nu\x98r\xc4\r4Z\x15h\xcc\t\xbf\xa7\xed=\x1e\x9e\x0e\x03#\xb5\x84\x15\xbb?1\xd1T\xecc@\x99\xa2\u009a-\xda\x1d\xaaA\xf9\xe9\xf9\xf1\xf5ϫ\xde0\x1c\xc5\fVXC`A\xac7ZYU(\x01k\xb4{D\xe9q\vj\xb5CM \xb7\xe5\xd2\x00\x93eG\x13\xd2\x05\a\xa8&'\xf7\xf4h6L\xb6\xee\xa4\x1aԩف\x8elP[\x1e\xd17|IXIF\aB\xfco֛\x03 \xb9\xc3.()\xbe`\x90\xaa\xc5V,[U\x05\xbbq\x03\x1a\x1b\x8d\x06e\x8884\xcc$\xa8\xf5\xcfX\xd8\xf9\x80\xf4\n5\x91\x89\xf7\xa1Pr\x87ڂ\xc6Bm%\xffOGۀU\xfeP\xc1,\x1a\xeb/\xa4\x96L\xc0\x8e\t\x87\xb7\x03\xed\xd1W\xb3w\xd0Hg\x82\x93\t=\xbf\xc1\f\xf9\xf8\xac4\x02\x97\x1b\xb5\x80\xca\xda\xc6,\xee\xee\xb6\xdc\xc6`[\xa8\xbav\x92\xdb\xf7;o\f\xbevVisW\xe2\x0eŝ\xe1\xdb\x19\xd3E\xc5-\x16\xd6i\xbcc\r\x9fyA\xa4\x0f\xb8\xf3\xba\xfc\x83nó\xe9\x1d;\xf2\xc2\xf0\xf9@y\x81y(~ҕ`-\xa9 \xe2\xc1\n4D\xaa\xfb\xfa\xf7\xd5\vDN\x82\xa5\x82Q\x0eKGz\x89\xf6!mr\xb9A\x1d\xf6m\xb4\xaa=M\x94e\xa3\xb8\xb4\xfeG!8J\vƭkn\xc9\r\xfe\xed\xd0X2ݐ\xec\xd2'$\xb0Fp\rAA9\\\xf0(a\xc9j\x14Kf\xf0;ۊ\xacbfd\x84\xb3\xac\x95\xa6Y\xc3\xc5A\xbd\xc9D̔\x8e\x98\xf6\x00\x1f\xab\x06\v\xb2)\xa9\x956\xf1\roc\ta\x00KV\xf6\xb5\x93\xbf\xf6\xf4eC\xc8pє\xab\xd1w\x9f#\x14y\x95\t~\xc7P\xd7F&яL\xe9w\x00\xf9v\x8f\xc6F\x19n\x95~'\xc2!4\x0e\xdd\xe0\xa8E\xe8+\x98,P\\#\xde\xd2\xef\x04.K\xd28vnL\x00\x14\xa8zF\x95\xdc*\xbaX\x89!\xe0\xd1\xd2\n\xf2j\x836/\xa6̄2.\xe1\x90MB\x9a5\x0eE]+%\x90\r5X\x18\xbe\x92\xac1\x95\xb2\x13\x02?n \xae|yo\x90\x0e_\xae\x1eo\xe9\x9f8N\x1e\xb4\xe3e\v\xf1t\xcb(\xafʛ\xad\xb5\xf3r\xf5\b\xa6\xdd>6\x92tB\xb0\xb5\xc0\x05X\xedƂ\x1dwXϽ\xe6;Թ\x99\xe1\xcd\xf1\v\xa3\x17\x86m\xe0\x8c\xcfV\xfd\xd0+e\xfa\x18\xa5\\*iQ\xe6ltҫ苒.\x053Y\x9e\a\x9c\xad\xd2\xf5\xb9k\x12\tB\xe1W؊\xe5\xf9\x82\x10t\xbd\x1c\x87M\xbc\xcb\xcd`\xcfmu\x95D႞-P\xb2<+O{߃8jsB\x98\xe7ץ\x97wJ2\n7\xd7H\xb6\xeb\x19\xfd\f\xd9\xfa^\x92\x93n\xc0\xe51\xe1\x14\xa1\x00\x81\x19\x96\xe0\x9a\xcby'\xd0\xe1\x1a\xcb1ϳ\x9e\xbd2\xd3}\xa1\x8f \xc9(2A\x9bt~\xa6\xb4r\xa9\xe4\x86o\xc7g\xa7\xf5\xf3\xa9k{R\xb4Q\xc4K\x8e$\x8dS\x80#Nf>Ý\xc5\xe8G\xb9\xe1\x86o\x9d>\x86F\x1b\x8e\xa2\x1c%0\x93\x004\xa1\x0f\xcf\xc45q\xa4\x93,\xc6\xef\x16R\x93\xcc>xI\x8aR!\xfc\x8de\x00\x82\xee\x03En\xe0\xc3\aP\x1a>\x84^ˇ۰\xdbqag\xbcW^\xec\xb9\x10\xf1\x94\x8b\"hWRPA\xa7\xdcTh\xc9\xea\xe0ˀ\xc6@\x15\x96\x8aO/\xbeU\xb0g<I\xeb\xbb\xd3\xcdm\x86\xee\x1a7\x94\x03j\xb4NK\x8a¨5\xa5EƓT.\x13\x86NHj\x92\x908!\xe50zz)\xe8\xffC,O\x01 #@\xceƧ8\xf4){\xd7غ\xc6\x14\xab>\x89ȼ\xd2|\xcbIᲛ9$c-ֵ]\v\x8fd\x1e\x8a\xb3\xfe١\xa5!\xb4<\x90\xa3\xeb\x1c\x0e'\xb4g\xb2\xf4\xf9B7_\xb6W/sq'\x15\xf2\xfc\xba\x9c\xb2Wwp\x06\xcaix_\xf1\xa2ꛎ\x8fA\x15\xc0\xb27\xf4\xb9\xf7\x05l\xe61|\x96\xcf\xc4\ak\x86\xb7o0\x9d\xba\xecp\xaao\xe8\xec\xec\xf3\xeb\xf2\xacj\xc57RΫWB\x87\xb4\xd5r\xe1\xb4\xf6\x95`\x18U\x9b\xab*\x16V\x14\xd8X,\xefߟT9\xe5\xf4\x9fz\x8b\x89\x11yN+)cj\xdf\\\u0086]ZrDv\xbb\x06\xd85\xd7\xf4Ӑ\x88o\x85\xe82\x01\xccq\x01\x11\xc0\xe68\xd3\x00/\xe4ྔ\xff!`$m\xf3\xc8K\xd7st\xe8\x88B\xec\xaeR\xad>\xa3\xfd\xd7E\xd9|\xa9\x16\x1a\xcbi\xeb\xf0\xaa\xbamLf\xac;\x16\vL\xdfӌ\x1d\xed\x9c\xc6\x0e\xe4:}\x05jX\x02\xeeP\x02\x95\xe2\x8c\v\x8aݞd\x06\xc0NSi\x83Xx\xbe\x88=\x9a\xd8\xcf\xcb6˦-\x99Q\xc2\x18;\xa51\xbb\x14\xf2+\x1a'2I\xc37L!Ñ\xa1[`\xb2)\xe4\xe9r\x96\x19`\xa0\x03\x91\x167\x8e\x81\xd6\xd9J\xca\xe6\x955\x1aöS\x88\xf69\xac\n\x9d\xbdv\v\xb05\xa5Q}\xd6~0-\xd0^\x04Wr\x1aS/B\xd2^S\xfebN\xbe\xac\xce\xe0\xe5ˊ\x0e\xf9\xb2\xfa\xad\xbc\xa0tu\xae\xb0bΪ̰\xe0\xd2\xfd\x92\x19\xdfsY\xaa\xfd\xf8~\x9d\x10\xb5a\xb6\x9a\x10\xf4\x99\xd9*\xc6э\x13\xc2\xef\x19\xe5\x97mj\xb6F\x02\x8e\xdf+\xcd\xf4\xad\xa7)\xf6hM.\xce\xe39w\xe6\x98\xe6\x9fp\x9f\x19\x8dq)3\xf5\xdc\x06\xbb\xcc\xd4\xe8e6\x9d\fݽ\x1c\xa6Ĺ,\xcd\xee\xf133\xf7\x0f\x1f\x05.\xd2s\xcb\xdf5a\xae\xeb\x13VJ\xc4\xc8\xe6\x1f-\xa5\xabר\xc9\b\xfeYtЯ\xa0\xbc;\xb1X\x86p\xb2\xbfK\xf6=\xa59\xbcT\xdc\xc4\xcef,\xd7Jn\x1a\xc1\xde;Y\xa6\xb0\xb5í\xe1\x8b\xd5\xd8IN\xb7\x04\xbb'\xe4|;'\xf7\x0e\xdc\xff\xc6/\xba\x83\xf9\xeei\xf8ۜp\"0\xc4\xeb\xfd\xf8pf\x1d\xfa\xf8\x10\xaf\"/QZ*\xad\x0f\xaf\x84\x87\x8a\xc6w\x9ds\xba\x1cv\xdb/+\xc2z\x7fXpUQڣ0\x91\xae\xb5\x7f\xe7\x90K\x8aV\x04\x06\x04A\xfe]j9|\x89\xbe\xed\x1e\xb6\x99m\x1fǊ\x8a\xc9-\xe6*=%)\a\xf09\xc4\xe5\xf9W_\xa0\xef\x99ze\xbdj4\xe89/\x13\xdam/1\x1dq\xeb\xee\xb5r\x01\xff\xfd\xffͯ\x01\x00\x00\xff\xff\xee\xe6t\xbc\x8f$\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYIs[\xb9\x11\xbe\xf3Wt9\a_̧8\xcbT\x8a7\x8bJ\xaaT\x19۬\xa1\xa2;\xf8^\x93\xc4\x18\x0f@\xb0\x90\xa3,\xff=\xd5X\xde\nJ\xa2fb\x92\x17bi|\xdd_\xa3\xbb\x01,\x97\xcb\x05\xd3\xfc\x11\x8d\xe5J\xae\x80i\x8e\xbf8\x94\xf4\xcfV\xdf\xfeb+\xaenN\x1f\x17߸lV\xb0\xf6֩\xf6'\xb4ʛ\x1a\xefp\xcf%w\\\xc9E\x8b\x8e5̱\xd5\x02\x80I\xa9\x1c\xa3fK\x7f\x01j%\x9dQB\xa0Y\x1ePV\xdf\xfc\x0ew\x9e\x8b\x06M\x10\x9e\x97>\xfd\xbe\xfa\xf8C\xf5\xe7\x05\x80d-\xae\x80\xe45\xea,\x85b\x8d\xadN(Ш\x8a\xab\x85\xd5X\x93\xe0\x83Q^\xaf\xa0\xef\x88\x13Ӣ\x11\xf0\x1ds\xec.\xc9\b͂[\xf7\xf7Y\u05cfܺЭ\x857LL\xd6\x0e=\x96˃\x17̌\xfb\x16\x00\xb6V\x1aW\xf0\x85\xb5h5\xab\xb1Y\x00$\x9d\x02\x94%\xb0\xa6\tVbbc\xb8th\xd6J\xf86[g\t\r\xda\xdapMCư\xc0:\xe6\xbc\x05\xeb\xeb#0\v_\xf0|s/7F\x1d\f\xda\b\v\xe0g\xab䆹\xe3\n\xaa8\xbc\xd2Gf1\xf5\x92EV\xb0\r\x1d\xa9\xc9=\x11^\xeb\f\x97\x87\x12\x82\a\xde\"4\xde\x04\n\xc1rY#\xb8#\xb7chgf\t\x9eq\xd8\\\x04\x12\xfaI\x9cu\xac\xd5SD\x83\xa9\x11R\xc3\x1c\x96\x00\xadU\xab\x05:l`\xf7\xe40\xeb\xbdW\xa6en\x05\\\xba\x1f\xfet\x11\x82Nƪ\xc2\xd4;%ǆ\xb9\xa5V\x184G$\xc4\xd2\x01M\xd1:\xca1\xf1k\x808\x12p;\x98\x1f\x91<P3\f\xdb_\x84B.\aj\x0f\xee\x88p\xcb\xeao^\xc3\xd6)\xc3\x0e\b?\xaa:\xd2w>\xa2!\xfa\x10vq\x04y/p\xe2N\x99\"u\x1a\xeb*\x8eM²\xac\t\x7f\xe3\x85~sߪ\r\xb2\xa2o\xe5PS\x85\x11\\ɲ\x83}:\u0adckhD\xa9\x1a\x1cXl\x84\x89[\xd0F\xd5hm\xd1ja\x83U$ uF\x14_\xfa\x86\x99i\xe2\x88\xd3\x1f\x98\xd0G\xf614\xd9\xfa\x88m\b\xa2\xf4Oi\x94\x9f6\xf7\x8f\x7f\u070e\x9aa\xac\xc0\b%\xab\x9d\xa5HA\xdah\xa3\x9c\xaa\x95\x80\x1d\xba3\xa2\f\x81\vZuB\x03Z\xf8\x03\x97\xd9\xd3\xe8\xcbd3\x1c\xd0\xc7l\xf2\xef`\x0eꍝ\x06\x83\xf7\x80\xd2h\x86\xec\x03\x99H\xa3q<G\xe1$\xbbO0\x83։\x1e\xffY\x8e\xfa\x00H\xf5\x18G\xa1\xa1L\x83Q\xad\x14[\xb1I֊\xe4q\v\x06\xb5A\x8b2\xe6\x1ejf\x12\xd4\xeeg\xac]5\x11\xbdECb\xc0\x1e\x95\x17\r%\xa8\x13\x1a\a\x06ku\x90\xfc_\x9dl\vN\x85E\x05sh\x1dmq4\x92\t81\xe1\xf1\x030\xd9,F\x82\xa1eO`\x90\xd6\x04/\a\xf2\xc2\x04;\xc5\xf1\x99\xac\xc8\xe5^\xad\xe0蜶\xab\x9b\x9b\x03w9\xed֪m\xbd\xe4\xee\xe9&\xb0\xc1w\xde)co\x1a<\xa1\xb8\xb1\xfc\xb0d\xa6>r\x87\xb5\xf3\x06o\x98\xe6ˠ\x88$\xf5m\xd56\xbf3)Q\x0fy.8b\xfc\x85\x84y\x05=\x94E)\x90\xb0$*ڤg\x81\x9a\xc8t?\xfdu\xfb\x00\x19I\xdc쑔~\xa8\xbd\xc4\x0fY\x93\xcb=\x9a8ooT\x1b\xe8@\xd9hť\v\x7fj\xc1Q:\xb0~\xd7rGn\xf0O\x8f\xd6\x11uS\xb1\xebP\x9a\xc0\x0e\xc1k\x8a\a\xcdt\xc0\xbd\x845kQ\xac\x99\xc5\xef\xcc\x15\xb1b\x97D«\xd8\x1a\x16\\\xfd'\x0e\x8e\xe6\x1dt\xe4\x8a\xe9\x02\xb5\xc3\b\xb2\xd5X\x13\xabdX\x9a\xc6\xf7<e\x12\n\x03l\x14m\xc6\x16*o}\xfa\x16\xb3\xc9t\xd0K\xeeF\xdfے\xa0\x8cV\x0e\x02y\xcau6eC\x91\x86\x16D\xce\xf2\xa3A\xad,w\xca<\xf5Yr\xea\n\x17Y\xa1_\xcdd\x8d\xe2-\xea\xad\xc3L\xe0\xb2!\x9bc\xe7\xca\x14\x84\xa2\xd4\xe0\xefJ\x1e\x14m\xae\x11\x15p\xef\xa0f\x92|ۢ[\xccdSZ\x93Ŭ\xc6%\xf45%\fk\xc7\xfe\x13\xd5\xdd)%\x90\xc9\xc5D/\xe6\xd8gJ\vk%\xf7\xfc0W|X\xfe^r\x91\x17l:\xb1\xde\xddxI\"\x8a\xbc\x93\xf6\xc32d\xa8ev]\n\xed{~H\x05Ga\xd1=G\xd1\xd8\xea\x82Ƴ\x9d\x94\x15\x0e\xab\xac\x9eGY七\x9ewW\xcaj\x83\xd4\xeb\x14\xb1\xe8m\xa8w\a\xae9\a\tp\xbf\x1fH\xe4\x16\u07bd\x03e\xe0]<\x13\xbd\xfb\x10g{.ܒ\x8f\xf2\xff\x99\v\x91W\xa9\x16W0A\x15\xce\xd7\xed\v\x9aS\xd5\xf3uK\xb4|\xdd^[[\xcdѠ\xf4\xed|\xc1%0\xefT\xa1Yp\xe9\x7f)\xb4\x9f\xb9l\xd4\xd9^\xa3lW\xdfP\x89\xa9\xbc{\v\xe1_'2&\xbc;*\x88\x03\xd7N\xc1\x99\xf1A\x8dѭn?\x14\xe4\xeepOŃA獤p\x80\xc6P\x84\xb6A\xa4\xf2\xae\xbaFS+\x99\xb6G\xe5\xee\xef^\xd0q\xdb\r\xccq\xf7\xfe.S\xfc\x18\xbc.\a\xd2,\x12\n,\x01\xf9^\xaa\"\x9b\x90֯C\x1b\xaa\x9a\xee\xc4\xfd\x16Z\xb6c\x11Y\x19e\xf8\x81K&\xc2)'\b\x1f\xf8쉎\xeda(\xa9\x88\rx}\x01;P8\xa6\xe2e\x87\xd0\xf0\xfd\x1e\rU(46-\xbcy\\\xbf\xb7\x83E\xf8~\xf8\x87\"\x7f˴Ɔ\xce\xe1Dn\xb2\xd5UVr\xcc\x1c\xd0=\x06\xd0/\x98\xe8a04\x9b\x82\xcaR\xd3v\xb545E\x89\xb0y\\\x17*_\xfam\x1e\xe7\b/\xd7\x05\xf9\x10t\x81\xc4\x19\xca\x19[\tO'\xa3(\xe2\x19\v\xd1O\x9f^\xb1\xf2\xe6\xb1Tet\xe6\x00wd\x0exwh\x85\xddSQ&\xe4-\x92\xe8|\x1b\xdeI)w\x01\xf0\xfaY\xc4\xeb)\xe4\xa2H\xa0\x04\xf4k!S\x11\xc3\rN\xce\x16\xf4[\xf6\xec\x17\xfa\xf4\xa9\xd8X\xbf>U\x97W^®TFN\xc6LC\xff\xa4\xbb\x8f\x97ӎq\\\x99\xf4\x0e\xb7\xe4\xe2\x15:Ļ\xa3\xd5\xe2\"\xcf\xc34\x1a/\xf92\xed\xb57!\xe8\xa4+D:\r\x8f\x92n\xb5x\xdd&eu\x8d\xdaas\xfbDY}\xb5x\xd6\xedh\b\x01\x90\xcf_\xaa\xfcC\xf7i\x1f5\xbb\xb6\xc2ΐ\xba\x8b\x9f\xb7$\x80OS!\xe1\xf4o\x9aAZ\x9eÍ\xa5\xd9e\xd0\x00\x0ftn\n\xa7\xd7\xf71\x13Ӵ\x90ߩB\x9d-:\x93\x90/\x13\xe9x\xba\xa4\xf9\xb3\x11\xd2\v\xc1v\x02W\xe0\x8c\xc7k\xecV\xc7{\xd4\xe4\xd4o\xb6\xdcz.fn;\x96\x03F\xbc\xcc\xcb7\xb8ճ\xf2:\x83Eq\xd8\x00\x9eP\x02\x1d>\x19\x17\xd8d\x99\xf6z\xcb\x17@\xdb\xefj\xfc\x16\xade\x87\x976\xd0\xe78\x8a\xa0\xb3<\x05؎\xea\xc6\xec\x8dy\x03\xbf\xb7)<T\xd7\xc0\x90\xbf\xd9&~e\xf5\xfe\f\x96p\xd6|\x01̆ƔbZ\am\x88\xe5\xf5\x87\x87/x.\xb4\xe6\xfdY\xe8ڤM_\xe8\x9a=\xc9\xf4\xdfe:\xd4ϕ\xef\xfb\x8a2\x93\xbf\x16\xfb\xfe\xc6xi\xd2s\x96N\xf8\u07b2ݻ\xab\x81\xa3\x12y\x87\x87\xb7\n\xe9\xdb\x1d\x1a\xa2!\xbc\x86d>\xba\xba\x9fn\x94\a\xac\x15D\xf7\x12\xd2\xc6N/<\x15<\xd0u_\xba\xcfȧ\xa3\x86[-\xd8S\xa7̰B-\b\xefw\xcd\xec\xba\xfa\xda\"\xb5{;*u\x96\x1f\x80Ɵ\xf9S\xce\xf8ӿ\t\xfd\x7fV\xb8X\"\x01\x8c\xdf\xe8\xde\xe2 ۑ\x84\x97RAz3\xbc>\x82\x8f\x97\xf9\x9e\xc1\xbbh\xbdYc@\xde\fd\xa7\xdb\xc7a\x8b\xdfuW\xf2+\xf8\xf7\x7f\x17\xff\x1b\x00\xb1\xea?f~\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcZY\x93\x1b\xb9\r~ׯ@9\x0f\xfbb\xf5dsl\xa5\xf4fk\x92*U\xd6\xf6\x945\x99wv7$q\x87MvxH;9\xfe{\n<Z}P\xe7:\x1e\xe9E$\x01~8\b\x80\xe0\xcc\xe7\xf3\x19k\xf9\vjÕ\\\x00k9\xfejQ\xd2/S\xbc\xfe\xc5\x14\\=\xec\x7f\x9c\xbdrY/`\xe9\x8cU\xcdW4\xca\xe9\n\x1fq\xc3%\xb7\\\xc9Y\x83\x96\xd5̲\xc5\f\x80I\xa9,\xa3aC?\x01*%\xadVB\xa0\x9eoQ\x16\xaf\xae\xc4\xd2qQ\xa3\xf6\xcc\xd3\xd6\xfb\xdf\x17?\xfeT\xfcy\x06 Y\x83\v ~\xae\x15\x8aզأ@\xad\n\xaef\xa6Ŋ\xd8n\xb5r\xed\x02\x8e\x13\x81,n\x19\xe0>2\xcb\xfe\xe19\xf8A\xc1\x8d\xfd\xfbh\xe2gn\xac\x9fl\x85\xd3L\fv\xf5\xe3\x86˭\x13L\xf7gf\x00\xa6R-.\xe03kд\xac\xc2z\x06\x10%\xf1\x10\xe6\xc0\xea\xda놉'ͥE\xbdT\xc25I's\xa8\xd1T\x9a\xb7\xb4\xa4\x0f\b\x8ce\xd6\x190\xae\xda\x013\xf0\x19\x0f\x0f+\xf9\xa4\xd5V\xa3\t\x90\x00~1J>1\xbb[@\x11\x96\x17\xed\x8e\x19\x8c\xb3\xa4\x87\x05\xac\xfdD\x1c\xb2o\x84\xd6X\xcd\xe56\xb7\xff3o\x10j\xa7\xbd\xd9\xc0pY!\xd8\x1d7}`\af\b\x9c\xb6X\x9f\x84\xe1牙\xb1\xaci\xc7xz\xa4\x01P\xcd,\xe6\xe0,U\xd3\n\xb4XC\xf9f1I\xbdQ\xbaav\x01\\ڟ\xfet\x12B\x1bUUx\xd2G%\x87j\xf9H\xa3\xd0\x1b\x0eH\xc8B[\xd4Y\xdd(\xcb\xc4o\x01b\x89\xc1\xc7\x1e}@\xf2L\xc3\xd0\x1f\xbf\b\x85\xdc\r\xd4\x06\xec\x0e\xe1#\xab^]\vk\xab4\xdb\"\xfc\xac\xaa`\xbc\xc3\x0eu4^\x19\x96\x98\x9dr\xa2\x862I\f`\xac\xd2Y+\xb6X\x15\x81*\xf2MlG\xa6\x1c\xee\xf9\x8d\x9d\xac\xd2ȲN\x96\xa2L\xe1Wp%\xf3\x9e\xf6a\x8bWyY_\x9bR\xd5ة\x0e\xfb\x88\xb8\x81V\xab\n\x8d\xc9j̟\xb2\x82\xc8\xe3d\xc0\xf0\xf980QKX\xb1\xff\x03\x13\xed\x8e\xfd\xe8\x87L\xb5\xc3\xc6GO\xfa\xa5Z\x94\x1f\x9eV/\x7f\\\x0f\x86a\b\xbf\x87\x91U\xd6P\xb0 IZ\xad\xac\xaa\x94\x80\x12\xed\x01Q\xfa\xb8\x05\x8dڣ\x86V\xb8-\x97\x06\x98L\xa2Ч\xb7\xe0\x18\xaa\xc9ɽ*h6PGwR-\xea\xbeف\xf4Ӣ\xb6<E\xdf\xf0饕\xde\xe8H\x88\xff\xcc\as\x00$w\xa0\x82\x9a\xf2\v\x06\xa9bl\xc5:\xaa*؍\x1b\xd0\xd8j4(Cơa&A\x95\xbf`e\x8b\x11\xeb5jb\x93\xceC\xa5\xe4\x1e\xb5\x05\x8d\x95\xdaJ\xfe\xaf\x8e\xb7\x01\xab\xfc\xa6\x82Y4\x96\x8e9j\xc9\x04\xec\x99p\xf8~\xa4=\xfa6\xec\r4Ҟ\xe0d\x8f\x9f'0c\x1c\x9f\x94F\xe0r\xa3\x16\xb0\xb3\xb65\x8b\x87\x87-\xb7)\xd9V\xaai\x9c\xe4\xf6\xed\xc1\x1b\x83\x97\xce*m\x1ejܣx0|;g\xba\xdaq\x8b\x95u\x1a\x1fX\xcb\xe7^\x10I⛢\xa9\x7f\xa7czNQ\xe5\x84\x17\x86\xafO\x947\x98\x87\xf2'p\x03,\xb2\n:9Z\x81\x86Hu_\xff\xba~\x86\x84$\x9c\xf2`\x94\xe3Rs\xca>\xa4M.7\xa8\x03\xddF\xabƛ\x03e\xdd*.\xad\xffQ\t\x8e҂qe\xc3-\xb9\xc1?\x1d\x1aK\xa6\x1b\xb3]\xfa\x82\x04J\x04\xd7R(\xa8\xc7\vV\x12\x96\xacA\xb1d\x06\xbf\xb3\xad\xc8*fNF\xb8\xcaZ\xfd2\xeb\xf8\x17\x16\a\xf5\xf6&R\xa5t´\xc7\xf0\xb1n\xb1\"\x9b\x92Z\x89\x88ox\xcc%\x14\x03X/\xd0\f\xb5\x93?\xf6\xf4ɦ\x90\xf1\xa2K\xaeF\x9f\x8f9F\t\xab\xec\xc5\xef\x94\xeab6\x14qi\x86\xe51\xc8G\x1a\x8d\xad2\xdc*\xfdF\x8cCj\x1c\xbb\xc1I\x8bзb\xb2Bq\x8fxKO\t\\֤q\xecܘ\x02P\xe0\xea}]ɭ\xa2\x83\xd53\x04\xac,TL\x92W\x1b\xb4\xb3\tg\xcae2\x93ʸ\x84c5\t\xfd\xaa\xf1\xf8\x17D-\x95\x12\xc8\xe4XV\xc3ג\xb5f\xa7\xec\x05\x81W\x1bH+\x9f\xdfZ$\xdd.\u05eb\xf7\xb0\\\xaf\xd28%\x8e=\xafc\x88\xa7\x88\xa8\x9bSf\x8bv^\xaeW`\"\xf9\xd4H\xd2\t\xc1J\x81\v\xb0\xdaM\x05;\xed\xb0\xf4\xa95ߣ\xce͌${\xf4\v\x93\x17\x062p\xc6W\xab\x1e\xe4\vU\xfa\x98\xa4\\*iQ\xe6lt֫\xe8\x9b$]\nf\xcc\x15Ⱥ-i}\xee\x98$\x86P\xf9\x15v\xc7\xf2\xb8\xc0˱\xf7r\x1c\x89xW\x9b\xc1\x81\xdb\xdd]\x12\x85\x03z\xb5@\xbd\xe5Yy\xe2y\x0f\xe2\xa8M\x96c\x10\xe6\xe9e\xe9\xe5\xbd$\x19\xa5\x9b{$\xdb\x0f\x8c~\x85lC/\xc9I7B\x99e\t\x14+\xca\x10̰\x06\xd7\xce2K\xcec\xa7\xa0\xc35\x8e\xea\x00\xfa\xce\a\xf6\xcaL\x0f\x85\x9e,8\x91\x99R\xd1\xf9\x89\xcaʥ\x92\x1b\xbe\x9d\xeeݿ?\x9f;\xb6gE\x1b(\xfcq\xb8%i\x9c\x12\x1c!\x99\xfb\nw\x9e\xb2\x1f\xb5,6|\x1b\xaf*\x99M7\x1cEmn\x0e@\x17\xf4\xe1A,\xce\v\x91\xcd#\x9dd)\x7fǐګ샗\xf4\xa3\x14y\x8ck\xa72\x00\xac6=\x8e\xdc\xc0\xbbw\xa04\xbc\v\xbd\x96w\xef=k\xea\xe0\xd89\x1f\\/\x0e\\\x88\xb4K1\xbb\xc1Pݕ\x82.t\xca\xd9{t\xf0e\xc4c\xa4\nK\x97O/\xbeUp`\xbcW\xd6w\xbb\x9b\xf7\x19\xbe%n\xa8^\xd7h\x9d\x96\x94\x85Qk*\x8b\x8cg\xa9\x9c\xbdI\xd2t\x96\x9fi\xc9y)\xc7ٓ\xb4N:\xecb_\x9c\x1f\x04\x80\tK\x00\xd7ކ\xd0_\x1e\xba\xc6\xd6=\xa6X\x0fY$\xf0J\xf3-\x97L\xf8.\x82g\u07bbq\xc7X\x17\xbb\x16>\x92\xf9P<\xc5\x0eT\xfbD\x96\x86J\xc0#;:\xceas\x8a\xf6L\xd6Tm\x1c\xe7\xebx\xf4\xcc\x1d\nyzY^\xb2W\xb7q&\x94\x13\x9eÎW\xbb\xa1\xe9\xf8\xb0\xec\x8fX\xd8+J\xba\x7f\xdf\x003\x1f\xc3\xe7P\xe6\n\xe8њ\xf1\xe9\x1bM\xf7]v<54tv\xf6\xe9e9\xbb\"\x06\x86\xa6\xd9bvR\xbd\xc7:6t6\x93\vTNk\x7f\x13\f\xa3\xd4\x00\xe8\x17ʳ\xeb\n@VU\xd8Z\xac?\xbeQ\xe7悥?\f\x16\x13\x10yM+i\xc2\x14\x88\xb4\xd5ز[\xaf\x1c\tn\xd7\x00\xbb\xe7\x98~\x183\xf1\xad\x10]\xf7\x02\xe6\xf4\x02\x11\x82\xcdi\xd0\x00\xcf\xe4\xe0\xfe*\xffC\x88\x91D\xe6#/\x1d\xcfɦ\x13\x0e\xa9\xbbJw\xf59\xd1ߗe\xb3z\xabBc9\xfa\xfaݚ[N\xd9Lu\xc7\xe2\xe1\v=\xcd\xd4\xd1.β\xeb\xf4\x15\xb8a\r\xb8G\tt\x15g\\P\xee\xf6,ͭ\\b\x12s\xde\x0fS\x8f&\xc2\xcb7\xcb.[2\xa3\x04\xf3]\x8dٕ\x90_\xd18a\xbfk\t\x19\xb6\xf4\xe51\x9al\ty\xfe:˨\x85\xa6\x03\x13\xb5\x19\x9d\xb3\xe2>%e\xeb\xca\x06\x8da\xdbK\x11\xedSXE>\xc3\x12\t\xb0\x92ʨ!\xb4\x1fL\f\xb4\xc5\xec\x06-\xca\xcb1\xf5\xa6H:h\xcaߌ\xe4\xcb\xfa\n,_ִɗ\xf5oł\xd25\xd3\xed\xe6\xc0\x9cU\x99a\xc1\xa5\xfb53~\xe0\xb2V\as\x8b\xa8-\xbdP\x9c\x17\x94\xdeUR\x1e\xdd8!<M\x92\xb8+RbiV\"\x05\x8eoUf\xfa\xd6\xd3%x\xb4&\x97\xe7;\x0f9\x9a\xe1z\xcd\x7f\xc6Cf4\xe5\xa5\xcc\xd4SLv\x99\xa9\xc9\xcb\xec\xf13\x8fݽ\xa9\xe8ǹ,\xcf\x18W\xb3s\x7fc<GtN\xcf\x11\xdf=i\xae\xeb\x13\xee\x94H\x99\xcd?ZJה\xa8\xc9\b\xfeY4Y#:\n\xd5\xdd=\x8be\x18\xf7\xe8\xbbb\xdfs*\xe0\x99Z\xfe\xb1\xb3\x99\xaek57\xad`o\x9d,\x97bk\x17\xb7R\x86K\x05nqcK\xb0{B\xceM\xe6߁\x87\x7f\xd3\x17\xdd\xe1\xdf\xf1i\xf8\xff\xb3ÙĐ\x8e\xf7\xea\xf1\x82k\xa4{\xe8\xea1\x1dE^\xd3\x13Ɔ\xf7^\t\xbb`\xc1}\xd7y\xc21\xbd\t\xf4\xba\xed\xc5-n<\xfcǂ{\x9cy=\xe0p\xa1\\\x8b\xff\xe70\x85\b\xb0\xa6`@!\x88n\xa7\xb0\x1c\xbfD\xbf\xef\x1e\xb6\x99\x8d\x8fcՎ\xc9m\xf7\xc8\xdf\xff(Iod\xbe\x86\xb8\xbd\xfe\x1a\ndf\xa7|\xe7ۗ^Y\xaf\x9a\fz\xe4u\x8fw\xec%\xf6G\\ٽV.\xe0\xdf\xff\x9d\xfdo\x00\xee\xe6t\xbc\x8f$\x00\x00"),
 }
 
 var CRDs = crds()
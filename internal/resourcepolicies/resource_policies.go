@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -56,17 +57,56 @@ type VolumePolicy struct {
 	Action     Action         `yaml:"action"`
 }
 
+// IncludeExcludePolicy defined the resources to include in or exclude from the backup. The filters
+// in the backup spec take precedence over the policy.
+type IncludeExcludePolicy struct {
+	// The following fields have the same semantics as those from the spec of backup.
+	// Refer to the comment in the velerov1api.BackupSpec for more details.
+	IncludedClusterScopedResources   []string `yaml:"includedClusterScopedResources"`
+	ExcludedClusterScopedResources   []string `yaml:"excludedClusterScopedResources"`
+	IncludedNamespaceScopedResources []string `yaml:"includedNamespaceScopedResources"`
+	ExcludedNamespaceScopedResources []string `yaml:"excludedNamespaceScopedResources"`
+}
+
+func (p *IncludeExcludePolicy) Validate() error {
+	if err := p.validateIncludeExclude(p.IncludedClusterScopedResources, p.ExcludedClusterScopedResources); err != nil {
+		return err
+	}
+	return p.validateIncludeExclude(p.IncludedNamespaceScopedResources, p.ExcludedNamespaceScopedResources)
+}
+
+func (p *IncludeExcludePolicy) validateIncludeExclude(includesList, excludesList []string) error {
+	includes := sets.NewString(includesList...)
+	excludes := sets.NewString(excludesList...)
+
+	if includes.Has("*") || excludes.Has("*") {
+		return fmt.Errorf("cannot use '*' in includes or excludes filters in the policy")
+	}
+	for _, itm := range excludes.List() {
+		if includes.Has(itm) {
+			return fmt.Errorf("excludes list cannot contain an item in the includes list: %s", itm)
+		}
+	}
+	return nil
+}
+
 // resourcePolicies currently defined slice of volume policies to handle backup
 type ResourcePolicies struct {
-	Version        string         `yaml:"version"`
-	VolumePolicies []VolumePolicy `yaml:"volumePolicies"`
+	Version              string                `yaml:"version"`
+	VolumePolicies       []VolumePolicy        `yaml:"volumePolicies"`
+	IncludeExcludePolicy *IncludeExcludePolicy `yaml:"includeExcludePolicy"`
 	// we may support other resource policies in the future, and they could be added separately
 	// OtherResourcePolicies []OtherResourcePolicy
 }
 
 type Policies struct {
-	version        string
-	volumePolicies []volPolicy
+	version              string
+	volumePolicies       []volPolicy
+	includeExcludePolicy *IncludeExcludePolicy
+	// needsPod and needsNamespace tell whether the volume policies have conditions on the pod
+	// mounting the volume or on the namespace of the volume.
+	needsPod       bool
+	needsNamespace bool
 	// OtherPolicies
 }
 
@@ -78,11 +118,13 @@ func unmarshalResourcePolicies(yamlData *string) (*ResourcePolicies, error) {
 	}
 
 	for _, vp := range resPolicies.VolumePolicies {
-		if raw, ok := vp.Conditions["pvcLabels"]; ok {
-			switch raw.(type) {
-			case map[string]any, map[string]string:
-			default:
-				return nil, fmt.Errorf("pvcLabels must be a map of string to string, got %T", raw)
+		for _, key := range []string{"pvcLabels", "pvcAnnotations", "namespaceLabels", "podLabels"} {
+			if raw, ok := vp.Conditions[key]; ok {
+				switch raw.(type) {
+				case map[string]any, map[string]string:
+				default:
+					return nil, fmt.Errorf("%s must be a map of string to string, got %T", key, raw)
+				}
 			}
 		}
 	}
@@ -109,10 +151,25 @@ func (p *Policies) BuildPolicy(resPolicies *ResourcePolicies) error {
 		if len(con.PVCLabels) > 0 {
 			volP.conditions = append(volP.conditions, &pvcLabelsCondition{labels: con.PVCLabels})
 		}
+		if len(con.PVCAnnotations) > 0 {
+			volP.conditions = append(volP.conditions, &pvcAnnotationsCondition{annotations: con.PVCAnnotations})
+		}
+		if len(con.Namespaces) > 0 {
+			volP.conditions = append(volP.conditions, &namespacesCondition{namespaces: con.Namespaces})
+		}
+		if len(con.NamespaceLabels) > 0 {
+			volP.conditions = append(volP.conditions, &namespaceLabelsCondition{labels: con.NamespaceLabels})
+			p.needsNamespace = true
+		}
+		if len(con.PodLabels) > 0 {
+			volP.conditions = append(volP.conditions, &podLabelsCondition{labels: con.PodLabels})
+			p.needsPod = true
+		}
 		p.volumePolicies = append(p.volumePolicies, volP)
 	}
 
 	// Other resource policies
+	p.includeExcludePolicy = resPolicies.IncludeExcludePolicy
 
 	p.version = resPolicies.Version
 	return nil
@@ -156,6 +213,7 @@ func (p *Policies) GetMatchAction(res any) (*Action, error) {
 	default:
 		return nil, errors.New("failed to convert object")
 	}
+	volume.parsePodsAndNamespace(data.PersistentVolume, data.Pods, data.Namespace)
 
 	return p.match(volume), nil
 }
@@ -175,9 +233,33 @@ func (p *Policies) Validate() error {
 			}
 		}
 	}
+
+	if p.includeExcludePolicy != nil {
+		if err := p.includeExcludePolicy.Validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
 	return nil
 }
 
+// GetIncludeExcludePolicy returns the include/exclude policy, or nil if the resource policies
+// don't have one.
+func (p *Policies) GetIncludeExcludePolicy() *IncludeExcludePolicy {
+	return p.includeExcludePolicy
+}
+
+// NeedsPod returns whether the volume policies have conditions on the pod mounting the volume,
+// in which case the pod must be set in the VolumeFilterData.
+func (p *Policies) NeedsPod() bool {
+	return p.needsPod
+}
+
+// NeedsNamespace returns whether the volume policies have conditions on the namespace of the
+// volume, in which case the namespace must be set in the VolumeFilterData.
+func (p *Policies) NeedsNamespace() bool {
+	return p.needsNamespace
+}
+
 func GetResourcePoliciesFromBackup(
 	backup velerov1api.Backup,
 	client crclient.Client,
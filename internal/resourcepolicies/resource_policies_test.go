@@ -142,6 +142,35 @@ volumePolicies:
       pvcLabels: "production"
     action:
       type: skip
+`,
+			wantErr: true,
+		},
+		{
+			name: "supported format of namespace and workload conditions",
+			yamlData: `version: v1
+volumePolicies:
+  - conditions:
+      namespaces:
+      - prod
+      namespaceLabels:
+        environment: production
+      podLabels:
+        tier: ephemeral
+      pvcAnnotations:
+        backup.example.com/tier: gold
+    action:
+      type: skip
+`,
+			wantErr: false,
+		},
+		{
+			name: "error format of podLabels (not a map)",
+			yamlData: `version: v1
+volumePolicies:
+  - conditions:
+      podLabels: "ephemeral"
+    action:
+      type: skip
 `,
 			wantErr: true,
 		},
@@ -158,6 +187,27 @@ volumePolicies:
 `,
 			wantErr: false,
 		},
+		{
+			name: "supported format includeExcludePolicy",
+			yamlData: `version: v1
+includeExcludePolicy:
+  includedNamespaceScopedResources:
+  - pods
+  - persistentvolumeclaims
+  excludedClusterScopedResources:
+  - storageclasses
+`,
+			wantErr: false,
+		},
+		{
+			name: "unknown key in includeExcludePolicy",
+			yamlData: `version: v1
+includeExcludePolicy:
+  includedResources:
+  - pods
+`,
+			wantErr: true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -355,6 +405,11 @@ volumePolicies:
         environment: production
     action:
       type: skip
+includeExcludePolicy:
+  includedNamespaceScopedResources:
+  - pods
+  excludedClusterScopedResources:
+  - storageclasses
 `,
 		},
 	}
@@ -404,6 +459,10 @@ volumePolicies:
 				},
 			},
 		},
+		IncludeExcludePolicy: &IncludeExcludePolicy{
+			IncludedNamespaceScopedResources: []string{"pods"},
+			ExcludedClusterScopedResources:   []string{"storageclasses"},
+		},
 	}
 
 	p := &Policies{}
@@ -415,6 +474,65 @@ volumePolicies:
 	assert.Equal(t, p, resPolicies)
 }
 
+func TestIncludeExcludePolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  IncludeExcludePolicy
+		wantErr string
+	}{
+		{
+			name: "valid policy",
+			policy: IncludeExcludePolicy{
+				IncludedClusterScopedResources:   []string{"storageclasses"},
+				ExcludedClusterScopedResources:   []string{"persistentvolumes"},
+				IncludedNamespaceScopedResources: []string{"pods", "configmaps"},
+				ExcludedNamespaceScopedResources: []string{"secrets"},
+			},
+		},
+		{
+			name: "wildcard in includes",
+			policy: IncludeExcludePolicy{
+				IncludedNamespaceScopedResources: []string{"*"},
+			},
+			wantErr: "cannot use '*' in includes or excludes filters in the policy",
+		},
+		{
+			name: "wildcard in excludes",
+			policy: IncludeExcludePolicy{
+				ExcludedClusterScopedResources: []string{"*"},
+			},
+			wantErr: "cannot use '*' in includes or excludes filters in the policy",
+		},
+		{
+			name: "item in both includes and excludes",
+			policy: IncludeExcludePolicy{
+				IncludedNamespaceScopedResources: []string{"pods", "secrets"},
+				ExcludedNamespaceScopedResources: []string{"secrets"},
+			},
+			wantErr: "excludes list cannot contain an item in the includes list: secrets",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+
+			p := &Policies{}
+			require.NoError(t, p.BuildPolicy(&ResourcePolicies{Version: "v1", IncludeExcludePolicy: &tc.policy}))
+			assert.Equal(t, &tc.policy, p.GetIncludeExcludePolicy())
+			if tc.wantErr == "" {
+				require.NoError(t, p.Validate())
+			} else {
+				require.EqualError(t, p.Validate(), tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestGetMatchAction(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -422,6 +540,8 @@ func TestGetMatchAction(t *testing.T) {
 		vol      *corev1api.PersistentVolume
 		podVol   *corev1api.Volume
 		pvc      *corev1api.PersistentVolumeClaim
+		pods     []*corev1api.Pod
+		ns       *corev1api.Namespace
 		skip     bool
 	}{
 		{
@@ -958,6 +1078,169 @@ volumePolicies:
 			},
 			skip: false,
 		},
+		{
+			name: "PVC annotations match",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    pvcAnnotations:
+      backup.example.com/tier: gold
+  action:
+    type: skip`,
+			vol: &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+			pvc: &corev1api.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "pvc-1",
+					Annotations: map[string]string{"backup.example.com/tier": "gold", "other": "value"},
+				},
+			},
+			skip: true,
+		},
+		{
+			name: "PVC annotations mismatch",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    pvcAnnotations:
+      backup.example.com/tier: gold
+  action:
+    type: skip`,
+			vol: &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+			pvc: &corev1api.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "pvc-1",
+					Annotations: map[string]string{"backup.example.com/tier": "silver"},
+				},
+			},
+			skip: false,
+		},
+		{
+			name: "namespace of the PVC matches",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    namespaces:
+    - prod-1
+    - prod-2
+  action:
+    type: skip`,
+			vol:  &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+			pvc:  &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "prod-2", Name: "pvc-1"}},
+			skip: true,
+		},
+		{
+			name: "namespace of the PV claim matches",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    namespaces:
+    - prod-1
+  action:
+    type: skip`,
+			vol: &corev1api.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+				Spec:       corev1api.PersistentVolumeSpec{ClaimRef: &corev1api.ObjectReference{Namespace: "prod-1", Name: "pvc-1"}},
+			},
+			skip: true,
+		},
+		{
+			name: "namespace of the pod volume mismatches",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    namespaces:
+    - prod-1
+  action:
+    type: skip`,
+			podVol: &corev1api.Volume{Name: "cache"},
+			pods:   []*corev1api.Pod{{ObjectMeta: metav1.ObjectMeta{Namespace: "dev-1", Name: "pod-1"}}},
+			skip:   false,
+		},
+		{
+			name: "namespace labels match",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    namespaceLabels:
+      environment: production
+  action:
+    type: skip`,
+			vol: &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+			pvc: &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "prod-1", Name: "pvc-1"}},
+			ns: &corev1api.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "prod-1",
+				Labels: map[string]string{"environment": "production"},
+			}},
+			skip: true,
+		},
+		{
+			name: "namespace labels don't match without the namespace",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    namespaceLabels:
+      environment: production
+  action:
+    type: skip`,
+			vol:  &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+			pvc:  &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "prod-1", Name: "pvc-1"}},
+			skip: false,
+		},
+		{
+			name: "pod labels and volume type match",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    podLabels:
+      tier: ephemeral
+    volumeTypes:
+    - emptyDir
+  action:
+    type: skip`,
+			podVol: &corev1api.Volume{Name: "cache", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{}}},
+			pods: []*corev1api.Pod{{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "dev-1",
+				Name:      "pod-1",
+				Labels:    map[string]string{"tier": "ephemeral", "app": "web"},
+			}}},
+			skip: true,
+		},
+		{
+			name: "pod labels mismatch",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    podLabels:
+      tier: ephemeral
+  action:
+    type: skip`,
+			podVol: &corev1api.Volume{Name: "cache", VolumeSource: corev1api.VolumeSource{EmptyDir: &corev1api.EmptyDirVolumeSource{}}},
+			pods: []*corev1api.Pod{{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "dev-1",
+				Name:      "pod-1",
+				Labels:    map[string]string{"tier": "persistent"},
+			}}},
+			skip: false,
+		},
+		{
+			name: "pod labels match any of the pods mounting the volume",
+			yamlData: `version: v1
+volumePolicies:
+- conditions:
+    podLabels:
+      tier: ephemeral
+  action:
+    type: skip`,
+			vol: &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+			pvc: &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "dev-1", Name: "pvc-1"}},
+			pods: []*corev1api.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "dev-1", Name: "pod-1", Labels: map[string]string{"tier": "persistent"}}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "dev-1", Name: "pod-2", Labels: map[string]string{"tier": "ephemeral"}}},
+			},
+			skip: true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -981,6 +1264,8 @@ volumePolicies:
 			if tc.podVol != nil {
 				vfd.PodVolume = tc.podVol
 			}
+			vfd.Pods = tc.pods
+			vfd.Namespace = tc.ns
 
 			action, err := policies.GetMatchAction(vfd)
 			require.NoError(t, err)
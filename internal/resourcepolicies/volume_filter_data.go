@@ -9,13 +9,19 @@ type VolumeFilterData struct {
 	PersistentVolume *corev1api.PersistentVolume
 	PodVolume        *corev1api.Volume
 	PVC              *corev1api.PersistentVolumeClaim
+	// Pods are the pods mounting the volume, they're only needed by the podLabels condition.
+	Pods []*corev1api.Pod
+	// Namespace is the namespace of the volume, it's only needed by the namespaceLabels condition.
+	Namespace *corev1api.Namespace
 }
 
 // NewVolumeFilterData constructs a new VolumeFilterData instance.
-func NewVolumeFilterData(pv *corev1api.PersistentVolume, podVol *corev1api.Volume, pvc *corev1api.PersistentVolumeClaim) VolumeFilterData {
+func NewVolumeFilterData(pv *corev1api.PersistentVolume, podVol *corev1api.Volume, pvc *corev1api.PersistentVolumeClaim, pods []*corev1api.Pod, ns *corev1api.Namespace) VolumeFilterData {
 	return VolumeFilterData{
 		PersistentVolume: pv,
 		PodVolume:        podVol,
 		PVC:              pvc,
+		Pods:             pods,
+		Namespace:        ns,
 	}
 }
@@ -78,7 +78,7 @@ func TestNewVolumeFilterData(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			vfd := NewVolumeFilterData(tc.pv, tc.podVol, tc.pvc)
+			vfd := NewVolumeFilterData(tc.pv, tc.podVol, tc.pvc, nil, nil)
 			if tc.expectedPVName != "" {
 				assert.NotNil(t, vfd.PersistentVolume)
 				assert.Equal(t, tc.expectedPVName, vfd.PersistentVolume.Name)
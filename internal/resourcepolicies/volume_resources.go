@@ -51,6 +51,12 @@ type structuredVolume struct {
 	csi          *csiVolumeSource
 	volumeType   SupportedVolume
 	pvcLabels    map[string]string
+
+	pvcAnnotations  map[string]string
+	namespace       string
+	namespaceLabels map[string]string
+	// podsLabels are the labels of each of the pods mounting the volume.
+	podsLabels []map[string]string
 }
 
 func (s *structuredVolume) parsePV(pv *corev1api.PersistentVolume) {
@@ -73,6 +79,31 @@ func (s *structuredVolume) parsePVC(pvc *corev1api.PersistentVolumeClaim) {
 	if pvc != nil && len(pvc.GetLabels()) > 0 {
 		s.pvcLabels = pvc.Labels
 	}
+	if pvc != nil && len(pvc.GetAnnotations()) > 0 {
+		s.pvcAnnotations = pvc.Annotations
+	}
+	if pvc != nil && pvc.Namespace != "" {
+		s.namespace = pvc.Namespace
+	}
+}
+
+// parsePodsAndNamespace parses the pods mounting the volume and the namespace of the volume. The
+// namespace name is taken from the first of the namespace, the pods, the PVC or the claim of the PV
+// that is known.
+func (s *structuredVolume) parsePodsAndNamespace(pv *corev1api.PersistentVolume, pods []*corev1api.Pod, ns *corev1api.Namespace) {
+	if s.namespace == "" && pv != nil && pv.Spec.ClaimRef != nil {
+		s.namespace = pv.Spec.ClaimRef.Namespace
+	}
+	for _, pod := range pods {
+		s.podsLabels = append(s.podsLabels, pod.Labels)
+		if pod.Namespace != "" {
+			s.namespace = pod.Namespace
+		}
+	}
+	if ns != nil {
+		s.namespaceLabels = ns.Labels
+		s.namespace = ns.Name
+	}
 }
 
 func (s *structuredVolume) parsePodVolume(vol *corev1api.Volume) {
@@ -110,6 +141,71 @@ func (c *pvcLabelsCondition) validate() error {
 	return nil
 }
 
+// pvcAnnotationsCondition defines a condition that matches if the PVC's annotations contain all the provided key/value pairs.
+type pvcAnnotationsCondition struct {
+	annotations map[string]string
+}
+
+func (c *pvcAnnotationsCondition) match(v *structuredVolume) bool {
+	for key, value := range c.annotations {
+		if got, ok := v.pvcAnnotations[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
+
+// namespacesCondition defines a condition that matches if the volume is in one of the provided namespaces.
+type namespacesCondition struct {
+	namespaces []string
+}
+
+func (c *namespacesCondition) match(v *structuredVolume) bool {
+	if len(c.namespaces) == 0 {
+		return true
+	}
+	for _, ns := range c.namespaces {
+		if v.namespace == ns {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceLabelsCondition defines a condition that matches if the labels of the volume's namespace contain all the provided key/value pairs.
+type namespaceLabelsCondition struct {
+	labels map[string]string
+}
+
+func (c *namespaceLabelsCondition) match(v *structuredVolume) bool {
+	if len(c.labels) == 0 {
+		return true
+	}
+	if v.namespaceLabels == nil {
+		return false
+	}
+	return labels.SelectorFromSet(c.labels).Matches(labels.Set(v.namespaceLabels))
+}
+
+// podLabelsCondition defines a condition that matches if the labels of any of the pods mounting the
+// volume contain all the provided key/value pairs.
+type podLabelsCondition struct {
+	labels map[string]string
+}
+
+func (c *podLabelsCondition) match(v *structuredVolume) bool {
+	if len(c.labels) == 0 {
+		return true
+	}
+	selector := labels.SelectorFromSet(c.labels)
+	for _, podLabels := range v.podsLabels {
+		if selector.Matches(labels.Set(podLabels)) {
+			return true
+		}
+	}
+	return false
+}
+
 type capacityCondition struct {
 	capacity capacity
 }
@@ -40,12 +40,16 @@ type nFSVolumeSource struct {
 
 // volumeConditions defined the current format of conditions we parsed
 type volumeConditions struct {
-	Capacity     string            `yaml:"capacity,omitempty"`
-	StorageClass []string          `yaml:"storageClass,omitempty"`
-	NFS          *nFSVolumeSource  `yaml:"nfs,omitempty"`
-	CSI          *csiVolumeSource  `yaml:"csi,omitempty"`
-	VolumeTypes  []SupportedVolume `yaml:"volumeTypes,omitempty"`
-	PVCLabels    map[string]string `yaml:"pvcLabels,omitempty"`
+	Capacity        string            `yaml:"capacity,omitempty"`
+	StorageClass    []string          `yaml:"storageClass,omitempty"`
+	NFS             *nFSVolumeSource  `yaml:"nfs,omitempty"`
+	CSI             *csiVolumeSource  `yaml:"csi,omitempty"`
+	VolumeTypes     []SupportedVolume `yaml:"volumeTypes,omitempty"`
+	PVCLabels       map[string]string `yaml:"pvcLabels,omitempty"`
+	PVCAnnotations  map[string]string `yaml:"pvcAnnotations,omitempty"`
+	Namespaces      []string          `yaml:"namespaces,omitempty"`
+	NamespaceLabels map[string]string `yaml:"namespaceLabels,omitempty"`
+	PodLabels       map[string]string `yaml:"podLabels,omitempty"`
 }
 
 func (c *capacityCondition) validate() error {
@@ -70,6 +74,26 @@ func (c *nfsCondition) validate() error {
 	return nil
 }
 
+func (c *pvcAnnotationsCondition) validate() error {
+	// validate by yamlv3
+	return nil
+}
+
+func (c *namespacesCondition) validate() error {
+	// validate by yamlv3
+	return nil
+}
+
+func (c *namespaceLabelsCondition) validate() error {
+	// validate by yamlv3
+	return nil
+}
+
+func (c *podLabelsCondition) validate() error {
+	// validate by yamlv3
+	return nil
+}
+
 func (c *csiCondition) validate() error {
 	if c != nil && c.csi != nil && c.csi.Driver == "" && c.csi.VolumeAttributes != nil {
 		return errors.New("csi driver should not be empty when filtering by volume attributes")
@@ -1,9 +1,11 @@
 package volumehelper
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -81,7 +83,12 @@ func (v *volumeHelperImpl) ShouldPerformSnapshot(obj runtime.Unstructured, group
 	}
 
 	if v.volumePolicy != nil {
-		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc)
+		pods, ns, err := GetPodsAndNamespace(v.volumePolicy, pv, pvc, nil, v.client)
+		if err != nil {
+			v.logger.WithError(err).Errorf("fail to get pod and namespace for PV %s", pv.Name)
+			return false, err
+		}
+		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc, pods, ns)
 		action, err := v.volumePolicy.GetMatchAction(vfd)
 		if err != nil {
 			v.logger.WithError(err).Errorf("fail to get VolumePolicy match action for PV %s", pv.Name)
@@ -166,7 +173,13 @@ func (v volumeHelperImpl) ShouldPerformFSBackup(volume corev1api.Volume, pod cor
 			return false, err
 		}
 
-		vfd := resourcepolicies.NewVolumeFilterData(pv, podVolume, pvc)
+		pods, ns, err := GetPodsAndNamespace(v.volumePolicy, pv, pvc, &pod, v.client)
+		if err != nil {
+			v.logger.WithError(err).Errorf("fail to get namespace for pod %s", pod.Namespace+"/"+pod.Name)
+			return false, err
+		}
+
+		vfd := resourcepolicies.NewVolumeFilterData(pv, podVolume, pvc, pods, ns)
 		action, err := v.volumePolicy.GetMatchAction(vfd)
 		if err != nil {
 			v.logger.WithError(err).Error("fail to get VolumePolicy match action for volume")
@@ -257,6 +270,58 @@ func (v *volumeHelperImpl) shouldIncludeVolumeInBackup(vol corev1api.Volume) boo
 	return includeVolumeInBackup
 }
 
+// GetPodsAndNamespace returns the pods mounting the volume and the namespace of the volume, when
+// the volume policies have conditions on them. The pod the volume belongs to is the only pod
+// returned when it's known already, as for the fs-backup of the volumes of a pod, otherwise the
+// pods are all the pods mounting the PVC of the volume.
+func GetPodsAndNamespace(
+	policies *resourcepolicies.Policies,
+	pv *corev1api.PersistentVolume,
+	pvc *corev1api.PersistentVolumeClaim,
+	pod *corev1api.Pod,
+	client crclient.Client,
+) ([]*corev1api.Pod, *corev1api.Namespace, error) {
+	var pods []*corev1api.Pod
+	if pod != nil {
+		pods = append(pods, pod)
+	}
+	if policies == nil {
+		return pods, nil, nil
+	}
+
+	var pvcNamespace, pvcName string
+	switch {
+	case pvc != nil && pvc.Name != "":
+		pvcNamespace, pvcName = pvc.Namespace, pvc.Name
+	case pv != nil && pv.Spec.ClaimRef != nil:
+		pvcNamespace, pvcName = pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name
+	}
+
+	if policies.NeedsPod() && pod == nil && pvcName != "" {
+		mounting, err := podvolumeutil.GetPodsUsingPVC(pvcNamespace, pvcName, client)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error getting pods using PVC %s/%s", pvcNamespace, pvcName)
+		}
+		for i := range mounting {
+			pods = append(pods, &mounting[i])
+		}
+	}
+
+	namespace := pvcNamespace
+	if len(pods) > 0 {
+		namespace = pods[0].Namespace
+	}
+	if !policies.NeedsNamespace() || namespace == "" {
+		return pods, nil, nil
+	}
+
+	ns := new(corev1api.Namespace)
+	if err := client.Get(context.TODO(), crclient.ObjectKey{Name: namespace}, ns); err != nil {
+		return nil, nil, errors.Wrapf(err, "error getting namespace %s", namespace)
+	}
+	return pods, ns, nil
+}
+
 func (v *volumeHelperImpl) getVolumeFromResource(resource any) (*corev1api.PersistentVolume, *corev1api.Volume, error) {
 	if pv, ok := resource.(*corev1api.PersistentVolume); ok {
 		return pv, nil, nil
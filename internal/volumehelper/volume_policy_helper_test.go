@@ -738,3 +738,106 @@ func TestGetVolumeFromResource(t *testing.T) {
 		assert.ErrorContains(t, err, "resource is not a PersistentVolume or Volume")
 	})
 }
+
+func TestGetPodsAndNamespace(t *testing.T) {
+	buildPolicies := func(conditions map[string]any) *resourcepolicies.Policies {
+		policies := &resourcepolicies.Policies{}
+		require.NoError(t, policies.BuildPolicy(&resourcepolicies.ResourcePolicies{
+			Version: "v1",
+			VolumePolicies: []resourcepolicies.VolumePolicy{
+				{
+					Conditions: conditions,
+					Action:     resourcepolicies.Action{Type: resourcepolicies.Skip},
+				},
+			},
+		}))
+		return policies
+	}
+
+	ns := builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("environment", "production")).Result()
+	pod := builder.ForPod("ns-1", "pod-1").Labels(map[string]string{"tier": "ephemeral"}).
+		Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result()
+	otherPod := builder.ForPod("ns-1", "pod-2").Result()
+	sharingPod := builder.ForPod("ns-1", "pod-3").Labels(map[string]string{"tier": "persistent"}).
+		Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result()
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
+	pv := builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result()
+	client := velerotest.NewFakeControllerRuntimeClient(t, ns, pod, otherPod, sharingPod)
+
+	testCases := []struct {
+		name       string
+		policies   *resourcepolicies.Policies
+		pv         *corev1api.PersistentVolume
+		pvc        *corev1api.PersistentVolumeClaim
+		pod        *corev1api.Pod
+		expectPods []string
+		expectNS   string
+		expectErr  string
+	}{
+		{
+			name: "nil policies",
+			pvc:  pvc,
+		},
+		{
+			name:     "policies without pod and namespace conditions",
+			policies: buildPolicies(map[string]any{"pvcLabels": map[string]string{"a": "b"}}),
+			pvc:      pvc,
+		},
+		{
+			name:       "pods mounting the PVC are looked up",
+			policies:   buildPolicies(map[string]any{"podLabels": map[string]string{"tier": "ephemeral"}}),
+			pvc:        pvc,
+			expectPods: []string{"pod-1", "pod-3"},
+		},
+		{
+			name:       "pods mounting the PVC of the PV are looked up",
+			policies:   buildPolicies(map[string]any{"podLabels": map[string]string{"tier": "ephemeral"}}),
+			pv:         pv,
+			pvc:        &corev1api.PersistentVolumeClaim{},
+			expectPods: []string{"pod-1", "pod-3"},
+		},
+		{
+			name:       "known pod is the only pod, the other pods mounting the PVC aren't looked up",
+			policies:   buildPolicies(map[string]any{"podLabels": map[string]string{"tier": "ephemeral"}}),
+			pvc:        pvc,
+			pod:        sharingPod,
+			expectPods: []string{"pod-3"},
+		},
+		{
+			name:       "known pod is kept, namespace is looked up",
+			policies:   buildPolicies(map[string]any{"namespaceLabels": map[string]string{"environment": "production"}}),
+			pod:        otherPod,
+			expectPods: []string{"pod-2"},
+			expectNS:   "ns-1",
+		},
+		{
+			name:      "namespace not found",
+			policies:  buildPolicies(map[string]any{"namespaceLabels": map[string]string{"environment": "production"}}),
+			pvc:       builder.ForPersistentVolumeClaim("ns-2", "pvc-2").Result(),
+			expectErr: "error getting namespace ns-2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPods, gotNS, err := GetPodsAndNamespace(tc.policies, tc.pv, tc.pvc, tc.pod, client)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var gotNames []string
+			for _, pod := range gotPods {
+				gotNames = append(gotNames, pod.Name)
+			}
+			assert.Equal(t, tc.expectPods, gotNames)
+			if tc.expectNS == "" {
+				assert.Nil(t, gotNS)
+			} else {
+				require.NotNil(t, gotNS)
+				assert.Equal(t, tc.expectNS, gotNS.Name)
+			}
+		})
+	}
+}
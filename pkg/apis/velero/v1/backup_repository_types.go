@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -47,6 +48,13 @@ type BackupRepositorySpec struct {
 	// +optional
 	// +nullable
 	RepositoryConfig map[string]string `json:"repositoryConfig,omitempty"`
+
+	// RepositoryKeySecret is the key of the Secret holding the password of the repository.
+	// Repositories without one use the password shared by all the repositories. Only kopia
+	// repositories can have one.
+	// +optional
+	// +nullable
+	RepositoryKeySecret *corev1api.SecretKeySelector `json:"repositoryKeySecret,omitempty"`
 }
 
 // BackupRepositoryPhase represents the lifecycle phase of a BackupRepository.
@@ -80,6 +88,17 @@ type BackupRepositoryStatus struct {
 	// RecentMaintenance is status of the recent repo maintenance.
 	// +optional
 	RecentMaintenance []BackupRepositoryMaintenanceStatus `json:"recentMaintenance,omitempty"`
+
+	// PendingRepositoryKeySecret is the key of the Secret holding the password the repository
+	// is being rotated to.
+	// +optional
+	// +nullable
+	PendingRepositoryKeySecret *corev1api.SecretKeySelector `json:"pendingRepositoryKeySecret,omitempty"`
+
+	// LastKeyRotationTime is the last time the password of the repository was rotated.
+	// +optional
+	// +nullable
+	LastKeyRotationTime *metav1.Time `json:"lastKeyRotationTime,omitempty"`
 }
 
 // BackupRepositoryMaintenanceResult represents the result of a repo maintenance.
@@ -184,6 +184,27 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForBackup `json:"uploaderConfig,omitempty"`
+
+	// DryRun specifies whether the backup only reports the items, volumes and hooks
+	// it would process, without uploading any data, taking snapshots or running hooks.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Incremental specifies whether the backup only stores the items whose content changed
+	// since its parent backup, the latest successful backup of the same schedule in the same
+	// storage location, along with the list of the items deleted since then. The backup is a
+	// full backup if there's no parent backup.
+	// +optional
+	// +nullable
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// LegalHold specifies whether the backup is under legal hold. A backup under legal hold
+	// isn't deleted, neither when it expires nor when its deletion is requested, until the
+	// hold is released.
+	// +optional
+	// +nullable
+	LegalHold *bool `json:"legalHold,omitempty"`
 }
 
 // UploaderConfigForBackup defines the configuration for the uploader when doing backup.
@@ -288,7 +309,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;Queued;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting;Deleted
 type BackupPhase string
 
 const (
@@ -296,6 +317,10 @@ const (
 	// yet processed by the BackupController.
 	BackupPhaseNew BackupPhase = "New"
 
+	// BackupPhaseQueued means the backup is waiting for running
+	// backups whose scope overlaps with its scope to finish.
+	BackupPhaseQueued BackupPhase = "Queued"
+
 	// BackupPhaseFailedValidation means the backup has failed
 	// the controller's validations and therefore will not run.
 	BackupPhaseFailedValidation BackupPhase = "FailedValidation"
@@ -349,6 +374,11 @@ const (
 
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
+
+	// BackupPhaseDeleted means the backup is deleted, but its data is kept in the
+	// trash of the backup storage location until its recovery window passes. It can
+	// be undeleted until then.
+	BackupPhaseDeleted BackupPhase = "Deleted"
 )
 
 // BackupStatus captures the current status of a Velero backup.
@@ -371,6 +401,18 @@ type BackupStatus struct {
 	// +optional
 	Phase BackupPhase `json:"phase,omitempty"`
 
+	// QueuePosition is the position of the backup in the queue of
+	// backups waiting to run, starting at 1. It's only set when the
+	// backup is Queued.
+	// +optional
+	QueuePosition int `json:"queuePosition,omitempty"`
+
+	// ParentBackup is the name of the backup an incremental backup is based on.
+	// Restoring the backup requires all the backups of its parent chain. It's
+	// empty for full backups.
+	// +optional
+	ParentBackup string `json:"parentBackup,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable).
 	// +optional
@@ -455,6 +497,87 @@ type BackupStatus struct {
 	// +optional
 	// +nullable
 	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// DryRunResult contains what the backup would have processed if it
+	// was not a dry run. It is only set for dry-run backups.
+	// +optional
+	// +nullable
+	DryRunResult *BackupDryRunResult `json:"dryRunResult,omitempty"`
+
+	// Verification contains the result of the latest verification of the
+	// backup's artifacts in object storage against its checksum manifest.
+	// +optional
+	// +nullable
+	Verification *BackupVerification `json:"verification,omitempty"`
+
+	// SoftDeletion records the deletion of a backup in the Deleted phase.
+	// +optional
+	// +nullable
+	SoftDeletion *BackupSoftDeletion `json:"softDeletion,omitempty"`
+}
+
+// BackupSoftDeletion records the deletion of a backup whose data is kept in the trash
+// of its backup storage location during the recovery window.
+type BackupSoftDeletion struct {
+	// Phase is the phase of the backup before it was deleted, which is restored when
+	// the backup is undeleted.
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// Timestamp records the time the backup was deleted.
+	// +optional
+	// +nullable
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// PurgeTimestamp records the time the recovery window of the backup passes, after
+	// which the backup and all its associated data are deleted.
+	// +optional
+	// +nullable
+	PurgeTimestamp *metav1.Time `json:"purgeTimestamp,omitempty"`
+}
+
+// BackupVerificationPhase is a string representation of the result of a
+// backup verification.
+// +kubebuilder:validation:Enum=Verified;Corrupted;Failed
+type BackupVerificationPhase string
+
+const (
+	// BackupVerificationPhaseVerified means every artifact of the backup
+	// matches its checksum manifest.
+	BackupVerificationPhaseVerified BackupVerificationPhase = "Verified"
+
+	// BackupVerificationPhaseCorrupted means at least one artifact of the
+	// backup is missing or doesn't match its checksum manifest.
+	BackupVerificationPhaseCorrupted BackupVerificationPhase = "Corrupted"
+
+	// BackupVerificationPhaseFailed means the backup couldn't be verified,
+	// e.g. because it has no checksum manifest or the backup storage
+	// location is unavailable.
+	BackupVerificationPhaseFailed BackupVerificationPhase = "Failed"
+)
+
+// BackupVerification records the result of verifying the artifacts of a
+// backup in object storage against its checksum manifest.
+type BackupVerification struct {
+	// Phase is the result of the verification.
+	// +optional
+	Phase BackupVerificationPhase `json:"phase,omitempty"`
+
+	// Timestamp records the time the verification completed.
+	// +optional
+	// +nullable
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// Request is the value of the velero.io/verification-requested
+	// annotation of the backup the verification was requested with.
+	// +optional
+	Request string `json:"request,omitempty"`
+
+	// Errors lists the corrupted artifacts of the backup, or the reason
+	// the backup couldn't be verified.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -485,6 +608,133 @@ type HookStatus struct {
 	HooksFailed int `json:"hooksFailed,omitempty"`
 }
 
+// BackupDryRunResult summarizes the items a dry-run backup would have processed.
+// The items, volumes and hooks are listed in the dry-run report of the backup
+// in the backup storage location.
+type BackupDryRunResult struct {
+	// Resources counts the items that would be backed up by resource.
+	// +optional
+	// +nullable
+	Resources []BackupDryRunResourceCount `json:"resources,omitempty"`
+
+	// Limitations lists what the dry run doesn't account for, so the items and volumes
+	// of the backup can differ from the ones it reports.
+	// +optional
+	// +nullable
+	Limitations []string `json:"limitations,omitempty"`
+}
+
+// BackupDryRunResourceCount is the number of items of a single resource a dry-run
+// backup would back up.
+type BackupDryRunResourceCount struct {
+	// Resource is the group resource of the items.
+	Resource string `json:"resource"`
+
+	// Count is the number of items.
+	Count int `json:"count"`
+}
+
+// BackupDryRunReport lists the items, volumes and hooks a dry-run backup would have
+// processed. It's uploaded to the backup storage location, since it's too large to
+// be kept in the backup's status.
+type BackupDryRunReport struct {
+	// Items lists the items that would be backed up, grouped by namespace and resource.
+	Items []BackupDryRunItems `json:"items,omitempty"`
+
+	// Volumes lists the volumes that would be snapshotted, backed up with
+	// pod volume file system backup, or skipped.
+	Volumes []BackupDryRunVolume `json:"volumes,omitempty"`
+
+	// Hooks lists the hooks that would be executed.
+	Hooks []BackupDryRunHook `json:"hooks,omitempty"`
+}
+
+// BackupDryRunItems lists the items of a single resource within a single namespace.
+type BackupDryRunItems struct {
+	// Namespace is the namespace of the items. It is empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Resource is the group resource of the items.
+	Resource string `json:"resource"`
+
+	// Names is the list of item names.
+	Names []string `json:"names"`
+}
+
+// BackupDryRunVolumeAction is the action a backup would take for a volume.
+// +kubebuilder:validation:Enum=snapshot;fs-backup;skip
+type BackupDryRunVolumeAction string
+
+const (
+	// BackupDryRunVolumeActionSnapshot means the volume would be snapshotted.
+	BackupDryRunVolumeActionSnapshot BackupDryRunVolumeAction = "snapshot"
+
+	// BackupDryRunVolumeActionFSBackup means the volume would be backed up
+	// with pod volume file system backup.
+	BackupDryRunVolumeActionFSBackup BackupDryRunVolumeAction = "fs-backup"
+
+	// BackupDryRunVolumeActionSkip means the volume data would not be backed up.
+	BackupDryRunVolumeActionSkip BackupDryRunVolumeAction = "skip"
+)
+
+// BackupDryRunVolume describes how a backup would handle a volume.
+type BackupDryRunVolume struct {
+	// Namespace is the namespace of the PVC or pod the volume belongs to.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// PVCName is the name of the PVC, if any.
+	// +optional
+	PVCName string `json:"pvcName,omitempty"`
+
+	// PVName is the name of the PV, if any.
+	// +optional
+	PVName string `json:"pvName,omitempty"`
+
+	// PodName is the name of the pod whose volume would be backed up
+	// with pod volume file system backup.
+	// +optional
+	PodName string `json:"podName,omitempty"`
+
+	// PodVolume is the name of the volume in the pod spec.
+	// +optional
+	PodVolume string `json:"podVolume,omitempty"`
+
+	// Action is the action the backup would take for the volume.
+	Action BackupDryRunVolumeAction `json:"action"`
+
+	// Reason explains why the volume would be skipped.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// BackupDryRunHook describes an exec hook a backup would run.
+type BackupDryRunHook struct {
+	// Name is the name of the hook spec. It is empty for hooks defined by pod annotations.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Source is where the hook is defined, either "annotation" or "spec".
+	Source string `json:"source"`
+
+	// Phase is the phase the hook would run in, either "pre" or "post".
+	Phase string `json:"phase"`
+
+	// Namespace is the namespace of the pod.
+	Namespace string `json:"namespace"`
+
+	// PodName is the name of the pod.
+	PodName string `json:"podName"`
+
+	// Container is the container in which the command would be executed.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Command is the command and arguments that would be executed.
+	Command []string `json:"command"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
@@ -54,6 +54,50 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// Encryption configures the client-side encryption of the objects Velero
+	// stores in the location. Objects are stored unencrypted when it's not set.
+	// +optional
+	// +nullable
+	Encryption *BackupStorageLocationEncryption `json:"encryption,omitempty"`
+
+	// MinRetainedSuccessfulBackups is the number of the newest successful backups of the
+	// location that aren't garbage-collected when they expire. Their deletion is deferred until
+	// newer backups of the location succeed. A value of 0 disables the safeguard.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinRetainedSuccessfulBackups int `json:"minRetainedSuccessfulBackups,omitempty"`
+
+	// DeletionGracePeriod defines how long the deleted backups of the location are kept in its
+	// trash, during which they can be undeleted, before they and all their associated data are
+	// purged. A value of 0 deletes the backups immediately.
+	// +optional
+	// +nullable
+	DeletionGracePeriod *metav1.Duration `json:"deletionGracePeriod,omitempty"`
+}
+
+// BackupStorageLocationEncryption configures the client-side encryption of the
+// objects Velero stores in a backup storage location. Objects are encrypted with
+// AES-256-GCM before they're uploaded, and decrypted when they're downloaded.
+type BackupStorageLocationEncryption struct {
+	// KeySecret references the key of a Secret in the Velero namespace holding
+	// the 32-byte key objects are encrypted with, raw or base64-encoded.
+	KeySecret *corev1api.SecretKeySelector `json:"keySecret"`
+
+	// PreviousKeySecrets reference the keys objects were encrypted with before
+	// the key was rotated. They're only used to decrypt the objects encrypted
+	// with them.
+	// +optional
+	// +nullable
+	PreviousKeySecrets []corev1api.SecretKeySelector `json:"previousKeySecrets,omitempty"`
+
+	// AllowUnencryptedObjects accepts the objects that aren't encrypted, e.g.
+	// the ones stored before encryption was enabled, as is. Otherwise they
+	// fail to be read, since anyone with write access to the bucket could
+	// replace an encrypted object with an unencrypted one. Only enable it
+	// while migrating a location to encryption.
+	// +optional
+	AllowUnencryptedObjects bool `json:"allowUnencryptedObjects,omitempty"`
 }
 
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
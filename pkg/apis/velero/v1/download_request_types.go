@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;RestoreVolumeInfo
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;RestoreVolumeInfo;RestoreDryRunReport;BackupDryRunReport
 type DownloadTargetKind string
 
 const (
@@ -43,6 +43,8 @@ const (
 	DownloadTargetKindCSIBackupVolumeSnapshotContents DownloadTargetKind = "CSIBackupVolumeSnapshotContents"
 	DownloadTargetKindBackupVolumeInfos               DownloadTargetKind = "BackupVolumeInfos"
 	DownloadTargetKindRestoreVolumeInfo               DownloadTargetKind = "RestoreVolumeInfo"
+	DownloadTargetKindRestoreDryRunReport             DownloadTargetKind = "RestoreDryRunReport"
+	DownloadTargetKindBackupDryRunReport              DownloadTargetKind = "BackupDryRunReport"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
@@ -56,7 +58,7 @@ type DownloadTarget struct {
 }
 
 // DownloadRequestPhase represents the lifecycle phase of a DownloadRequest.
-// +kubebuilder:validation:Enum=New;Processed
+// +kubebuilder:validation:Enum=New;Processed;Failed
 type DownloadRequestPhase string
 
 const (
@@ -67,6 +69,10 @@ const (
 	// DownloadRequestPhaseProcessed means the DownloadRequest has been processed by the
 	// DownloadRequestController.
 	DownloadRequestPhaseProcessed DownloadRequestPhase = "Processed"
+
+	// DownloadRequestPhaseFailed means the target file can't be made available for
+	// download, the reason is in the message of the DownloadRequest.
+	DownloadRequestPhaseFailed DownloadRequestPhase = "Failed"
 )
 
 // DownloadRequestStatus is the current status of a DownloadRequest.
@@ -83,6 +89,17 @@ type DownloadRequestStatus struct {
 	// +optional
 	// +nullable
 	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// DecryptionKey is the base64-encoded one-time key the target file is
+	// encrypted with for this request, when its backup storage location uses
+	// client-side encryption. The file is decrypted by the server and encrypted
+	// again with this key, so it can be decrypted without the keys of the location.
+	// +optional
+	DecryptionKey string `json:"decryptionKey,omitempty"`
+
+	// Message is the reason the DownloadRequest failed.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
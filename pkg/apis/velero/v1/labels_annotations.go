@@ -80,6 +80,10 @@ const (
 	// timeout value for backup to plugins.
 	ResourceTimeoutAnnotation = "velero.io/resource-timeout"
 
+	// RetentionPeriodsAnnotation is the annotation key used to record the periods of the
+	// retention policy of its schedule a backup is kept for.
+	RetentionPeriodsAnnotation = "velero.io/retention-periods"
+
 	// AsyncOperationIDLabel is the label key used to identify the async operation ID
 	AsyncOperationIDLabel = "velero.io/async-operation-id"
 
@@ -169,4 +173,17 @@ const (
 
 	// Annotation prefix on Backup to override VGS class per CSI driver
 	VolumeGroupSnapshotClassAnnotationBackupPrefix = "velero.io/csi-volumegroupsnapshot-class_"
+
+	// BackupVerificationRequestedAnnotation is the annotation key on a backup
+	// holding a unique value, e.g. a random nonce, identifying the last request
+	// to verify the backup. The backup is verified every time the value changes.
+	BackupVerificationRequestedAnnotation = "velero.io/verification-requested"
+
+	// BackupUndeletionRequestedAnnotation is the annotation key on a deleted backup
+	// holding the RFC3339 time its undeletion was requested at.
+	BackupUndeletionRequestedAnnotation = "velero.io/undeletion-requested"
+
+	// RepositoryKeyRotationRequestedAnnotation is the annotation key on a backup repository
+	// holding the RFC3339 time a rotation of the repository password was requested at.
+	RepositoryKeyRotationRequestedAnnotation = "velero.io/key-rotation-requested"
 )
@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// RestoreRollbackSpec is the specification for which restore to roll back.
+type RestoreRollbackSpec struct {
+	// RestoreName is the name of the restore to roll back.
+	RestoreName string `json:"restoreName"`
+}
+
+// RestoreRollbackPhase represents the lifecycle phase of a RestoreRollback.
+// +kubebuilder:validation:Enum=New;InProgress;Completed;PartiallyFailed;Failed
+type RestoreRollbackPhase string
+
+const (
+	// RestoreRollbackPhaseNew means the RestoreRollback has not been processed yet.
+	RestoreRollbackPhaseNew RestoreRollbackPhase = "New"
+
+	// RestoreRollbackPhaseInProgress means the RestoreRollback is being processed.
+	RestoreRollbackPhaseInProgress RestoreRollbackPhase = "InProgress"
+
+	// RestoreRollbackPhaseCompleted means all the items created by the restore were deleted,
+	// and all the items updated by the restore were reverted.
+	RestoreRollbackPhaseCompleted RestoreRollbackPhase = "Completed"
+
+	// RestoreRollbackPhasePartiallyFailed means the RestoreRollback has run to completion
+	// but encountered 1+ errors deleting or reverting individual items.
+	RestoreRollbackPhasePartiallyFailed RestoreRollbackPhase = "PartiallyFailed"
+
+	// RestoreRollbackPhaseFailed means the RestoreRollback was unable to execute, or was in
+	// progress when the Velero server restarted.
+	RestoreRollbackPhaseFailed RestoreRollbackPhase = "Failed"
+)
+
+// RestoreRollbackStatus is the current status of a RestoreRollback.
+type RestoreRollbackStatus struct {
+	// Phase is the current state of the RestoreRollback.
+	// +optional
+	Phase RestoreRollbackPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the rollback was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the rollback was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// ItemsDeleted is the number of items created by the restore that were deleted.
+	// +optional
+	ItemsDeleted int `json:"itemsDeleted,omitempty"`
+
+	// ItemsReverted is the number of items updated by the restore that were reverted to
+	// their state before the restore.
+	// +optional
+	ItemsReverted int `json:"itemsReverted,omitempty"`
+
+	// ItemsSkipped is the number of items that were left as is, because they no longer
+	// exist, or because they were replaced since the restore.
+	// +optional
+	ItemsSkipped int `json:"itemsSkipped,omitempty"`
+
+	// Errors contains any errors that were encountered during the rollback.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="RestoreName",type="string",JSONPath=".spec.restoreName",description="The name of the restore to be rolled back"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="The status of the rollback"
+
+// RestoreRollback is a request to undo a restore, by deleting the items it created and
+// reverting the items it updated.
+type RestoreRollback struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec RestoreRollbackSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status RestoreRollbackStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// RestoreRollbackList is a list of RestoreRollbacks.
+type RestoreRollbackList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RestoreRollback `json:"items"`
+}
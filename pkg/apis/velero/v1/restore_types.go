@@ -35,6 +35,22 @@ type RestoreSpec struct {
 	// +optional
 	ScheduleName string `json:"scheduleName,omitempty"`
 
+	// RestoreAsOf restores from the most recent backup of the schedule completed at or
+	// before this time, instead of the most recent backup of the schedule. It can only be
+	// specified with ScheduleName.
+	// +optional
+	// +nullable
+	RestoreAsOf *metav1.Time `json:"restoreAsOf,omitempty"`
+
+	// BackupSelectionPolicy specifies which backups of the schedule can be restored from.
+	// Completed, the default, only selects Completed backups. PreferCompleted selects the
+	// most recent Completed backup, falling back to the most recent PartiallyFailed backup
+	// if the schedule has no Completed backup. AllowPartiallyFailed selects the most recent
+	// Completed or PartiallyFailed backup. It can only be specified with ScheduleName.
+	// +optional
+	// +kubebuilder:validation:Enum=Completed;PreferCompleted;AllowPartiallyFailed
+	BackupSelectionPolicy BackupSelectionPolicy `json:"backupSelectionPolicy,omitempty"`
+
 	// IncludedNamespaces is a slice of namespace names to include objects
 	// from. If empty, all namespaces are included.
 	// +optional
@@ -66,6 +82,21 @@ type RestoreSpec struct {
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
+	// NamespaceMappingRules is a list of prefix, suffix and regular
+	// expression rules mapping source namespace names to target
+	// namespace names to restore into. The rules are evaluated in
+	// order for the source namespaces not included in NamespaceMapping,
+	// and the first matching rule is applied.
+	// +optional
+	// +nullable
+	NamespaceMappingRules []NamespaceMappingRule `json:"namespaceMappingRules,omitempty"`
+
+	// NameMapping renames the items of the backup when they're
+	// restored, and rewrites the references to the renamed items.
+	// +optional
+	// +nullable
+	NameMapping *RestoreNameMapping `json:"nameMapping,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when restoring individual objects from the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -115,6 +146,13 @@ type RestoreSpec struct {
 	// +nullable
 	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
 
+	// RecreateResources is the list of resources whose items that exist in the cluster and differ
+	// from the backed-up version are deleted and recreated when ExistingResourcePolicy is recreate.
+	// Resources may be shortcuts (for example 'deploy' for 'deployments') or fully-qualified.
+	// +optional
+	// +nullable
+	RecreateResources []string `json:"recreateResources,omitempty"`
+
 	// ItemOperationTimeout specifies the time used to wait for RestoreItemAction operations
 	// The default value is 4 hour.
 	// +optional
@@ -129,6 +167,51 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForRestore `json:"uploaderConfig,omitempty"`
+
+	// ResourceOrdering specifies how the order in which the resources are restored is computed.
+	// Priority, the default, follows the restore resource priorities of the Velero server.
+	// DependencyGraph computes a topological order from the references between the items
+	// in the backup, falling back to the resource priorities for unrelated resources.
+	// +optional
+	// +kubebuilder:validation:Enum=Priority;DependencyGraph
+	ResourceOrdering RestoreResourceOrdering `json:"resourceOrdering,omitempty"`
+
+	// ItemWorkerCount is the number of items of a resource that are restored in parallel.
+	// If unset, the item worker count of the Velero server is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ItemWorkerCount int `json:"itemWorkerCount,omitempty"`
+
+	// DryRun specifies whether the restore only reports what it would do to the items of
+	// the backup, sending the creates and patches to the API server with server-side dry run,
+	// without restoring volume data or running hooks.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// ReadinessCheck specifies that the restore waits for the restored workloads to become
+	// ready once the restore item operations are complete. A restore whose workloads are
+	// not ready within the timeout is PartiallyFailed.
+	// +optional
+	// +nullable
+	ReadinessCheck *RestoreReadinessCheck `json:"readinessCheck,omitempty"`
+
+	// PreflightPolicy specifies whether the restore checks the target cluster before restoring
+	// any item, and how the failed checks are handled. Warn reports them as warnings of the
+	// restore. Enforce fails the validation of the restore if the API or storage class checks
+	// fail, and reports the other failed checks as warnings. The checks are skipped by default.
+	// +optional
+	// +kubebuilder:validation:Enum=Warn;Enforce
+	PreflightPolicy RestorePreflightPolicy `json:"preflightPolicy,omitempty"`
+}
+
+// RestoreReadinessCheck configures the verification of the readiness of the restored
+// Deployments, StatefulSets, DaemonSets, Jobs and PersistentVolumeClaims.
+type RestoreReadinessCheck struct {
+	// Timeout is how long to wait for the restored workloads to become ready.
+	// The default value is 10 minutes.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
 // UploaderConfigForRestore defines the configuration for the restore.
@@ -145,6 +228,10 @@ type UploaderConfigForRestore struct {
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
+
+	// PreHooks is a list of RestorePreHookSpecs whose hooks run before the items are restored.
+	// +optional
+	PreHooks []RestorePreHookSpec `json:"preHooks,omitempty"`
 }
 
 type RestoreStatusSpec struct {
@@ -251,6 +338,117 @@ type InitRestoreHook struct {
 	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
+// RestorePreHookScope is the scope of the hooks of a RestorePreHookSpec.
+// +kubebuilder:validation:Enum=Restore;Namespace
+type RestorePreHookScope string
+
+const (
+	// RestorePreHookScopeRestore runs the hooks once, before any item of the restore is restored.
+	RestorePreHookScopeRestore RestorePreHookScope = "Restore"
+
+	// RestorePreHookScopeNamespace runs the hooks for each target namespace of the restore that
+	// matches the included and excluded namespaces and exists in the cluster, before the items
+	// of the namespace are restored.
+	RestorePreHookScopeNamespace RestorePreHookScope = "Namespace"
+)
+
+// RestorePreHookSpec defines one or more RestorePreHooks that run before the items of the
+// restore, or of the matching namespaces, are restored.
+type RestorePreHookSpec struct {
+	// Name is the name of this hook.
+	Name string `json:"name"`
+
+	// Scope specifies whether the hooks run once for the restore or for each matching namespace.
+	// The default value is Restore.
+	// +optional
+	Scope RestorePreHookScope `json:"scope,omitempty"`
+
+	// IncludedNamespaces specifies the target namespaces the hooks run for with the Namespace
+	// scope. If empty, they run for all namespaces.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces specifies the target namespaces the hooks don't run for with the
+	// Namespace scope.
+	// +optional
+	// +nullable
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// Hooks is a list of RestorePreHooks, run in order.
+	Hooks []RestorePreHook `json:"hooks"`
+}
+
+// RestorePreHook defines a hook that runs before items are restored.
+type RestorePreHook struct {
+	// Exec defines a hook executing a command in a pod of the cluster.
+	// +optional
+	Exec *ExecRestorePreHook `json:"exec,omitempty"`
+
+	// Job defines a hook running a Job.
+	// +optional
+	Job *JobRestorePreHook `json:"job,omitempty"`
+}
+
+// ExecRestorePreHook is a hook that uses pod exec API to execute a command inside a container in
+// a pod that exists in the cluster before the items are restored.
+type ExecRestorePreHook struct {
+	// Namespace is the namespace of the pod. Defaults to the namespace the hook runs for with
+	// the Namespace scope, required with the Restore scope.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Pod is the name of the pod.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// PodSelector selects the pod by its labels if Pod isn't specified. The command is executed
+	// in the first running pod it matches.
+	// +optional
+	// +nullable
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Container is the container in the pod where the command should be executed. If not specified,
+	// the pod's first container is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Command is the command and arguments to execute.
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// OnError specifies how Velero should behave if it encounters an error executing this hook.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the hook to complete before
+	// considering the execution a failure.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// JobRestorePreHook is a hook that runs a Job and waits for it to complete before the items are
+// restored.
+type JobRestorePreHook struct {
+	// Namespace is the namespace the Job is created in, overriding the namespace of its manifest.
+	// Defaults to the namespace the hook runs for with the Namespace scope.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// Manifest is the manifest of the Job.
+	Manifest runtime.RawExtension `json:"manifest"`
+
+	// OnError specifies how Velero should behave if it encounters an error running this hook.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the Job to complete before
+	// considering the execution a failure. The default value is 10 minutes.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
 // +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed;Finalizing;FinalizingPartiallyFailed
@@ -313,6 +511,11 @@ const (
 	// PolicyTypeUpdate means velero will try to attempt a patch on
 	// the changed resources.
 	PolicyTypeUpdate PolicyType = "update"
+
+	// PolicyTypeRecreate means velero will delete the changed resources
+	// of the RecreateResources, wait for them to be gone, and create them
+	// from the backup.
+	PolicyTypeRecreate PolicyType = "recreate"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -380,6 +583,150 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// DryRunResult summarizes what the restore would have done if it
+	// was not a dry run. It is only set for dry-run restores, the report
+	// of the items is stored in object storage.
+	// +optional
+	// +nullable
+	DryRunResult *RestoreDryRunResult `json:"dryRunResult,omitempty"`
+
+	// BackupResolution records how the backup the restore is from was selected among the
+	// backups of the schedule. It is only set for restores from a schedule.
+	// +optional
+	// +nullable
+	BackupResolution *RestoreBackupResolution `json:"backupResolution,omitempty"`
+
+	// Readiness records the readiness of the restored workloads. It is only set for
+	// restores with a readiness check.
+	// +optional
+	// +nullable
+	Readiness *RestoreReadiness `json:"readiness,omitempty"`
+	// OriginalItemsNotSaved is the number of items updated by the restore whose
+	// in-cluster version before the restore couldn't be saved to object storage.
+	// The rollback of the restore can't revert them.
+	// +optional
+	OriginalItemsNotSaved int `json:"originalItemsNotSaved,omitempty"`
+}
+
+// RestoreReadiness records the readiness of the workloads created or updated by a restore.
+type RestoreReadiness struct {
+	// WorkloadsReady is the number of restored workloads that became ready.
+	// +optional
+	WorkloadsReady int `json:"workloadsReady,omitempty"`
+
+	// WorkloadsNotReady is the number of restored workloads that didn't become ready
+	// within the timeout.
+	// +optional
+	WorkloadsNotReady int `json:"workloadsNotReady,omitempty"`
+
+	// Workloads is the readiness of each restored workload.
+	// +optional
+	// +nullable
+	Workloads []RestoreWorkloadReadiness `json:"workloads,omitempty"`
+}
+
+// RestoreWorkloadReadiness is the readiness of a restored workload.
+type RestoreWorkloadReadiness struct {
+	// Kind is the kind of the workload, such as Deployment.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the workload.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the workload.
+	Name string `json:"name"`
+
+	// Ready is whether the workload became ready.
+	Ready bool `json:"ready"`
+
+	// Message describes why the workload is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RestoreBackupResolution records the backup of a schedule selected for a restore.
+type RestoreBackupResolution struct {
+	// BackupName is the name of the selected backup.
+	BackupName string `json:"backupName"`
+
+	// BackupPhase is the phase of the selected backup.
+	// +optional
+	BackupPhase BackupPhase `json:"backupPhase,omitempty"`
+
+	// BackupStartTimestamp is the time the selected backup was started.
+	// +optional
+	// +nullable
+	BackupStartTimestamp *metav1.Time `json:"backupStartTimestamp,omitempty"`
+
+	// SelectionPolicy is the backup selection policy the backup was selected with.
+	// +optional
+	SelectionPolicy BackupSelectionPolicy `json:"selectionPolicy,omitempty"`
+
+	// RestoreAsOf is the time the backup was selected as of, if any.
+	// +optional
+	// +nullable
+	RestoreAsOf *metav1.Time `json:"restoreAsOf,omitempty"`
+}
+
+// RestoreDryRunResult counts the items of a dry-run restore by result.
+type RestoreDryRunResult struct {
+	// WouldCreate is the number of items that would be created.
+	// +optional
+	WouldCreate int `json:"wouldCreate,omitempty"`
+
+	// WouldUpdate is the number of existing items that would be updated.
+	// +optional
+	WouldUpdate int `json:"wouldUpdate,omitempty"`
+
+	// ExistsUnchanged is the number of items that already exist in the
+	// cluster and are the same as the backed up version.
+	// +optional
+	ExistsUnchanged int `json:"existsUnchanged,omitempty"`
+
+	// ExistsDiffers is the number of items that already exist in the cluster,
+	// are different from the backed up version and would be left as is.
+	// +optional
+	ExistsDiffers int `json:"existsDiffers,omitempty"`
+
+	// Skipped is the number of items that would be skipped.
+	// +optional
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// RestoreDryRunItemResult is what a dry-run restore would have done to an item.
+type RestoreDryRunItemResult string
+
+const (
+	RestoreDryRunItemResultWouldCreate     RestoreDryRunItemResult = "WouldCreate"
+	RestoreDryRunItemResultWouldUpdate     RestoreDryRunItemResult = "WouldUpdate"
+	RestoreDryRunItemResultExistsUnchanged RestoreDryRunItemResult = "ExistsUnchanged"
+	RestoreDryRunItemResultExistsDiffers   RestoreDryRunItemResult = "ExistsDiffers"
+	RestoreDryRunItemResultSkipped         RestoreDryRunItemResult = "Skipped"
+)
+
+// RestoreDryRunItem is an entry of the report of a dry-run restore.
+type RestoreDryRunItem struct {
+	// Resource is the API version and kind of the item, e.g. apps/v1/Deployment.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item would be restored into. It is
+	// empty for cluster-scoped items.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Result is what the restore would have done to the item.
+	Result RestoreDryRunItemResult `json:"result"`
+
+	// Diff is the JSON merge patch from the in-cluster version of the item
+	// to the restored version. It is only set for the items that would be
+	// updated or that differ from the backed up version.
+	// +optional
+	Diff string `json:"diff,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
@@ -431,3 +778,94 @@ type RestoreList struct {
 
 // PolicyType helps specify the ExistingResourcePolicy
 type PolicyType string
+
+// NamespaceMappingRule maps the source namespace names matching a pattern to target namespace names.
+type NamespaceMappingRule struct {
+	// Source is the pattern the source namespace names are matched against. A pattern
+	// starting with "^" is a regular expression that must match the whole namespace name,
+	// such as "^(.*)$". Otherwise, the pattern is a namespace name with a single "*"
+	// wildcard, such as "team-*" for a prefix or "*-prod" for a suffix.
+	Source string `json:"source"`
+
+	// Target is the name of the namespace to restore into. For a wildcard pattern, the "*"
+	// characters of Target are replaced with the part of the source namespace name matched
+	// by the wildcard, such as "team-*-dr". For a regular expression, Target may reference
+	// its capture groups, such as "$1-restored".
+	Target string `json:"target"`
+}
+
+// RestoreNameMapping renames the items of a backup when they're restored.
+type RestoreNameMapping struct {
+	// IncludedResources is a slice of resource names whose items are
+	// renamed. If empty, the items of all the namespaced resources are
+	// renamed. The namespaces are never renamed, they're mapped with
+	// the namespace mappings.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// Names is a map of source item names to target item names. It
+	// takes precedence over Prefix and Suffix.
+	// +optional
+	Names map[string]string `json:"names,omitempty"`
+
+	// Prefix is prepended to the names of the items not included in Names.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Suffix is appended to the names of the items not included in Names.
+	// +optional
+	Suffix string `json:"suffix,omitempty"`
+
+	// LabelKeys is a slice of label keys whose values are renamed like
+	// the item names, in the labels, the pod template labels and the
+	// selectors of the items, so that the renamed Services and workloads
+	// select the renamed pods.
+	// +optional
+	// +nullable
+	LabelKeys []string `json:"labelKeys,omitempty"`
+}
+
+// BackupSelectionPolicy is the way the backup of a schedule a restore is from is selected.
+type BackupSelectionPolicy string
+
+const (
+	// BackupSelectionPolicyCompleted selects the most recent Completed backup.
+	BackupSelectionPolicyCompleted BackupSelectionPolicy = "Completed"
+
+	// BackupSelectionPolicyPreferCompleted selects the most recent Completed backup, or the
+	// most recent PartiallyFailed backup if there is no Completed backup.
+	BackupSelectionPolicyPreferCompleted BackupSelectionPolicy = "PreferCompleted"
+
+	// BackupSelectionPolicyAllowPartiallyFailed selects the most recent Completed or
+	// PartiallyFailed backup.
+	BackupSelectionPolicyAllowPartiallyFailed BackupSelectionPolicy = "AllowPartiallyFailed"
+)
+
+// RestoreResourceOrdering is the way the order in which the resources are restored is computed.
+type RestoreResourceOrdering string
+
+const (
+	// RestoreResourceOrderingPriority restores the resources following the restore
+	// resource priorities of the Velero server.
+	RestoreResourceOrderingPriority RestoreResourceOrdering = "Priority"
+
+	// RestoreResourceOrderingDependencyGraph restores the resources in a topological order
+	// computed from the owner references and the well-known references between the items
+	// in the backup, e.g. CRD to custom resource, namespace to namespaced item, PVC to PV,
+	// ServiceAccount to pod and APIService to its backing Service.
+	RestoreResourceOrderingDependencyGraph RestoreResourceOrdering = "DependencyGraph"
+)
+
+// RestorePreflightPolicy is the way the failed preflight checks of a restore are handled.
+type RestorePreflightPolicy string
+
+const (
+	// RestorePreflightPolicyWarn reports the failed preflight checks as warnings of the restore.
+	RestorePreflightPolicyWarn RestorePreflightPolicy = "Warn"
+
+	// RestorePreflightPolicyEnforce fails the validation of the restore, before any item is
+	// restored, if the backup has items whose API isn't served by the cluster or PVCs whose
+	// storage class doesn't exist. The resource quota and node checks are reported as warnings.
+	RestorePreflightPolicyEnforce RestorePreflightPolicy = "Enforce"
+)
@@ -49,6 +49,92 @@ type ScheduleSpec struct {
 	// If empty, will follow server configuration (default: false).
 	// +optional
 	SkipImmediately *bool `json:"skipImmediately,omitempty"`
+
+	// Retention is the grandfather-father-son retention policy of the completed backups of the
+	// schedule. When set, the backups it keeps aren't garbage-collected when they expire, and the
+	// other completed backups expire regardless of their TTL.
+	// +optional
+	// +nullable
+	Retention *ScheduleRetentionPolicy `json:"retention,omitempty"`
+
+	// MinRetainedSuccessfulBackups is the number of the newest successful backups of the
+	// schedule that aren't garbage-collected when they expire. Their deletion is deferred until
+	// newer backups of the schedule succeed. A value of 0 disables the safeguard.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinRetainedSuccessfulBackups int `json:"minRetainedSuccessfulBackups,omitempty"`
+
+	// AllowedWindows are the windows of time the backups of the schedule can start in. When
+	// set, the runs that are due outside of them are handled according to the BlackoutPolicy.
+	// +optional
+	AllowedWindows []ScheduleWindow `json:"allowedWindows,omitempty"`
+
+	// BlackoutWindows are the windows of time the backups of the schedule can't start in, e.g.
+	// business hours or change freezes. They take precedence over the AllowedWindows.
+	// +optional
+	BlackoutWindows []ScheduleWindow `json:"blackoutWindows,omitempty"`
+
+	// BlackoutPolicy is how the runs that are due in a blackout window, or outside of the
+	// allowed windows, are handled. Skip skips them, and the schedule runs at the next time
+	// of its Cron expression. Defer defers them until the windows allow the backup to start.
+	// Default is Skip.
+	// +optional
+	BlackoutPolicy ScheduleBlackoutPolicy `json:"blackoutPolicy,omitempty"`
+}
+
+// ScheduleWindow is a recurring window of time, which starts at the times of a Cron expression
+// and lasts for a duration.
+type ScheduleWindow struct {
+	// Schedule is a Cron expression defining when the window starts. The time zone of the
+	// window can be specified with the CRON_TZ=<timezone> prefix, as for the schedule.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window lasts.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ScheduleBlackoutPolicy is how a schedule handles the runs its windows don't allow.
+// +kubebuilder:validation:Enum=Skip;Defer
+type ScheduleBlackoutPolicy string
+
+const (
+	// ScheduleBlackoutPolicySkip skips the run, the schedule runs at the next time of its
+	// Cron expression.
+	ScheduleBlackoutPolicySkip ScheduleBlackoutPolicy = "Skip"
+
+	// ScheduleBlackoutPolicyDefer defers the run until the windows of the schedule allow it.
+	ScheduleBlackoutPolicyDefer ScheduleBlackoutPolicy = "Defer"
+)
+
+// ScheduleRetentionPolicy keeps the newest completed backup of each of the latest hours, days,
+// weeks, months and years that have a completed backup. The periods are in the time zone of the
+// schedule, set with CRON_TZ or TZ, else in the time zone of the server, and the weeks start on
+// Monday. A backup kept for several periods is only kept once.
+type ScheduleRetentionPolicy struct {
+	// Hourly is the number of hours whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Hourly int `json:"hourly,omitempty"`
+
+	// Daily is the number of days whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Daily int `json:"daily,omitempty"`
+
+	// Weekly is the number of weeks whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Weekly int `json:"weekly,omitempty"`
+
+	// Monthly is the number of months whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Monthly int `json:"monthly,omitempty"`
+
+	// Yearly is the number of years whose newest backup is kept.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Yearly int `json:"yearly,omitempty"`
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
@@ -87,12 +173,40 @@ type ScheduleStatus struct {
 	// +nullable
 	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
 
+	// LastBlackout is the last run of the schedule its windows didn't allow to start,
+	// and how it was handled.
+	// +optional
+	// +nullable
+	LastBlackout *ScheduleBlackout `json:"lastBlackout,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
 	ValidationErrors []string `json:"validationErrors,omitempty"`
 }
 
+// ScheduleBlackout records a run of a schedule that was due in a blackout window, or outside
+// of the allowed windows.
+type ScheduleBlackout struct {
+	// Policy is how the run was handled, Skip or Defer.
+	// +optional
+	Policy ScheduleBlackoutPolicy `json:"policy,omitempty"`
+
+	// ScheduledTime is the time the run was due at.
+	// +optional
+	// +nullable
+	ScheduledTime *metav1.Time `json:"scheduledTime,omitempty"`
+
+	// Timestamp is the time the run was skipped or first deferred at.
+	// +optional
+	// +nullable
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+
+	// Reason is the window that didn't allow the run.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
 // TODO(2.0) After converting all resources to use the runtime-controller client, the genclient and k8s:deepcopy markers will no longer be needed and should be removed.
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
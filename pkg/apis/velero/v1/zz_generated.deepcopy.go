@@ -37,6 +37,135 @@ func (in *Backup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunHook) DeepCopyInto(out *BackupDryRunHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunHook.
+func (in *BackupDryRunHook) DeepCopy() *BackupDryRunHook {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunItems) DeepCopyInto(out *BackupDryRunItems) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunItems.
+func (in *BackupDryRunItems) DeepCopy() *BackupDryRunItems {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunItems)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunReport) DeepCopyInto(out *BackupDryRunReport) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupDryRunItems, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]BackupDryRunVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]BackupDryRunHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunReport.
+func (in *BackupDryRunReport) DeepCopy() *BackupDryRunReport {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunResourceCount) DeepCopyInto(out *BackupDryRunResourceCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunResourceCount.
+func (in *BackupDryRunResourceCount) DeepCopy() *BackupDryRunResourceCount {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunResourceCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunResult) DeepCopyInto(out *BackupDryRunResult) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]BackupDryRunResourceCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.Limitations != nil {
+		in, out := &in.Limitations, &out.Limitations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunResult.
+func (in *BackupDryRunResult) DeepCopy() *BackupDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupDryRunVolume) DeepCopyInto(out *BackupDryRunVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDryRunVolume.
+func (in *BackupDryRunVolume) DeepCopy() *BackupDryRunVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupDryRunVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 	*out = *in
@@ -199,6 +328,11 @@ func (in *BackupRepositorySpec) DeepCopyInto(out *BackupRepositorySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.RepositoryKeySecret != nil {
+		in, out := &in.RepositoryKeySecret, &out.RepositoryKeySecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositorySpec.
@@ -225,6 +359,15 @@ func (in *BackupRepositoryStatus) DeepCopyInto(out *BackupRepositoryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PendingRepositoryKeySecret != nil {
+		in, out := &in.PendingRepositoryKeySecret, &out.PendingRepositoryKeySecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastKeyRotationTime != nil {
+		in, out := &in.LastKeyRotationTime, &out.LastKeyRotationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRepositoryStatus.
@@ -311,6 +454,29 @@ func (in *BackupResourceHookSpec) DeepCopy() *BackupResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSoftDeletion) DeepCopyInto(out *BackupSoftDeletion) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.PurgeTimestamp != nil {
+		in, out := &in.PurgeTimestamp, &out.PurgeTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSoftDeletion.
+func (in *BackupSoftDeletion) DeepCopy() *BackupSoftDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSoftDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -422,6 +588,21 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(UploaderConfigForBackup)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Incremental != nil {
+		in, out := &in.Incremental, &out.Incremental
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LegalHold != nil {
+		in, out := &in.LegalHold, &out.LegalHold
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -464,6 +645,21 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		*out = new(HookStatus)
 		**out = **in
 	}
+	if in.DryRunResult != nil {
+		in, out := &in.DryRunResult, &out.DryRunResult
+		*out = new(BackupDryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoftDeletion != nil {
+		in, out := &in.SoftDeletion, &out.SoftDeletion
+		*out = new(BackupSoftDeletion)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -503,6 +699,33 @@ func (in *BackupStorageLocation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationEncryption) DeepCopyInto(out *BackupStorageLocationEncryption) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousKeySecrets != nil {
+		in, out := &in.PreviousKeySecrets, &out.PreviousKeySecrets
+		*out = make([]corev1.SecretKeySelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationEncryption.
+func (in *BackupStorageLocationEncryption) DeepCopy() *BackupStorageLocationEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStorageLocationList) DeepCopyInto(out *BackupStorageLocationList) {
 	*out = *in
@@ -561,6 +784,16 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BackupStorageLocationEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionGracePeriod != nil {
+		in, out := &in.DeletionGracePeriod, &out.DeletionGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationSpec.
@@ -596,6 +829,30 @@ func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerification) DeepCopyInto(out *BackupVerification) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerification.
+func (in *BackupVerification) DeepCopy() *BackupVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
 	*out = *in
@@ -847,6 +1104,32 @@ func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecRestorePreHook) DeepCopyInto(out *ExecRestorePreHook) {
+	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecRestorePreHook.
+func (in *ExecRestorePreHook) DeepCopy() *ExecRestorePreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecRestorePreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HookStatus) DeepCopyInto(out *HookStatus) {
 	*out = *in
@@ -885,6 +1168,23 @@ func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobRestorePreHook) DeepCopyInto(out *JobRestorePreHook) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobRestorePreHook.
+func (in *JobRestorePreHook) DeepCopy() *JobRestorePreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(JobRestorePreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -907,6 +1207,21 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceMappingRule) DeepCopyInto(out *NamespaceMappingRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMappingRule.
+func (in *NamespaceMappingRule) DeepCopy() *NamespaceMappingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceMappingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
@@ -1196,6 +1511,59 @@ func (in *Restore) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreBackupResolution) DeepCopyInto(out *RestoreBackupResolution) {
+	*out = *in
+	if in.BackupStartTimestamp != nil {
+		in, out := &in.BackupStartTimestamp, &out.BackupStartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.RestoreAsOf != nil {
+		in, out := &in.RestoreAsOf, &out.RestoreAsOf
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreBackupResolution.
+func (in *RestoreBackupResolution) DeepCopy() *RestoreBackupResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreBackupResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreDryRunItem) DeepCopyInto(out *RestoreDryRunItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreDryRunItem.
+func (in *RestoreDryRunItem) DeepCopy() *RestoreDryRunItem {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreDryRunItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreDryRunResult) DeepCopyInto(out *RestoreDryRunResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreDryRunResult.
+func (in *RestoreDryRunResult) DeepCopy() *RestoreDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreHooks) DeepCopyInto(out *RestoreHooks) {
 	*out = *in
@@ -1206,6 +1574,13 @@ func (in *RestoreHooks) DeepCopyInto(out *RestoreHooks) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PreHooks != nil {
+		in, out := &in.PreHooks, &out.PreHooks
+		*out = make([]RestorePreHookSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreHooks.
@@ -1250,6 +1625,95 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreNameMapping) DeepCopyInto(out *RestoreNameMapping) {
+	*out = *in
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LabelKeys != nil {
+		in, out := &in.LabelKeys, &out.LabelKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreNameMapping.
+func (in *RestoreNameMapping) DeepCopy() *RestoreNameMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreNameMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestorePreHook) DeepCopyInto(out *RestorePreHook) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecRestorePreHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobRestorePreHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestorePreHook.
+func (in *RestorePreHook) DeepCopy() *RestorePreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(RestorePreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestorePreHookSpec) DeepCopyInto(out *RestorePreHookSpec) {
+	*out = *in
+	if in.IncludedNamespaces != nil {
+		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]RestorePreHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestorePreHookSpec.
+func (in *RestorePreHookSpec) DeepCopy() *RestorePreHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestorePreHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreProgress) DeepCopyInto(out *RestoreProgress) {
 	*out = *in
@@ -1265,6 +1729,42 @@ func (in *RestoreProgress) DeepCopy() *RestoreProgress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreReadiness) DeepCopyInto(out *RestoreReadiness) {
+	*out = *in
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]RestoreWorkloadReadiness, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreReadiness.
+func (in *RestoreReadiness) DeepCopy() *RestoreReadiness {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreReadiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreReadinessCheck) DeepCopyInto(out *RestoreReadinessCheck) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreReadinessCheck.
+func (in *RestoreReadinessCheck) DeepCopy() *RestoreReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
 	*out = *in
@@ -1337,9 +1837,115 @@ func (in *RestoreResourceHookSpec) DeepCopy() *RestoreResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreRollback) DeepCopyInto(out *RestoreRollback) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRollback.
+func (in *RestoreRollback) DeepCopy() *RestoreRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreRollback) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreRollbackList) DeepCopyInto(out *RestoreRollbackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RestoreRollback, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRollbackList.
+func (in *RestoreRollbackList) DeepCopy() *RestoreRollbackList {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreRollbackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreRollbackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreRollbackSpec) DeepCopyInto(out *RestoreRollbackSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRollbackSpec.
+func (in *RestoreRollbackSpec) DeepCopy() *RestoreRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreRollbackStatus) DeepCopyInto(out *RestoreRollbackStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRollbackStatus.
+func (in *RestoreRollbackStatus) DeepCopy() *RestoreRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
+	if in.RestoreAsOf != nil {
+		in, out := &in.RestoreAsOf, &out.RestoreAsOf
+		*out = (*in).DeepCopy()
+	}
 	if in.IncludedNamespaces != nil {
 		in, out := &in.IncludedNamespaces, &out.IncludedNamespaces
 		*out = make([]string, len(*in))
@@ -1367,6 +1973,16 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.NamespaceMappingRules != nil {
+		in, out := &in.NamespaceMappingRules, &out.NamespaceMappingRules
+		*out = make([]NamespaceMappingRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.NameMapping != nil {
+		in, out := &in.NameMapping, &out.NameMapping
+		*out = new(RestoreNameMapping)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(metav1.LabelSelector)
@@ -1404,6 +2020,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.RecreateResources != nil {
+		in, out := &in.RecreateResources, &out.RecreateResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.ItemOperationTimeout = in.ItemOperationTimeout
 	if in.ResourceModifier != nil {
 		in, out := &in.ResourceModifier, &out.ResourceModifier
@@ -1415,6 +2036,16 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(UploaderConfigForRestore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadinessCheck != nil {
+		in, out := &in.ReadinessCheck, &out.ReadinessCheck
+		*out = new(RestoreReadinessCheck)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
@@ -1453,6 +2084,21 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = new(HookStatus)
 		**out = **in
 	}
+	if in.DryRunResult != nil {
+		in, out := &in.DryRunResult, &out.DryRunResult
+		*out = new(RestoreDryRunResult)
+		**out = **in
+	}
+	if in.BackupResolution != nil {
+		in, out := &in.BackupResolution, &out.BackupResolution
+		*out = new(RestoreBackupResolution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(RestoreReadiness)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
@@ -1490,6 +2136,21 @@ func (in *RestoreStatusSpec) DeepCopy() *RestoreStatusSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreWorkloadReadiness) DeepCopyInto(out *RestoreWorkloadReadiness) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreWorkloadReadiness.
+func (in *RestoreWorkloadReadiness) DeepCopy() *RestoreWorkloadReadiness {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreWorkloadReadiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -1517,6 +2178,29 @@ func (in *Schedule) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleBlackout) DeepCopyInto(out *ScheduleBlackout) {
+	*out = *in
+	if in.ScheduledTime != nil {
+		in, out := &in.ScheduledTime, &out.ScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleBlackout.
+func (in *ScheduleBlackout) DeepCopy() *ScheduleBlackout {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleBlackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleList) DeepCopyInto(out *ScheduleList) {
 	*out = *in
@@ -1549,6 +2233,21 @@ func (in *ScheduleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRetentionPolicy) DeepCopyInto(out *ScheduleRetentionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRetentionPolicy.
+func (in *ScheduleRetentionPolicy) DeepCopy() *ScheduleRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 	*out = *in
@@ -1563,6 +2262,21 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ScheduleRetentionPolicy)
+		**out = **in
+	}
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
@@ -1586,6 +2300,11 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.LastSkipped, &out.LastSkipped
 		*out = (*in).DeepCopy()
 	}
+	if in.LastBlackout != nil {
+		in, out := &in.LastBlackout, &out.LastBlackout
+		*out = new(ScheduleBlackout)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -1603,6 +2322,22 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerStatusRequest) DeepCopyInto(out *ServerStatusRequest) {
 	*out = *in
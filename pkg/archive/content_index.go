@@ -0,0 +1,222 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ContentIndex records the content hash of every item file of a backup. An incremental
+// backup only stores the item files whose hash differs from the one in its parent backup,
+// and lists the item files of the parent that were deleted as tombstones.
+type ContentIndex struct {
+	// Parent is the name of the backup an incremental backup is based on, empty for full backups.
+	Parent string `json:"parent,omitempty"`
+
+	// Depth is the number of backups between the backup and the full backup of its chain.
+	Depth int `json:"depth,omitempty"`
+
+	// Hashes maps the path of every item file of the backup in the tarball, including the
+	// files stored in a parent backup, to the SHA-256 of its content.
+	Hashes map[string]string `json:"hashes"`
+
+	// Tombstones lists the paths of the item files of the parent backup which aren't in
+	// the backup anymore.
+	Tombstones []string `json:"tombstones,omitempty"`
+
+	lock sync.Mutex
+}
+
+// NewContentIndex returns an empty ContentIndex for a backup based on the parent, which
+// is nil for full backups.
+func NewContentIndex(parentName string, parent *ContentIndex) *ContentIndex {
+	index := &ContentIndex{Hashes: map[string]string{}}
+	if parent != nil {
+		index.Parent = parentName
+		index.Depth = parent.Depth + 1
+	}
+	return index
+}
+
+// Add records the hash of the content of an item file, and returns it.
+func (c *ContentIndex) Add(path string, content []byte) string {
+	hash := contentHash(content)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.Hashes[path] = hash
+	return hash
+}
+
+// Hash returns the hash of the content of an item file, and false if the file isn't in the backup.
+func (c *ContentIndex) Hash(path string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hash, ok := c.Hashes[path]
+	return hash, ok
+}
+
+// SetTombstones records the item files of the parent which aren't in the backup.
+func (c *ContentIndex) SetTombstones(parent *ContentIndex) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.Tombstones = nil
+	for path := range parent.Hashes {
+		if _, ok := c.Hashes[path]; !ok {
+			c.Tombstones = append(c.Tombstones, path)
+		}
+	}
+	sort.Strings(c.Tombstones)
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// IncrementalLayer is a backup of an incremental chain: the content index of the backup,
+// and a function opening its gzipped tarball, which is only called when the tarball is read.
+type IncrementalLayer struct {
+	Index *ContentIndex
+	Open  func() (io.ReadCloser, error)
+}
+
+// MergeIncremental writes to w the gzipped tarball of the full content of the first backup
+// of the layers, which are the backups of an incremental chain from the newest to the full
+// backup. The item files come from the newest backup that stores them, the item files
+// deleted by a newer backup of the chain are left out, and the other files come from the
+// first backup.
+func MergeIncremental(w io.Writer, layers []IncrementalLayer) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	written := sets.New[string]()
+	deleted := sets.New[string]()
+	for i, layer := range layers {
+		if err := mergeLayer(tw, layer, i == 0, written, deleted); err != nil {
+			return err
+		}
+		if layer.Index != nil {
+			deleted.Insert(layer.Index.Tombstones...)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(gzw.Close())
+}
+
+// mergeLayer copies the files of a layer that haven't been written or deleted by a newer layer.
+// Only the item files are copied from the layers other than the first one.
+func mergeLayer(tw *tar.Writer, layer IncrementalLayer, first bool, written, deleted sets.Set[string]) error {
+	contents, err := layer.Open()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	gzr, err := gzip.NewReader(contents)
+	if err != nil {
+		return errors.Wrap(err, "error creating gzip reader")
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading tar")
+		}
+
+		isItem := strings.HasPrefix(header.Name, velerov1api.ResourcesDir+"/")
+		if (!first && !isItem) || written.Has(header.Name) || deleted.Has(header.Name) {
+			continue
+		}
+		written.Insert(header.Name)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil { //nolint:gosec // Internal usage. No need to check.
+			return errors.WithStack(err)
+		}
+	}
+}
+
+// StripUnchanged writes to w the gzipped tarball read from r without the item files whose
+// content is the same as in the parent backup, according to its content index. These are the
+// item files an incremental backup based on the parent doesn't store.
+func StripUnchanged(w io.Writer, r io.Reader, parent *ContentIndex) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, "error creating gzip reader")
+	}
+	defer gzr.Close()
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading tar")
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if strings.HasPrefix(header.Name, velerov1api.ResourcesDir+"/") {
+			if hash, ok := parent.Hash(header.Name); ok && hash == contentHash(content) {
+				continue
+			}
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(gzw.Close())
+}
@@ -0,0 +1,187 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentIndex(t *testing.T) {
+	full := NewContentIndex("", nil)
+	assert.Empty(t, full.Parent)
+	assert.Equal(t, 0, full.Depth)
+
+	hash := full.Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1"))
+	full.Add("resources/configmaps/namespaces/ns-1/cm-2.json", []byte("cm-2"))
+	got, ok := full.Hash("resources/configmaps/namespaces/ns-1/cm-1.json")
+	assert.True(t, ok)
+	assert.Equal(t, hash, got)
+	_, ok = full.Hash("resources/configmaps/namespaces/ns-1/cm-3.json")
+	assert.False(t, ok)
+
+	incremental := NewContentIndex("backup-1", full)
+	assert.Equal(t, "backup-1", incremental.Parent)
+	assert.Equal(t, 1, incremental.Depth)
+
+	// the same content has the same hash
+	assert.Equal(t, hash, incremental.Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1")))
+	incremental.Add("resources/configmaps/namespaces/ns-1/cm-3.json", []byte("cm-3"))
+	incremental.SetTombstones(full)
+	assert.Equal(t, []string{"resources/configmaps/namespaces/ns-1/cm-2.json"}, incremental.Tombstones)
+}
+
+// gzippedTarball returns the gzipped tarball of the files.
+func gzippedTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Mode: 0644, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+// readGzippedTarball returns the files of the gzipped tarball.
+func readGzippedTarball(t *testing.T, r io.Reader) map[string]string {
+	t.Helper()
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+}
+
+func TestMergeIncremental(t *testing.T) {
+	tarball := func(files map[string]string) func() (io.ReadCloser, error) {
+		data := gzippedTarball(t, files)
+		return func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil }
+	}
+
+	tests := []struct {
+		name    string
+		layers  []IncrementalLayer
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "item files come from the newest backup storing them, deleted items are left out",
+			layers: []IncrementalLayer{
+				{
+					Index: &ContentIndex{Parent: "backup-2", Tombstones: []string{"resources/secrets/namespaces/ns-1/secret-1.json"}},
+					Open: tarball(map[string]string{
+						"metadata/version": "3",
+						"resources/configmaps/namespaces/ns-1/cm-1.json": "cm-1-v3",
+					}),
+				},
+				{
+					Index: &ContentIndex{Parent: "backup-1", Tombstones: []string{"resources/configmaps/namespaces/ns-1/cm-2.json"}},
+					Open: tarball(map[string]string{
+						"metadata/version": "2",
+						"resources/configmaps/namespaces/ns-1/cm-1.json": "cm-1-v2",
+						"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+					}),
+				},
+				{
+					Index: &ContentIndex{},
+					Open: tarball(map[string]string{
+						"metadata/version": "1",
+						"resources/configmaps/namespaces/ns-1/cm-1.json":  "cm-1-v1",
+						"resources/configmaps/namespaces/ns-1/cm-2.json":  "cm-2",
+						"resources/configmaps/namespaces/ns-1/cm-4.json":  "cm-4",
+						"resources/secrets/namespaces/ns-1/secret-1.json": "secret-1",
+					}),
+				},
+			},
+			want: map[string]string{
+				"metadata/version": "3",
+				"resources/configmaps/namespaces/ns-1/cm-1.json": "cm-1-v3",
+				"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+				"resources/configmaps/namespaces/ns-1/cm-4.json": "cm-4",
+			},
+		},
+		{
+			name: "error opening a backup of the chain is returned",
+			layers: []IncrementalLayer{
+				{
+					Index: &ContentIndex{Parent: "backup-1"},
+					Open:  tarball(map[string]string{"metadata/version": "2"}),
+				},
+				{
+					Index: &ContentIndex{},
+					Open:  func() (io.ReadCloser, error) { return nil, errors.New("backup-1 not found") },
+				},
+			},
+			wantErr: "backup-1 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := MergeIncremental(buf, tc.layers)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, readGzippedTarball(t, buf))
+		})
+	}
+}
+
+func TestStripUnchanged(t *testing.T) {
+	parent := NewContentIndex("", nil)
+	parent.Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1"))
+	parent.Add("resources/configmaps/namespaces/ns-1/cm-2.json", []byte("cm-2"))
+	parent.Add("metadata/version", []byte("1"))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, StripUnchanged(buf, bytes.NewReader(gzippedTarball(t, map[string]string{
+		"metadata/version": "1",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "cm-1",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "cm-2-updated",
+		"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+	})), parent))
+
+	assert.Equal(t, map[string]string{
+		"metadata/version": "1",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "cm-2-updated",
+		"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+	}, readGzippedTarball(t, buf))
+}
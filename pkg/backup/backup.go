@@ -53,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemblock"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -108,6 +109,14 @@ type Backupper interface {
 		asyncBIAOperations []*itemoperation.BackupOperation,
 		backupStore persistence.BackupStore,
 	) error
+
+	// DryRun reports the items, volumes and hooks the backup would process in the
+	// DryRunReport of the request, without writing any backup data, taking snapshots
+	// or running hooks.
+	DryRun(
+		log logrus.FieldLogger,
+		backupRequest *Request,
+	) error
 }
 
 // kubernetesBackupper implements Backupper.
@@ -247,9 +256,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		return errors.WithStack(err)
 	}
 
-	backupRequest.NamespaceIncludesExcludes = getNamespaceIncludesExcludes(backupRequest.Backup)
-	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
-	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
+	kb.initIncludesExcludes(log, backupRequest)
 
 	// check if there are any namespaces included in the backup which are managed by argoCD
 	// We will check for the existence of a ArgoCD label in the includedNamespaces and add a warning
@@ -263,22 +270,6 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 		}
 	}
 
-	if collections.UseOldResourceFilters(backupRequest.Spec) {
-		backupRequest.ResourceIncludesExcludes = collections.GetGlobalResourceIncludesExcludes(kb.discoveryHelper, log,
-			backupRequest.Spec.IncludedResources,
-			backupRequest.Spec.ExcludedResources,
-			backupRequest.Spec.IncludeClusterResources,
-			*backupRequest.NamespaceIncludesExcludes)
-	} else {
-		backupRequest.ResourceIncludesExcludes = collections.GetScopeResourceIncludesExcludes(kb.discoveryHelper, log,
-			backupRequest.Spec.IncludedNamespaceScopedResources,
-			backupRequest.Spec.ExcludedNamespaceScopedResources,
-			backupRequest.Spec.IncludedClusterScopedResources,
-			backupRequest.Spec.ExcludedClusterScopedResources,
-			*backupRequest.NamespaceIncludesExcludes,
-		)
-	}
-
 	log.Infof("Backing up all volumes using pod volume backup: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToFsBackup))
 
 	var err error
@@ -622,6 +613,39 @@ func (kb *kubernetesBackupper) BackupWithResolvers(
 	return nil
 }
 
+// initIncludesExcludes sets the namespace and resource includes/excludes of the backup request
+// from its spec.
+func (kb *kubernetesBackupper) initIncludesExcludes(log logrus.FieldLogger, backupRequest *Request) {
+	backupRequest.NamespaceIncludesExcludes = getNamespaceIncludesExcludes(backupRequest.Backup)
+	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
+	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
+
+	var includeExcludePolicy *resourcepolicies.IncludeExcludePolicy
+	if backupRequest.ResPolicies != nil {
+		includeExcludePolicy = backupRequest.ResPolicies.GetIncludeExcludePolicy()
+	}
+
+	// the include/exclude policy can't be combined with the old resource filters, so the scoped
+	// filters are used when the backup has an include/exclude policy.
+	if collections.UseOldResourceFilters(backupRequest.Spec) && includeExcludePolicy == nil {
+		backupRequest.ResourceIncludesExcludes = collections.GetGlobalResourceIncludesExcludes(kb.discoveryHelper, log,
+			backupRequest.Spec.IncludedResources,
+			backupRequest.Spec.ExcludedResources,
+			backupRequest.Spec.IncludeClusterResources,
+			*backupRequest.NamespaceIncludesExcludes)
+	} else {
+		scopeIncludesExcludes := collections.GetScopeResourceIncludesExcludes(kb.discoveryHelper, log,
+			backupRequest.Spec.IncludedNamespaceScopedResources,
+			backupRequest.Spec.ExcludedNamespaceScopedResources,
+			backupRequest.Spec.IncludedClusterScopedResources,
+			backupRequest.Spec.ExcludedClusterScopedResources,
+			*backupRequest.NamespaceIncludesExcludes,
+		)
+		scopeIncludesExcludes.CombineWithPolicy(includeExcludePolicy)
+		backupRequest.ResourceIncludesExcludes = scopeIncludesExcludes
+	}
+}
+
 func (kb *kubernetesBackupper) executeItemBlockActions(
 	log logrus.FieldLogger,
 	obj runtime.Unstructured,
@@ -647,6 +671,17 @@ func (kb *kubernetesBackupper) executeItemBlockActions(
 			continue
 		}
 
+		if graph := itemBlock.itemBackupper.backupRequest.Manifest; graph != nil {
+			for _, relatedItem := range relatedItems {
+				graph.AddEdges(groupResource.String(), namespace, name, manifest.Edge{
+					Type:      manifest.EdgeTypeItemBlock,
+					Resource:  relatedItem.GroupResource.String(),
+					Namespace: relatedItem.Namespace,
+					Name:      relatedItem.Name,
+				})
+			}
+		}
+
 		for _, relatedItem := range relatedItems {
 			var newBlockItem *unstructured.Unstructured
 			// Look for item in itemsMap
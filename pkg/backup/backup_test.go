@@ -46,12 +46,14 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -170,6 +172,108 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 	assert.Equal(t, req.BackedUpItems.Len(), req.Status.Progress.ItemsBackedUp)
 }
 
+// TestBackupManifestIsPopulated verifies that after a backup has run, the
+// request's manifest holds every backed up item with its edges.
+func TestBackupManifestIsPopulated(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+		ItemBlockChannel: h.itemBlockPool.GetInputChannel(),
+		Manifest:         manifest.New(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("foo", "bar").
+				ServiceAccount("sa-1").
+				Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).
+				Result(),
+		),
+		test.PVCs(
+			builder.ForPersistentVolumeClaim("foo", "pvc-1").VolumeName("pv-1").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+
+	assert.Equal(t, req.BackedUpItems.Len(), req.Manifest.Len())
+
+	pod := req.Manifest.Get("pods", "foo", "bar")
+	require.NotNil(t, pod)
+	assert.Equal(t, []manifest.Edge{
+		{Type: manifest.EdgeTypeServiceAccount, Resource: "serviceaccounts", Namespace: "foo", Name: "sa-1"},
+		{Type: manifest.EdgeTypePersistentVolumeClaim, Resource: "persistentvolumeclaims", Namespace: "foo", Name: "pvc-1"},
+	}, pod.Edges)
+
+	pvc := req.Manifest.Get("persistentvolumeclaims", "foo", "pvc-1")
+	require.NotNil(t, pvc)
+	assert.Equal(t, []*manifest.Item{req.Manifest.Get("persistentvolumes", "", "pv-1")}, pvc.Dependencies())
+}
+
+// TestIncrementalBackupSkipsUnchangedItems verifies that an incremental backup
+// only writes the item files whose content changed since its parent backup to
+// the tarball, while recording every item file in its content index.
+func TestIncrementalBackupSkipsUnchangedItems(t *testing.T) {
+	runBackup := func(req *Request, apiResources ...*test.APIResource) *bytes.Buffer {
+		h := newHarness(t, nil)
+		defer h.itemBlockPool.Stop()
+		req.SkippedPVTracker = NewSkipPVTracker()
+		req.BackedUpItems = NewBackedUpItemsMap()
+		req.ItemBlockChannel = h.itemBlockPool.GetInputChannel()
+		for _, resource := range apiResources {
+			h.addItems(t, resource)
+		}
+
+		backupFile := bytes.NewBuffer([]byte{})
+		h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+		return backupFile
+	}
+
+	parent := &Request{
+		Backup:       defaultBackup().Result(),
+		ContentIndex: archive.NewContentIndex("", nil),
+	}
+	runBackup(parent,
+		test.Pods(
+			builder.ForPod("foo", "bar").Result(),
+			builder.ForPod("zoo", "raz").Result(),
+		),
+	)
+
+	req := &Request{
+		Backup:             defaultBackup().Result(),
+		ContentIndex:       archive.NewContentIndex("parent", parent.ContentIndex),
+		ParentContentIndex: parent.ContentIndex,
+	}
+	backupFile := runBackup(req,
+		test.Pods(
+			builder.ForPod("foo", "bar").Result(),
+			builder.ForPod("zoo", "raz").ObjectMeta(builder.WithLabels("changed", "true")).Result(),
+			builder.ForPod("zoo", "new").Result(),
+		),
+	)
+
+	assert.Equal(t, 3, req.BackedUpItems.Len())
+	assert.Len(t, req.ContentIndex.Hashes, 6)
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/zoo/raz.json",
+		"resources/pods/v1-preferredversion/namespaces/zoo/raz.json",
+		"resources/pods/namespaces/zoo/new.json",
+		"resources/pods/v1-preferredversion/namespaces/zoo/new.json",
+	)
+}
+
 // TestBackupOldResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -4492,9 +4596,65 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 		name         string
 		backup       *velerov1.Backup
 		apiResources []*test.APIResource
+		resPolicies  *resourcepolicies.ResourcePolicies
 		want         []string
 		actions      []biav2.BackupItemAction
 	}{
+		{
+			name:   "include/exclude policy filters resources when the backup has no filters",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("test1").Result(),
+				),
+			},
+			resPolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				IncludeExcludePolicy: &resourcepolicies.IncludeExcludePolicy{
+					ExcludedNamespaceScopedResources: []string{"deployments.apps"},
+					ExcludedClusterScopedResources:   []string{"persistentvolumes"},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
+		{
+			name:   "backup filters take precedence over the include/exclude policy",
+			backup: defaultBackup().IncludedNamespaceScopedResources("deployments").ExcludedClusterScopedResources("*").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("test1").Result(),
+				),
+			},
+			resPolicies: &resourcepolicies.ResourcePolicies{
+				Version: "v1",
+				IncludeExcludePolicy: &resourcepolicies.IncludeExcludePolicy{
+					IncludedNamespaceScopedResources: []string{"pods"},
+					ExcludedNamespaceScopedResources: []string{"deployments.apps"},
+					IncludedClusterScopedResources:   []string{"persistentvolumes"},
+				},
+			},
+			want: []string{
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
 		{
 			name:   "no namespace-scoped resources + some cluster-scoped resources",
 			backup: defaultBackup().IncludedClusterScopedResources("persistentvolumes").ExcludedNamespaceScopedResources("*").Result(),
@@ -5321,6 +5481,11 @@ func TestBackupNewResourceFiltering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
+			if tc.resPolicies != nil {
+				req.ResPolicies = new(resourcepolicies.Policies)
+				require.NoError(t, req.ResPolicies.BuildPolicy(tc.resPolicies))
+			}
+
 			h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
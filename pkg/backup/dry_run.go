@@ -0,0 +1,338 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/volumehelper"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// dryRunItemsKey identifies the items of one resource within one namespace.
+type dryRunItemsKey struct {
+	namespace string
+	resource  string
+}
+
+// dryRunLimitations are what the dry run doesn't account for, they're reported with its result.
+// The BackupItemAction plugins aren't run since they can have side effects, e.g. creating the
+// VolumeSnapshots of CSI volumes.
+var dryRunLimitations = []string{
+	"BackupItemAction and ItemBlockAction plugins aren't run, the additional items they would return aren't listed",
+}
+
+// DryRun collects the items selected by the backup, applying the namespace and resource
+// filters, the resource policies and the ordered resources, and reports the items, volumes
+// and hooks that the backup would process in the DryRunReport of the request. It doesn't
+// write a tarball, take snapshots, run hooks or invoke any plugin.
+func (kb *kubernetesBackupper) DryRun(log logrus.FieldLogger, backupRequest *Request) error {
+	kb.initIncludesExcludes(log, backupRequest)
+
+	var err error
+	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Debugf("Error from getResourceHooks")
+		return err
+	}
+
+	// set up a temp dir for the itemCollector to use to temporarily
+	// store items as they're scraped from the API.
+	tempDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		return errors.Wrap(err, "error creating temp dir for backup")
+	}
+	defer os.RemoveAll(tempDir)
+
+	collector := &itemCollector{
+		log:                   log,
+		backupRequest:         backupRequest,
+		discoveryHelper:       kb.discoveryHelper,
+		dynamicFactory:        kb.dynamicFactory,
+		cohabitatingResources: cohabitatingResources(),
+		dir:                   tempDir,
+		pageSize:              kb.clientPageSize,
+	}
+
+	items := collector.getAllItems()
+	log.Infof("Collected %d items matching the backup spec from the Kubernetes API", len(items))
+
+	// the itemBackupper is only used for its inclusion checks, it never writes anything
+	itemBackupper := &itemBackupper{
+		backupRequest:   backupRequest,
+		kbClient:        kb.kbClient,
+		discoveryHelper: kb.discoveryHelper,
+		dynamicFactory:  kb.dynamicFactory,
+	}
+	volumeHelper := volumehelper.NewVolumeHelperImpl(
+		backupRequest.ResPolicies,
+		backupRequest.Spec.SnapshotVolumes,
+		log,
+		kb.kbClient,
+		boolptr.IsSetToTrue(backupRequest.Spec.DefaultVolumesToFsBackup),
+		!backupRequest.ResourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumeClaims.String()),
+	)
+	hookRecorder := &dryRunPodCommandExecutor{}
+	hookHandler := &hook.DefaultItemHookHandler{PodCommandExecutor: hookRecorder}
+	hookTracker := hook.NewHookTracker()
+
+	itemNames := map[dryRunItemsKey][]string{}
+	seen := map[itemKey]struct{}{}
+	var (
+		pods []*corev1api.Pod
+		pvcs []*unstructured.Unstructured
+		pvs  []*unstructured.Unstructured
+	)
+	for _, item := range items {
+		obj, err := readKubernetesResource(item)
+		if err != nil {
+			log.WithError(err).WithField("resource", item.groupResource.String()).Error("Error reading collected item")
+			continue
+		}
+
+		metadata, err := meta.Accessor(obj)
+		if err != nil {
+			log.WithError(errors.WithStack(err)).Warn("Error accessing item metadata")
+			continue
+		}
+
+		itemLog := log.WithFields(logrus.Fields{
+			"name":      item.name,
+			"resource":  item.groupResource.String(),
+			"namespace": item.namespace,
+		})
+		if !itemBackupper.itemInclusionChecks(itemLog, false, metadata, obj, item.groupResource) {
+			continue
+		}
+
+		// with the EnableAPIGroupVersions feature, the same item is collected once per version
+		key := itemKey{resource: item.groupResource.String(), namespace: item.namespace, name: item.name}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		namesKey := dryRunItemsKey{namespace: item.namespace, resource: item.groupResource.String()}
+		itemNames[namesKey] = append(itemNames[namesKey], item.name)
+
+		switch item.groupResource {
+		case kuberesource.Pods:
+			pod := new(corev1api.Pod)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+				itemLog.WithError(errors.WithStack(err)).Error("Error converting item to pod")
+				continue
+			}
+			pods = append(pods, pod)
+
+			for _, phase := range []hook.HookPhase{hook.PhasePre, hook.PhasePost} {
+				hookRecorder.phase = phase
+				if err := hookHandler.HandleHooks(itemLog, item.groupResource, obj, backupRequest.ResourceHooks, phase, hookTracker); err != nil {
+					itemLog.WithError(err).Error("Error resolving hooks for pod")
+				}
+			}
+		case kuberesource.PersistentVolumeClaims:
+			pvcs = append(pvcs, obj)
+		case kuberesource.PersistentVolumes:
+			pvs = append(pvs, obj)
+		}
+	}
+
+	report := &velerov1api.BackupDryRunReport{
+		Volumes: dryRunVolumes(log, volumeHelper, pods, pvcs, pvs),
+		Hooks:   hookRecorder.hooks,
+	}
+
+	keys := make([]dryRunItemsKey, 0, len(itemNames))
+	for key := range itemNames {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].resource < keys[j].resource
+	})
+	for _, key := range keys {
+		report.Items = append(report.Items, velerov1api.BackupDryRunItems{
+			Namespace: key.namespace,
+			Resource:  key.resource,
+			Names:     itemNames[key],
+		})
+	}
+
+	log.Infof("Dry run found %d items, %d volumes and %d hooks", len(seen), len(report.Volumes), len(report.Hooks))
+	backupRequest.DryRunReport = report
+
+	return nil
+}
+
+// dryRunVolumes decides how each volume would be backed up. Pod volumes selected for pod volume
+// file system backup take precedence over snapshots of the PVCs they mount, the same as during
+// a real backup.
+func dryRunVolumes(
+	log logrus.FieldLogger,
+	volumeHelper volumehelper.VolumeHelper,
+	pods []*corev1api.Pod,
+	pvcs []*unstructured.Unstructured,
+	pvs []*unstructured.Unstructured,
+) []velerov1api.BackupDryRunVolume {
+	var volumes []velerov1api.BackupDryRunVolume
+	fsBackupPVCs := map[string]struct{}{}
+	handledPVs := map[string]struct{}{}
+
+	for _, pod := range pods {
+		for _, volume := range pod.Spec.Volumes {
+			shouldDoFSBackup, err := volumeHelper.ShouldPerformFSBackup(volume, *pod)
+			if err != nil {
+				log.WithError(err).Errorf("Error checking whether volume %s of pod %s/%s would be backed up with pod volume backup", volume.Name, pod.Namespace, pod.Name)
+				continue
+			}
+			if !shouldDoFSBackup {
+				continue
+			}
+
+			dryRunVolume := velerov1api.BackupDryRunVolume{
+				Namespace: pod.Namespace,
+				PodName:   pod.Name,
+				PodVolume: volume.Name,
+				Action:    velerov1api.BackupDryRunVolumeActionFSBackup,
+			}
+			if volume.PersistentVolumeClaim != nil {
+				pvcKey := pod.Namespace + "/" + volume.PersistentVolumeClaim.ClaimName
+				// the PVC has already been backed up from another pod
+				if _, ok := fsBackupPVCs[pvcKey]; ok {
+					continue
+				}
+				fsBackupPVCs[pvcKey] = struct{}{}
+				dryRunVolume.PVCName = volume.PersistentVolumeClaim.ClaimName
+			}
+			volumes = append(volumes, dryRunVolume)
+		}
+	}
+
+	for _, obj := range pvcs {
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+			log.WithError(errors.WithStack(err)).Error("Error converting item to PVC")
+			continue
+		}
+		if pvc.Spec.VolumeName != "" {
+			handledPVs[pvc.Spec.VolumeName] = struct{}{}
+		}
+		if _, ok := fsBackupPVCs[pvc.Namespace+"/"+pvc.Name]; ok {
+			continue
+		}
+
+		dryRunVolume := velerov1api.BackupDryRunVolume{
+			Namespace: pvc.Namespace,
+			PVCName:   pvc.Name,
+			PVName:    pvc.Spec.VolumeName,
+		}
+		setDryRunSnapshotAction(&dryRunVolume, volumeHelper, obj, kuberesource.PersistentVolumeClaims)
+		volumes = append(volumes, dryRunVolume)
+	}
+
+	for _, obj := range pvs {
+		if _, ok := handledPVs[obj.GetName()]; ok {
+			continue
+		}
+
+		dryRunVolume := velerov1api.BackupDryRunVolume{PVName: obj.GetName()}
+		setDryRunSnapshotAction(&dryRunVolume, volumeHelper, obj, kuberesource.PersistentVolumes)
+		volumes = append(volumes, dryRunVolume)
+	}
+
+	return volumes
+}
+
+func setDryRunSnapshotAction(volume *velerov1api.BackupDryRunVolume, volumeHelper volumehelper.VolumeHelper, obj *unstructured.Unstructured, groupResource schema.GroupResource) {
+	shouldSnapshot, err := volumeHelper.ShouldPerformSnapshot(obj, groupResource)
+	switch {
+	case err != nil:
+		volume.Action = velerov1api.BackupDryRunVolumeActionSkip
+		volume.Reason = err.Error()
+	case shouldSnapshot:
+		volume.Action = velerov1api.BackupDryRunVolumeActionSnapshot
+	default:
+		volume.Action = velerov1api.BackupDryRunVolumeActionSkip
+		volume.Reason = "not selected for snapshot by the volume policies or the snapshotVolumes setting"
+	}
+}
+
+// readKubernetesResource reads a collected item from the file the itemCollector
+// stored it in, and removes the file.
+func readKubernetesResource(item *kubernetesResource) (*unstructured.Unstructured, error) {
+	f, err := os.Open(item.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening file containing item")
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	obj := new(unstructured.Unstructured)
+	if err := json.NewDecoder(f).Decode(obj); err != nil {
+		return nil, errors.Wrap(err, "error decoding JSON from file")
+	}
+	return obj, nil
+}
+
+// dryRunPodCommandExecutor records the exec hooks a backup would run instead of executing them.
+type dryRunPodCommandExecutor struct {
+	phase hook.HookPhase
+	hooks []velerov1api.BackupDryRunHook
+}
+
+func (e *dryRunPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]any, namespace, name, hookName string, execHook *velerov1api.ExecHook) error {
+	dryRunHook := velerov1api.BackupDryRunHook{
+		Name:      hookName,
+		Source:    hook.HookSourceSpec,
+		Phase:     string(e.phase),
+		Namespace: namespace,
+		PodName:   name,
+		Container: execHook.Container,
+		Command:   execHook.Command,
+	}
+	// hooks defined by pod annotations are passed with a placeholder name
+	if hookName == "<from-annotation>" {
+		dryRunHook.Name = ""
+		dryRunHook.Source = hook.HookSourceAnnotation
+	}
+	if dryRunHook.Container == "" {
+		// same as the pod command executor, default to the pod's first container
+		containers, _, _ := unstructured.NestedSlice(item, "spec", "containers")
+		if len(containers) > 0 {
+			if container, ok := containers[0].(map[string]any); ok {
+				dryRunHook.Container, _ = container["name"].(string)
+			}
+		}
+	}
+	e.hooks = append(e.hooks, dryRunHook)
+	return nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/internal/hook"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDryRunItemsAndHooks(t *testing.T) {
+	h := newHarness(t, nil)
+	defer h.itemBlockPool.Stop()
+
+	backup := defaultBackup().
+		IncludedNamespaces("ns-1").
+		Hooks(velerov1.BackupHooks{
+			Resources: []velerov1.BackupResourceHookSpec{
+				{
+					Name:              "hook-1",
+					IncludedResources: []string{"pods"},
+					PreHooks: []velerov1.BackupResourceHook{
+						{Exec: &velerov1.ExecHook{Command: []string{"sync"}}},
+					},
+				},
+			},
+		}).
+		Result()
+	req := &Request{
+		Backup:           backup,
+		SkippedPVTracker: NewSkipPVTracker(),
+		BackedUpItems:    NewBackedUpItemsMap(),
+	}
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "pod-1").Containers(builder.ForContainer("c1", "image").Result()).Result(),
+			builder.ForPod("ns-1", "pod-2").
+				ObjectMeta(builder.WithAnnotations(
+					"post.hook.backup.velero.io/container", "c2",
+					"post.hook.backup.velero.io/command", `["/bin/flush"]`,
+				)).
+				Containers(builder.ForContainer("c2", "image").Result()).
+				Result(),
+			builder.ForPod("ns-2", "pod-3").Result(),
+		),
+		test.Deployments(
+			builder.ForDeployment("ns-1", "deploy-1").Result(),
+			builder.ForDeployment("ns-2", "deploy-2").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	require.NoError(t, h.backupper.DryRun(h.log, req))
+	report := req.DryRunReport
+
+	assert.Equal(t, []velerov1.BackupDryRunItems{
+		{Namespace: "ns-1", Resource: "deployments.apps", Names: []string{"deploy-1"}},
+		{Namespace: "ns-1", Resource: "pods", Names: []string{"pod-1", "pod-2"}},
+	}, report.Items)
+	assert.Equal(t, []velerov1.BackupDryRunHook{
+		{
+			Name:      "hook-1",
+			Source:    hook.HookSourceSpec,
+			Phase:     string(hook.PhasePre),
+			Namespace: "ns-1",
+			PodName:   "pod-1",
+			Container: "c1",
+			Command:   []string{"sync"},
+		},
+		{
+			Name:      "hook-1",
+			Source:    hook.HookSourceSpec,
+			Phase:     string(hook.PhasePre),
+			Namespace: "ns-1",
+			PodName:   "pod-2",
+			Container: "c2",
+			Command:   []string{"sync"},
+		},
+		{
+			Source:    hook.HookSourceAnnotation,
+			Phase:     string(hook.PhasePost),
+			Namespace: "ns-1",
+			PodName:   "pod-2",
+			Container: "c2",
+			Command:   []string{"/bin/flush"},
+		},
+	}, report.Hooks)
+	assert.Empty(t, report.Volumes)
+
+	// only the number of items by resource is kept in the status of the backup
+	assert.Equal(t, &velerov1.BackupDryRunResult{
+		Resources: []velerov1.BackupDryRunResourceCount{
+			{Resource: "deployments.apps", Count: 1},
+			{Resource: "pods", Count: 2},
+		},
+		Limitations: dryRunLimitations,
+	}, req.DryRunResult())
+}
+
+func TestDryRunVolumes(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").
+		ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "data")).
+		Volumes(
+			builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result(),
+			builder.ForVolume("logs").PersistentVolumeClaimSource("pvc-2").Result(),
+		).
+		Result()
+	pvc1 := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Phase(corev1api.ClaimBound).Result()
+	pvc2 := builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Phase(corev1api.ClaimBound).Result()
+	pv1 := builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result()
+	pv2 := builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result()
+	pv3 := builder.ForPersistentVolume("pv-3").Result()
+
+	tests := []struct {
+		name   string
+		backup *velerov1.Backup
+		want   []velerov1.BackupDryRunVolume
+	}{
+		{
+			name:   "opted-in pod volumes use fs-backup and the other volumes are snapshotted",
+			backup: defaultBackup().Result(),
+			want: []velerov1.BackupDryRunVolume{
+				{Namespace: "ns-1", PVCName: "pvc-1", PodName: "pod-1", PodVolume: "data", Action: velerov1.BackupDryRunVolumeActionFSBackup},
+				{Namespace: "ns-1", PVCName: "pvc-2", PVName: "pv-2", Action: velerov1.BackupDryRunVolumeActionSnapshot},
+				{PVName: "pv-3", Action: velerov1.BackupDryRunVolumeActionSnapshot},
+			},
+		},
+		{
+			name:   "volumes are skipped when snapshotVolumes is false",
+			backup: defaultBackup().SnapshotVolumes(false).Result(),
+			want: []velerov1.BackupDryRunVolume{
+				{Namespace: "ns-1", PVCName: "pvc-1", PodName: "pod-1", PodVolume: "data", Action: velerov1.BackupDryRunVolumeActionFSBackup},
+				{
+					Namespace: "ns-1",
+					PVCName:   "pvc-2",
+					PVName:    "pv-2",
+					Action:    velerov1.BackupDryRunVolumeActionSkip,
+					Reason:    "not selected for snapshot by the volume policies or the snapshotVolumes setting",
+				},
+				{
+					PVName: "pv-3",
+					Action: velerov1.BackupDryRunVolumeActionSkip,
+					Reason: "not selected for snapshot by the volume policies or the snapshotVolumes setting",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t, nil)
+			defer h.itemBlockPool.Stop()
+			h.backupper.kbClient = test.NewFakeControllerRuntimeClient(t, pod, pvc1, pvc2, pv1, pv2, pv3)
+
+			req := &Request{
+				Backup:           tc.backup,
+				SkippedPVTracker: NewSkipPVTracker(),
+				BackedUpItems:    NewBackedUpItemsMap(),
+			}
+
+			h.addItems(t, test.Pods(pod))
+			h.addItems(t, test.PVCs(pvc1, pvc2))
+			h.addItems(t, test.PVs(pv1, pv2, pv3))
+
+			require.NoError(t, h.backupper.DryRun(h.log, req))
+
+			assert.Equal(t, tc.want, req.DryRunReport.Volumes)
+		})
+	}
+}
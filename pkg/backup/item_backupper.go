@@ -49,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemblock"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	vsv1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/volumesnapshotter/v1"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
@@ -99,6 +100,9 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	ib.tarWriter.Lock()
 	defer ib.tarWriter.Unlock()
 	for _, file := range files {
+		if ib.unchangedSinceParent(file) {
+			continue
+		}
 		if err := ib.tarWriter.WriteHeader(file.Header); err != nil {
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
@@ -300,6 +304,10 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		return false, itemFiles, kubeerrs.NewAggregate(backupErrs)
 	}
 
+	if ib.backupRequest.Manifest != nil {
+		ib.addToManifest(log, obj, groupResource)
+	}
+
 	itemBytes, err := json.Marshal(obj.UnstructuredContent())
 	if err != nil {
 		return false, itemFiles, errors.WithStack(err)
@@ -316,6 +324,47 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 	return true, itemFiles, nil
 }
 
+// unchangedSinceParent records the hash of the item file in the backup's content index, and
+// returns true if the parent of an incremental backup has the same content for the file, in
+// which case the file isn't stored in the backup tarball.
+func (ib *itemBackupper) unchangedSinceParent(file FileForArchive) bool {
+	index := ib.backupRequest.ContentIndex
+	if index == nil {
+		return false
+	}
+	hash := index.Add(file.FilePath, file.FileBytes)
+
+	parent := ib.backupRequest.ParentContentIndex
+	if parent == nil {
+		return false
+	}
+	parentHash, ok := parent.Hash(file.FilePath)
+	return ok && parentHash == hash
+}
+
+// addToManifest records the item and the edges to the items it depends on in the backup's
+// object graph manifest.
+func (ib *itemBackupper) addToManifest(log logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource) {
+	item, err := manifest.NewItem(&unstructured.Unstructured{Object: obj.UnstructuredContent()}, groupResource, ib.resourceForKind)
+	if err != nil {
+		log.WithError(err).Warn("Error building the manifest item")
+		return
+	}
+	if _, err := ib.backupRequest.Manifest.Add(item); err != nil {
+		// with the EnableAPIGroupVersions feature, the same item is backed up once per version
+		log.WithError(err).Debug("Item not added to the manifest")
+	}
+}
+
+// resourceForKind returns the group resource of a kind, using the discovery helper.
+func (ib *itemBackupper) resourceForKind(gvk schema.GroupVersionKind) (schema.GroupResource, error) {
+	gvr, _, err := ib.discoveryHelper.KindFor(gvk)
+	if err != nil {
+		return schema.GroupResource{}, err
+	}
+	return gvr.GroupResource(), nil
+}
+
 func getFileForArchive(namespace, name, groupResource, versionPath string, itemBytes []byte) FileForArchive {
 	filePath := archive.GetVersionedItemFilePath("", groupResource, namespace, name, versionPath)
 	hdr := &tar.Header{
@@ -603,7 +652,11 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 				return err
 			}
 		}
-		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc)
+		pods, ns, err := volumehelper.GetPodsAndNamespace(ib.backupRequest.ResPolicies, pv, pvc, nil, ib.kbClient)
+		if err != nil {
+			return err
+		}
+		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc, pods, ns)
 		if action, err := ib.backupRequest.ResPolicies.GetMatchAction(vfd); err != nil {
 			log.WithError(err).Errorf("Error getting matched resource policies for pv %s", pv.Name)
 			return nil
@@ -721,7 +774,11 @@ func (ib *itemBackupper) getMatchAction(obj runtime.Unstructured, groupResource
 		if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: pvName}, pv); err != nil {
 			return nil, errors.WithStack(err)
 		}
-		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc)
+		pods, ns, err := volumehelper.GetPodsAndNamespace(ib.backupRequest.ResPolicies, pv, pvc, nil, ib.kbClient)
+		if err != nil {
+			return nil, err
+		}
+		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc, pods, ns)
 		return ib.backupRequest.ResPolicies.GetMatchAction(vfd)
 	}
 
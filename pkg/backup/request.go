@@ -17,11 +17,15 @@ limitations under the License.
 package backup
 
 import (
+	"sort"
+
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
@@ -52,6 +56,15 @@ type Request struct {
 	SkippedPVTracker          *skipPVTracker
 	VolumesInformation        volume.BackupVolumesInformation
 	ItemBlockChannel          chan ItemBlockInput
+	Manifest                  *manifest.Manifest
+	// ContentIndex records the content hashes of the item files of the backup.
+	ContentIndex *archive.ContentIndex
+	// ParentContentIndex is the content index of the parent of an incremental backup,
+	// the item files with the same content in the parent aren't stored in the backup.
+	ParentContentIndex *archive.ContentIndex
+	// DryRunReport is the report of the items, volumes and hooks of a dry-run backup, it's set
+	// by the backupper.
+	DryRunReport *velerov1api.BackupDryRunReport
 }
 
 // BackupVolumesInformation contains the information needs by generating
@@ -72,6 +85,27 @@ func (r *Request) BackupResourceList() map[string][]string {
 	return r.BackedUpItems.ResourceMap()
 }
 
+// DryRunResult returns the number of items of the dry-run report by resource, along with the
+// limitations of the dry run.
+func (r *Request) DryRunResult() *velerov1api.BackupDryRunResult {
+	result := &velerov1api.BackupDryRunResult{Limitations: dryRunLimitations}
+	if r.DryRunReport == nil {
+		return result
+	}
+
+	counts := map[string]int{}
+	for _, items := range r.DryRunReport.Items {
+		counts[items.Resource] += len(items.Names)
+	}
+	for resource, count := range counts {
+		result.Resources = append(result.Resources, velerov1api.BackupDryRunResourceCount{Resource: resource, Count: count})
+	}
+	sort.Slice(result.Resources, func(i, j int) bool {
+		return result.Resources[i].Resource < result.Resources[j].Resource
+	})
+	return result
+}
+
 func (r *Request) FillVolumesInformation() {
 	skippedPVMap := make(map[string]string)
 
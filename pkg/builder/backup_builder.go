@@ -315,6 +315,30 @@ func (b *BackupBuilder) ParallelFilesUpload(parallel int) *BackupBuilder {
 	return b
 }
 
+// DryRun sets the Backup's dry-run flag.
+func (b *BackupBuilder) DryRun(val bool) *BackupBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
+
+// Incremental sets the Backup's incremental flag.
+func (b *BackupBuilder) Incremental(val bool) *BackupBuilder {
+	b.object.Spec.Incremental = &val
+	return b
+}
+
+// LegalHold sets the Backup's legal hold flag.
+func (b *BackupBuilder) LegalHold(val bool) *BackupBuilder {
+	b.object.Spec.LegalHold = &val
+	return b
+}
+
+// ParentBackup sets the Backup's parent backup.
+func (b *BackupBuilder) ParentBackup(name string) *BackupBuilder {
+	b.object.Status.ParentBackup = name
+	return b
+}
+
 // WithStatus sets the Backup's status.
 func (b *BackupBuilder) WithStatus(status velerov1api.BackupStatus) *BackupBuilder {
 	b.object.Status = status
@@ -128,3 +128,33 @@ func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeyS
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// Encryption sets the BackupStorageLocation's encryption key selector, and the selectors of
+// the keys it was rotated from.
+func (b *BackupStorageLocationBuilder) Encryption(key *corev1api.SecretKeySelector, previousKeys ...corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
+	b.object.Spec.Encryption = &velerov1api.BackupStorageLocationEncryption{
+		KeySecret:          key,
+		PreviousKeySecrets: previousKeys,
+	}
+	return b
+}
+
+// AllowUnencryptedObjects sets whether the objects of the BackupStorageLocation that aren't
+// encrypted are accepted. Encryption must be set first.
+func (b *BackupStorageLocationBuilder) AllowUnencryptedObjects(allow bool) *BackupStorageLocationBuilder {
+	b.object.Spec.Encryption.AllowUnencryptedObjects = allow
+	return b
+}
+
+// MinRetainedSuccessfulBackups sets the BackupStorageLocation's minimum number of retained
+// successful backups.
+func (b *BackupStorageLocationBuilder) MinRetainedSuccessfulBackups(count int) *BackupStorageLocationBuilder {
+	b.object.Spec.MinRetainedSuccessfulBackups = count
+	return b
+}
+
+// DeletionGracePeriod sets the BackupStorageLocation's deletion grace period.
+func (b *BackupStorageLocationBuilder) DeletionGracePeriod(val time.Duration) *BackupStorageLocationBuilder {
+	b.object.Spec.DeletionGracePeriod = &metav1.Duration{Duration: val}
+	return b
+}
@@ -71,6 +71,18 @@ func (b *RestoreBuilder) Schedule(name string) *RestoreBuilder {
 	return b
 }
 
+// RestoreAsOf sets the Restore's restore as of time.
+func (b *RestoreBuilder) RestoreAsOf(val time.Time) *RestoreBuilder {
+	b.object.Spec.RestoreAsOf = &metav1.Time{Time: val}
+	return b
+}
+
+// BackupSelectionPolicy sets the Restore's backup selection policy.
+func (b *RestoreBuilder) BackupSelectionPolicy(policy velerov1api.BackupSelectionPolicy) *RestoreBuilder {
+	b.object.Spec.BackupSelectionPolicy = policy
+	return b
+}
+
 // IncludedNamespaces appends to the Restore's included namespaces.
 func (b *RestoreBuilder) IncludedNamespaces(namespaces ...string) *RestoreBuilder {
 	b.object.Spec.IncludedNamespaces = append(b.object.Spec.IncludedNamespaces, namespaces...)
@@ -101,6 +113,36 @@ func (b *RestoreBuilder) ExistingResourcePolicy(policy string) *RestoreBuilder {
 	return b
 }
 
+// RecreateResources appends to the Restore's resources recreated by the recreate resource policy.
+func (b *RestoreBuilder) RecreateResources(resources ...string) *RestoreBuilder {
+	b.object.Spec.RecreateResources = append(b.object.Spec.RecreateResources, resources...)
+	return b
+}
+
+// ResourceOrdering sets the Restore's resource ordering.
+func (b *RestoreBuilder) ResourceOrdering(ordering velerov1api.RestoreResourceOrdering) *RestoreBuilder {
+	b.object.Spec.ResourceOrdering = ordering
+	return b
+}
+
+// ItemWorkerCount sets the Restore's item worker count.
+func (b *RestoreBuilder) ItemWorkerCount(count int) *RestoreBuilder {
+	b.object.Spec.ItemWorkerCount = count
+	return b
+}
+
+// PreflightPolicy sets the Restore's preflight policy.
+func (b *RestoreBuilder) PreflightPolicy(policy velerov1api.RestorePreflightPolicy) *RestoreBuilder {
+	b.object.Spec.PreflightPolicy = policy
+	return b
+}
+
+// DryRun sets the Restore's dry run flag.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
+
 // IncludeClusterResources sets the Restore's "include cluster resources" flag.
 func (b *RestoreBuilder) IncludeClusterResources(val bool) *RestoreBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -136,6 +178,30 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// NamespaceMappingRules appends rules to the Restore's namespace mapping rules.
+func (b *RestoreBuilder) NamespaceMappingRules(rules ...velerov1api.NamespaceMappingRule) *RestoreBuilder {
+	b.object.Spec.NamespaceMappingRules = append(b.object.Spec.NamespaceMappingRules, rules...)
+	return b
+}
+
+// NameMapping sets the Restore's name mapping.
+func (b *RestoreBuilder) NameMapping(mapping *velerov1api.RestoreNameMapping) *RestoreBuilder {
+	b.object.Spec.NameMapping = mapping
+	return b
+}
+
+// ReadinessCheck sets the Restore's readiness check with the timeout.
+func (b *RestoreBuilder) ReadinessCheck(timeout time.Duration) *RestoreBuilder {
+	b.object.Spec.ReadinessCheck = &velerov1api.RestoreReadinessCheck{Timeout: metav1.Duration{Duration: timeout}}
+	return b
+}
+
+// PreHooks appends to the Restore's pre hooks.
+func (b *RestoreBuilder) PreHooks(specs ...velerov1api.RestorePreHookSpec) *RestoreBuilder {
+	b.object.Spec.Hooks.PreHooks = append(b.object.Spec.Hooks.PreHooks, specs...)
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// RestoreRollbackBuilder builds RestoreRollback objects
+type RestoreRollbackBuilder struct {
+	object *velerov1api.RestoreRollback
+}
+
+// ForRestoreRollback is the constructor for a RestoreRollbackBuilder.
+func ForRestoreRollback(ns, name string) *RestoreRollbackBuilder {
+	return &RestoreRollbackBuilder{
+		object: &velerov1api.RestoreRollback{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "RestoreRollback",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built RestoreRollback.
+func (b *RestoreRollbackBuilder) Result() *velerov1api.RestoreRollback {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the RestoreRollback's ObjectMeta.
+func (b *RestoreRollbackBuilder) ObjectMeta(opts ...ObjectMetaOpt) *RestoreRollbackBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+	return b
+}
+
+// RestoreName sets the RestoreRollback's restore name.
+func (b *RestoreRollbackBuilder) RestoreName(name string) *RestoreRollbackBuilder {
+	b.object.Spec.RestoreName = name
+	return b
+}
+
+// Phase sets the RestoreRollback's phase.
+func (b *RestoreRollbackBuilder) Phase(phase velerov1api.RestoreRollbackPhase) *RestoreRollbackBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// Errors sets the RestoreRollback's errors.
+func (b *RestoreRollbackBuilder) Errors(errors ...string) *RestoreRollbackBuilder {
+	b.object.Status.Errors = errors
+	return b
+}
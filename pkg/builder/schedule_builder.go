@@ -95,3 +95,33 @@ func (b *ScheduleBuilder) SkipImmediately(skip *bool) *ScheduleBuilder {
 	b.object.Spec.SkipImmediately = skip
 	return b
 }
+
+// Retention sets the Schedule's retention policy.
+func (b *ScheduleBuilder) Retention(policy *velerov1api.ScheduleRetentionPolicy) *ScheduleBuilder {
+	b.object.Spec.Retention = policy
+	return b
+}
+
+// MinRetainedSuccessfulBackups sets the Schedule's minimum number of retained successful backups.
+func (b *ScheduleBuilder) MinRetainedSuccessfulBackups(count int) *ScheduleBuilder {
+	b.object.Spec.MinRetainedSuccessfulBackups = count
+	return b
+}
+
+// AllowedWindows sets the Schedule's allowed windows.
+func (b *ScheduleBuilder) AllowedWindows(windows ...velerov1api.ScheduleWindow) *ScheduleBuilder {
+	b.object.Spec.AllowedWindows = append(b.object.Spec.AllowedWindows, windows...)
+	return b
+}
+
+// BlackoutWindows sets the Schedule's blackout windows.
+func (b *ScheduleBuilder) BlackoutWindows(windows ...velerov1api.ScheduleWindow) *ScheduleBuilder {
+	b.object.Spec.BlackoutWindows = append(b.object.Spec.BlackoutWindows, windows...)
+	return b
+}
+
+// BlackoutPolicy sets the Schedule's blackout policy.
+func (b *ScheduleBuilder) BlackoutPolicy(policy velerov1api.ScheduleBlackoutPolicy) *ScheduleBuilder {
+	b.object.Spec.BlackoutPolicy = policy
+	return b
+}
@@ -37,11 +37,15 @@ type DynamicFactory interface {
 	ClientForGroupVersionResource(gv schema.GroupVersion, resource metav1.APIResource, namespace string) (Dynamic, error)
 	// DynamicSharedInformerFactory returns a DynamicSharedInformerFactory.
 	DynamicSharedInformerFactory() dynamicinformer.DynamicSharedInformerFactory
+	// DryRun returns a DynamicFactory whose clients send the requests modifying objects
+	// with server-side dry run, so the objects aren't persisted.
+	DryRun() DynamicFactory
 }
 
 // dynamicFactory implements DynamicFactory.
 type dynamicFactory struct {
 	dynamicClient dynamic.Interface
+	dryRun        bool
 }
 
 // NewDynamicFactory returns a new ClientPool-based dynamic factory.
@@ -50,15 +54,23 @@ func NewDynamicFactory(dynamicClient dynamic.Interface) DynamicFactory {
 }
 
 func (f *dynamicFactory) ClientForGroupVersionResource(gv schema.GroupVersion, resource metav1.APIResource, namespace string) (Dynamic, error) {
-	return &dynamicResourceClient{
+	client := &dynamicResourceClient{
 		resourceClient: f.dynamicClient.Resource(gv.WithResource(resource.Name)).Namespace(namespace),
-	}, nil
+	}
+	if f.dryRun {
+		client.dryRun = []string{metav1.DryRunAll}
+	}
+	return client, nil
 }
 
 func (f *dynamicFactory) DynamicSharedInformerFactory() dynamicinformer.DynamicSharedInformerFactory {
 	return dynamicinformer.NewDynamicSharedInformerFactory(f.dynamicClient, time.Minute)
 }
 
+func (f *dynamicFactory) DryRun() DynamicFactory {
+	return &dynamicFactory{dynamicClient: f.dynamicClient, dryRun: true}
+}
+
 // Creator creates an object.
 type Creator interface {
 	// Create creates an object.
@@ -116,12 +128,14 @@ type Dynamic interface {
 // dynamicResourceClient implements Dynamic.
 type dynamicResourceClient struct {
 	resourceClient dynamic.ResourceInterface
+	// dryRun is set in the options of the requests modifying objects
+	dryRun []string
 }
 
 var _ Dynamic = &dynamicResourceClient{}
 
 func (d *dynamicResourceClient) Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
-	return d.resourceClient.Create(context.TODO(), obj, metav1.CreateOptions{})
+	return d.resourceClient.Create(context.TODO(), obj, metav1.CreateOptions{DryRun: d.dryRun})
 }
 
 func (d *dynamicResourceClient) List(options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
@@ -137,13 +151,19 @@ func (d *dynamicResourceClient) Get(name string, opts metav1.GetOptions) (*unstr
 }
 
 func (d *dynamicResourceClient) Patch(name string, data []byte) (*unstructured.Unstructured, error) {
-	return d.resourceClient.Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{})
+	return d.resourceClient.Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{DryRun: d.dryRun})
 }
 
 func (d *dynamicResourceClient) Delete(name string, opts metav1.DeleteOptions) error {
+	if d.dryRun != nil {
+		opts.DryRun = d.dryRun
+	}
 	return d.resourceClient.Delete(context.TODO(), name, opts)
 }
 
 func (d *dynamicResourceClient) UpdateStatus(obj *unstructured.Unstructured, opts metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	if d.dryRun != nil {
+		opts.DryRun = d.dryRun
+	}
 	return d.resourceClient.UpdateStatus(context.TODO(), obj, opts)
 }
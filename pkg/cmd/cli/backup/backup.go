@@ -36,6 +36,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewVerifyCommand(f),
+		NewHoldCommand(f),
+		NewReleaseCommand(f),
+		NewUndeleteCommand(f),
 	)
 
 	return c
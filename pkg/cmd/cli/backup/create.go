@@ -66,12 +66,16 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero backup create backup3 --snapshot-volumes=false -o yaml
 
   # Wait for a backup to complete before returning from the command.
-  velero backup create backup4 --wait`,
+  velero backup create backup4 --wait
+
+  # Report the items, volumes and hooks a backup would process, without backing up any data.
+  velero backup create backup5 --include-namespaces nginx-example --dry-run --wait`,
 	}
 
 	o.BindFlags(c.Flags())
 	o.BindWait(c.Flags())
 	o.BindFromSchedule(c.Flags())
+	o.BindDryRun(c.Flags())
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
 
@@ -108,6 +112,7 @@ type CreateOptions struct {
 	ResPoliciesConfigmap            string
 	client                          kbclient.WithWatch
 	ParallelFilesUpload             int
+	DryRun                          bool
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -170,6 +175,12 @@ func (o *CreateOptions) BindFromSchedule(flags *pflag.FlagSet) {
 	flags.StringVar(&o.FromSchedule, "from-schedule", "", "Create a backup from the template of an existing schedule. Cannot be used with any other filters. Backup name is optional if used.")
 }
 
+// BindDryRun binds the dry-run flag separately so it is not called by other create
+// commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindDryRun(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only report the items, volumes and hooks the backup would process, without backing up any data, taking snapshots or running hooks.")
+}
+
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
 	if err := output.ValidateFlags(c); err != nil {
 		return err
@@ -422,6 +433,10 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		}
 	}
 
+	if o.DryRun {
+		backupBuilder.DryRun(true)
+	}
+
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data()), builder.WithAnnotationsMap(o.Annotations.Data())).Result()
 	return backup, nil
 }
@@ -110,6 +110,10 @@ func Run(o *cli.DeleteOptions) error {
 			return errors.WithStack(err)
 		}
 		for i := range backupList.Items {
+			// deleted backups are purged once their recovery window passes
+			if backupList.Items[i].Status.Phase == velerov1api.BackupPhaseDeleted {
+				continue
+			}
 			backups = append(backups, &backupList.Items[i])
 		}
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +40,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		details               bool
 		insecureSkipTLSVerify bool
 		outputFormat          = "plaintext"
+		showDeleted           bool
 	)
 
 	config, err := client.LoadConfig()
@@ -71,6 +73,12 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				cmd.CheckError(err)
 				err = kbClient.List(context.Background(), backups, &controllerclient.ListOptions{LabelSelector: parsedSelector, Namespace: f.Namespace()})
 				cmd.CheckError(err)
+
+				if !showDeleted {
+					backups.Items = slices.DeleteFunc(backups.Items, func(backup velerov1api.Backup) bool {
+						return backup.Status.Phase == velerov1api.BackupPhaseDeleted
+					})
+				}
 			}
 
 			first := true
@@ -114,6 +122,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
+	c.Flags().BoolVar(&showDeleted, "show-deleted", showDeleted, "Also describe the deleted backups that can still be undeleted.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections. If not specified, the CA certificate from the BackupStorageLocation will be used if available.")
 	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'plaintext, json'. 'json' only applies to a single backup")
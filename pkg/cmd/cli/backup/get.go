@@ -18,6 +18,7 @@ package backup
 
 import (
 	"context"
+	"slices"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,7 +32,10 @@ import (
 )
 
 func NewGetCommand(f client.Factory, use string) *cobra.Command {
-	var listOptions metav1.ListOptions
+	var (
+		listOptions metav1.ListOptions
+		showDeleted bool
+	)
 
 	c := &cobra.Command{
 		Use:   use,
@@ -59,6 +63,12 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 					Namespace:     f.Namespace(),
 				})
 				cmd.CheckError(err)
+
+				if !showDeleted {
+					backups.Items = slices.DeleteFunc(backups.Items, func(backup api.Backup) bool {
+						return backup.Status.Phase == api.BackupPhaseDeleted
+					})
+				}
 			}
 
 			_, err = output.PrintWithFormat(c, backups)
@@ -67,6 +77,7 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector")
+	c.Flags().BoolVar(&showDeleted, "show-deleted", showDeleted, "Also show the deleted backups that can still be undeleted")
 
 	output.BindFlags(c.Flags())
 
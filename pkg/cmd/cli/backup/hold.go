@@ -0,0 +1,135 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func NewHoldCommand(f client.Factory) *cobra.Command {
+	o := NewHoldOptions(true)
+
+	c := &cobra.Command{
+		Use:   "hold NAME [NAME...]",
+		Short: "Place backups under legal hold",
+		Long: `Place backups under legal hold.
+
+A backup under legal hold isn't deleted, neither when it expires nor when its deletion is requested,
+until the hold is released with "velero backup release".`,
+		Example: `  # Place the backup named "backup-1" under legal hold.
+  velero backup hold backup-1`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	return c
+}
+
+func NewReleaseCommand(f client.Factory) *cobra.Command {
+	o := NewHoldOptions(false)
+
+	c := &cobra.Command{
+		Use:   "release NAME [NAME...]",
+		Short: "Release the legal hold of backups",
+		Long: `Release the legal hold of backups.
+
+Once released, the backups are deleted when they expire or when their deletion is requested again.`,
+		Example: `  # Release the legal hold of the backup named "backup-1".
+  velero backup release backup-1`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	return c
+}
+
+// HoldOptions are the options of the commands placing backups under legal hold and releasing it.
+type HoldOptions struct {
+	Names []string
+	// Hold is whether the backups are placed under legal hold or released.
+	Hold bool
+}
+
+func NewHoldOptions(hold bool) *HoldOptions {
+	return &HoldOptions{Hold: hold}
+}
+
+func (o *HoldOptions) Complete(args []string) error {
+	o.Names = args
+	return nil
+}
+
+func (o *HoldOptions) Run(f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range o.Names {
+		backup := new(velerov1api.Backup)
+		if err := kbClient.Get(context.TODO(), controllerclient.ObjectKey{Namespace: f.Namespace(), Name: name}, backup); err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+
+		if boolptr.IsSetToTrue(backup.Spec.LegalHold) == o.Hold {
+			if o.Hold {
+				fmt.Printf("Backup %s is already under legal hold.\n", name)
+			} else {
+				fmt.Printf("Backup %s isn't under legal hold.\n", name)
+			}
+			continue
+		}
+
+		original := backup.DeepCopy()
+		if o.Hold {
+			backup.Spec.LegalHold = boolptr.True()
+		} else {
+			backup.Spec.LegalHold = nil
+		}
+		if err := kbClient.Patch(context.TODO(), backup, controllerclient.MergeFrom(original)); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error updating the legal hold of backup %s", name))
+			continue
+		}
+
+		if o.Hold {
+			fmt.Printf("Backup %s is placed under legal hold.\n", name)
+		} else {
+			fmt.Printf("Legal hold of backup %s is released.\n", name)
+		}
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestHoldOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		hold     bool
+		backups  []string
+		wantErr  string
+		wantHeld map[string]bool
+	}{
+		{
+			name:     "backups are placed under legal hold",
+			hold:     true,
+			backups:  []string{"backup-1", "backup-held"},
+			wantHeld: map[string]bool{"backup-1": true, "backup-held": true},
+		},
+		{
+			name:     "legal hold of backups is released",
+			backups:  []string{"backup-1", "backup-held"},
+			wantHeld: map[string]bool{"backup-1": false, "backup-held": false},
+		},
+		{
+			name:     "the other backups are updated when a backup doesn't exist",
+			hold:     true,
+			backups:  []string{"backup-missing", "backup-1"},
+			wantErr:  `backups.velero.io "backup-missing" not found`,
+			wantHeld: map[string]bool{"backup-1": true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(),
+				builder.ForBackup(cmdtest.VeleroNameSpace, "backup-held").LegalHold(true).Result(),
+			)
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			o := NewHoldOptions(tc.hold)
+			require.NoError(t, o.Complete(tc.backups))
+
+			err := o.Run(f)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for name, held := range tc.wantHeld {
+				backup := &velerov1api.Backup{}
+				require.NoError(t, kbClient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: name}, backup))
+				assert.Equal(t, held, backup.Spec.LegalHold != nil && *backup.Spec.LegalHold, name)
+			}
+		})
+	}
+}
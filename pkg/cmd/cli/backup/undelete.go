@@ -0,0 +1,98 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewUndeleteCommand(f client.Factory) *cobra.Command {
+	o := NewUndeleteOptions()
+
+	c := &cobra.Command{
+		Use:   "undelete NAME",
+		Short: "Undelete a deleted backup",
+		Long: `Undelete a deleted backup.
+
+A backup whose backup storage location has a deletion grace period is kept in the trash of the location
+when it's deleted, and is only purged once the grace period passes. Until then, the Velero server can
+move it out of the trash. Run "velero backup get --show-deleted" to list the deleted backups.`,
+		Example: `  # Undelete the backup named "backup-1".
+  velero backup undelete backup-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	return c
+}
+
+type UndeleteOptions struct {
+	Name string
+	// now returns the time the undeletion is requested at, it's replaced in tests.
+	now func() time.Time
+}
+
+func NewUndeleteOptions() *UndeleteOptions {
+	return &UndeleteOptions{
+		now: time.Now,
+	}
+}
+
+func (o *UndeleteOptions) Complete(args []string) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *UndeleteOptions) Run(f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	backup := new(velerov1api.Backup)
+	if err := kbClient.Get(context.TODO(), controllerclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}, backup); err != nil {
+		return errors.WithStack(err)
+	}
+	if backup.Status.Phase != velerov1api.BackupPhaseDeleted {
+		return errors.Errorf("backup %s is %s, only Deleted backups can be undeleted", o.Name, backup.Status.Phase)
+	}
+
+	original := backup.DeepCopy()
+	if backup.Annotations == nil {
+		backup.Annotations = map[string]string{}
+	}
+	backup.Annotations[velerov1api.BackupUndeletionRequestedAnnotation] = o.now().UTC().Format(time.RFC3339)
+	if err := kbClient.Patch(context.TODO(), backup, controllerclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error requesting the undeletion of backup %s", o.Name)
+	}
+
+	fmt.Printf("Undeletion of backup %s requested. Run `velero backup describe %s` to see its phase.\n", o.Name, o.Name)
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestUndeleteOptions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-deleted").Phase(velerov1api.BackupPhaseDeleted).Result(),
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-completed").Phase(velerov1api.BackupPhaseCompleted).Result(),
+	)
+	f := &factorymocks.Factory{}
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+	f.On("KubebuilderClient").Return(kbClient, nil)
+
+	tests := []struct {
+		name    string
+		backup  string
+		wantErr string
+	}{
+		{
+			name:   "undeletion of a deleted backup is requested",
+			backup: "backup-deleted",
+		},
+		{
+			name:    "backup that isn't deleted can't be undeleted",
+			backup:  "backup-completed",
+			wantErr: "backup backup-completed is Completed, only Deleted backups can be undeleted",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := NewUndeleteOptions()
+			o.now = func() time.Time { return now }
+			require.NoError(t, o.Complete([]string{tc.backup}))
+
+			err := o.Run(f)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, kbClient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: tc.backup}, backup))
+			assert.Equal(t, now.Format(time.RFC3339), backup.Annotations[velerov1api.BackupUndeletionRequestedAnnotation])
+		})
+	}
+}
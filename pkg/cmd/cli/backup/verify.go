@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewVerifyCommand(f client.Factory) *cobra.Command {
+	o := NewVerifyOptions()
+
+	c := &cobra.Command{
+		Use:   "verify NAME",
+		Short: "Verify a backup is unmodified",
+		Long: `Verify a backup is unmodified.
+
+The Velero server re-downloads the artifacts of the backup from object storage and verifies them against
+the checksum manifest written with the backup. The result is recorded in the status of the backup.`,
+		Example: `  # Verify the backup named "backup-1".
+  velero backup verify backup-1
+
+  # Request the verification of the backup named "backup-1" without waiting for the result.
+  velero backup verify backup-1 --wait=false`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type VerifyOptions struct {
+	Name    string
+	Wait    bool
+	Timeout time.Duration
+	// newRequest returns the unique value identifying the verification request, it's
+	// replaced in tests.
+	newRequest func() string
+}
+
+func NewVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{
+		Wait:       true,
+		Timeout:    30 * time.Minute,
+		newRequest: uuid.NewString,
+	}
+}
+
+func (o *VerifyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Wait, "wait", o.Wait, "Wait for the verification to complete.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the verification to complete.")
+}
+
+func (o *VerifyOptions) Complete(args []string) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *VerifyOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	backup := new(velerov1api.Backup)
+	key := controllerclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}
+	if err := kbClient.Get(context.TODO(), key, backup); err != nil {
+		return errors.WithStack(err)
+	}
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+		return errors.Errorf("backup %s is %s, only Completed and PartiallyFailed backups can be verified", o.Name, backup.Status.Phase)
+	}
+
+	request := o.newRequest()
+	original := backup.DeepCopy()
+	if backup.Annotations == nil {
+		backup.Annotations = map[string]string{}
+	}
+	backup.Annotations[velerov1api.BackupVerificationRequestedAnnotation] = request
+	if err := kbClient.Patch(context.TODO(), backup, controllerclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error requesting the verification of backup %s", o.Name)
+	}
+
+	if !o.Wait {
+		fmt.Printf("Verification of backup %s requested. Run `velero backup describe %s` to see the result.\n", o.Name, o.Name)
+		return nil
+	}
+
+	fmt.Printf("Waiting for the verification of backup %s to complete.\n", o.Name)
+	err = wait.PollUntilContextTimeout(context.TODO(), time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		if err := kbClient.Get(ctx, key, backup); err != nil {
+			return false, errors.WithStack(err)
+		}
+		verification := backup.Status.Verification
+		return verification != nil && verification.Request == request, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for the verification of backup %s", o.Name)
+	}
+
+	verification := backup.Status.Verification
+	switch verification.Phase {
+	case velerov1api.BackupVerificationPhaseVerified:
+		fmt.Printf("Backup %s is verified, all its artifacts match its checksum manifest.\n", o.Name)
+		return nil
+	case velerov1api.BackupVerificationPhaseCorrupted:
+		fmt.Printf("Backup %s is corrupted:\n", o.Name)
+		for _, problem := range verification.Errors {
+			fmt.Printf("\t%s\n", problem)
+		}
+		return errors.Errorf("backup %s is corrupted", o.Name)
+	default:
+		return errors.Errorf("backup %s couldn't be verified: %v", o.Name, verification.Errors)
+	}
+}
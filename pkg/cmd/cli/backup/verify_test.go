@@ -0,0 +1,106 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestVerifyOptions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	verified := func(name string, phase velerov1api.BackupVerificationPhase, errs ...string) *velerov1api.Backup {
+		backup := builder.ForBackup(cmdtest.VeleroNameSpace, name).Phase(velerov1api.BackupPhaseCompleted).Result()
+		backup.Status.Verification = &velerov1api.BackupVerification{
+			Phase:     phase,
+			Timestamp: &metav1.Time{Time: now},
+			Errors:    errs,
+			Request:   "request-1",
+		}
+		return backup
+	}
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		verified("backup-verified", velerov1api.BackupVerificationPhaseVerified),
+		verified("backup-corrupted", velerov1api.BackupVerificationPhaseCorrupted, "backup-corrupted-logs.gz: checksum mismatch"),
+		builder.ForBackup(cmdtest.VeleroNameSpace, "backup-in-progress").Phase(velerov1api.BackupPhaseInProgress).Result(),
+	)
+	f := &factorymocks.Factory{}
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+	f.On("KubebuilderClient").Return(kbClient, nil)
+
+	tests := []struct {
+		name    string
+		backup  string
+		flags   []string
+		wantErr string
+	}{
+		{
+			name:   "verified backup",
+			backup: "backup-verified",
+		},
+		{
+			name:    "corrupted backup",
+			backup:  "backup-corrupted",
+			wantErr: "backup backup-corrupted is corrupted",
+		},
+		{
+			name:    "in progress backup can't be verified",
+			backup:  "backup-in-progress",
+			wantErr: "backup backup-in-progress is InProgress, only Completed and PartiallyFailed backups can be verified",
+		},
+		{
+			name:   "verification is only requested without waiting",
+			backup: "backup-corrupted",
+			flags:  []string{"--wait=false"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := NewVerifyOptions()
+			o.newRequest = func() string { return "request-1" }
+			flags := new(flag.FlagSet)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse(tc.flags))
+			require.NoError(t, o.Complete([]string{tc.backup}))
+
+			err := o.Run(NewVerifyCommand(f), f)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, kbClient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: tc.backup}, backup))
+			assert.Equal(t, "request-1", backup.Annotations[velerov1api.BackupVerificationRequestedAnnotation])
+		})
+	}
+}
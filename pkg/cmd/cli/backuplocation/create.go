@@ -66,6 +66,7 @@ type CreateOptions struct {
 	Provider                              string
 	Bucket                                string
 	Credential                            flag.Map
+	EncryptionKey                         flag.Map
 	DefaultBackupStorageLocation          bool
 	Prefix                                string
 	BackupSyncPeriod, ValidationFrequency time.Duration
@@ -73,13 +74,16 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	MinRetainedSuccessfulBackups          int
+	DeletionGracePeriod                   time.Duration
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Credential: flag.NewMap(),
-		Config:     flag.NewMap(),
-		Labels:     flag.NewMap(),
+		Credential:    flag.NewMap(),
+		EncryptionKey: flag.NewMap(),
+		Config:        flag.NewMap(),
+		Labels:        flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
@@ -92,6 +96,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the backup storage provider (e.g. aws, azure, gcp).")
 	flags.StringVar(&o.Bucket, "bucket", o.Bucket, "Name of the object storage bucket where backups should be stored.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.Var(&o.EncryptionKey, "encryption-key", "The key objects stored in this location are encrypted with, as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
@@ -99,6 +104,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup storage location.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
+	flags.IntVar(&o.MinRetainedSuccessfulBackups, "min-retained-successful-backups", o.MinRetainedSuccessfulBackups, "Number of the newest successful backups of this location that aren't garbage-collected when they expire. Optional.")
+	flags.DurationVar(&o.DeletionGracePeriod, "deletion-grace-period", o.DeletionGracePeriod, "How long the deleted backups of this location are kept in its trash, during which they can be undeleted, before they're purged. Optional. Default 0s, backups are deleted immediately.")
 	flags.Var(
 		o.AccessMode,
 		"access-mode",
@@ -123,10 +130,22 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--backup-sync-period must be non-negative")
 	}
 
+	if o.MinRetainedSuccessfulBackups < 0 {
+		return errors.New("--min-retained-successful-backups must be non-negative")
+	}
+
+	if o.DeletionGracePeriod < 0 {
+		return errors.New("--deletion-grace-period must be non-negative")
+	}
+
 	if len(o.Credential.Data()) > 1 {
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if len(o.EncryptionKey.Data()) > 1 {
+		return errors.New("--encryption-key can only contain 1 key/value pair")
+	}
+
 	return nil
 }
 
@@ -163,9 +182,10 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 					CACert: caCertData,
 				},
 			},
-			Config:     o.Config.Data(),
-			Default:    o.DefaultBackupStorageLocation,
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:                       o.Config.Data(),
+			Default:                      o.DefaultBackupStorageLocation,
+			AccessMode:                   velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			MinRetainedSuccessfulBackups: o.MinRetainedSuccessfulBackups,
 		},
 	}
 
@@ -177,11 +197,22 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		backupStorageLocation.Spec.ValidationFrequency = &metav1.Duration{Duration: o.ValidationFrequency}
 	}
 
+	if o.DeletionGracePeriod > 0 {
+		backupStorageLocation.Spec.DeletionGracePeriod = &metav1.Duration{Duration: o.DeletionGracePeriod}
+	}
+
 	for secretName, secretKey := range o.Credential.Data() {
 		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
 		break
 	}
 
+	for secretName, secretKey := range o.EncryptionKey.Data() {
+		backupStorageLocation.Spec.Encryption = &velerov1api.BackupStorageLocationEncryption{
+			KeySecret: builder.ForSecretKeySelector(secretName, secretKey).Result(),
+		}
+		break
+	}
+
 	return backupStorageLocation, nil
 }
 
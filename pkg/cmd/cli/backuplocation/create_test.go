@@ -30,6 +30,7 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -87,6 +88,25 @@ func TestBuildBackupStorageLocationSetsCredential(t *testing.T) {
 	}, bsl.Spec.Credential)
 }
 
+func TestBuildBackupStorageLocationSetsEncryptionKey(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	require.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Encryption)
+
+	require.NoError(t, o.EncryptionKey.Set("encryption=key-1"))
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupStorageLocationEncryption{
+		KeySecret: &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: "encryption"},
+			Key:                  "key-1",
+		},
+	}, bsl.Spec.Encryption)
+}
+
 func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	o := NewCreateOptions()
 
@@ -21,10 +21,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -60,20 +64,29 @@ type SetOptions struct {
 	Name                         string
 	CACertFile                   string
 	Credential                   flag.Map
+	EncryptionKey                flag.Map
+	AllowUnencryptedObjects      bool
 	DefaultBackupStorageLocation flag.OptionalBool
+	MinRetainedSuccessfulBackups int
+	DeletionGracePeriod          time.Duration
 }
 
 func NewSetOptions() *SetOptions {
 	return &SetOptions{
-		Credential: flag.NewMap(),
+		Credential:    flag.NewMap(),
+		EncryptionKey: flag.NewMap(),
 	}
 }
 
 func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.Var(&o.EncryptionKey, "encryption-key", "Sets the key objects stored in this location are encrypted with, as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. The previous key is kept to decrypt the objects encrypted with it. Optional, one value only.")
+	flags.BoolVar(&o.AllowUnencryptedObjects, "allow-unencrypted-objects", o.AllowUnencryptedObjects, "Sets whether the objects of this encrypted location that aren't encrypted, e.g. the ones stored before encryption was enabled, are accepted. Only enable it while migrating the location to encryption. Optional.")
 	f := flags.VarPF(&o.DefaultBackupStorageLocation, "default", "", "Sets this new location to be the new default backup storage location. Optional.")
 	f.NoOptDefVal = cmd.TRUE
+	flags.IntVar(&o.MinRetainedSuccessfulBackups, "min-retained-successful-backups", o.MinRetainedSuccessfulBackups, "Sets the number of the newest successful backups of this location that aren't garbage-collected when they expire. Optional.")
+	flags.DurationVar(&o.DeletionGracePeriod, "deletion-grace-period", o.DeletionGracePeriod, "Sets how long the deleted backups of this location are kept in its trash, during which they can be undeleted, before they're purged. Set this to `0s` to delete backups immediately. Optional.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -81,6 +94,18 @@ func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory)
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if len(o.EncryptionKey.Data()) > 1 {
+		return errors.New("--encryption-key can only contain 1 key/value pair")
+	}
+
+	if o.MinRetainedSuccessfulBackups < 0 {
+		return errors.New("--min-retained-successful-backups must be non-negative")
+	}
+
+	if o.DeletionGracePeriod < 0 {
+		return errors.New("--deletion-grace-period must be non-negative")
+	}
+
 	return nil
 }
 
@@ -148,6 +173,29 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	for name, key := range o.EncryptionKey.Data() {
+		rotateEncryptionKey(location, builder.ForSecretKeySelector(name, key).Result())
+		break
+	}
+
+	if c.Flags().Changed("allow-unencrypted-objects") {
+		if location.Spec.Encryption == nil {
+			return errors.New("--allow-unencrypted-objects requires the location to have an encryption key")
+		}
+		location.Spec.Encryption.AllowUnencryptedObjects = o.AllowUnencryptedObjects
+	}
+
+	if c.Flags().Changed("min-retained-successful-backups") {
+		location.Spec.MinRetainedSuccessfulBackups = o.MinRetainedSuccessfulBackups
+	}
+
+	if c.Flags().Changed("deletion-grace-period") {
+		location.Spec.DeletionGracePeriod = nil
+		if o.DeletionGracePeriod > 0 {
+			location.Spec.DeletionGracePeriod = &metav1.Duration{Duration: o.DeletionGracePeriod}
+		}
+	}
+
 	if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -155,3 +203,33 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup storage location %q configured successfully.\n", o.Name)
 	return nil
 }
+
+// rotateEncryptionKey sets the key the objects of the location are encrypted with, and keeps the
+// previous key to decrypt the objects encrypted with it.
+func rotateEncryptionKey(location *velerov1api.BackupStorageLocation, key *corev1api.SecretKeySelector) {
+	encryption := location.Spec.Encryption
+	if encryption == nil {
+		location.Spec.Encryption = &velerov1api.BackupStorageLocationEncryption{KeySecret: key}
+		return
+	}
+
+	var previous []corev1api.SecretKeySelector
+	if encryption.KeySecret != nil {
+		previous = append(previous, *encryption.KeySecret)
+	}
+	previous = append(previous, encryption.PreviousKeySecrets...)
+
+	encryption.KeySecret = key
+	encryption.PreviousKeySecrets = nil
+	for _, selector := range previous {
+		if selector.Name == key.Name && selector.Key == key.Key {
+			continue
+		}
+		if slices.ContainsFunc(encryption.PreviousKeySecrets, func(s corev1api.SecretKeySelector) bool {
+			return s.Name == selector.Name && s.Key == selector.Key
+		}) {
+			continue
+		}
+		encryption.PreviousKeySecrets = append(encryption.PreviousKeySecrets, selector)
+	}
+}
@@ -27,7 +27,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
@@ -109,3 +112,27 @@ func TestSetCommand_Execute(t *testing.T) {
 	}
 	t.Fatalf("process ran with err %v, want backup delete successfully", err)
 }
+
+func TestRotateEncryptionKey(t *testing.T) {
+	key := func(name string) *corev1api.SecretKeySelector {
+		return builder.ForSecretKeySelector("encryption", name).Result()
+	}
+
+	location := builder.ForBackupStorageLocation("velero", "default").Result()
+	rotateEncryptionKey(location, key("key-1"))
+	assert.Equal(t, &velerov1api.BackupStorageLocationEncryption{KeySecret: key("key-1")}, location.Spec.Encryption)
+
+	rotateEncryptionKey(location, key("key-2"))
+	rotateEncryptionKey(location, key("key-3"))
+	assert.Equal(t, &velerov1api.BackupStorageLocationEncryption{
+		KeySecret:          key("key-3"),
+		PreviousKeySecrets: []corev1api.SecretKeySelector{*key("key-2"), *key("key-1")},
+	}, location.Spec.Encryption)
+
+	// rotating back to a previous key doesn't duplicate it
+	rotateEncryptionKey(location, key("key-1"))
+	assert.Equal(t, &velerov1api.BackupStorageLocationEncryption{
+		KeySecret:          key("key-1"),
+		PreviousKeySecrets: []corev1api.SecretKeySelector{*key("key-3"), *key("key-2")},
+	}, location.Spec.Encryption)
+}
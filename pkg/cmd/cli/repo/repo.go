@@ -31,6 +31,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 
 	c.AddCommand(
 		NewGetCommand(f, "get"),
+		NewRotateKeyCommand(f),
 	)
 
 	return c
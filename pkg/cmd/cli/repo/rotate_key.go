@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewRotateKeyCommand(f client.Factory) *cobra.Command {
+	o := NewRotateKeyOptions()
+
+	c := &cobra.Command{
+		Use:   "rotate-key NAME",
+		Short: "Rotate the key of a backup repository",
+		Long: `Rotate the key of a backup repository.
+
+The Velero server generates a new random key for the repository, stores it in the Secret holding the keys
+of the repository, escrows it in the backup storage location, and changes the password of the repository to
+the new key. The snapshots in the repository stay readable, and the previous keys are kept. The rotation is
+postponed while pod volume backups or restores, data movements or maintenance jobs of the repository are in progress.
+Only the keys of kopia repositories can be rotated, the restic repositories always use the shared key.`,
+		Example: `  # Rotate the key of the backup repository named "ns-1-default-kopia-abcde".
+  velero repo rotate-key ns-1-default-kopia-abcde
+
+  # Request the rotation without waiting for it to complete.
+  velero repo rotate-key ns-1-default-kopia-abcde --wait=false`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type RotateKeyOptions struct {
+	Name    string
+	Wait    bool
+	Timeout time.Duration
+	// now returns the time the rotation is requested at, it's replaced in tests.
+	now func() time.Time
+}
+
+func NewRotateKeyOptions() *RotateKeyOptions {
+	return &RotateKeyOptions{
+		Wait:    true,
+		Timeout: 10 * time.Minute,
+		now:     time.Now,
+	}
+}
+
+func (o *RotateKeyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Wait, "wait", o.Wait, "Wait for the rotation to complete.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the rotation to complete.")
+}
+
+func (o *RotateKeyOptions) Complete(args []string) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *RotateKeyOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	repo := new(velerov1api.BackupRepository)
+	key := controllerclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}
+	if err := kbClient.Get(context.TODO(), key, repo); err != nil {
+		return errors.WithStack(err)
+	}
+	if repo.Spec.RepositoryType != velerov1api.BackupRepositoryTypeKopia {
+		return errors.Errorf("backup repository %s is not a kopia repository, only the keys of kopia repositories can be rotated", o.Name)
+	}
+
+	requested := o.now().UTC().Truncate(time.Second)
+	original := repo.DeepCopy()
+	if repo.Annotations == nil {
+		repo.Annotations = map[string]string{}
+	}
+	repo.Annotations[velerov1api.RepositoryKeyRotationRequestedAnnotation] = requested.Format(time.RFC3339)
+	if err := kbClient.Patch(context.TODO(), repo, controllerclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error requesting the key rotation of backup repository %s", o.Name)
+	}
+
+	if !o.Wait {
+		fmt.Printf("Key rotation of backup repository %s requested.\n", o.Name)
+		return nil
+	}
+
+	fmt.Printf("Waiting for the key rotation of backup repository %s to complete.\n", o.Name)
+	err = wait.PollUntilContextTimeout(context.TODO(), time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		if err := kbClient.Get(ctx, key, repo); err != nil {
+			return false, errors.WithStack(err)
+		}
+		rotated := repo.Status.LastKeyRotationTime
+		return repo.Status.PendingRepositoryKeySecret == nil && rotated != nil && !rotated.Time.Before(requested), nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for the key rotation of backup repository %s", o.Name)
+	}
+
+	fmt.Printf("Key of backup repository %s rotated, the repository uses key %s of secret %s.\n",
+		o.Name, repo.Spec.RepositoryKeySecret.Key, repo.Spec.RepositoryKeySecret.Name)
+	return nil
+}
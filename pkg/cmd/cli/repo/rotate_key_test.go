@@ -0,0 +1,102 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRotateKeyOptions(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := func(name, repoType string) *velerov1api.BackupRepository {
+		return &velerov1api.BackupRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: cmdtest.VeleroNameSpace, Name: name},
+			Spec: velerov1api.BackupRepositorySpec{
+				RepositoryType:      repoType,
+				RepositoryKeySecret: builder.ForSecretKeySelector("velero-repo-key-"+name, "repository-password-abcde").Result(),
+			},
+			Status: velerov1api.BackupRepositoryStatus{
+				LastKeyRotationTime: &metav1.Time{Time: now.Add(time.Second)},
+			},
+		}
+	}
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		repo("kopia-repo", velerov1api.BackupRepositoryTypeKopia),
+		repo("restic-repo", velerov1api.BackupRepositoryTypeRestic),
+	)
+	f := &factorymocks.Factory{}
+	f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+	f.On("KubebuilderClient").Return(kbClient, nil)
+
+	tests := []struct {
+		name    string
+		repo    string
+		flags   []string
+		wantErr string
+	}{
+		{
+			name: "kopia repository",
+			repo: "kopia-repo",
+		},
+		{
+			name:  "rotation is only requested without waiting",
+			repo:  "kopia-repo",
+			flags: []string{"--wait=false"},
+		},
+		{
+			name:    "restic repository",
+			repo:    "restic-repo",
+			wantErr: "backup repository restic-repo is not a kopia repository, only the keys of kopia repositories can be rotated",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			o := NewRotateKeyOptions()
+			o.now = func() time.Time { return now }
+			flags := new(flag.FlagSet)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse(tc.flags))
+			require.NoError(t, o.Complete([]string{tc.repo}))
+
+			err := o.Run(NewRotateKeyCommand(f), f)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			repo := &velerov1api.BackupRepository{}
+			require.NoError(t, kbClient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: tc.repo}, repo))
+			assert.Equal(t, now.Format(time.RFC3339), repo.Annotations[velerov1api.RepositoryKeyRotationRequestedAnnotation])
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -60,8 +61,26 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   # Create a restore from the latest successful OR partially-failed backup triggered by schedule "schedule-1".
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
+  # Create a restore from the latest backup triggered by schedule "schedule-1" before 14:00 UTC, preferring the successful backups.
+  velero restore create --from-schedule schedule-1 --restore-as-of 2024-06-01T14:00:00Z --backup-selection-policy PreferCompleted
+
+  # Create a restore from backup "backup-1" that restores the namespaces prefixed with "team-" into namespaces suffixed with "-dr".
+  velero restore create --from-backup backup-1 --namespace-mapping-rules 'team-*:team-*-dr'
+
+  # Create a restore from backup "backup-1" that clones the items of namespace "app-1" inside the same namespace, with names suffixed with "-clone".
+  velero restore create --from-backup backup-1 --include-namespaces app-1 --name-suffix=-clone --name-mapping-label-keys app
+
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Create a restore from backup "backup-1" that fails if the restored workloads are not ready within 15 minutes.
+  velero restore create --from-backup backup-1 --readiness-check --readiness-timeout 15m --wait
+
+  # Create a restore from backup "backup-1" that fails its validation, before restoring any item, if the cluster doesn't serve the APIs or the storage classes of the backup.
+  velero restore create --from-backup backup-1 --preflight-policy Enforce
+
+  # Report what a restore from backup "backup-1" would do to the items of the backup, without restoring them.
+  velero restore create --from-backup backup-1 --dry-run --wait`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -80,6 +99,8 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 type CreateOptions struct {
 	BackupName                string
 	ScheduleName              string
+	RestoreAsOf               string
+	BackupSelectionPolicy     string
 	RestoreName               string
 	RestoreVolumes            flag.OptionalBool
 	PreserveNodePorts         flag.OptionalBool
@@ -88,11 +109,19 @@ type CreateOptions struct {
 	IncludeNamespaces         flag.StringArray
 	ExcludeNamespaces         flag.StringArray
 	ExistingResourcePolicy    string
+	RecreateResources         flag.StringArray
+	ResourceOrdering          string
 	IncludeResources          flag.StringArray
 	ExcludeResources          flag.StringArray
 	StatusIncludeResources    flag.StringArray
 	StatusExcludeResources    flag.StringArray
 	NamespaceMappings         flag.Map
+	NamespaceMappingRules     []string
+	NameMappings              flag.Map
+	NamePrefix                string
+	NameSuffix                string
+	NameMappingResources      flag.StringArray
+	NameMappingLabelKeys      flag.StringArray
 	Selector                  flag.LabelSelector
 	OrSelector                flag.OrLabelSelector
 	IncludeClusterResources   flag.OptionalBool
@@ -102,6 +131,11 @@ type CreateOptions struct {
 	ResourceModifierConfigMap string
 	WriteSparseFiles          flag.OptionalBool
 	ParallelFilesDownload     int
+	ItemWorkerCount           int
+	DryRun                    bool
+	ReadinessCheck            bool
+	ReadinessTimeout          time.Duration
+	PreflightPolicy           string
 	client                    kbclient.WithWatch
 }
 
@@ -111,6 +145,7 @@ func NewCreateOptions() *CreateOptions {
 		Annotations:             flag.NewMap(),
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		NameMappings:            flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		PreserveNodePorts:       flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
@@ -121,14 +156,24 @@ func NewCreateOptions() *CreateOptions {
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.BackupName, "from-backup", "", "Backup to restore from")
 	flags.StringVar(&o.ScheduleName, "from-schedule", "", "Schedule to restore from")
+	flags.StringVar(&o.RestoreAsOf, "restore-as-of", "", "If using --from-schedule, restore from the most recent backup of the schedule completed at or before this time, in RFC3339 format, such as 2024-06-01T14:00:00Z.")
+	flags.StringVar(&o.BackupSelectionPolicy, "backup-selection-policy", "", "If using --from-schedule, which backups of the schedule can be restored from, can be - Completed, PreferCompleted or AllowPartiallyFailed. PreferCompleted falls back to the most recent PartiallyFailed backup if there is no Completed backup.")
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
 	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.StringArrayVar(&o.NamespaceMappingRules, "namespace-mapping-rules", nil, "Namespace mapping rules in the form src:dst, evaluated in order for the namespaces not in --namespace-mappings. The src is a namespace name with a single '*' wildcard, such as team-*:team-*-dr, or a regular expression starting with '^', such as '^(.*)$:$1-restored'. Can be specified multiple times.")
+	flags.Var(&o.NameMappings, "name-mappings", "Name mappings from item name in the backup to desired restored name in the form src1:dst1,src2:dst2,... The names that aren't mapped are renamed with --name-prefix and --name-suffix, if specified.")
+	flags.StringVar(&o.NamePrefix, "name-prefix", "", "Prefix to add to the names of the restored items.")
+	flags.StringVar(&o.NameSuffix, "name-suffix", "", "Suffix to add to the names of the restored items.")
+	flags.Var(&o.NameMappingResources, "name-mapping-resources", "Resources whose items are renamed, formatted as resource.group, such as deployments.apps (use '*' for all resources, including cluster-scoped ones). Defaults to all namespaced resources.")
+	flags.Var(&o.NameMappingLabelKeys, "name-mapping-label-keys", "Keys of the labels whose values are renamed along with the items, such as app, so that the selectors keep selecting the renamed items.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
 	flags.Var(&o.Annotations, "annotations", "Annotations to apply to the restore.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
-	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none or update")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none, update or recreate")
+	flags.Var(&o.RecreateResources, "recreate-resources", "Resources whose existing items that differ from the backup are deleted and recreated by the recreate existing resource policy, formatted as resource.group, such as deployments.apps.")
+	flags.StringVar(&o.ResourceOrdering, "resource-ordering", "", "How the order in which resources are restored is computed, can be - Priority or DependencyGraph. Priority follows the restore resource priorities of the server, DependencyGraph computes the order from the references between the items in the backup.")
 	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources to include in the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources to exclude from the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
@@ -158,6 +203,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.IntVar(&o.ParallelFilesDownload, "parallel-files-download", 0, "The number of restore operations to run in parallel. If set to 0, the default parallelism will be the number of CPUs for the node that node agent pod is running.")
+	flags.IntVar(&o.ItemWorkerCount, "item-worker-count", 0, "The number of items of a resource to restore in parallel. If set to 0, the item worker count of the Velero server is used.")
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only report what the restore would do to the items of the backup, sending the creates and patches with server-side dry run, without restoring volume data or running hooks.")
+	flags.BoolVar(&o.ReadinessCheck, "readiness-check", o.ReadinessCheck, "Wait for the restored Deployments, StatefulSets, DaemonSets, Jobs and PersistentVolumeClaims to become ready before completing the restore. The restore is PartiallyFailed if they're not ready within the readiness timeout.")
+	flags.DurationVar(&o.ReadinessTimeout, "readiness-timeout", o.ReadinessTimeout, "How long to wait for the restored workloads to become ready with --readiness-check. If set to 0, the default timeout of 10 minutes is used.")
+	flags.StringVar(&o.PreflightPolicy, "preflight-policy", "", "Check the cluster before restoring any item, can be - Warn or Enforce. Warn reports the failed checks as warnings, Enforce fails the validation of the restore if the APIs or the storage classes of the backup are missing. The checks are skipped if not set.")
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -204,13 +254,67 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	}
 
 	if len(o.ExistingResourcePolicy) > 0 && !restore.IsResourcePolicyValid(o.ExistingResourcePolicy) {
-		return errors.New("existing-resource-policy has invalid value, it accepts only none, update as value")
+		return errors.New("existing-resource-policy has invalid value, it accepts only none, update, recreate as value")
+	}
+
+	if o.ExistingResourcePolicy == string(api.PolicyTypeRecreate) && len(o.RecreateResources) == 0 {
+		return errors.New("recreate-resources must be specified when existing-resource-policy is recreate")
+	}
+
+	if o.ScheduleName == "" && (o.RestoreAsOf != "" || o.BackupSelectionPolicy != "") {
+		return errors.New("restore-as-of and backup-selection-policy can only be specified with from-schedule")
+	}
+
+	if o.RestoreAsOf != "" {
+		if _, err := time.Parse(time.RFC3339, o.RestoreAsOf); err != nil {
+			return errors.Wrap(err, "restore-as-of must be in RFC3339 format")
+		}
+	}
+
+	if len(o.BackupSelectionPolicy) > 0 && !restore.IsBackupSelectionPolicyValid(o.BackupSelectionPolicy) {
+		return errors.New("backup-selection-policy has invalid value, it accepts only Completed, PreferCompleted, AllowPartiallyFailed as value")
+	}
+
+	if boolptr.IsSetToTrue(o.AllowPartiallyFailed.Value) && o.BackupSelectionPolicy != "" {
+		return errors.New("either allow-partially-failed or backup-selection-policy can be specified, but not both")
+	}
+
+	namespaceMappingRules, err := parseNamespaceMappingRules(o.NamespaceMappingRules)
+	if err != nil {
+		return err
+	}
+	if errs := restore.ValidateNamespaceMappingRules(namespaceMappingRules); len(errs) > 0 {
+		return errs[0]
+	}
+
+	if errs := restore.ValidateNameMapping(o.nameMapping()); len(errs) > 0 {
+		return errs[0]
+	}
+
+	if len(o.ResourceOrdering) > 0 && !restore.IsResourceOrderingValid(o.ResourceOrdering) {
+		return errors.New("resource-ordering has invalid value, it accepts only Priority, DependencyGraph as value")
+	}
+
+	if len(o.PreflightPolicy) > 0 && !restore.IsPreflightPolicyValid(o.PreflightPolicy) {
+		return errors.New("preflight-policy has invalid value, it accepts only Warn, Enforce as value")
 	}
 
 	if o.ParallelFilesDownload < 0 {
 		return errors.New("parallel-files-download cannot be negative")
 	}
 
+	if o.ItemWorkerCount < 0 {
+		return errors.New("item-worker-count cannot be negative")
+	}
+
+	if o.ReadinessTimeout < 0 {
+		return errors.New("readiness-timeout cannot be negative")
+	}
+
+	if o.ReadinessTimeout > 0 && !o.ReadinessCheck {
+		return errors.New("readiness-timeout can only be specified with readiness-check")
+	}
+
 	switch {
 	case o.BackupName != "":
 		backup := new(api.Backup)
@@ -234,6 +338,35 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	return nil
 }
 
+// parseNamespaceMappingRules parses the namespace mapping rules in the form src:dst. The target
+// namespace names can't contain ':', so the rules are split on the last one.
+func parseNamespaceMappingRules(rules []string) ([]api.NamespaceMappingRule, error) {
+	var res []api.NamespaceMappingRule
+	for _, rule := range rules {
+		i := strings.LastIndex(rule, ":")
+		if i <= 0 || i == len(rule)-1 {
+			return nil, errors.Errorf("namespace-mapping-rules has invalid value %q, it must be in the form src:dst", rule)
+		}
+		res = append(res, api.NamespaceMappingRule{Source: rule[:i], Target: rule[i+1:]})
+	}
+	return res, nil
+}
+
+// nameMapping returns the name mapping of the restore, nil if no name mapping flag is specified.
+func (o *CreateOptions) nameMapping() *api.RestoreNameMapping {
+	if len(o.NameMappings.Data()) == 0 && o.NamePrefix == "" && o.NameSuffix == "" &&
+		len(o.NameMappingResources) == 0 && len(o.NameMappingLabelKeys) == 0 {
+		return nil
+	}
+	return &api.RestoreNameMapping{
+		IncludedResources: o.NameMappingResources,
+		Names:             o.NameMappings.Data(),
+		Prefix:            o.NamePrefix,
+		Suffix:            o.NameSuffix,
+		LabelKeys:         o.NameMappingLabelKeys,
+	}
+}
+
 // mostRecentBackup returns the backup with the most recent start timestamp that has a phase that's
 // in the provided list of allowed phases.
 func mostRecentBackup(backups []api.Backup, allowedPhases ...api.BackupPhase) *api.Backup {
@@ -276,10 +409,30 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return errors.New("Velero client is not set; unable to proceed")
 	}
 
+	var restoreAsOf *metav1.Time
+	if o.RestoreAsOf != "" {
+		asOf, err := time.Parse(time.RFC3339, o.RestoreAsOf)
+		if err != nil {
+			return errors.Wrap(err, "restore-as-of must be in RFC3339 format")
+		}
+		restoreAsOf = &metav1.Time{Time: asOf}
+	}
+
+	namespaceMappingRules, err := parseNamespaceMappingRules(o.NamespaceMappingRules)
+	if err != nil {
+		return err
+	}
+
+	backupSelectionPolicy := api.BackupSelectionPolicy(o.BackupSelectionPolicy)
+	if restoreAsOf != nil && boolptr.IsSetToTrue(o.AllowPartiallyFailed.Value) {
+		// the Velero server selects the backup completed at or before the time
+		backupSelectionPolicy = api.BackupSelectionPolicyAllowPartiallyFailed
+	}
+
 	// if --allow-partially-failed was specified, look up the most recent Completed or
 	// PartiallyFailed backup for the provided schedule, and use that specific backup
 	// to restore from.
-	if o.ScheduleName != "" && boolptr.IsSetToTrue(o.AllowPartiallyFailed.Value) {
+	if o.ScheduleName != "" && restoreAsOf == nil && boolptr.IsSetToTrue(o.AllowPartiallyFailed.Value) {
 		backupList := new(api.BackupList)
 		err := o.client.List(context.TODO(), backupList, &kbclient.ListOptions{
 			LabelSelector: labels.SelectorFromSet(map[string]string{api.ScheduleNameLabel: o.ScheduleName}),
@@ -320,18 +473,26 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		Spec: api.RestoreSpec{
 			BackupName:              o.BackupName,
 			ScheduleName:            o.ScheduleName,
+			RestoreAsOf:             restoreAsOf,
+			BackupSelectionPolicy:   backupSelectionPolicy,
 			IncludedNamespaces:      o.IncludeNamespaces,
 			ExcludedNamespaces:      o.ExcludeNamespaces,
 			IncludedResources:       o.IncludeResources,
 			ExcludedResources:       o.ExcludeResources,
 			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
+			RecreateResources:       o.RecreateResources,
+			ResourceOrdering:        api.RestoreResourceOrdering(o.ResourceOrdering),
+			PreflightPolicy:         api.RestorePreflightPolicy(o.PreflightPolicy),
 			NamespaceMapping:        o.NamespaceMappings.Data(),
+			NamespaceMappingRules:   namespaceMappingRules,
+			NameMapping:             o.nameMapping(),
 			LabelSelector:           o.Selector.LabelSelector,
 			OrLabelSelectors:        o.OrSelector.OrLabelSelectors,
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
 			ResourceModifier:        resModifiers,
+			ItemWorkerCount:         o.ItemWorkerCount,
 			ItemOperationTimeout: metav1.Duration{
 				Duration: o.ItemOperationTimeout,
 			},
@@ -342,6 +503,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 	}
 
+	if o.DryRun {
+		restore.Spec.DryRun = boolptr.True()
+	}
+
+	if o.ReadinessCheck {
+		restore.Spec.ReadinessCheck = &api.RestoreReadinessCheck{Timeout: metav1.Duration{Duration: o.ReadinessTimeout}}
+	}
+
 	if len([]string(o.StatusIncludeResources)) > 0 {
 		restore.Spec.RestoreStatus = &api.RestoreStatusSpec{
 			IncludedResources: o.StatusIncludeResources,
@@ -397,7 +566,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		go restoreInformer.Run(stop)
 	}
 
-	err := o.client.Create(context.TODO(), restore, &kbclient.CreateOptions{})
+	err = o.client.Create(context.TODO(), restore, &kbclient.CreateOptions{})
 	if err != nil {
 		return err
 	}
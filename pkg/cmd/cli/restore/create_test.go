@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -68,6 +69,7 @@ func TestCreateCommand(t *testing.T) {
 		includeNamespaces := "app1,app2"
 		excludeNamespaces := "pod1,pod2,pod3"
 		existingResourcePolicy := "none"
+		recreateResources := "deployments.apps,statefulsets.apps"
 		includeResources := "sc,sts"
 		excludeResources := "job"
 		statusIncludeResources := "sc,sts"
@@ -79,6 +81,7 @@ func TestCreateCommand(t *testing.T) {
 		itemOperationTimeout := "10m0s"
 		writeSparseFiles := "true"
 		parallel := 2
+		itemWorkerCount := 4
 		flags := new(pflag.FlagSet)
 		o := NewCreateOptions()
 		o.BindFlags(flags)
@@ -90,6 +93,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--labels", labels})
 		flags.Parse([]string{"--annotations", annotations})
 		flags.Parse([]string{"--existing-resource-policy", existingResourcePolicy})
+		flags.Parse([]string{"--recreate-resources", recreateResources})
 		flags.Parse([]string{"--include-namespaces", includeNamespaces})
 		flags.Parse([]string{"--exclude-namespaces", excludeNamespaces})
 		flags.Parse([]string{"--include-resources", includeResources})
@@ -103,6 +107,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--item-operation-timeout", itemOperationTimeout})
 		flags.Parse([]string{"--write-sparse-files", writeSparseFiles})
 		flags.Parse([]string{"--parallel-files-download", "2"})
+		flags.Parse([]string{"--item-worker-count", "4"})
 		client := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
 
 		f.On("Namespace").Return(mock.Anything)
@@ -129,6 +134,7 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, includeNamespaces, o.IncludeNamespaces.String())
 		require.Equal(t, excludeNamespaces, o.ExcludeNamespaces.String())
 		require.Equal(t, existingResourcePolicy, o.ExistingResourcePolicy)
+		require.Equal(t, recreateResources, o.RecreateResources.String())
 		require.Equal(t, includeResources, o.IncludeResources.String())
 		require.Equal(t, excludeResources, o.ExcludeResources.String())
 
@@ -141,6 +147,7 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, itemOperationTimeout, o.ItemOperationTimeout.String())
 		require.Equal(t, writeSparseFiles, o.WriteSparseFiles.String())
 		require.Equal(t, parallel, o.ParallelFilesDownload)
+		require.Equal(t, itemWorkerCount, o.ItemWorkerCount)
 	})
 
 	t.Run("create a restore from schedule", func(t *testing.T) {
@@ -189,4 +196,254 @@ func TestCreateCommand(t *testing.T) {
 		err := o.Validate(c, []string{}, f)
 		require.Equal(t, "backups.velero.io \"not-exist\" not found", err.Error())
 	})
+
+	t.Run("create a restore from schedule as of a time", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		fromSchedule := "schedule-name-1"
+		require.NoError(t, flags.Parse([]string{"--from-schedule", fromSchedule, "--restore-as-of", "2024-06-01T14:00:00Z", "--allow-partially-failed"}))
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		schedule := builder.ForSchedule(cmdtest.VeleroNameSpace, fromSchedule).Result()
+		backup := builder.ForBackup(cmdtest.VeleroNameSpace, "test-backup").FromSchedule(schedule).Phase(velerov1api.BackupPhasePartiallyFailed).Result()
+		require.NoError(t, kbclient.Create(t.Context(), backup, &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.NoError(t, o.Validate(c, []string{}, f))
+		require.NoError(t, o.Run(c, f))
+
+		restore := new(velerov1api.Restore)
+		require.NoError(t, kbclient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: name}, restore))
+		require.Equal(t, fromSchedule, restore.Spec.ScheduleName)
+		require.Empty(t, restore.Spec.BackupName)
+		require.NotNil(t, restore.Spec.RestoreAsOf)
+		require.Equal(t, time.Date(2024, 6, 1, 14, 0, 0, 0, time.UTC), restore.Spec.RestoreAsOf.UTC())
+		require.Equal(t, velerov1api.BackupSelectionPolicyAllowPartiallyFailed, restore.Spec.BackupSelectionPolicy)
+	})
+
+	t.Run("invalid backup selection of a restore", func(t *testing.T) {
+		tests := []struct {
+			flags   []string
+			wantErr string
+		}{
+			{
+				flags:   []string{"--from-backup", "backup-1", "--restore-as-of", "2024-06-01T14:00:00Z"},
+				wantErr: "restore-as-of and backup-selection-policy can only be specified with from-schedule",
+			},
+			{
+				flags:   []string{"--from-schedule", "schedule-1", "--restore-as-of", "2024-06-01 14:00"},
+				wantErr: "restore-as-of must be in RFC3339 format",
+			},
+			{
+				flags:   []string{"--from-schedule", "schedule-1", "--backup-selection-policy", "Latest"},
+				wantErr: "backup-selection-policy has invalid value, it accepts only Completed, PreferCompleted, AllowPartiallyFailed as value",
+			},
+			{
+				flags:   []string{"--from-schedule", "schedule-1", "--backup-selection-policy", "PreferCompleted", "--allow-partially-failed"},
+				wantErr: "either allow-partially-failed or backup-selection-policy can be specified, but not both",
+			},
+		}
+
+		for _, tc := range tests {
+			f := &factorymocks.Factory{}
+			c := NewCreateCommand(f, "")
+			flags := new(pflag.FlagSet)
+			o := NewCreateOptions()
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse(tc.flags))
+
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderWatchClient").Return(velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch), nil)
+
+			require.NoError(t, o.Complete(args, f))
+			require.ErrorContains(t, o.Validate(c, []string{}, f), tc.wantErr)
+		}
+	})
+
+	t.Run("create a restore with namespace mapping rules", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--namespace-mapping-rules", "team-*:team-*-dr", "--namespace-mapping-rules", "^(a|b),(.*)$:$2-restored"}))
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		require.NoError(t, kbclient.Create(t.Context(), builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(), &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.NoError(t, o.Validate(c, []string{}, f))
+		require.NoError(t, o.Run(c, f))
+
+		restore := new(velerov1api.Restore)
+		require.NoError(t, kbclient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: name}, restore))
+		require.Equal(t, []velerov1api.NamespaceMappingRule{
+			{Source: "team-*", Target: "team-*-dr"},
+			{Source: "^(a|b),(.*)$", Target: "$2-restored"},
+		}, restore.Spec.NamespaceMappingRules)
+	})
+
+	t.Run("invalid namespace mapping rules of a restore", func(t *testing.T) {
+		tests := []struct {
+			rule    string
+			wantErr string
+		}{
+			{
+				rule:    "team-*",
+				wantErr: `namespace-mapping-rules has invalid value "team-*", it must be in the form src:dst`,
+			},
+			{
+				rule:    "team:team-dr",
+				wantErr: `invalid namespace mapping rule "team": the pattern must contain a single "*" wildcard or be a regular expression starting with "^"`,
+			},
+		}
+
+		for _, tc := range tests {
+			f := &factorymocks.Factory{}
+			c := NewCreateCommand(f, "")
+			flags := new(pflag.FlagSet)
+			o := NewCreateOptions()
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--namespace-mapping-rules", tc.rule}))
+
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderWatchClient").Return(velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch), nil)
+
+			require.NoError(t, o.Complete(args, f))
+			require.EqualError(t, o.Validate(c, []string{}, f), tc.wantErr)
+		}
+	})
+
+	t.Run("create a restore with name mapping", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--name-mappings", "app-1:app-2", "--name-suffix", "-clone", "--name-mapping-resources", "pods,deployments.apps", "--name-mapping-label-keys", "app"}))
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		require.NoError(t, kbclient.Create(t.Context(), builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(), &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.NoError(t, o.Validate(c, []string{}, f))
+		require.NoError(t, o.Run(c, f))
+
+		restore := new(velerov1api.Restore)
+		require.NoError(t, kbclient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: name}, restore))
+		require.Equal(t, &velerov1api.RestoreNameMapping{
+			IncludedResources: []string{"pods", "deployments.apps"},
+			Names:             map[string]string{"app-1": "app-2"},
+			Suffix:            "-clone",
+			LabelKeys:         []string{"app"},
+		}, restore.Spec.NameMapping)
+	})
+
+	t.Run("name mapping without names, prefix or suffix is invalid", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--name-mapping-resources", "pods"}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch), nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.EqualError(t, o.Validate(c, []string{}, f), "name mapping must have names, a prefix or a suffix")
+	})
+
+	t.Run("create a restore with a readiness check", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--readiness-check", "--readiness-timeout", "15m"}))
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		require.NoError(t, kbclient.Create(t.Context(), builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(), &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.NoError(t, o.Validate(c, []string{}, f))
+		require.NoError(t, o.Run(c, f))
+
+		restore := new(velerov1api.Restore)
+		require.NoError(t, kbclient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: name}, restore))
+		require.Equal(t, &velerov1api.RestoreReadinessCheck{Timeout: metav1.Duration{Duration: 15 * time.Minute}}, restore.Spec.ReadinessCheck)
+	})
+
+	t.Run("readiness timeout without a readiness check is invalid", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--readiness-timeout", "15m"}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch), nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.EqualError(t, o.Validate(c, []string{}, f), "readiness-timeout can only be specified with readiness-check")
+	})
+
+	t.Run("create a restore with a preflight policy", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--preflight-policy", "Enforce"}))
+
+		kbclient := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
+		require.NoError(t, kbclient.Create(t.Context(), builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result(), &controllerclient.CreateOptions{}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(kbclient, nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.NoError(t, o.Validate(c, []string{}, f))
+		require.NoError(t, o.Run(c, f))
+
+		restore := new(velerov1api.Restore)
+		require.NoError(t, kbclient.Get(t.Context(), controllerclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: name}, restore))
+		require.Equal(t, velerov1api.RestorePreflightPolicyEnforce, restore.Spec.PreflightPolicy)
+	})
+
+	t.Run("invalid preflight policy", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		c := NewCreateCommand(f, "")
+		flags := new(pflag.FlagSet)
+		o := NewCreateOptions()
+		o.BindFlags(flags)
+		require.NoError(t, flags.Parse([]string{"--from-backup", "backup-1", "--preflight-policy", "Skip"}))
+
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderWatchClient").Return(velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch), nil)
+
+		require.NoError(t, o.Complete(args, f))
+		require.EqualError(t, o.Validate(c, []string{}, f), "preflight-policy has invalid value, it accepts only Warn, Enforce as value")
+	})
 }
@@ -35,6 +35,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDeleteCommand(f, "delete"),
+		NewRollbackCommand(f, "rollback"),
 	)
 
 	return c
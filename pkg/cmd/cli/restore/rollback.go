@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/wait"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/confirm"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// NewRollbackCommand creates and returns a new cobra command for rolling back restores.
+func NewRollbackCommand(f client.Factory, use string) *cobra.Command {
+	o := NewRollbackOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Roll back a restore",
+		Long: `Roll back a restore.
+
+The Velero server deletes the items created by the restore, in the reverse order they were restored in,
+and reverts the items updated by the restore to their state before the restore. The items that were
+deleted or replaced since the restore are left as is.`,
+		Example: `  # Roll back the restore named "restore-1".
+  velero restore rollback restore-1
+
+  # Roll back the restore named "restore-1" without prompting for confirmation, and without waiting for the rollback to complete.
+  velero restore rollback restore-1 --confirm --wait=false`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type RollbackOptions struct {
+	RestoreName string
+	Confirm     bool
+	Wait        bool
+	Timeout     time.Duration
+}
+
+func NewRollbackOptions() *RollbackOptions {
+	return &RollbackOptions{
+		Wait:    true,
+		Timeout: 10 * time.Minute,
+	}
+}
+
+func (o *RollbackOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Confirm, "confirm", o.Confirm, "Confirm the rollback without prompting.")
+	flags.BoolVar(&o.Wait, "wait", o.Wait, "Wait for the rollback to complete.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the rollback to complete.")
+}
+
+func (o *RollbackOptions) Complete(args []string) error {
+	o.RestoreName = args[0]
+	return nil
+}
+
+func (o *RollbackOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	restore := new(velerov1api.Restore)
+	if err := kbClient.Get(context.TODO(), controllerclient.ObjectKey{Namespace: f.Namespace(), Name: o.RestoreName}, restore); err != nil {
+		return errors.WithStack(err)
+	}
+	if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		return errors.Errorf("restore %s is a dry run, there is nothing to roll back", o.RestoreName)
+	}
+
+	if !o.Confirm && !confirm.GetConfirmation(fmt.Sprintf("The items created by restore %s will be deleted, and the items it updated will be reverted.", o.RestoreName)) {
+		return nil
+	}
+
+	rollback := builder.ForRestoreRollback(f.Namespace(), "").
+		ObjectMeta(
+			builder.WithGenerateName(o.RestoreName+"-"),
+			builder.WithLabels(velerov1api.RestoreNameLabel, label.GetValidName(o.RestoreName)),
+		).
+		RestoreName(o.RestoreName).
+		Result()
+	if err := kbClient.Create(context.TODO(), rollback); err != nil {
+		return errors.Wrapf(err, "error requesting the rollback of restore %s", o.RestoreName)
+	}
+
+	if !o.Wait {
+		fmt.Printf("Rollback of restore %s requested. Run `velero restore describe %s` for more details.\n", o.RestoreName, o.RestoreName)
+		return nil
+	}
+
+	fmt.Printf("Waiting for the rollback of restore %s to complete.\n", o.RestoreName)
+	key := controllerclient.ObjectKey{Namespace: rollback.Namespace, Name: rollback.Name}
+	err = wait.PollUntilContextTimeout(context.TODO(), time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		if err := kbClient.Get(ctx, key, rollback); err != nil {
+			return false, errors.WithStack(err)
+		}
+		switch rollback.Status.Phase {
+		case velerov1api.RestoreRollbackPhaseCompleted, velerov1api.RestoreRollbackPhasePartiallyFailed, velerov1api.RestoreRollbackPhaseFailed:
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for the rollback of restore %s", o.RestoreName)
+	}
+
+	fmt.Printf("Rollback of restore %s %s: %d items deleted, %d items reverted, %d items skipped.\n",
+		o.RestoreName, rollbackPhaseDescription(rollback.Status.Phase), rollback.Status.ItemsDeleted, rollback.Status.ItemsReverted, rollback.Status.ItemsSkipped)
+	for _, e := range rollback.Status.Errors {
+		fmt.Printf("\t%s\n", e)
+	}
+	return nil
+}
+
+func rollbackPhaseDescription(phase velerov1api.RestoreRollbackPhase) string {
+	switch phase {
+	case velerov1api.RestoreRollbackPhaseCompleted:
+		return "completed"
+	case velerov1api.RestoreRollbackPhasePartiallyFailed:
+		return "partially failed"
+	default:
+		return "failed"
+	}
+}
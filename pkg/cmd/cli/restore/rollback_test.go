@@ -0,0 +1,92 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRollbackOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		restore      string
+		wantErr      string
+		wantRollback bool
+	}{
+		{
+			name:         "rollback of a restore is requested",
+			restore:      "restore-1",
+			wantRollback: true,
+		},
+		{
+			name:    "dry-run restore can't be rolled back",
+			restore: "restore-2",
+			wantErr: "restore restore-2 is a dry run, there is nothing to roll back",
+		},
+		{
+			name:    "restore that doesn't exist can't be rolled back",
+			restore: "restore-3",
+			wantErr: `restores.velero.io "restore-3" not found`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForRestore(cmdtest.VeleroNameSpace, "restore-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+				builder.ForRestore(cmdtest.VeleroNameSpace, "restore-2").DryRun(true).Phase(velerov1api.RestorePhaseCompleted).Result(),
+			)
+			f := &factorymocks.Factory{}
+			f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+			f.On("KubebuilderClient").Return(kbClient, nil)
+
+			o := NewRollbackOptions()
+			flags := new(flag.FlagSet)
+			o.BindFlags(flags)
+			require.NoError(t, flags.Parse([]string{"--confirm", "--wait=false"}))
+			require.NoError(t, o.Complete([]string{tc.restore}))
+
+			err := o.Run(NewRollbackCommand(f, "rollback"), f)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			rollbacks := new(velerov1api.RestoreRollbackList)
+			require.NoError(t, kbClient.List(t.Context(), rollbacks))
+			if !tc.wantRollback {
+				assert.Empty(t, rollbacks.Items)
+				return
+			}
+			require.Len(t, rollbacks.Items, 1)
+			assert.Equal(t, tc.restore, rollbacks.Items[0].Spec.RestoreName)
+			assert.Equal(t, tc.restore, rollbacks.Items[0].Labels[velerov1api.RestoreNameLabel])
+			assert.Equal(t, cmdtest.VeleroNameSpace, rollbacks.Items[0].Namespace)
+		})
+	}
+}
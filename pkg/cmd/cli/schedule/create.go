@@ -19,6 +19,8 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,7 +33,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -64,7 +68,16 @@ example: "@every 2h30m".`,
   velero create schedule NAME --schedule="@every 24h" --include-namespaces web
 
   # Create a weekly backup, each living for 90 days (2160 hours).
-  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s`,
+  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+  # Create an hourly backup that only stores the resources changed since the previous backup.
+  velero create schedule NAME --schedule="@every 1h" --incremental
+
+  # Create an hourly backup, keeping the last 24 hourly, 7 daily, 4 weekly and 12 monthly backups.
+  velero create schedule NAME --schedule="0 * * * *" --keep-hourly 24 --keep-daily 7 --keep-weekly 4 --keep-monthly 12
+
+  # Create an hourly backup that doesn't start during the business hours in New York, deferring the runs until they end.
+  velero create schedule NAME --schedule="0 * * * *" --blackout-window="CRON_TZ=America/New_York 0 9 * * 1-5;8h" --blackout-policy Defer`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -86,12 +99,23 @@ type CreateOptions struct {
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
 	Paused                     bool
+	Incremental                bool
+	Retention                  api.ScheduleRetentionPolicy
+	MinRetainedBackups         int
+	AllowedWindows             []string
+	BlackoutWindows            []string
+	BlackoutPolicy             *flag.Enum
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
 		BackupOptions: backup.NewCreateOptions(),
 		SkipOptions:   NewSkipOptions(),
+		BlackoutPolicy: flag.NewEnum(
+			string(api.ScheduleBlackoutPolicySkip),
+			string(api.ScheduleBlackoutPolicySkip),
+			string(api.ScheduleBlackoutPolicyDefer),
+		),
 	}
 }
 
@@ -101,6 +125,16 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
 	flags.BoolVar(&o.Paused, "paused", o.Paused, "Specifies whether the newly created schedule is paused or not.")
+	flags.BoolVar(&o.Incremental, "incremental", o.Incremental, "Only store the resources that changed since the previous backup of the schedule. Every backup is restored as a full backup.")
+	flags.IntVar(&o.Retention.Hourly, "keep-hourly", o.Retention.Hourly, "Keep the newest completed backup of each of the last N hours that have one. Once any --keep-* flag is set, the other completed backups expire regardless of their TTL.")
+	flags.IntVar(&o.Retention.Daily, "keep-daily", o.Retention.Daily, "Keep the newest completed backup of each of the last N days that have one.")
+	flags.IntVar(&o.Retention.Weekly, "keep-weekly", o.Retention.Weekly, "Keep the newest completed backup of each of the last N weeks that have one.")
+	flags.IntVar(&o.Retention.Monthly, "keep-monthly", o.Retention.Monthly, "Keep the newest completed backup of each of the last N months that have one.")
+	flags.IntVar(&o.Retention.Yearly, "keep-yearly", o.Retention.Yearly, "Keep the newest completed backup of each of the last N years that have one.")
+	flags.IntVar(&o.MinRetainedBackups, "min-retained-successful-backups", o.MinRetainedBackups, "Number of the newest successful backups of this schedule that aren't garbage-collected when they expire.")
+	flags.StringArrayVar(&o.AllowedWindows, "allowed-window", o.AllowedWindows, "A window of time the backups of this schedule can start in, in the form <cron expression>;<duration>, e.g. '0 22 * * *;8h'. The time zone can be specified with the CRON_TZ=<timezone> prefix. Can be specified multiple times.")
+	flags.StringArrayVar(&o.BlackoutWindows, "blackout-window", o.BlackoutWindows, "A window of time the backups of this schedule can't start in, in the form <cron expression>;<duration>, e.g. 'CRON_TZ=Europe/Paris 0 9 * * 1-5;9h'. Takes precedence over --allowed-window. Can be specified multiple times.")
+	flags.Var(o.BlackoutPolicy, "blackout-policy", fmt.Sprintf("How the runs that are due in a blackout window, or outside of the allowed windows, are handled. Valid values are %s.", strings.Join(o.BlackoutPolicy.AllowedValues(), ",")))
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -108,6 +142,20 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if o.Retention.Hourly < 0 || o.Retention.Daily < 0 || o.Retention.Weekly < 0 || o.Retention.Monthly < 0 || o.Retention.Yearly < 0 {
+		return errors.New("--keep-hourly, --keep-daily, --keep-weekly, --keep-monthly and --keep-yearly cannot be negative")
+	}
+
+	if o.MinRetainedBackups < 0 {
+		return errors.New("--min-retained-successful-backups cannot be negative")
+	}
+
+	for _, window := range append(o.AllowedWindows, o.BlackoutWindows...) {
+		if _, err := parseWindow(window); err != nil {
+			return err
+		}
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -160,10 +208,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				DataMover:                        o.BackupOptions.DataMover,
 				SnapshotMoveData:                 o.BackupOptions.SnapshotMoveData.Value,
 			},
-			Schedule:                   o.Schedule,
-			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
-			Paused:                     o.Paused,
-			SkipImmediately:            o.SkipOptions.SkipImmediately.Value,
+			Schedule:                     o.Schedule,
+			UseOwnerReferencesInBackup:   &o.UseOwnerReferencesInBackup,
+			Paused:                       o.Paused,
+			SkipImmediately:              o.SkipOptions.SkipImmediately.Value,
+			MinRetainedSuccessfulBackups: o.MinRetainedBackups,
 		},
 	}
 
@@ -171,6 +220,33 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		schedule.Spec.Template.ResourcePolicy = &corev1api.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: o.BackupOptions.ResPoliciesConfigmap}
 	}
 
+	if o.Incremental {
+		schedule.Spec.Template.Incremental = boolptr.True()
+	}
+
+	if o.Retention != (api.ScheduleRetentionPolicy{}) {
+		retention := o.Retention
+		schedule.Spec.Retention = &retention
+	}
+
+	for _, window := range o.AllowedWindows {
+		parsed, err := parseWindow(window)
+		if err != nil {
+			return err
+		}
+		schedule.Spec.AllowedWindows = append(schedule.Spec.AllowedWindows, parsed)
+	}
+	for _, window := range o.BlackoutWindows {
+		parsed, err := parseWindow(window)
+		if err != nil {
+			return err
+		}
+		schedule.Spec.BlackoutWindows = append(schedule.Spec.BlackoutWindows, parsed)
+	}
+	if len(schedule.Spec.AllowedWindows) > 0 || len(schedule.Spec.BlackoutWindows) > 0 {
+		schedule.Spec.BlackoutPolicy = api.ScheduleBlackoutPolicy(o.BlackoutPolicy.String())
+	}
+
 	if o.BackupOptions.ParallelFilesUpload > 0 {
 		schedule.Spec.Template.UploaderConfig = &api.UploaderConfigForBackup{
 			ParallelFilesUpload: o.BackupOptions.ParallelFilesUpload,
@@ -189,3 +265,19 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Schedule %q created successfully.\n", schedule.Name)
 	return nil
 }
+
+// parseWindow parses a window of time in the form <cron expression>;<duration>.
+func parseWindow(window string) (api.ScheduleWindow, error) {
+	schedule, duration, ok := strings.Cut(window, ";")
+	if !ok || strings.TrimSpace(schedule) == "" {
+		return api.ScheduleWindow{}, errors.Errorf("invalid window %q: must be in the form <cron expression>;<duration>", window)
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(duration))
+	if err != nil {
+		return api.ScheduleWindow{}, errors.Wrapf(err, "invalid window %q", window)
+	}
+	if d <= 0 {
+		return api.ScheduleWindow{}, errors.Errorf("invalid window %q: the duration must be positive", window)
+	}
+	return api.ScheduleWindow{Schedule: strings.TrimSpace(schedule), Duration: metav1.Duration{Duration: d}}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	podvolumeconfigs "github.com/vmware-tanzu/velero/pkg/podvolume/configs"
 	"github.com/vmware-tanzu/velero/pkg/types"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
@@ -54,6 +55,10 @@ const (
 	DefaultMaintenanceJobMemLimit    = "0"
 
 	DefaultItemBlockWorkerCount = 1
+
+	DefaultConcurrentBackups = 1
+
+	DefaultRestoreItemWorkerCount = 1
 )
 
 var (
@@ -64,6 +69,8 @@ var (
 		constant.ControllerBackupDeletion,
 		constant.ControllerBackupFinalizer,
 		constant.ControllerBackupSync,
+		constant.ControllerBackupTrash,
+		constant.ControllerBackupVerification,
 		constant.ControllerDownloadRequest,
 		constant.ControllerGarbageCollection,
 		constant.ControllerBackupRepo,
@@ -72,6 +79,7 @@ var (
 		constant.ControllerSchedule,
 		constant.ControllerServerStatusRequest,
 		constant.ControllerRestoreFinalizer,
+		constant.ControllerRestoreRollback,
 	}
 
 	/*
@@ -169,6 +177,8 @@ type Config struct {
 	LogFormat                      *logging.FormatFlag
 	RepoMaintenanceFrequency       time.Duration
 	GarbageCollectionFrequency     time.Duration
+	BackupVerificationFrequency    time.Duration
+	ChecksumKeySecret              string
 	ItemOperationSyncFrequency     time.Duration
 	DefaultVolumesToFsBackup       bool
 	UploaderType                   string
@@ -182,6 +192,8 @@ type Config struct {
 	PodResources                   kube.PodResources
 	KeepLatestMaintenanceJobs      int
 	ItemBlockWorkerCount           int
+	ConcurrentBackups              int
+	RestoreItemWorkerCount         int
 }
 
 func GetDefaultConfig() *Config {
@@ -213,6 +225,7 @@ func GetDefaultConfig() *Config {
 		DisableInformerCache:           defaultDisableInformerCache,
 		ScheduleSkipImmediately:        false,
 		CredentialsDirectory:           credentials.DefaultStoreDirectory(),
+		ChecksumKeySecret:              persistence.DefaultChecksumKeySecret,
 		PodResources: kube.PodResources{
 			CPURequest:    DefaultMaintenanceJobCPULimit,
 			CPULimit:      DefaultMaintenanceJobCPURequest,
@@ -221,6 +234,8 @@ func GetDefaultConfig() *Config {
 		},
 		KeepLatestMaintenanceJobs: DefaultKeepLatestMaintenanceJobs,
 		ItemBlockWorkerCount:      DefaultItemBlockWorkerCount,
+		ConcurrentBackups:         DefaultConcurrentBackups,
+		RestoreItemWorkerCount:    DefaultRestoreItemWorkerCount,
 	}
 
 	return config
@@ -247,6 +262,8 @@ func (c *Config) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&c.DefaultVGSLabelKey, "volume-group-snapshot-label-key", c.DefaultVGSLabelKey, "Label key for grouping PVCs into VolumeGroupSnapshot. Default value is 'velero.io/volume-group'")
 	flags.DurationVar(&c.RepoMaintenanceFrequency, "default-repo-maintain-frequency", c.RepoMaintenanceFrequency, "How often 'maintain' is run for backup repositories by default.")
 	flags.DurationVar(&c.GarbageCollectionFrequency, "garbage-collection-frequency", c.GarbageCollectionFrequency, "How often garbage collection is run for expired backups.")
+	flags.DurationVar(&c.BackupVerificationFrequency, "backup-verification-frequency", c.BackupVerificationFrequency, "How often the artifacts of completed backups are verified against their checksum manifest. Backups are only verified on request when set to 0.")
+	flags.StringVar(&c.ChecksumKeySecret, "checksum-key-secret", c.ChecksumKeySecret, "Name of the Secret in the Velero namespace holding the key the checksum manifests of the backups are signed with, under its \"key\" key. It's created with a random key if it doesn't exist. Use the Secret of the cluster that created the backups to verify them in another cluster.")
 	flags.DurationVar(&c.ItemOperationSyncFrequency, "item-operation-sync-frequency", c.ItemOperationSyncFrequency, "How often to check status on backup/restore operations after backup/restore processing. Default is 10 seconds")
 	flags.BoolVar(&c.DefaultVolumesToFsBackup, "default-volumes-to-fs-backup", c.DefaultVolumesToFsBackup, "Backup all volumes with pod volume file system backup by default.")
 	flags.StringVar(&c.UploaderType, "uploader-type", c.UploaderType, "Type of uploader to handle the transfer of data of pod volumes")
@@ -306,4 +323,16 @@ func (c *Config) BindFlags(flags *pflag.FlagSet) {
 		c.ItemBlockWorkerCount,
 		"Number of worker threads to process ItemBlocks. Default is one. Optional.",
 	)
+	flags.IntVar(
+		&c.ConcurrentBackups,
+		"concurrent-backups",
+		c.ConcurrentBackups,
+		"Number of backups that can run at the same time when the namespaces and cluster-scoped resources they select don't overlap. Default is one. Optional.",
+	)
+	flags.IntVar(
+		&c.RestoreItemWorkerCount,
+		"restore-item-worker-count",
+		c.RestoreItemWorkerCount,
+		"Number of items of a resource that are restored in parallel, unless set on the restore. Default is one. Optional.",
+	)
 }
@@ -106,6 +106,14 @@ func NewCommand(f client.Factory) *cobra.Command {
 					"velero.io/cluster-role-bindings",
 					newClusterRoleBindingItemAction,
 				).
+				RegisterRestoreItemAction(
+					"velero.io/ingress",
+					newIngressRestoreItemAction,
+				).
+				RegisterRestoreItemAction(
+					"velero.io/workload",
+					newWorkloadRestoreItemAction,
+				).
 				RegisterRestoreItemAction(
 					"velero.io/crd-preserve-fields",
 					newCRDV1PreserveUnknownFieldsItemAction,
@@ -304,6 +312,14 @@ func newAddPVCFromPodRestoreItemAction(logger logrus.FieldLogger) (any, error) {
 	return ria.NewAddPVCFromPodAction(logger), nil
 }
 
+func newIngressRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return ria.NewIngressAction(logger), nil
+}
+
+func newWorkloadRestoreItemAction(logger logrus.FieldLogger) (any, error) {
+	return ria.NewWorkloadAction(logger), nil
+}
+
 func newCRDV1PreserveUnknownFieldsItemAction(logger logrus.FieldLogger) (any, error) {
 	return ria.NewCRDV1PreserveUnknownFieldsAction(logger), nil
 }
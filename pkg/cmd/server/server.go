@@ -503,6 +503,11 @@ func (s *server) initRepoManager() error {
 		return err
 	}
 
+	// ensure the key the repository keys are escrowed with is set up
+	if err := repokey.EnsureEscrowKey(s.kubeClient.CoreV1(), s.namespace); err != nil {
+		return err
+	}
+
 	s.repoLocker = repository.NewRepoLocker()
 	s.repoEnsurer = repository.NewEnsurer(s.mgr.GetClient(), s.logger, s.config.ResourceTimeout)
 
@@ -543,7 +548,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
 	}
 
-	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
+	// ensure the key the checksum manifests of the backups are signed with is set up
+	if err := persistence.EnsureChecksumKey(s.kubeClient.CoreV1(), s.namespace, s.config.ChecksumKeySecret); err != nil {
+		return err
+	}
+
+	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore, s.credentialSecretStore, s.config.ChecksumKeySecret)
 
 	backupTracker := controller.NewBackupTracker()
 
@@ -559,6 +569,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		constant.ControllerBackupOperations:    {},
 		constant.ControllerBackupRepo:          {},
 		constant.ControllerBackupSync:          {},
+		constant.ControllerBackupTrash:         {},
+		constant.ControllerBackupVerification:  {},
 		constant.ControllerDownloadRequest:     {},
 		constant.ControllerGarbageCollection:   {},
 		constant.ControllerRestore:             {},
@@ -566,6 +578,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		constant.ControllerSchedule:            {},
 		constant.ControllerServerStatusRequest: {},
 		constant.ControllerRestoreFinalizer:    {},
+		constant.ControllerRestoreRollback:     {},
 	}
 
 	if s.config.RestoreOnly {
@@ -575,6 +588,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			constant.ControllerBackupDeletion,
 			constant.ControllerBackupFinalizer,
 			constant.ControllerBackupOperations,
+			constant.ControllerBackupTrash,
 			constant.ControllerGarbageCollection,
 			constant.ControllerSchedule,
 		)
@@ -637,6 +651,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logLevel,
 			newPluginManager,
 			backupTracker,
+			controller.NewBackupScopeTracker(s.config.ConcurrentBackups),
+			s.config.ConcurrentBackups,
 			s.mgr.GetClient(),
 			s.config.DefaultBackupLocation,
 			s.config.DefaultVolumesToFsBackup,
@@ -736,6 +752,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			s.mgr.GetClient(),
 			s.repoManager,
+			s.repoLocker,
+			newPluginManager,
+			backupStoreGetter,
 			s.config.RepoMaintenanceFrequency,
 			s.config.BackupRepoConfig,
 			s.config.KeepLatestMaintenanceJobs,
@@ -799,8 +818,39 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[constant.ControllerBackupVerification]; ok {
+		r := controller.NewBackupVerificationReconciler(
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+			s.config.BackupVerificationFrequency,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackupVerification)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[constant.ControllerBackupTrash]; ok {
+		r := controller.NewBackupTrashReconciler(
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerBackupTrash)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[constant.ControllerGarbageCollection]; ok {
-		r := controller.NewGCReconciler(s.logger, s.mgr.GetClient(), s.config.GarbageCollectionFrequency)
+		r := controller.NewGCReconciler(
+			s.logger,
+			s.mgr.GetClient(),
+			s.config.GarbageCollectionFrequency,
+			s.mgr.GetEventRecorderFor(constant.ControllerGarbageCollection),
+			s.metrics,
+		)
 		if err := r.SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerGarbageCollection)
 		}
@@ -830,6 +880,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.PodVolumeOperationTimeout,
 			s.config.ResourceTerminatingTimeout,
 			s.config.ResourceTimeout,
+			s.config.RestoreItemWorkerCount,
 			s.logger,
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.kubeClient.CoreV1().RESTClient(),
@@ -896,6 +947,19 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[constant.ControllerRestoreRollback]; ok {
+		if err := controller.NewRestoreRollbackReconciler(
+			s.logger,
+			s.namespace,
+			s.mgr.GetClient(),
+			restore.NewKubernetesRollbacker(s.discoveryHelper, client.NewDynamicFactory(s.dynamicClient), s.config.RestoreResourcePriorities, s.config.ResourceTerminatingTimeout),
+			newPluginManager,
+			backupStoreGetter,
+		).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", constant.ControllerRestoreRollback)
+		}
+	}
+
 	s.logger.Info("Server starting...")
 
 	if err := s.mgr.Start(s.ctx); err != nil {
@@ -944,6 +1008,8 @@ func markInProgressCRsFailed(ctx context.Context, client ctrlclient.Client, name
 	markInProgressBackupsFailed(ctx, client, namespace, log)
 
 	markInProgressRestoresFailed(ctx, client, namespace, log)
+
+	markInProgressRestoreRollbacksFailed(ctx, client, namespace, log)
 }
 
 func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
@@ -998,6 +1064,31 @@ func markInProgressRestoresFailed(ctx context.Context, client ctrlclient.Client,
 	}
 }
 
+func markInProgressRestoreRollbacksFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+	rollbacks := &velerov1api.RestoreRollbackList{}
+	if err := client.List(ctx, rollbacks, &ctrlclient.ListOptions{Namespace: namespace}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("failed to list restore rollbacks")
+		return
+	}
+	for i, rollback := range rollbacks.Items {
+		if rollback.Status.Phase != velerov1api.RestoreRollbackPhaseInProgress {
+			log.Debugf("the status of restore rollback %q is %q, skip", rollback.GetName(), rollback.Status.Phase)
+			continue
+		}
+		updated := rollback.DeepCopy()
+		updated.Status.Phase = velerov1api.RestoreRollbackPhaseFailed
+		reason := fmt.Sprintf("found a restore rollback with status %q during the server starting, mark it as %q", rollback.Status.Phase, updated.Status.Phase)
+		updated.Status.Errors = append(updated.Status.Errors, reason)
+		updated.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&rollbacks.Items[i])); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("failed to patch restore rollback %q", rollback.GetName())
+			continue
+		}
+
+		log.WithField("restorerollback", rollback.GetName()).Warn(reason)
+	}
+}
+
 func markDataUploadsCancel(ctx context.Context, client ctrlclient.Client, backup velerov1api.Backup, log logrus.FieldLogger) {
 	dataUploads := &velerov2alpha1api.DataUploadList{}
 
@@ -291,6 +291,7 @@ func Test_veleroResourcesExist(t *testing.T) {
 				{Kind: "BackupStorageLocation"},
 				{Kind: "VolumeSnapshotLocation"},
 				{Kind: "ServerStatusRequest"},
+				{Kind: "RestoreRollback"},
 			},
 		},
 		{
@@ -384,6 +385,47 @@ func Test_markInProgressRestoresFailed(t *testing.T) {
 	assert.Equal(t, velerov1api.RestorePhaseCompleted, restore02.Status.Phase)
 }
 
+func Test_markInProgressRestoreRollbacksFailed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov1api.AddToScheme(scheme)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithLists(&velerov1api.RestoreRollbackList{
+			Items: []velerov1api.RestoreRollback{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "rollback01",
+					},
+					Status: velerov1api.RestoreRollbackStatus{
+						Phase: velerov1api.RestoreRollbackPhaseInProgress,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "velero",
+						Name:      "rollback02",
+					},
+					Status: velerov1api.RestoreRollbackStatus{
+						Phase: velerov1api.RestoreRollbackPhaseCompleted,
+					},
+				},
+			},
+		}).
+		Build()
+	markInProgressRestoreRollbacksFailed(t.Context(), c, "velero", logrus.New())
+
+	rollback01 := &velerov1api.RestoreRollback{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "velero", Name: "rollback01"}, rollback01))
+	assert.Equal(t, velerov1api.RestoreRollbackPhaseFailed, rollback01.Status.Phase)
+	assert.Len(t, rollback01.Status.Errors, 1)
+
+	rollback02 := &velerov1api.RestoreRollback{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "velero", Name: "rollback02"}, rollback02))
+	assert.Equal(t, velerov1api.RestoreRollbackPhaseCompleted, rollback02.Status.Phase)
+}
+
 func Test_setDefaultBackupLocation(t *testing.T) {
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
@@ -34,6 +34,7 @@ import (
 
 	veleroV1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
@@ -70,12 +71,16 @@ func StreamWithBSLCACert(
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	downloadURL, err := getDownloadURL(ctx, kbClient, namespace, name, kind)
+	downloadURL, decryptionKey, err := getDownloadURL(ctx, kbClient, namespace, name, kind)
 	if err != nil {
 		return err
 	}
 
-	if err := download(ctx, downloadURL, kind, w, insecureSkipTLSVerify, caCertFile, bslCACert); err != nil {
+	decrypt := func(r io.Reader) (io.Reader, error) {
+		return decryptDownload(decryptionKey, r)
+	}
+
+	if err := download(ctx, downloadURL, kind, w, insecureSkipTLSVerify, caCertFile, bslCACert, decrypt); err != nil {
 		return err
 	}
 
@@ -87,32 +92,35 @@ func getDownloadURL(
 	kbClient kbclient.Client,
 	namespace, name string,
 	kind veleroV1api.DownloadTargetKind,
-) (string, error) {
+) (string, string, error) {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	reqName := fmt.Sprintf("%s-%s", name, uuid.String())
 	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
 
 	if err := kbClient.Create(ctx, created, &kbclient.CreateOptions{}); err != nil {
-		return "", errors.WithStack(err)
+		return "", "", errors.WithStack(err)
 	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			return "", ErrDownloadRequestDownloadURLTimeout
+			return "", "", ErrDownloadRequestDownloadURLTimeout
 
 		case <-time.After(25 * time.Millisecond):
 			updated := &veleroV1api.DownloadRequest{}
 			if err := kbClient.Get(ctx, kbclient.ObjectKey{Name: created.Name, Namespace: namespace}, updated); err != nil {
-				return "", errors.WithStack(err)
+				return "", "", errors.WithStack(err)
 			}
 
+			if updated.Status.Phase == veleroV1api.DownloadRequestPhaseFailed {
+				return "", "", errors.Errorf("download request failed: %s", updated.Status.Message)
+			}
 			if updated.Status.DownloadURL != "" {
-				return updated.Status.DownloadURL, nil
+				return updated.Status.DownloadURL, updated.Status.DecryptionKey, nil
 			}
 		}
 	}
@@ -126,6 +134,7 @@ func download(
 	insecureSkipTLSVerify bool,
 	caCertFile string,
 	caCertByteString string,
+	decrypt func(io.Reader) (io.Reader, error),
 ) error {
 	var caPool *x509.CertPool
 	var err error
@@ -202,10 +211,15 @@ func download(
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	// files of locations using client-side encryption are downloaded encrypted with the one-time
+	// key of the download request
+	reader, err := decrypt(resp.Body)
+	if err != nil {
+		return err
+	}
 	if kind != veleroV1api.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
@@ -216,3 +230,26 @@ func download(
 	_, err = io.Copy(w, reader)
 	return err
 }
+
+// decryptDownload decrypts the downloaded file with the one-time key of the download request, if
+// the file was encrypted with it by the server, since its backup storage location uses client-side
+// encryption.
+func decryptDownload(decryptionKey string, r io.Reader) (io.Reader, error) {
+	if decryptionKey == "" {
+		return r, nil
+	}
+
+	key, err := encryption.ParseKey([]byte(decryptionKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid decryption key of the download request")
+	}
+	keyring, err := encryption.NewKeyring(nil, key)
+	if err != nil {
+		return nil, err
+	}
+	decrypted, err := keyring.Decrypt(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decrypting the downloaded file")
+	}
+	return decrypted, nil
+}
@@ -24,7 +24,9 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
+	"io"
 	"math/big"
 	"net"
 	"net/http"
@@ -43,8 +45,13 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/cacert"
 	"github.com/vmware-tanzu/velero/pkg/util"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 )
 
+func noDecryption(r io.Reader) (io.Reader, error) {
+	return r, nil
+}
+
 // createSelfSignedCertificate creates a self-signed certificate for testing.
 // This allows us to test the BSL CA certificate functionality by ensuring
 // that the client properly validates server certificates against the CA cert
@@ -518,6 +525,7 @@ func TestDownload(t *testing.T) {
 				tc.insecureSkipTLSVerify,
 				tc.caCertFile,
 				tc.bslCACert,
+				noDecryption,
 			)
 
 			if tc.expectedError {
@@ -983,6 +991,7 @@ func TestConcurrentDownloadsWithBSLCACert(t *testing.T) {
 				false,
 				"",
 				string(serverCACertPEM),
+				noDecryption,
 			)
 			if err != nil {
 				errors <- err
@@ -1101,6 +1110,7 @@ func TestDownloadWithBSLCACert(t *testing.T) {
 				tc.insecureSkipTLSVerify,
 				"",
 				tc.bslCACert,
+				noDecryption,
 			)
 
 			if tc.expectedError {
@@ -1201,6 +1211,7 @@ func TestCACertFallback(t *testing.T) {
 				false,
 				caCertFile,
 				tc.bslCACert,
+				noDecryption,
 			)
 
 			if tc.expectedError {
@@ -1215,3 +1226,39 @@ func TestCACertFallback(t *testing.T) {
 		})
 	}
 }
+
+func TestDecryptDownload(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, encryption.KeySize)
+	keyring, err := encryption.NewKeyring(key)
+	require.NoError(t, err)
+	encrypted, err := keyring.Encrypt(strings.NewReader("backup contents"))
+	require.NoError(t, err)
+	sealed, err := io.ReadAll(encrypted)
+	require.NoError(t, err)
+
+	decrypt := func(decryptionKey string, data []byte) (string, error) {
+		r, err := decryptDownload(decryptionKey, bytes.NewReader(data))
+		if err != nil {
+			return "", err
+		}
+		plain, err := io.ReadAll(r)
+		return string(plain), err
+	}
+
+	// files encrypted with the one-time key of the request are decrypted
+	plain, err := decrypt(base64.StdEncoding.EncodeToString(key), sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "backup contents", plain)
+
+	// files of locations without encryption are returned as is
+	plain, err = decrypt("", []byte("plain contents"))
+	require.NoError(t, err)
+	assert.Equal(t, "plain contents", plain)
+
+	// files that should be encrypted but aren't are rejected
+	_, err = decrypt(base64.StdEncoding.EncodeToString(key), []byte("plain contents"))
+	require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+
+	_, err = decrypt(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, encryption.KeySize)), sealed)
+	require.ErrorContains(t, err, "object is encrypted with unknown key")
+}
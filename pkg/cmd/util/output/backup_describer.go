@@ -41,6 +41,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 
 	"github.com/vmware-tanzu/velero/internal/volume"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
@@ -74,7 +75,7 @@ func DescribeBackup(
 		case velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
 		case velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed:
 		case velerov1api.BackupPhaseInProgress:
-		case velerov1api.BackupPhaseNew:
+		case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseQueued:
 		}
 
 		logsNote := ""
@@ -83,6 +84,14 @@ func DescribeBackup(
 		}
 
 		d.Printf("Phase:\t%s%s\n", phaseString, logsNote)
+		if phase == velerov1api.BackupPhaseQueued {
+			d.Printf("Queue Position:\t%d\n", backup.Status.QueuePosition)
+		}
+
+		if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+			d.Println()
+			d.Printf("Dry Run:\ttrue\n")
+		}
 
 		if backup.Spec.ResourcePolicy != nil {
 			d.Println()
@@ -231,6 +240,9 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
+	if boolptr.IsSetToTrue(spec.LegalHold) {
+		d.Printf("Legal Hold:\ttrue\n")
+	}
 
 	d.Println()
 	d.Printf("CSISnapshotTimeout:\t%s\n", spec.CSISnapshotTimeout.Duration)
@@ -312,6 +324,11 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 			d.Printf("\t%s: %s\n", key, value)
 		}
 	}
+
+	if boolptr.IsSetToTrue(spec.Incremental) {
+		d.Println()
+		d.Printf("Incremental:\ttrue\n")
+	}
 }
 
 // DescribeBackupStatus describes a backup status in human-readable format.
@@ -342,6 +359,25 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	d.Printf("Expiration:\t%s\n", status.Expiration)
 	d.Println()
 
+	if status.ParentBackup != "" {
+		d.Printf("Parent Backup:\t%s\n", status.ParentBackup)
+		d.Println()
+	}
+
+	// the objects of deleted backups are in the trash of the location until they're
+	// purged, so they can't be downloaded
+	if status.Phase == velerov1api.BackupPhaseDeleted {
+		describeBackupSoftDeletion(d, status.SoftDeletion)
+		return
+	}
+
+	// dry-run backups only upload their report to object storage, so the result is
+	// the only thing to describe
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		describeBackupDryRunResult(ctx, kbClient, d, backup, details, insecureSkipTLSVerify, caCertPath)
+		return
+	}
+
 	if backup.Status.Progress != nil {
 		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
 			d.Printf("Estimated total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
@@ -368,6 +404,40 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Printf("HooksAttempted:\t%d\n", status.HookStatus.HooksAttempted)
 		d.Printf("HooksFailed:\t%d\n", status.HookStatus.HooksFailed)
 	}
+
+	if status.Verification != nil {
+		d.Println()
+		describeBackupVerification(d, status.Verification)
+	}
+}
+
+// describeBackupVerification describes the result of the latest verification of the backup
+// against its checksum manifest.
+func describeBackupVerification(d *Describer, verification *velerov1api.BackupVerification) {
+	d.Printf("Verification:\t%s\n", verification.Phase)
+	if verification.Timestamp != nil {
+		d.Printf("Verified At:\t%s\n", verification.Timestamp.Time)
+	}
+	if len(verification.Errors) > 0 {
+		d.Printf("Verification Errors:\n")
+		for _, e := range verification.Errors {
+			d.Printf("  %s\n", e)
+		}
+	}
+}
+
+// describeBackupSoftDeletion describes the deletion of a backup kept in the trash of its location.
+func describeBackupSoftDeletion(d *Describer, softDeletion *velerov1api.BackupSoftDeletion) {
+	if softDeletion == nil {
+		return
+	}
+	if softDeletion.Timestamp != nil {
+		d.Printf("Deleted:\t%s\n", softDeletion.Timestamp.Time)
+	}
+	if softDeletion.PurgeTimestamp != nil {
+		d.Printf("Purged After:\t%s\n", softDeletion.PurgeTimestamp.Time)
+	}
+	d.Printf("Phase Before Deletion:\t%s\n", softDeletion.Phase)
 }
 
 func describeBackupItemOperations(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
@@ -753,6 +823,123 @@ func describeBackupItemOperation(d *Describer, operation *itemoperation.BackupOp
 	}
 }
 
+// describeBackupDryRunResult describes the number of items by resource a dry-run backup would
+// back up, and the report of the items, volumes and hooks when details are requested.
+func describeBackupDryRunResult(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	result := backup.Status.DryRunResult
+	if result == nil {
+		d.Printf("Dry Run Result:\t<none>\n")
+		return
+	}
+
+	total := 0
+	for _, resource := range result.Resources {
+		total += resource.Count
+	}
+	d.Printf("Dry Run Result:\n")
+	d.Printf("\tItems to be backed up:\t%d\n", total)
+	for _, resource := range result.Resources {
+		d.Printf("\t\t%s:\t%d\n", resource.Resource, resource.Count)
+	}
+
+	if len(result.Limitations) > 0 {
+		d.Println()
+		d.Printf("\tLimitations:\n")
+		for _, limitation := range result.Limitations {
+			d.Printf("\t\t- %s\n", limitation)
+		}
+	}
+
+	if !details {
+		return
+	}
+
+	// Get BSL cacert if available
+	bslCACert, err := cacert.GetCACertFromBackup(ctx, kbClient, backup.Namespace, backup)
+	if err != nil {
+		// Log the error but don't fail - we can still try to download without the BSL cacert
+		d.Printf("WARNING: Error getting cacert from BSL: %v\n", err)
+		bslCACert = ""
+	}
+
+	d.Println()
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.StreamWithBSLCACert(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupDryRunReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath, bslCACert); err != nil {
+		// the report is missing if the backup storage location was read-only
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Dry Run Report:\t<backup dry-run report not found>")
+		} else {
+			d.Printf("Dry Run Report:\t<error getting backup dry-run report: %v>\n", err)
+		}
+		return
+	}
+
+	report := new(velerov1api.BackupDryRunReport)
+	if err := json.NewDecoder(buf).Decode(report); err != nil {
+		d.Printf("Dry Run Report:\t<error reading backup dry-run report: %v>\n", err)
+		return
+	}
+
+	describeBackupDryRunReport(d, report)
+}
+
+// describeBackupDryRunReport describes the items, volumes and hooks of the report of a dry-run backup.
+func describeBackupDryRunReport(d *Describer, report *velerov1api.BackupDryRunReport) {
+	d.Println("Dry Run Report:")
+	if len(report.Items) == 0 {
+		d.Printf("\tItems:\t<none>\n")
+	} else {
+		d.Printf("\tItems:\n")
+		for _, items := range report.Items {
+			namespace := items.Namespace
+			if namespace == "" {
+				namespace = "<cluster-scoped>"
+			}
+			d.Printf("\t\t%s/%s:\n", namespace, items.Resource)
+			for _, name := range items.Names {
+				d.Printf("\t\t\t- %s\n", name)
+			}
+		}
+	}
+
+	d.Println()
+	if len(report.Volumes) == 0 {
+		d.Printf("\tVolumes:\t<none included>\n")
+	} else {
+		d.Printf("\tVolumes:\n")
+		for _, v := range report.Volumes {
+			var name string
+			switch {
+			case v.PodName != "":
+				name = fmt.Sprintf("%s/%s/%s", v.Namespace, v.PodName, v.PodVolume)
+			case v.PVCName != "":
+				name = fmt.Sprintf("%s/%s", v.Namespace, v.PVCName)
+			default:
+				name = v.PVName
+			}
+			if v.Reason != "" {
+				d.Printf("\t\t%s:\t%s (%s)\n", name, v.Action, v.Reason)
+			} else {
+				d.Printf("\t\t%s:\t%s\n", name, v.Action)
+			}
+		}
+	}
+
+	d.Println()
+	if len(report.Hooks) == 0 {
+		d.Printf("\tHooks:\t<none>\n")
+	} else {
+		d.Printf("\tHooks:\n")
+		for _, h := range report.Hooks {
+			name := h.Name
+			if name == "" {
+				name = "<from-annotation>"
+			}
+			d.Printf("\t\t%s (%s):\t%s/%s/%s %s\n", name, h.Phase, h.Namespace, h.PodName, h.Container, strings.Join(h.Command, " "))
+		}
+	}
+}
+
 // DescribeDeleteBackupRequests describes delete backup requests in human-readable format.
 func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBackupRequest) {
 	d.Printf("Deletion Attempts")
@@ -926,6 +1113,13 @@ func DescribeBackupResults(ctx context.Context, kbClient kbclient.Client, d *Des
 		return
 	}
 
+	// dry-run backups don't upload the results file, only the counts are available
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		d.Printf("Errors:\t%d\n", backup.Status.Errors)
+		d.Printf("Warnings:\t%d\n", backup.Status.Warnings)
+		return
+	}
+
 	// Get BSL cacert if available
 	bslCACert, err := cacert.GetCACertFromBackup(ctx, kbClient, backup.Namespace, backup)
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -819,6 +820,94 @@ func TestDescribeDeleteBackupRequests(t *testing.T) {
 	}
 }
 
+func TestDescribeBackupVerification(t *testing.T) {
+	verifiedAt, err := time.Parse("2006-Jan-02", "2023-Jun-26")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name   string
+		input  *velerov1api.BackupVerification
+		expect string
+	}{
+		{
+			name: "verified backup",
+			input: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseVerified,
+				Timestamp: &metav1.Time{Time: verifiedAt},
+			},
+			expect: `Verification:  Verified
+Verified At:   2023-06-26 00:00:00 +0000 UTC
+`,
+		},
+		{
+			name: "corrupted backup",
+			input: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseCorrupted,
+				Timestamp: &metav1.Time{Time: verifiedAt},
+				Errors:    []string{"backup-1-logs.gz: checksum mismatch"},
+			},
+			expect: `Verification:  Corrupted
+Verified At:   2023-06-26 00:00:00 +0000 UTC
+Verification Errors:
+  backup-1-logs.gz: checksum mismatch
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeBackupVerification(d, tc.input)
+			d.out.Flush()
+			assert.Equal(tt, tc.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeBackupDryRunReport(t *testing.T) {
+	report := &velerov1api.BackupDryRunReport{
+		Items: []velerov1api.BackupDryRunItems{
+			{Resource: "persistentvolumes", Names: []string{"pv-1"}},
+			{Namespace: "ns-1", Resource: "pods", Names: []string{"pod-1", "pod-2"}},
+		},
+		Volumes: []velerov1api.BackupDryRunVolume{
+			{Namespace: "ns-1", PodName: "pod-1", PodVolume: "data", Action: velerov1api.BackupDryRunVolumeActionFSBackup},
+			{PVName: "pv-1", Action: velerov1api.BackupDryRunVolumeActionSkip, Reason: "not selected"},
+		},
+		Hooks: []velerov1api.BackupDryRunHook{
+			{Source: "annotation", Phase: "pre", Namespace: "ns-1", PodName: "pod-2", Container: "c1", Command: []string{"/bin/sync", "-f"}},
+		},
+	}
+	expected := `Dry Run Report:
+  Items:
+    <cluster-scoped>/persistentvolumes:
+      - pv-1
+    ns-1/pods:
+      - pod-1
+      - pod-2
+
+  Volumes:
+    ns-1/pod-1/data:  fs-backup
+    pv-1:             skip (not selected)
+
+  Hooks:
+    <from-annotation> (pre):  ns-1/pod-2/c1 /bin/sync -f
+`
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	describeBackupDryRunReport(d, report)
+	d.out.Flush()
+	assert.Equal(t, expected, d.buf.String())
+}
+
 func TestDescribeBackupItemOperation(t *testing.T) {
 	t1, err1 := time.Parse("2006-Jan-02", "2023-Jun-26")
 	require.NoError(t, err1)
@@ -32,6 +32,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/cacert"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
@@ -51,6 +52,9 @@ func DescribeBackupInSF(
 		d.DescribeMetadata(backup.ObjectMeta)
 
 		d.Describe("phase", backup.Status.Phase)
+		if backup.Status.Phase == velerov1api.BackupPhaseQueued {
+			d.Describe("queuePosition", backup.Status.QueuePosition)
+		}
 
 		if backup.Spec.ResourcePolicy != nil {
 			DescribeResourcePoliciesInSF(d, backup.Spec.ResourcePolicy)
@@ -219,6 +223,10 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 		backupSpecInfo["orderedResources"] = spec.OrderedResources
 	}
 
+	if boolptr.IsSetToTrue(spec.Incremental) {
+		backupSpecInfo["incremental"] = true
+	}
+
 	d.Describe("spec", backupSpecInfo)
 }
 
@@ -248,8 +256,22 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	// just display `<nil>`, though this should be temporary.
 	backupStatusInfo["expiration"] = status.Expiration.String()
 
+	if status.ParentBackup != "" {
+		backupStatusInfo["parentBackup"] = status.ParentBackup
+	}
+
 	defer d.Describe("status", backupStatusInfo)
 
+	// dry-run backups only upload their report to object storage, so the result is
+	// the only thing to describe
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		backupStatusInfo["dryRunResult"] = status.DryRunResult
+		if details {
+			describeBackupDryRunReportInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
+		}
+		return
+	}
+
 	if backup.Status.Progress != nil {
 		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
 			backupStatusInfo["estimatedTotalItemsToBeBackedUp"] = backup.Status.Progress.TotalItems
@@ -270,6 +292,39 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 		backupStatusInfo["hooksAttempted"] = status.HookStatus.HooksAttempted
 		backupStatusInfo["hooksFailed"] = status.HookStatus.HooksFailed
 	}
+
+	if status.Verification != nil {
+		backupStatusInfo["verification"] = status.Verification
+	}
+}
+
+// describeBackupDryRunReportInSF describes the items, volumes and hooks of the report of a
+// dry-run backup in structured format.
+func describeBackupDryRunReportInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]any, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	// Get BSL cacert if available
+	bslCACert, err := cacert.GetCACertFromBackup(ctx, kbClient, backup.Namespace, backup)
+	if err != nil {
+		// Log the error but don't fail - we can still try to download without the BSL cacert
+		backupStatusInfo["warningGettingBSLCACert"] = fmt.Sprintf("Warning: Error getting cacert from BSL: %v", err)
+		bslCACert = ""
+	}
+
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.StreamWithBSLCACert(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupDryRunReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath, bslCACert); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			backupStatusInfo["errorGettingDryRunReport"] = "<backup dry-run report not found>"
+		} else {
+			backupStatusInfo["errorGettingDryRunReport"] = fmt.Sprintf("<error getting backup dry-run report: %v>", err)
+		}
+		return
+	}
+
+	report := new(velerov1api.BackupDryRunReport)
+	if err := json.NewDecoder(buf).Decode(report); err != nil {
+		backupStatusInfo["errorGettingDryRunReport"] = fmt.Sprintf("<error reading backup dry-run report: %v>", err)
+		return
+	}
+	backupStatusInfo["dryRunReport"] = report
 }
 
 func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]any, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
@@ -574,6 +629,13 @@ func DescribeBackupResultsInSF(ctx context.Context, kbClient kbclient.Client, d
 		d.Describe("warnings", warnings)
 	}()
 
+	// dry-run backups don't upload the results file, only the counts are available
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		errors["count"] = backup.Status.Errors
+		warnings["count"] = backup.Status.Warnings
+		return
+	}
+
 	// If 'ErrNotFound' occurs, it means the backup bundle in the bucket has already been there before the backup-result file is introduced.
 	// We only display the count of errors and warnings in this case.
 	err = downloadrequest.StreamWithBSLCACert(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath, bslCACert)
@@ -46,6 +46,7 @@ func DescribeRestore(
 	kbClient kbclient.Client,
 	restore *velerov1api.Restore,
 	podVolumeRestores []velerov1api.PodVolumeRestore,
+	rollbacks []velerov1api.RestoreRollback,
 	details bool,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
@@ -78,6 +79,9 @@ func DescribeRestore(
 		}
 
 		d.Printf("Phase:\t%s%s\n", phaseString, resultsNote)
+		if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+			d.Printf("Dry Run:\ttrue\n")
+		}
 		if restore.Status.Progress != nil {
 			if restore.Status.Phase == velerov1api.RestorePhaseInProgress {
 				d.Printf("Estimated total items to be restored:\t%d\n", restore.Status.Progress.TotalItems)
@@ -112,8 +116,22 @@ func DescribeRestore(
 
 		describeRestoreResults(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 
+		if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+			d.Println()
+			describeRestoreDryRunResult(ctx, kbClient, d, restore, details, insecureSkipTLSVerify, caCertFile)
+		}
+
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
+		if restore.Spec.RestoreAsOf != nil {
+			d.Printf("Restore As Of:\t%s\n", restore.Spec.RestoreAsOf)
+		}
+		if restore.Spec.BackupSelectionPolicy != "" {
+			d.Printf("Backup Selection Policy:\t%s\n", restore.Spec.BackupSelectionPolicy)
+		}
+		if restore.Status.BackupResolution != nil {
+			describeRestoreBackupResolution(d, restore.Spec.ScheduleName, restore.Status.BackupResolution)
+		}
 
 		d.Println()
 		d.Printf("Namespaces:\n")
@@ -152,6 +170,38 @@ func DescribeRestore(
 
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
+		if len(restore.Spec.NamespaceMappingRules) > 0 {
+			rules := make([]string, 0, len(restore.Spec.NamespaceMappingRules))
+			for _, rule := range restore.Spec.NamespaceMappingRules {
+				rules = append(rules, fmt.Sprintf("%s=%s", rule.Source, rule.Target))
+			}
+			d.DescribeSlice(0, "Namespace mapping rules", rules)
+		}
+		if m := restore.Spec.NameMapping; m != nil {
+			d.Printf("Name mapping:\n")
+			s = "all namespaced resources"
+			if len(m.IncludedResources) > 0 {
+				s = strings.Join(m.IncludedResources, ", ")
+			}
+			d.Printf("\tResources:\t%s\n", s)
+			if m.Prefix != "" {
+				d.Printf("\tPrefix:\t%s\n", m.Prefix)
+			}
+			if m.Suffix != "" {
+				d.Printf("\tSuffix:\t%s\n", m.Suffix)
+			}
+			if len(m.Names) > 0 {
+				names := make([]string, 0, len(m.Names))
+				for source, target := range m.Names {
+					names = append(names, fmt.Sprintf("%s=%s", source, target))
+				}
+				sort.Strings(names)
+				d.DescribeSlice(1, "Names", names)
+			}
+			if len(m.LabelKeys) > 0 {
+				d.Printf("\tLabel keys:\t%s\n", strings.Join(m.LabelKeys, ", "))
+			}
+		}
 
 		d.Println()
 		s = emptyDisplay
@@ -209,6 +259,20 @@ func DescribeRestore(
 			s = string(restore.Spec.ExistingResourcePolicy)
 		}
 		d.Printf("Existing Resource Policy: \t%s\n", s)
+		if len(restore.Spec.RecreateResources) > 0 {
+			d.Printf("Recreate Resources:\t%s\n", strings.Join(restore.Spec.RecreateResources, ", "))
+		}
+		s = string(velerov1api.RestoreResourceOrderingPriority)
+		if restore.Spec.ResourceOrdering != "" {
+			s = string(restore.Spec.ResourceOrdering)
+		}
+		d.Printf("Resource Ordering:\t%s\n", s)
+		if restore.Spec.ItemWorkerCount > 0 {
+			d.Printf("Item Worker Count:\t%d\n", restore.Spec.ItemWorkerCount)
+		}
+		if restore.Spec.PreflightPolicy != "" {
+			d.Printf("Preflight Policy:\t%s\n", restore.Spec.PreflightPolicy)
+		}
 		d.Printf("ItemOperationTimeout:\t%s\n", restore.Spec.ItemOperationTimeout.Duration)
 
 		d.Println()
@@ -230,13 +294,95 @@ func DescribeRestore(
 			d.Printf("HooksFailed: \t%d\n", restore.Status.HookStatus.HooksFailed)
 		}
 
-		if details {
+		if restore.Spec.ReadinessCheck != nil {
+			d.Println()
+			describeRestoreReadiness(d, restore, details)
+		}
+
+		if len(rollbacks) > 0 {
+			d.Println()
+			DescribeRestoreRollbacks(d, rollbacks)
+		}
+
+		// a dry-run restore has no resource list, its items are in the dry-run report
+		if details && !boolptr.IsSetToTrue(restore.Spec.DryRun) {
 			d.Println()
 			describeRestoreResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
 		}
 	})
 }
 
+// describeRestoreBackupResolution describes the backup of the schedule selected for a restore.
+func describeRestoreBackupResolution(d *Describer, scheduleName string, resolution *velerov1api.RestoreBackupResolution) {
+	selected := fmt.Sprintf("%s (%s", resolution.BackupName, resolution.BackupPhase)
+	if resolution.BackupStartTimestamp != nil {
+		selected += fmt.Sprintf(", started %s", resolution.BackupStartTimestamp)
+	}
+	selected += ")"
+	d.Printf("Backup Resolution:\tselected %s from schedule %s with the %s policy", selected, scheduleName, resolution.SelectionPolicy)
+	if resolution.RestoreAsOf != nil {
+		d.Printf(" as of %s", resolution.RestoreAsOf)
+	}
+	d.Println()
+}
+
+// describeRestoreReadiness describes the readiness of the workloads restored by a restore with
+// a readiness check. The ready workloads are only listed when details are requested.
+func describeRestoreReadiness(d *Describer, restore *velerov1api.Restore, details bool) {
+	timeout := restore.Spec.ReadinessCheck.Timeout.Duration
+	readiness := restore.Status.Readiness
+	if readiness == nil {
+		if timeout > 0 {
+			d.Printf("Workload Readiness:\tnot verified yet (timeout %s)\n", timeout)
+		} else {
+			d.Printf("Workload Readiness:\tnot verified yet\n")
+		}
+		return
+	}
+
+	d.Printf("Workload Readiness:\t%d of %d workloads ready", readiness.WorkloadsReady, readiness.WorkloadsReady+readiness.WorkloadsNotReady)
+	if !details && readiness.WorkloadsReady > 0 {
+		d.Printf(" (specify --details for more information)")
+	}
+	d.Println()
+	for _, workload := range readiness.Workloads {
+		if workload.Ready && !details {
+			continue
+		}
+		status := "ready"
+		if !workload.Ready {
+			status = "not ready: " + workload.Message
+		}
+		d.Printf("\t%s %s/%s:\t%s\n", workload.Kind, workload.Namespace, workload.Name, status)
+	}
+}
+
+// DescribeRestoreRollbacks describes the rollbacks of a restore in human-readable format.
+func DescribeRestoreRollbacks(d *Describer, rollbacks []velerov1api.RestoreRollback) {
+	d.Println("Rollbacks:")
+
+	for i, rollback := range rollbacks {
+		if i > 0 {
+			d.Println()
+		}
+
+		phase := rollback.Status.Phase
+		if phase == "" {
+			phase = velerov1api.RestoreRollbackPhaseNew
+		}
+		d.Printf("\t%s: %s\n", rollback.CreationTimestamp.String(), phase)
+		d.Printf("\tItems deleted:\t%d\n", rollback.Status.ItemsDeleted)
+		d.Printf("\tItems reverted:\t%d\n", rollback.Status.ItemsReverted)
+		d.Printf("\tItems skipped:\t%d\n", rollback.Status.ItemsSkipped)
+		if len(rollback.Status.Errors) > 0 {
+			d.Printf("\tErrors:\n")
+			for _, err := range rollback.Status.Errors {
+				d.Printf("\t\t%s\n", err)
+			}
+		}
+	}
+}
+
 // describeUploaderConfigForRestore describes uploader config in human-readable format
 func describeUploaderConfigForRestore(d *Describer, spec velerov1api.RestoreSpec) {
 	if spec.UploaderConfig != nil {
@@ -526,6 +672,80 @@ func describeRestoreResourceList(ctx context.Context, kbClient kbclient.Client,
 	}
 }
 
+// describeRestoreDryRunResult describes what a dry-run restore would have done to the items,
+// and the report of the items when details are requested.
+func describeRestoreDryRunResult(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	result := restore.Status.DryRunResult
+	if result == nil {
+		d.Printf("Dry Run Result:\t<none>\n")
+		return
+	}
+
+	d.Printf("Dry Run Result:\n")
+	d.Printf("\tWould create:\t%d\n", result.WouldCreate)
+	d.Printf("\tWould update:\t%d\n", result.WouldUpdate)
+	d.Printf("\tExists unchanged:\t%d\n", result.ExistsUnchanged)
+	d.Printf("\tExists differs:\t%d\n", result.ExistsDiffers)
+	d.Printf("\tSkipped:\t%d\n", result.Skipped)
+
+	if !details {
+		return
+	}
+
+	// Get BSL cacert if available
+	bslCACert, err := cacert.GetCACertFromRestore(ctx, kbClient, restore.Namespace, restore)
+	if err != nil {
+		// Log the error but don't fail - we can still try to download without the BSL cacert
+		d.Printf("WARNING: Error getting cacert from BSL: %v\n", err)
+		bslCACert = ""
+	}
+
+	d.Println()
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.StreamWithBSLCACert(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreDryRunReport, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath, bslCACert); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Dry Run Report:\t<restore dry-run report not found>")
+		} else {
+			d.Printf("Dry Run Report:\t<error getting restore dry-run report: %v>\n", err)
+		}
+		return
+	}
+
+	var report []velerov1api.RestoreDryRunItem
+	if err := json.NewDecoder(buf).Decode(&report); err != nil {
+		d.Printf("Dry Run Report:\t<error reading restore dry-run report: %v>\n", err)
+		return
+	}
+
+	describeRestoreDryRunReport(d, report)
+}
+
+// describeRestoreDryRunReport describes the items of the report of a dry-run restore grouped by
+// API version and kind, along with the diff of the items that exist in the cluster.
+func describeRestoreDryRunReport(d *Describer, report []velerov1api.RestoreDryRunItem) {
+	d.Println("Dry Run Report:")
+	if len(report) == 0 {
+		d.Printf("\t<none>\n")
+		return
+	}
+
+	resource := ""
+	for _, item := range report {
+		if item.Resource != resource {
+			resource = item.Resource
+			d.Printf("\t%s:\n", resource)
+		}
+		name := item.Name
+		if item.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+		d.Printf("\t\t- %s:\t%s\n", name, item.Result)
+		if item.Diff != "" {
+			d.Printf("\t\t\tDiff:\t%s\n", item.Diff)
+		}
+	}
+}
+
 // DescribeResourceModifier describes resource policies in human-readable format
 func DescribeResourceModifier(d *Describer, resModifier *corev1api.TypedLocalObjectReference) {
 	d.Printf("Resource modifier:\n")
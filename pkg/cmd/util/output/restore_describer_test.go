@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -415,3 +416,146 @@ func TestDescribeResourceModifier(t *testing.T) {
 	fmt.Println(d.buf.String())
 	require.Equal(t, expectOutput, d.buf.String())
 }
+
+func TestDescribeRestoreRollbacks(t *testing.T) {
+	t1, err := time.Parse("2006-Jan-02", "2023-Jun-26")
+	require.NoError(t, err)
+	rollback1 := builder.ForRestoreRollback("velero", "restore-1-abcde").
+		ObjectMeta(builder.WithCreationTimestamp(t1)).
+		RestoreName("restore-1").
+		Phase(velerov1api.RestoreRollbackPhasePartiallyFailed).
+		Errors("error deleting configmaps ns-1/cm-1: forbidden").Result()
+	rollback1.Status.ItemsDeleted = 3
+	rollback1.Status.ItemsReverted = 1
+	t2, err := time.Parse("2006-Jan-02", "2023-Jun-27")
+	require.NoError(t, err)
+	rollback2 := builder.ForRestoreRollback("velero", "restore-1-fghij").
+		ObjectMeta(builder.WithCreationTimestamp(t2)).
+		RestoreName("restore-1").Result()
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeRestoreRollbacks(d, []velerov1api.RestoreRollback{*rollback1, *rollback2})
+	d.out.Flush()
+
+	expect := `Rollbacks:
+  2023-06-26 00:00:00 +0000 UTC: PartiallyFailed
+  Items deleted:   3
+  Items reverted:  1
+  Items skipped:   0
+  Errors:
+    error deleting configmaps ns-1/cm-1: forbidden
+
+  2023-06-27 00:00:00 +0000 UTC: New
+  Items deleted:   0
+  Items reverted:  0
+  Items skipped:   0
+`
+	assert.Equal(t, expect, d.buf.String())
+}
+
+func TestDescribeRestoreBackupResolution(t *testing.T) {
+	started, err := time.Parse("2006-Jan-02", "2023-Jun-26")
+	require.NoError(t, err)
+	asOf, err := time.Parse("2006-Jan-02", "2023-Jun-27")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name       string
+		resolution *velerov1api.RestoreBackupResolution
+		expect     string
+	}{
+		{
+			name: "most recent backup",
+			resolution: &velerov1api.RestoreBackupResolution{
+				BackupName:           "schedule-1-20230626000000",
+				BackupPhase:          velerov1api.BackupPhaseCompleted,
+				BackupStartTimestamp: &metav1.Time{Time: started},
+				SelectionPolicy:      velerov1api.BackupSelectionPolicyCompleted,
+			},
+			expect: "Backup Resolution:  selected schedule-1-20230626000000 (Completed, started 2023-06-26 00:00:00 +0000 UTC) from schedule schedule-1 with the Completed policy\n",
+		},
+		{
+			name: "backup as of a time",
+			resolution: &velerov1api.RestoreBackupResolution{
+				BackupName:           "schedule-1-20230626000000",
+				BackupPhase:          velerov1api.BackupPhasePartiallyFailed,
+				BackupStartTimestamp: &metav1.Time{Time: started},
+				SelectionPolicy:      velerov1api.BackupSelectionPolicyPreferCompleted,
+				RestoreAsOf:          &metav1.Time{Time: asOf},
+			},
+			expect: "Backup Resolution:  selected schedule-1-20230626000000 (PartiallyFailed, started 2023-06-26 00:00:00 +0000 UTC) from schedule schedule-1 with the PreferCompleted policy as of 2023-06-27 00:00:00 +0000 UTC\n",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeRestoreBackupResolution(d, "schedule-1", tc.resolution)
+			d.out.Flush()
+			assert.Equal(t, tc.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeRestoreReadiness(t *testing.T) {
+	readiness := &velerov1api.RestoreReadiness{
+		WorkloadsReady:    1,
+		WorkloadsNotReady: 1,
+		Workloads: []velerov1api.RestoreWorkloadReadiness{
+			{Kind: "Deployment", Namespace: "ns-1", Name: "deploy-1", Ready: true},
+			{Kind: "StatefulSet", Namespace: "ns-1", Name: "sts-1", Message: "0 of 1 replicas are ready"},
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		readiness *velerov1api.RestoreReadiness
+		details   bool
+		expect    string
+	}{
+		{
+			name:   "not verified yet",
+			expect: "Workload Readiness:  not verified yet (timeout 5m0s)\n",
+		},
+		{
+			name:      "not ready workloads are listed",
+			readiness: readiness,
+			expect: `Workload Readiness:  1 of 2 workloads ready (specify --details for more information)
+                     StatefulSet ns-1/sts-1:  not ready: 0 of 1 replicas are ready
+`,
+		},
+		{
+			name:      "all workloads are listed with details",
+			readiness: readiness,
+			details:   true,
+			expect: `Workload Readiness:  1 of 2 workloads ready
+                     Deployment ns-1/deploy-1:  ready
+                     StatefulSet ns-1/sts-1:    not ready: 0 of 1 replicas are ready
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ReadinessCheck(5 * time.Minute).Result()
+			restore.Status.Readiness = tc.readiness
+			describeRestoreReadiness(d, restore, tc.details)
+			d.out.Flush()
+			assert.Equal(t, tc.expect, d.buf.String())
+		})
+	}
+}
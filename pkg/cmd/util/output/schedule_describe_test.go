@@ -2,8 +2,10 @@ package output
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -103,6 +105,111 @@ Backup Template:
   Hooks:  <none>
 
 Last Backup:  2023-06-25 15:04:05 +0000 UTC
+`
+
+	input5 := builder.ForSchedule("velero", "schedule-5").
+		Phase(velerov1api.SchedulePhaseEnabled).
+		CronSchedule("0 * * * *").
+		Retention(&velerov1api.ScheduleRetentionPolicy{Hourly: 24, Daily: 7, Weekly: 4, Monthly: 12}).
+		Template(builder.ForBackup("velero", "backup-1").Result().Spec).
+		LastBackupTime("2023-06-25 15:04:05").Result()
+	expect5 := `Name:         schedule-5
+Namespace:    velero
+Labels:       <none>
+Annotations:  <none>
+
+Phase:  Enabled
+
+Paused:  false
+
+Schedule:   0 * * * *
+Retention:  24 hourly, 7 daily, 4 weekly, 12 monthly
+
+Backup Template:
+  Namespaces:
+    Included:  *
+    Excluded:  <none>
+  
+  Resources:
+    Included:        *
+    Excluded:        <none>
+    Cluster-scoped:  auto
+  
+  Label selector:  <none>
+  
+  Or label selector:  <none>
+  
+  Storage Location:  
+  
+  Velero-Native Snapshot PVs:  auto
+  Snapshot Move Data:          auto
+  Data Mover:                  velero
+  
+  TTL:  0s
+  
+  CSISnapshotTimeout:    0s
+  ItemOperationTimeout:  0s
+  
+  Hooks:  <none>
+
+Last Backup:  2023-06-25 15:04:05 +0000 UTC
+`
+
+	input6 := builder.ForSchedule("velero", "schedule-6").
+		Phase(velerov1api.SchedulePhaseEnabled).
+		CronSchedule("0 * * * *").
+		BlackoutWindows(velerov1api.ScheduleWindow{Schedule: "CRON_TZ=Europe/Paris 0 9 * * 1-5", Duration: metav1.Duration{Duration: 9 * time.Hour}}).
+		BlackoutPolicy(velerov1api.ScheduleBlackoutPolicyDefer).
+		Template(builder.ForBackup("velero", "backup-1").Result().Spec).
+		LastBackupTime("2023-06-25 15:04:05").Result()
+	input6.Status.LastBlackout = &velerov1api.ScheduleBlackout{
+		Policy:        velerov1api.ScheduleBlackoutPolicyDefer,
+		ScheduledTime: &metav1.Time{Time: time.Date(2023, 6, 26, 10, 0, 0, 0, time.UTC)},
+		Reason:        `in blackout window "CRON_TZ=Europe/Paris 0 9 * * 1-5" for 9h0m0s`,
+	}
+	expect6 := `Name:         schedule-6
+Namespace:    velero
+Labels:       <none>
+Annotations:  <none>
+
+Phase:  Enabled
+
+Paused:  false
+
+Schedule:          0 * * * *
+Allowed Windows:   <none>
+Blackout Windows:  "CRON_TZ=Europe/Paris 0 9 * * 1-5" for 9h0m0s
+Blackout Policy:   Defer
+
+Backup Template:
+  Namespaces:
+    Included:  *
+    Excluded:  <none>
+  
+  Resources:
+    Included:        *
+    Excluded:        <none>
+    Cluster-scoped:  auto
+  
+  Label selector:  <none>
+  
+  Or label selector:  <none>
+  
+  Storage Location:  
+  
+  Velero-Native Snapshot PVs:  auto
+  Snapshot Move Data:          auto
+  Data Mover:                  velero
+  
+  TTL:  0s
+  
+  CSISnapshotTimeout:    0s
+  ItemOperationTimeout:  0s
+  
+  Hooks:  <none>
+
+Last Backup:    2023-06-25 15:04:05 +0000 UTC
+Last Blackout:  Deferred the run due at 2023-06-26 10:00:00 +0000 UTC, in blackout window "CRON_TZ=Europe/Paris 0 9 * * 1-5" for 9h0m0s
 `
 
 	input3 := builder.ForSchedule("velero", "schedule-3").
@@ -214,6 +321,16 @@ Last Backup:  2023-06-25 15:04:05 +0000 UTC
 			input:  input2,
 			expect: expect2,
 		},
+		{
+			name:   "schedule with retention policy",
+			input:  input5,
+			expect: expect5,
+		},
+		{
+			name:   "schedule with blackout windows",
+			input:  input6,
+			expect: expect6,
+		},
 		{
 			name:   "schedule with DefaultVolumesToFsBackup is true",
 			input:  input3,
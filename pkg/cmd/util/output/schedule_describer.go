@@ -18,6 +18,7 @@ package output
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/fatih/color"
 
@@ -75,6 +76,18 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.Retention != nil {
+		d.Printf("Retention:\t%s\n", describeRetention(spec.Retention))
+	}
+	if len(spec.AllowedWindows) > 0 || len(spec.BlackoutWindows) > 0 {
+		d.Printf("Allowed Windows:\t%s\n", describeScheduleWindows(spec.AllowedWindows))
+		d.Printf("Blackout Windows:\t%s\n", describeScheduleWindows(spec.BlackoutWindows))
+		policy := spec.BlackoutPolicy
+		if policy == "" {
+			policy = v1.ScheduleBlackoutPolicySkip
+		}
+		d.Printf("Blackout Policy:\t%s\n", policy)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -83,10 +96,54 @@ func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Prefix = ""
 }
 
+func describeRetention(policy *v1.ScheduleRetentionPolicy) string {
+	var periods []string
+	for _, period := range []struct {
+		name  string
+		count int
+	}{
+		{"hourly", policy.Hourly},
+		{"daily", policy.Daily},
+		{"weekly", policy.Weekly},
+		{"monthly", policy.Monthly},
+		{"yearly", policy.Yearly},
+	} {
+		if period.count > 0 {
+			periods = append(periods, fmt.Sprintf("%d %s", period.count, period.name))
+		}
+	}
+	if len(periods) == 0 {
+		return "<none>"
+	}
+	return strings.Join(periods, ", ")
+}
+
+func describeScheduleWindows(windows []v1.ScheduleWindow) string {
+	if len(windows) == 0 {
+		return "<none>"
+	}
+	var described []string
+	for _, window := range windows {
+		described = append(described, fmt.Sprintf("%q for %s", window.Schedule, window.Duration.Duration))
+	}
+	return strings.Join(described, ", ")
+}
+
 func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 	lastBackup := "<never>"
 	if status.LastBackup != nil && !status.LastBackup.Time.IsZero() {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+	if blackout := status.LastBlackout; blackout != nil {
+		action := "Skipped"
+		if blackout.Policy == v1.ScheduleBlackoutPolicyDefer {
+			action = "Deferred"
+		}
+		scheduledTime := "<unknown>"
+		if blackout.ScheduledTime != nil {
+			scheduledTime = fmt.Sprintf("%v", blackout.ScheduledTime.Time)
+		}
+		d.Printf("Last Blackout:\t%s the run due at %s, %s\n", action, scheduledTime, blackout.Reason)
+	}
 }
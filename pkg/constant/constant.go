@@ -8,6 +8,8 @@ const (
 	ControllerBackupRepo            = "backup-repo"
 	ControllerBackupStorageLocation = "backup-storage-location"
 	ControllerBackupSync            = "backup-sync"
+	ControllerBackupTrash           = "backup-trash"
+	ControllerBackupVerification    = "backup-verification"
 	ControllerDataDownload          = "data-download"
 	ControllerDataUpload            = "data-upload"
 	ControllerDownloadRequest       = "download-request"
@@ -19,6 +21,7 @@ const (
 	ControllerSchedule              = "schedule"
 	ControllerServerStatusRequest   = "server-status-request"
 	ControllerRestoreFinalizer      = "restore-finalizer"
+	ControllerRestoreRollback       = "restore-rollback"
 
 	PluginCSIPVCRestoreRIA            = "velero.io/csi-pvc-restorer"
 	PluginCsiVolumeSnapshotRestoreRIA = "velero.io/csi-volumesnapshot-restorer"
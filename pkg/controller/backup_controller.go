@@ -37,6 +37,7 @@ import (
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
@@ -48,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -63,6 +65,9 @@ import (
 
 const (
 	backupResyncPeriod = time.Minute
+
+	// backupQueueRequeuePeriod is how often a queued backup checks whether it can start.
+	backupQueueRequeuePeriod = 5 * time.Second
 )
 
 var autoExcludeNamespaceScopedResources = []string{
@@ -89,6 +94,8 @@ type backupReconciler struct {
 	backupLogLevel              logrus.Level
 	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
 	backupTracker               BackupTracker
+	backupScopeTracker          BackupScopeTracker
+	concurrentBackups           int
 	defaultBackupLocation       string
 	defaultVolumesToFsBackup    bool
 	defaultBackupTTL            time.Duration
@@ -116,6 +123,8 @@ func NewBackupReconciler(
 	backupLogLevel logrus.Level,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupTracker BackupTracker,
+	backupScopeTracker BackupScopeTracker,
+	concurrentBackups int,
 	kbClient kbclient.Client,
 	defaultBackupLocation string,
 	defaultVolumesToFsBackup bool,
@@ -143,6 +152,8 @@ func NewBackupReconciler(
 		backupLogLevel:              backupLogLevel,
 		newPluginManager:            newPluginManager,
 		backupTracker:               backupTracker,
+		backupScopeTracker:          backupScopeTracker,
+		concurrentBackups:           concurrentBackups,
 		kbClient:                    kbClient,
 		defaultBackupLocation:       defaultBackupLocation,
 		defaultVolumesToFsBackup:    defaultVolumesToFsBackup,
@@ -170,6 +181,9 @@ func (b *backupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.Backup{}).
 		Named(constant.ControllerBackup).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: max(b.concurrentBackups, 1),
+		}).
 		Complete(b)
 }
 
@@ -239,6 +253,8 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Debug("backup not found")
+			// the backup may have been deleted while it was queued
+			b.backupScopeTracker.Release(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		log.WithError(err).Error("error getting backup")
@@ -254,8 +270,8 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// InProgress, we still need this check so we can return nil to indicate we've finished processing
 	// this key (even though it was a no-op).
 	switch original.Status.Phase {
-	case "", velerov1api.BackupPhaseNew:
-		// only process new backups
+	case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseQueued:
+		// only process new and queued backups
 	default:
 		b.logger.WithFields(logrus.Fields{
 			"backup": kubeutil.NamespaceAndName(original),
@@ -268,15 +284,24 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	request := b.prepareBackupRequest(original, log)
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
+		b.backupScopeTracker.Release(request.Namespace, request.Name)
 	} else {
+		if admitted, position := b.backupScopeTracker.Admit(original); !admitted {
+			return b.queueBackup(original, position, log)
+		}
+		// release the backup's scope once the backup is done running, the
+		// async operations of the backup don't prevent other backups from starting
+		defer b.backupScopeTracker.Release(request.Namespace, request.Name)
+
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
+		request.Status.QueuePosition = 0
 		request.Status.StartTimestamp = &metav1.Time{Time: b.clock.Now()}
 	}
 
 	// update status to
 	// BackupPhaseFailedValidation
 	// BackupPhaseInProgress
-	// if patch fail, backup can reconcile again as phase would still be "" or New
+	// if patch fail, backup can reconcile again as phase would still be "", New or Queued
 	if err := kubeutil.PatchResource(original, request.Backup, b.kbClient); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "error updating Backup status to %s", request.Status.Phase)
 	}
@@ -302,6 +327,22 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 	}()
 
+	if boolptr.IsSetToTrue(request.Spec.DryRun) {
+		log.Debug("Running dry-run backup")
+		if err := b.runDryRun(request); err != nil {
+			log.WithError(err).Error("dry-run backup failed")
+			request.Status.Phase = velerov1api.BackupPhaseFailed
+			request.Status.FailureReason = err.Error()
+			request.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+		}
+
+		log.Info("Updating backup's status")
+		if err := kubeutil.PatchResourceWithRetriesOnErrors(b.resourceTimeout, original, request.Backup, b.kbClient); err != nil {
+			log.WithError(err).Errorf("error updating backup's status from %v to %v", original.Status.Phase, request.Backup.Status.Phase)
+		}
+		return ctrl.Result{}, nil
+	}
+
 	log.Debug("Running backup")
 
 	b.metrics.RegisterBackupAttempt(backupScheduleName)
@@ -347,11 +388,29 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	return ctrl.Result{}, nil
 }
 
+// queueBackup records that the backup is waiting for the running backups whose scope
+// overlaps with its scope to finish, and requeues it to check again later.
+func (b *backupReconciler) queueBackup(backup *velerov1api.Backup, position int, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.WithField("position", position).Debug("Backup is queued")
+
+	if backup.Status.Phase != velerov1api.BackupPhaseQueued || backup.Status.QueuePosition != position {
+		updated := backup.DeepCopy()
+		updated.Status.Phase = velerov1api.BackupPhaseQueued
+		updated.Status.QueuePosition = position
+		if err := kubeutil.PatchResource(backup, updated, b.kbClient); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating Backup status to %s", velerov1api.BackupPhaseQueued)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: backupQueueRequeuePeriod}, nil
+}
+
 func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logger logrus.FieldLogger) *pkgbackup.Request {
 	request := &pkgbackup.Request{
 		Backup:           backup.DeepCopy(), // don't modify items in the cache
 		SkippedPVTracker: pkgbackup.NewSkipPVTracker(),
 		BackedUpItems:    pkgbackup.NewBackedUpItemsMap(),
+		Manifest:         manifest.New(),
 		ItemBlockChannel: b.workerPool.GetInputChannel(),
 	}
 	request.VolumesInformation.Init()
@@ -437,7 +496,8 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	} else {
 		request.StorageLocation = storageLocation
 
-		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		// a dry-run backup doesn't write anything to the backup storage location
+		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly && !boolptr.IsSetToTrue(request.Spec.DryRun) {
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
@@ -450,6 +510,11 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		}
 	}
 
+	// the parent of an incremental backup is the previous backup of its schedule
+	if boolptr.IsSetToTrue(request.Spec.Incremental) && request.GetLabels()[velerov1api.ScheduleNameLabel] == "" {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "incremental backups must be created by a schedule")
+	}
+
 	// add the storage location as a label for easy filtering later.
 	if request.Labels == nil {
 		request.Labels = make(map[string]string)
@@ -560,6 +625,14 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 	}
 	request.ResPolicies = resourcePolicies
 
+	// validate that the old resource filters aren't mixed with the include/exclude policy
+	if resourcePolicies != nil && resourcePolicies.GetIncludeExcludePolicy() != nil &&
+		(request.Spec.IncludeClusterResources != nil || len(request.Spec.IncludedResources) > 0 || len(request.Spec.ExcludedResources) > 0) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+			"include-resources, exclude-resources and include-cluster-resources are old filter parameters.\n"+
+				"They cannot be used with the include/exclude policy of the resource policies")
+	}
+
 	return request
 }
 
@@ -721,6 +794,12 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	if err := b.setParentBackup(backup, backupStore, backupLog); err != nil {
+		backup.Status.Phase = velerov1api.BackupPhaseFailed
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+		return err
+	}
+
 	backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
 	itemBlockActionResolver := framework.NewItemBlockActionResolver(ibActions)
 
@@ -820,6 +899,65 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// runDryRun collects the items selected by a dry-run backup, records the number of items a
+// real backup would process in the backup's status, and uploads the report of the items,
+// volumes and hooks to object storage. No backup data is uploaded.
+// Any error returned from this function causes the backup to be Failed.
+func (b *backupReconciler) runDryRun(backup *pkgbackup.Request) error {
+	b.logger.WithField(constant.ControllerBackup, kubeutil.NamespaceAndName(backup)).Info("Setting up dry-run backup log")
+
+	logCounter := logging.NewLogHook()
+	backupLog, err := logging.NewTempFileLogger(b.backupLogLevel, b.formatFlag, logCounter, logrus.Fields{constant.ControllerBackup: kubeutil.NamespaceAndName(backup)})
+	if err != nil {
+		return errors.Wrap(err, "error creating dual mode logger for backup")
+	}
+	defer backupLog.Dispose(b.logger.WithField(constant.ControllerBackup, kubeutil.NamespaceAndName(backup)))
+
+	if err := b.backupper.DryRun(backupLog, backup); err != nil {
+		return err
+	}
+	backup.Status.DryRunResult = backup.DryRunResult()
+
+	// the report is too large to be kept in the backup's status
+	if backup.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		backupLog.Warnf("Backup storage location %s is in read-only mode, the dry-run report isn't uploaded", backup.StorageLocation.Name)
+	} else {
+		pluginManager := b.newPluginManager(backupLog)
+		defer pluginManager.CleanupClients()
+
+		if err := putBackupDryRunReport(backup, pluginManager, b.backupStoreGetter, backupLog); err != nil {
+			backupLog.WithError(err).Error("Error uploading backup dry-run report to backup storage")
+		}
+	}
+
+	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
+	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
+	if backup.Status.Errors > 0 {
+		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+	} else {
+		backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	}
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+
+	b.logger.WithField(constant.ControllerBackup, kubeutil.NamespaceAndName(backup)).Infof("Dry-run backup processing complete, moving to %s", backup.Status.Phase)
+
+	return nil
+}
+
+func putBackupDryRunReport(backup *pkgbackup.Request, pluginManager clientmgmt.Manager, backupStoreGetter persistence.ObjectBackupStoreGetter, log logrus.FieldLogger) error {
+	backupStore, err := backupStoreGetter.Get(backup.StorageLocation, pluginManager, log)
+	if err != nil {
+		return err
+	}
+
+	report, errs := encode.ToJSONGzip(backup.DryRunReport, "backup dry-run report")
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return backupStore.PutBackupDryRunReport(backup.Name, report)
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics, finalize bool) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -911,6 +1049,19 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	backupManifest, errs := encode.ToJSONGzip(backup.Manifest, "backup manifest")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
+	if backup.ParentContentIndex != nil {
+		backup.ContentIndex.SetTombstones(backup.ParentContentIndex)
+	}
+	backupContentIndex, errs := encode.ToJSONGzip(backup.ContentIndex, "backup content index")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	backup.FillVolumesInformation()
 
 	volumeInfoJSON, errs := encode.ToJSONGzip(backup.VolumesInformation.Result(
@@ -934,6 +1085,8 @@ func persistBackup(backup *pkgbackup.Request,
 		csiSnapshotClassesJSON = nil
 		backupResult = nil
 		volumeInfoJSON = nil
+		backupManifest = nil
+		backupContentIndex = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -948,6 +1101,8 @@ func persistBackup(backup *pkgbackup.Request,
 		BackupResourceList:       backupResourceList,
 		CSIVolumeSnapshotClasses: csiSnapshotClassesJSON,
 		BackupVolumeInfo:         volumeInfoJSON,
+		Manifest:                 backupManifest,
+		ContentIndex:             backupContentIndex,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
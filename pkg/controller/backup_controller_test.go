@@ -18,6 +18,8 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -33,6 +35,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -94,6 +97,14 @@ func (b *fakeBackupper) FinalizeBackup(
 	return args.Error(0)
 }
 
+func (b *fakeBackupper) DryRun(logger logrus.FieldLogger, backup *pkgbackup.Request) error {
+	args := b.Called(logger, backup)
+	if report, ok := args.Get(0).(*velerov1api.BackupDryRunReport); ok {
+		backup.DryRunReport = report
+	}
+	return args.Error(1)
+}
+
 func defaultBackup() *builder.BackupBuilder {
 	return builder.ForBackup(velerov1api.DefaultNamespace, "backup-1")
 }
@@ -160,6 +171,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 		name           string
 		backup         *velerov1api.Backup
 		backupLocation *velerov1api.BackupStorageLocation
+		resourcePolicy *corev1api.ConfigMap
 		expectedErrs   []string
 	}{
 		{
@@ -185,6 +197,12 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:           "incremental backup not created by a schedule fails validation",
+			backup:         defaultBackup().Incremental(true).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"incremental backups must be created by a schedule"},
+		},
 		{
 			name: "labelSelector as well as orLabelSelectors both are specified in backup request fails validation",
 			backup: defaultBackup().LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).OrLabelSelector([]*metav1.LabelSelector{
@@ -202,6 +220,13 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"include-resources, exclude-resources and include-cluster-resources are old filter parameters.\ninclude-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources are new filter parameters.\nThey cannot be used together"},
 		},
+		{
+			name:           "use old filter parameters with the include/exclude policy",
+			backup:         defaultBackup().IncludedResources("deployments").ResourcePolicies("policy").Result(),
+			backupLocation: defaultBackupLocation,
+			resourcePolicy: builder.ForConfigMap(velerov1api.DefaultNamespace, "policy").Data("policy", "version: v1\nincludeExcludePolicy:\n  excludedNamespaceScopedResources:\n  - secrets\n").Result(),
+			expectedErrs:   []string{"include-resources, exclude-resources and include-cluster-resources are old filter parameters.\nThey cannot be used with the include/exclude policy of the resource policies"},
+		},
 		{
 			name:           "BSL in unavailable state",
 			backup:         defaultBackup().StorageLocation("unavailable").Result(),
@@ -219,12 +244,14 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
 			require.NoError(t, err)
 
-			var fakeClient kbclient.Client
+			var objs []runtime.Object
 			if test.backupLocation != nil {
-				fakeClient = velerotest.NewFakeControllerRuntimeClient(t, test.backupLocation)
-			} else {
-				fakeClient = velerotest.NewFakeControllerRuntimeClient(t)
+				objs = append(objs, test.backupLocation)
 			}
+			if test.resourcePolicy != nil {
+				objs = append(objs, test.resourcePolicy)
+			}
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
 
 			c := &backupReconciler{
 				logger:                logger,
@@ -234,6 +261,7 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 				clock:                 &clock.RealClock{},
 				formatFlag:            formatFlag,
 				metrics:               metrics.NewServerMetrics(),
+				backupScopeTracker:    NewBackupScopeTracker(1),
 				workerPool:            pkgbackup.StartItemBlockWorkerPool(t.Context(), 1, logger),
 			}
 			defer c.workerPool.Stop()
@@ -1531,6 +1559,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				defaultVolumesToFsBackup: test.defaultVolumesToFsBackup,
 				defaultSnapshotMoveData:  test.defaultSnapshotMoveData,
 				backupTracker:            NewBackupTracker(),
+				backupScopeTracker:       NewBackupScopeTracker(1),
 				metrics:                  metrics.NewServerMetrics(),
 				clock:                    testclocks.NewFakeClock(now),
 				newPluginManager:         func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
@@ -1595,6 +1624,192 @@ func TestProcessBackupCompletions(t *testing.T) {
 	}
 }
 
+func TestProcessBackupDryRun(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").
+		Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+	readOnlyLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").
+		AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
+	dryRunReport := &velerov1api.BackupDryRunReport{
+		Items: []velerov1api.BackupDryRunItems{
+			{Namespace: "ns-1", Resource: "pods", Names: []string{"pod-1"}},
+			{Namespace: "ns-2", Resource: "pods", Names: []string{"pod-2", "pod-3"}},
+		},
+	}
+	dryRunResult := &velerov1api.BackupDryRunResult{
+		Resources:   []velerov1api.BackupDryRunResourceCount{{Resource: "pods", Count: 3}},
+		Limitations: new(pkgbackup.Request).DryRunResult().Limitations,
+	}
+
+	tests := []struct {
+		name             string
+		location         *velerov1api.BackupStorageLocation
+		dryRunErr        error
+		putReportErr     error
+		expectedPhase    velerov1api.BackupPhase
+		expectedRes      *velerov1api.BackupDryRunResult
+		expectedWarnings int
+		expectedErrors   int
+		expectReport     bool
+	}{
+		{
+			name:          "dry-run backup completes with the counts in status and the report in the location",
+			location:      location,
+			expectedPhase: velerov1api.BackupPhaseCompleted,
+			expectedRes:   dryRunResult,
+			expectReport:  true,
+		},
+		{
+			name:             "dry-run backup against a read-only location completes without uploading the report",
+			location:         readOnlyLocation,
+			expectedPhase:    velerov1api.BackupPhaseCompleted,
+			expectedRes:      dryRunResult,
+			expectedWarnings: 1,
+		},
+		{
+			name:           "dry-run backup whose report fails to upload partially fails",
+			location:       location,
+			putReportErr:   errors.New("put error"),
+			expectedPhase:  velerov1api.BackupPhasePartiallyFailed,
+			expectedRes:    dryRunResult,
+			expectedErrors: 1,
+			expectReport:   true,
+		},
+		{
+			name:          "dry-run error fails the backup",
+			location:      readOnlyLocation,
+			dryRunErr:     errors.New("dry-run error"),
+			expectedPhase: velerov1api.BackupPhaseFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				logger        = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+				pluginManager = new(pluginmocks.Manager)
+				backupStore   = new(persistencemocks.BackupStore)
+				backupper     = new(fakeBackupper)
+				fakeClient    = velerotest.NewFakeControllerRuntimeClient(t, test.location)
+			)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupReconciler{
+				logger:                logger,
+				discoveryHelper:       discoveryHelper,
+				kbClient:              fakeClient,
+				defaultBackupLocation: test.location.Name,
+				backupTracker:         NewBackupTracker(),
+				backupScopeTracker:    NewBackupScopeTracker(1),
+				metrics:               metrics.NewServerMetrics(),
+				clock:                 testclocks.NewFakeClock(now),
+				newPluginManager:      func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:     NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupper:             backupper,
+				backupLogLevel:        logrus.InfoLevel,
+				formatFlag:            logging.FormatText,
+				workerPool:            pkgbackup.StartItemBlockWorkerPool(t.Context(), 1, logger),
+			}
+			defer c.workerPool.Stop()
+
+			if test.dryRunErr != nil {
+				backupper.On("DryRun", mock.Anything, mock.Anything).Return(nil, test.dryRunErr)
+			} else {
+				backupper.On("DryRun", mock.Anything, mock.Anything).Return(dryRunReport, nil)
+			}
+			pluginManager.On("CleanupClients").Return()
+			var uploaded *velerov1api.BackupDryRunReport
+			backupStore.On("PutBackupDryRunReport", "backup-1", mock.Anything).Run(func(args mock.Arguments) {
+				gzr, err := gzip.NewReader(args.Get(1).(io.Reader))
+				require.NoError(t, err)
+				uploaded = new(velerov1api.BackupDryRunReport)
+				require.NoError(t, json.NewDecoder(gzr).Decode(uploaded))
+			}).Return(test.putReportErr)
+
+			backup := defaultBackup().DryRun(true).Result()
+			require.NoError(t, c.kbClient.Create(t.Context(), backup))
+
+			_, err = c.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+			require.NoError(t, err)
+
+			res := &velerov1api.Backup{}
+			require.NoError(t, c.kbClient.Get(t.Context(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Name}, res))
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Empty(t, res.Status.ValidationErrors)
+			assert.Equal(t, test.expectedRes, res.Status.DryRunResult)
+			assert.Equal(t, test.expectedWarnings, res.Status.Warnings)
+			assert.Equal(t, test.expectedErrors, res.Status.Errors)
+			assert.NotNil(t, res.Status.CompletionTimestamp)
+
+			if test.expectReport {
+				assert.Equal(t, dryRunReport, uploaded)
+			} else {
+				backupStore.AssertNotCalled(t, "PutBackupDryRunReport", mock.Anything, mock.Anything)
+			}
+			backupper.AssertNotCalled(t, "BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			backupStore.AssertNotCalled(t, "PutBackup", mock.Anything)
+		})
+	}
+}
+
+func TestProcessBackupQueued(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").
+		Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+	logger := logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+
+	apiServer := velerotest.NewAPIServer(t)
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupReconciler{
+		logger:                logger,
+		discoveryHelper:       discoveryHelper,
+		kbClient:              velerotest.NewFakeControllerRuntimeClient(t, location),
+		defaultBackupLocation: location.Name,
+		backupTracker:         NewBackupTracker(),
+		backupScopeTracker:    NewBackupScopeTracker(2),
+		metrics:               metrics.NewServerMetrics(),
+		clock:                 &clock.RealClock{},
+		formatFlag:            logging.FormatText,
+		workerPool:            pkgbackup.StartItemBlockWorkerPool(t.Context(), 1, logger),
+	}
+	defer c.workerPool.Stop()
+
+	// a running backup of the same namespace keeps the backup queued
+	running := builder.ForBackup(velerov1api.DefaultNamespace, "running").IncludedNamespaces("ns-1").Result()
+	admitted, _ := c.backupScopeTracker.Admit(running)
+	require.True(t, admitted)
+
+	backup := defaultBackup().IncludedNamespaces("ns-1", "ns-2").Result()
+	require.NoError(t, c.kbClient.Create(t.Context(), backup))
+
+	result, err := c.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: backupQueueRequeuePeriod}, result)
+
+	res := &velerov1api.Backup{}
+	require.NoError(t, c.kbClient.Get(t.Context(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Name}, res))
+	assert.Equal(t, velerov1api.BackupPhaseQueued, res.Status.Phase)
+	assert.Equal(t, 1, res.Status.QueuePosition)
+	assert.Nil(t, res.Status.StartTimestamp)
+
+	// deleting the queued backup removes it from the queue
+	require.NoError(t, c.kbClient.Delete(t.Context(), res))
+	_, err = c.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+
+	other := builder.ForBackup(velerov1api.DefaultNamespace, "other").IncludedNamespaces("ns-2").Result()
+	admitted, _ = c.backupScopeTracker.Admit(other)
+	assert.True(t, admitted)
+}
+
 func TestValidateAndGetSnapshotLocations(t *testing.T) {
 	defaultBSL := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "bsl").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
 	tests := []struct {
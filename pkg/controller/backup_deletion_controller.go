@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -28,6 +29,7 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
@@ -183,6 +185,20 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup")
 	}
 
+	// Don't allow deleting backups under legal hold
+	if boolptr.IsSetToTrue(backup.Spec.LegalHold) {
+		err := r.patchDeleteBackupRequestWithError(ctx, dbr, errors.New("backup is under legal hold, release it first"))
+		return ctrl.Result{}, err
+	}
+
+	// Don't allow deleting backups that incremental backups are based on
+	if children, err := incrementalChildren(ctx, r.Client, backup); err != nil {
+		return ctrl.Result{}, err
+	} else if len(children) > 0 {
+		err := r.patchDeleteBackupRequestWithError(ctx, dbr, fmt.Errorf("backup is the parent of incremental backup(s) %s, delete them first", strings.Join(children, ", ")))
+		return ctrl.Result{}, err
+	}
+
 	// Don't allow deleting backups in read-only storage locations
 	location := &velerov1api.BackupStorageLocation{}
 	if err := r.Get(context.Background(), client.ObjectKey{
@@ -206,6 +222,17 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	// Deleted backups are kept in the trash of the location until their recovery window passes
+	trashed := backup.Status.Phase == velerov1api.BackupPhaseDeleted
+	if trashed {
+		if softDeletion := backup.Status.SoftDeletion; softDeletion != nil && softDeletion.PurgeTimestamp != nil && r.clock.Now().Before(softDeletion.PurgeTimestamp.Time) {
+			err := r.patchDeleteBackupRequestWithError(ctx, dbr, fmt.Errorf("backup is already deleted, it's purged at %s", softDeletion.PurgeTimestamp.UTC().Format(time.RFC3339)))
+			return ctrl.Result{}, err
+		}
+	} else if location.Spec.DeletionGracePeriod != nil && location.Spec.DeletionGracePeriod.Duration > 0 {
+		return r.softDeleteBackup(ctx, dbr, backup, location, log)
+	}
+
 	// if the request object has no labels defined, initialize an empty map since
 	// we will be updating labels
 	if dbr.Labels == nil {
@@ -250,6 +277,15 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err2
 	}
 
+	// the objects of a deleted backup are moved back from the trash to be purged with it
+	if trashed {
+		if err := backupStore.UntrashBackup(backup.Name); err != nil {
+			log.WithError(err).Error("Error moving the backup out of the trash")
+			err2 := r.patchDeleteBackupRequestWithError(ctx, dbr, errors.Wrap(err, "error moving the backup out of the trash"))
+			return ctrl.Result{}, err2
+		}
+	}
+
 	actions, err := pluginManager.GetDeleteItemActions()
 	log.Debugf("%d actions before invoking actions", len(actions))
 	if err != nil {
@@ -262,8 +298,8 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	var errs []string
 
 	if len(actions) > 0 {
-		// Download the tarball
-		backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+		// Download the tarball, rebuilt from the parent chain for incremental backups
+		backupFile, err := downloadBackupContents(backup, backupStore, log)
 
 		if err != nil {
 			log.WithError(err).Errorf("Unable to download tarball for backup %s, skipping associated DeleteItemAction plugins", backup.Name)
@@ -450,6 +486,57 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// softDeleteBackup moves the objects of the backup to the trash of its location and marks the
+// backup as deleted. The backup and all its associated data are purged once the deletion grace
+// period of the location passes, until then the backup can be undeleted.
+func (r *backupDeletionReconciler) softDeleteBackup(ctx context.Context, dbr *velerov1api.DeleteBackupRequest, backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (ctrl.Result, error) {
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Error("Error getting the backup store")
+		err2 := r.patchDeleteBackupRequestWithError(ctx, dbr, errors.Wrap(err, "error getting the backup store"))
+		return ctrl.Result{}, err2
+	}
+
+	if err := backupStore.TrashBackup(backup.Name); err != nil {
+		log.WithError(err).Error("Error moving the backup to the trash")
+		err2 := r.patchDeleteBackupRequestWithError(ctx, dbr, errors.Wrap(err, "error moving the backup to the trash"))
+		return ctrl.Result{}, err2
+	}
+
+	now := r.clock.Now()
+	if _, err := r.patchBackup(ctx, backup, func(b *velerov1api.Backup) {
+		b.Status.SoftDeletion = &velerov1api.BackupSoftDeletion{
+			Phase:          b.Status.Phase,
+			Timestamp:      &metav1.Time{Time: now},
+			PurgeTimestamp: &metav1.Time{Time: now.Add(location.Spec.DeletionGracePeriod.Duration)},
+		}
+		b.Status.Phase = velerov1api.BackupPhaseDeleted
+	}); err != nil {
+		log.WithError(err).Error("Error setting backup phase to deleted")
+		// the backup would otherwise be removed from the cluster as it's missing in the backups of
+		// the location, while its objects are left in the trash
+		if err := backupStore.UntrashBackup(backup.Name); err != nil {
+			log.WithError(err).Error("Error moving the backup out of the trash")
+		}
+		err2 := r.patchDeleteBackupRequestWithError(ctx, dbr, errors.Wrap(err, "error setting backup phase to deleted"))
+		return ctrl.Result{}, err2
+	}
+	log.Infof("Backup is moved to the trash, it's purged after %s", location.Spec.DeletionGracePeriod.Duration)
+
+	if dbr.Labels == nil {
+		dbr.Labels = map[string]string{}
+	}
+	_, err = r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+		r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+		r.Labels[velerov1api.BackupNameLabel] = label.GetValidName(backup.Name)
+		r.Labels[velerov1api.BackupUIDLabel] = string(backup.UID)
+	})
+	return ctrl.Result{}, err
+}
+
 func (r *backupDeletionReconciler) volumeSnapshottersForVSL(
 	ctx context.Context,
 	namespace, vslName string,
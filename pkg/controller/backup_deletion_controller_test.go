@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
@@ -40,14 +41,17 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	testclocks "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -239,6 +243,92 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		assert.Len(t, res.Status.Errors, 1)
 		assert.Equal(t, "backup not found", res.Status.Errors[0])
 	})
+	t.Run("deleting a backup under legal hold isn't allowed", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").LegalHold(true).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup)
+
+		_, err := td.controller.Reconcile(t.Context(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		assert.Len(t, res.Status.Errors, 1)
+		assert.Equal(t, "backup is under legal hold, release it first", res.Status.Errors[0])
+
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{}))
+	})
+	t.Run("backup is moved to the trash when its location has a deletion grace period", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Phase(velerov1api.BackupPhasePartiallyFailed).Result()
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).DeletionGracePeriod(24 * time.Hour).Result()
+		restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseCompleted).Backup("foo").Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location, restore)
+		td.controller.clock = testclocks.NewFakeClock(now)
+		td.backupStore.On("TrashBackup", "foo").Return(nil)
+
+		_, err := td.controller.Reconcile(t.Context(), td.req)
+		require.NoError(t, err)
+		td.backupStore.AssertExpectations(t)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		require.NoError(t, td.fakeClient.Get(ctx, td.req.NamespacedName, res))
+		assert.Equal(t, velerov1api.DeleteBackupRequestPhaseProcessed, res.Status.Phase)
+		assert.Empty(t, res.Status.Errors)
+
+		deleted := &velerov1api.Backup{}
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, deleted))
+		assert.Equal(t, velerov1api.BackupPhaseDeleted, deleted.Status.Phase)
+		require.NotNil(t, deleted.Status.SoftDeletion)
+		assert.Equal(t, velerov1api.BackupPhasePartiallyFailed, deleted.Status.SoftDeletion.Phase)
+		assert.True(t, now.Equal(deleted.Status.SoftDeletion.Timestamp.Time))
+		assert.True(t, now.Add(24*time.Hour).Equal(deleted.Status.SoftDeletion.PurgeTimestamp.Time))
+
+		require.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}, &velerov1api.Restore{}))
+	})
+	t.Run("deleted backup isn't purged before its recovery window passes", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Phase(velerov1api.BackupPhaseDeleted).Result()
+		backup.Status.SoftDeletion = &velerov1api.BackupSoftDeletion{PurgeTimestamp: &metav1.Time{Time: now.Add(time.Hour)}}
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).DeletionGracePeriod(24 * time.Hour).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+		td.controller.clock = testclocks.NewFakeClock(now)
+
+		_, err := td.controller.Reconcile(t.Context(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		require.NoError(t, td.fakeClient.Get(ctx, td.req.NamespacedName, res))
+		assert.Equal(t, velerov1api.DeleteBackupRequestPhaseProcessed, res.Status.Phase)
+		assert.Equal(t, []string{"backup is already deleted, it's purged at 2024-06-01T13:00:00Z"}, res.Status.Errors)
+	})
+	t.Run("deleted backup is purged once its recovery window passes", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Phase(velerov1api.BackupPhaseDeleted).Result()
+		backup.Status.SoftDeletion = &velerov1api.BackupSoftDeletion{PurgeTimestamp: &metav1.Time{Time: now.Add(-time.Hour)}}
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).DeletionGracePeriod(24 * time.Hour).Result()
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+		td.controller.clock = testclocks.NewFakeClock(now)
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+		td.backupStore.On("UntrashBackup", "foo").Return(nil)
+		td.backupStore.On("GetBackupVolumeSnapshots", "foo").Return(nil, nil)
+		td.backupStore.On("DeleteBackup", "foo").Return(nil)
+
+		_, err := td.controller.Reconcile(t.Context(), td.req)
+		require.NoError(t, err)
+		td.backupStore.AssertExpectations(t)
+
+		err = td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{})
+		assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+	})
 	t.Run("unable to find backup storage location", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
 
@@ -705,6 +795,62 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		// Make sure snapshot was deleted
 		assert.Equal(t, 0, td.volumeSnapshotter.SnapshotsTaken.Len())
 	})
+	t.Run("DeleteItemAction plugins get the items of an incremental backup rebuilt from its parent chain", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("primary").ParentBackup("backup-1").Result()
+		backup.UID = "uid"
+
+		location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+
+		cm1 := builder.ForConfigMap("ns-1", "cm-1").Result()
+		cm2 := builder.ForConfigMap("ns-1", "cm-2").Result()
+		cm1JSON, err := json.Marshal(cm1)
+		require.NoError(t, err)
+		cm2JSON, err := json.Marshal(cm2)
+		require.NoError(t, err)
+
+		parentIndex := archive.NewContentIndex("", nil)
+		parentIndex.Add("resources/configmaps/namespaces/ns-1/cm-1.json", cm1JSON)
+		index := archive.NewContentIndex("backup-1", parentIndex)
+		index.Add("resources/configmaps/namespaces/ns-1/cm-1.json", cm1JSON)
+		index.Add("resources/configmaps/namespaces/ns-1/cm-2.json", cm2JSON)
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), backup, location)
+
+		var deletedItems []string
+		action := new(mocks.DeleteItemAction)
+		action.On("AppliesTo").Return(velero.ResourceSelector{}, nil)
+		action.On("Execute", mock.Anything).Run(func(args mock.Arguments) {
+			item := args.Get(0).(*velero.DeleteItemActionExecuteInput).Item.(*unstructured.Unstructured)
+			deletedItems = append(deletedItems, item.GetName())
+		}).Return(nil)
+
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("GetDeleteItemActions").Return([]velero.DeleteItemAction{action}, nil)
+		pluginManager.On("CleanupClients")
+		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+		td.backupStore.On("GetBackupVolumeSnapshots", "foo").Return(nil, nil)
+		td.backupStore.On("GetBackupContentIndex", "foo").Return(index, nil)
+		td.backupStore.On("GetBackupContentIndex", "backup-1").Return(parentIndex, nil)
+		td.backupStore.On("GetBackupContents", "foo").Return(io.NopCloser(velerotest.NewTarWriter(t).
+			Add("metadata/version", []byte("1")).
+			Add("resources/configmaps/namespaces/ns-1/cm-2.json", cm2JSON).
+			Done()), nil)
+		td.backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(velerotest.NewTarWriter(t).
+			Add("metadata/version", []byte("1")).
+			Add("resources/configmaps/namespaces/ns-1/cm-1.json", cm1JSON).
+			Done()), nil)
+		td.backupStore.On("DeleteBackup", "foo").Return(nil)
+
+		_, err = td.controller.Reconcile(t.Context(), td.req)
+		require.NoError(t, err)
+
+		// the items inherited from the parent backup are passed to the plugins too
+		sort.Strings(deletedItems)
+		assert.Equal(t, []string{"cm-1", "cm-2"}, deletedItems)
+		td.backupStore.AssertCalled(t, "DeleteBackup", "foo")
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", "backup-1")
+	})
 	t.Run("Expired request will be deleted if the status is processed", func(t *testing.T) {
 		expired := time.Date(2018, 4, 3, 12, 0, 0, 0, time.UTC)
 		input := defaultTestDbr()
@@ -166,7 +166,7 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	var outBackupFile *os.File
 	if len(operations) > 0 {
 		log.Info("Setting up finalized backup temp file")
-		inBackupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+		inBackupFile, err := downloadBackupContents(backup, backupStore, log)
 		if err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "error downloading backup")
 		}
@@ -200,6 +200,15 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			log.WithError(err).Error("error finalizing Backup")
 			return ctrl.Result{}, errors.WithStack(err)
 		}
+
+		if backup.Status.ParentBackup != "" {
+			strippedBackupFile, err := stripParentContents(backup, outBackupFile, backupStore, log)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "error stripping the contents of the parent backup")
+			}
+			defer closeAndRemoveFile(strippedBackupFile, log)
+			outBackupFile = strippedBackupFile
+		}
 	}
 	backupScheduleName := backupRequest.GetLabels()[velerov1api.ScheduleNameLabel]
 	switch backup.Status.Phase {
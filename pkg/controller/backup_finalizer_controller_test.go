@@ -17,7 +17,9 @@ limitations under the License.
 package controller
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"io"
 	"testing"
 	"time"
@@ -34,13 +36,16 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -242,3 +247,136 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupFinalizerReconcileIncrementalBackup(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	metav1Now := metav1.NewTime(fakeClock.Now())
+
+	cm1, cm2, cm3 := []byte("cm-1"), []byte("cm-2"), []byte("cm-3")
+	parentIndex := archive.NewContentIndex("", nil)
+	parentIndex.Add("resources/configmaps/namespaces/ns-1/cm-1.json", cm1)
+	parentIndex.Add("resources/configmaps/namespaces/ns-1/cm-2.json", cm2)
+	index := archive.NewContentIndex("backup-1", parentIndex)
+	index.Add("resources/configmaps/namespaces/ns-1/cm-1.json", cm1)
+	index.Add("resources/configmaps/namespaces/ns-1/cm-2.json", cm2)
+	index.Add("resources/configmaps/namespaces/ns-1/cm-3.json", cm3)
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").
+		StorageLocation("default").
+		ObjectMeta(builder.WithUID("foo")).
+		StartTimestamp(fakeClock.Now()).
+		ParentBackup("backup-1").
+		Phase(velerov1api.BackupPhaseFinalizing).Result()
+	operations := []*itemoperation.BackupOperation{
+		{
+			Spec: itemoperation.BackupOperationSpec{
+				BackupName:       "backup-2",
+				BackupUID:        "foo",
+				BackupItemAction: "foo",
+				ResourceIdentifier: velero.ResourceIdentifier{
+					GroupResource: kuberesource.ConfigMaps,
+					Namespace:     "ns-1",
+					Name:          "cm-2",
+				},
+				OperationID: "operation-1",
+			},
+			Status: itemoperation.OperationStatus{
+				Phase:   itemoperation.OperationPhaseCompleted,
+				Created: &metav1Now,
+			},
+		},
+	}
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t, backup, builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result())
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+	pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetBackupItemOperations", "backup-2").Return(operations, nil)
+	backupStore.On("GetBackupContentIndex", "backup-1").Return(parentIndex, nil)
+	backupStore.On("GetBackupContentIndex", "backup-2").Return(index, nil)
+	backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		Add("resources/configmaps/namespaces/ns-1/cm-1.json", cm1).
+		Add("resources/configmaps/namespaces/ns-1/cm-2.json", cm2).
+		Done()), nil)
+	backupStore.On("GetBackupContents", "backup-2").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		Add("resources/configmaps/namespaces/ns-1/cm-3.json", cm3).
+		Done()), nil)
+	backupStore.On("PutBackupMetadata", "backup-2", mock.Anything).Return(nil)
+	var finalizedContents []byte
+	backupStore.On("PutBackupContents", "backup-2", mock.Anything).Run(func(args mock.Arguments) {
+		var err error
+		finalizedContents, err = io.ReadAll(args.Get(1).(io.Reader))
+		require.NoError(t, err)
+	}).Return(nil)
+
+	backupper := new(fakeBackupper)
+	var finalizerInput map[string]string
+	// the finalization updates cm-2 and keeps the other files of the contents it's given
+	backupper.On("FinalizeBackup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolverV2{}, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		finalizerInput = readTarball(t, args.Get(2).(io.Reader))
+		tw := velerotest.NewTarWriter(t)
+		for name, content := range finalizerInput {
+			if name == "resources/configmaps/namespaces/ns-1/cm-2.json" {
+				content = "cm-2-finalized"
+			}
+			tw.Add(name, []byte(content))
+		}
+		_, err := io.Copy(args.Get(3).(io.Writer), tw.Done())
+		require.NoError(t, err)
+	}).Return(nil)
+
+	reconciler := NewBackupFinalizerReconciler(
+		fakeClient,
+		fakeClient,
+		fakeClock,
+		backupper,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewBackupTracker(),
+		NewFakeSingleObjectBackupStoreGetter(backupStore),
+		logrus.StandardLogger(),
+		metrics.NewServerMetrics(),
+		10*time.Minute,
+	)
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+
+	// the backup is finalized from its full contents, rebuilt from its parent chain
+	assert.Equal(t, map[string]string{
+		"metadata/version": "1",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "cm-1",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "cm-2",
+		"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+	}, finalizerInput)
+	// and only stores the files that changed since its parent backup
+	assert.Equal(t, map[string]string{
+		"metadata/version": "1",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "cm-2-finalized",
+		"resources/configmaps/namespaces/ns-1/cm-3.json": "cm-3",
+	}, readTarball(t, bytes.NewReader(finalizedContents)))
+
+	backupAfter := velerov1api.Backup{}
+	require.NoError(t, fakeClient.Get(t.Context(), types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &backupAfter))
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, backupAfter.Status.Phase)
+}
+
+// readTarball returns the files of a gzipped tarball.
+func readTarball(t *testing.T, r io.Reader) map[string]string {
+	t.Helper()
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+}
@@ -25,9 +25,11 @@ import (
 	"slices"
 	"time"
 
+	"github.com/kopia/kopia/repo"
 	"github.com/petar/GoLLRB/llrb"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,12 +39,20 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/repository"
 	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	"github.com/vmware-tanzu/velero/pkg/repository/maintenance"
 	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -63,6 +73,9 @@ type BackupRepoReconciler struct {
 	maintenanceFrequency      time.Duration
 	backupRepoConfig          string
 	repositoryManager         repomanager.Manager
+	repoLocker                *repository.RepoLocker
+	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter         persistence.ObjectBackupStoreGetter
 	keepLatestMaintenanceJobs int
 	repoMaintenanceConfig     string
 	maintenanceJobResources   kube.PodResources
@@ -75,6 +88,9 @@ func NewBackupRepoReconciler(
 	logger logrus.FieldLogger,
 	client client.Client,
 	repositoryManager repomanager.Manager,
+	repoLocker *repository.RepoLocker,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	maintenanceFrequency time.Duration,
 	backupRepoConfig string,
 	keepLatestMaintenanceJobs int,
@@ -91,6 +107,9 @@ func NewBackupRepoReconciler(
 		maintenanceFrequency,
 		backupRepoConfig,
 		repositoryManager,
+		repoLocker,
+		newPluginManager,
+		backupStoreGetter,
 		keepLatestMaintenanceJobs,
 		repoMaintenanceConfig,
 		maintenanceJobResources,
@@ -111,7 +130,14 @@ func (r *BackupRepoReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&velerov1api.BackupRepository{}, builder.WithPredicates(kube.SpecChangePredicate{})).
+		For(&velerov1api.BackupRepository{}, builder.WithPredicates(predicate.Or[client.Object](
+			kube.SpecChangePredicate{},
+			// reconcile when a rotation of the repository key is requested
+			kube.NewUpdateEventPredicate(func(oldObj, newObj client.Object) bool {
+				return oldObj.GetAnnotations()[velerov1api.RepositoryKeyRotationRequestedAnnotation] !=
+					newObj.GetAnnotations()[velerov1api.RepositoryKeyRotationRequestedAnnotation]
+			}),
+		))).
 		WatchesRawSource(s).
 		Watches(
 			// mark BackupRepository as invalid when BSL is created, updated or deleted.
@@ -252,6 +278,13 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		log.WithError(err).Error("Error checking repository for stale locks")
 	}
 
+	// pick up the key the repository was rotated to by the other clusters sharing the BSL
+	if backupRepo.Status.Phase == velerov1api.BackupRepositoryPhaseReady && backupRepo.Spec.RepositoryType == velerov1api.BackupRepositoryTypeKopia {
+		if _, err := r.syncRepositoryKey(ctx, backupRepo, bsl, log); err != nil {
+			log.WithError(err).Warn("Failed to sync the key of the backup repository with its escrowed keys")
+		}
+	}
+
 	switch backupRepo.Status.Phase {
 	case velerov1api.BackupRepositoryPhaseNotReady:
 		ready, err := r.checkNotReadyRepo(ctx, backupRepo, bsl, log)
@@ -275,6 +308,12 @@ func (r *BackupRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return ctrl.Result{}, errors.Wrap(err, "error check and run repo maintenance jobs")
 		}
 
+		// The repository keeps working with its current key if the rotation fails, so the
+		// rotation is retried in the next reconcile rather than failing this one.
+		if err := r.rotateKeyIfDue(ctx, backupRepo, bsl, log); err != nil {
+			log.WithError(err).Warn("Failed to rotate the key of the backup repository")
+		}
+
 		// Get the configured number of maintenance jobs to keep from ConfigMap, fallback to CLI parameter
 		keepJobs := r.keepLatestMaintenanceJobs
 		if configuredKeep, err := maintenance.GetKeepLatestMaintenanceJobs(ctx, r.Client, log, r.namespace, r.repoMaintenanceConfig, backupRepo); err != nil {
@@ -356,6 +395,10 @@ func (r *BackupRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 		return err
 	}
 
+	if err := r.prepareRepositoryKey(ctx, req, bsl, log); err != nil {
+		return r.patchBackupRepository(ctx, req, repoNotReady(err.Error()))
+	}
+
 	if err := ensureRepo(req, r.repositoryManager); err != nil {
 		return r.patchBackupRepository(ctx, req, repoNotReady(err.Error()))
 	}
@@ -544,6 +587,330 @@ func dueForMaintenance(req *velerov1api.BackupRepository, now time.Time) bool {
 	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
 }
 
+// keyRotationDue returns whether the key of the repository should be rotated: a rotation is
+// requested through the annotation, or a previous rotation didn't complete. Only the keys of
+// kopia repositories can be rotated.
+func keyRotationDue(req *velerov1api.BackupRepository) bool {
+	if req.Spec.RepositoryType != velerov1api.BackupRepositoryTypeKopia {
+		return false
+	}
+
+	if req.Status.PendingRepositoryKeySecret != nil {
+		return true
+	}
+
+	requested, err := time.Parse(time.RFC3339, req.Annotations[velerov1api.RepositoryKeyRotationRequestedAnnotation])
+	if err != nil {
+		return false
+	}
+	return req.Status.LastKeyRotationTime == nil || requested.After(req.Status.LastKeyRotationTime.Truncate(time.Second))
+}
+
+// rotateKeyIfDue moves the repository to a new randomly generated key. The new key is recorded
+// in the status of the repository, and escrowed in the BSL, before the password of the repository
+// is changed, so that an interrupted rotation completes in a later reconcile and the new key is
+// never only held by this cluster. The rotation is postponed to a later reconcile while the data
+// of the repository is moved or the repository is maintained.
+func (r *BackupRepoReconciler) rotateKeyIfDue(ctx context.Context, req *velerov1api.BackupRepository, bsl *velerov1api.BackupStorageLocation, log logrus.FieldLogger) error {
+	if !keyRotationDue(req) {
+		return nil
+	}
+
+	// the exclusive lock only serializes the rotation with the operations this server runs on the
+	// repository through the repository manager. The pod volume backups and restores, the data
+	// movements and the maintenance jobs run in other pods and connect to the repository on their
+	// own, so they're checked for separately.
+	r.repoLocker.LockExclusive(req.Name)
+	defer r.repoLocker.UnlockExclusive(req.Name)
+
+	operation, err := r.repositoryOperationInProgress(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "error checking the operations of the backup repository in progress")
+	}
+	if operation != "" {
+		log.Infof("Postponing the rotation of the key of the backup repository, %s is in progress", operation)
+		return nil
+	}
+
+	log.Info("Rotating the key of the backup repository")
+
+	current := ""
+	if req.Spec.RepositoryKeySecret != nil {
+		current = req.Spec.RepositoryKeySecret.Key
+	}
+
+	if req.Status.PendingRepositoryKeySecret == nil {
+		newKey, err := repokey.NewRepositoryKey(ctx, r.Client, req)
+		if err != nil {
+			return errors.Wrap(err, "error generating backup repository key")
+		}
+
+		if err := r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+			rr.Status.PendingRepositoryKeySecret = newKey
+		}); err != nil {
+			return err
+		}
+	}
+	newKey := req.Status.PendingRepositoryKeySecret
+
+	if err := r.escrowRepositoryKeys(ctx, req, bsl, current, newKey.Key, log); err != nil {
+		return errors.Wrap(err, "error escrowing the new backup repository key")
+	}
+
+	if err := r.repositoryManager.ChangeKey(req, newKey); err != nil {
+		return errors.Wrap(err, "error changing backup repository key")
+	}
+
+	if err := r.escrowRepositoryKeys(ctx, req, bsl, newKey.Key, "", log); err != nil {
+		return errors.Wrap(err, "error escrowing the rotated backup repository key")
+	}
+
+	if err := r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+		rr.Spec.RepositoryKeySecret = rr.Status.PendingRepositoryKeySecret
+		rr.Status.PendingRepositoryKeySecret = nil
+		rr.Status.LastKeyRotationTime = &metav1.Time{Time: r.clock.Now()}
+	}); err != nil {
+		return err
+	}
+
+	log.Info("Rotated the key of the backup repository")
+
+	return nil
+}
+
+// repositoryOperationInProgress returns the description of a pod volume backup or restore, data
+// upload or download, or maintenance job of the repository that isn't completed yet, or an empty
+// string if there's none.
+func (r *BackupRepoReconciler) repositoryOperationInProgress(ctx context.Context, req *velerov1api.BackupRepository) (string, error) {
+	isRepoVolume := func(volumeNamespace, bsl string) bool {
+		return volumeNamespace == req.Spec.VolumeNamespace && bsl == req.Spec.BackupStorageLocation
+	}
+
+	pvbs := new(velerov1api.PodVolumeBackupList)
+	if err := r.List(ctx, pvbs, client.InNamespace(req.Namespace)); err != nil {
+		return "", errors.Wrap(err, "error listing pod volume backups")
+	}
+	for _, pvb := range pvbs.Items {
+		if !isRepoVolume(pvb.Spec.Pod.Namespace, pvb.Spec.BackupStorageLocation) || podvolume.GetPvbRepositoryType(&pvb) != req.Spec.RepositoryType {
+			continue
+		}
+		switch pvb.Status.Phase {
+		case velerov1api.PodVolumeBackupPhaseCompleted, velerov1api.PodVolumeBackupPhaseFailed, velerov1api.PodVolumeBackupPhaseCanceled:
+		default:
+			return fmt.Sprintf("pod volume backup %s", pvb.Name), nil
+		}
+	}
+
+	pvrs := new(velerov1api.PodVolumeRestoreList)
+	if err := r.List(ctx, pvrs, client.InNamespace(req.Namespace)); err != nil {
+		return "", errors.Wrap(err, "error listing pod volume restores")
+	}
+	for _, pvr := range pvrs.Items {
+		if !isRepoVolume(pvr.Spec.SourceNamespace, pvr.Spec.BackupStorageLocation) || podvolume.GetPvrRepositoryType(&pvr) != req.Spec.RepositoryType {
+			continue
+		}
+		switch pvr.Status.Phase {
+		case velerov1api.PodVolumeRestorePhaseCompleted, velerov1api.PodVolumeRestorePhaseFailed, velerov1api.PodVolumeRestorePhaseCanceled:
+		default:
+			return fmt.Sprintf("pod volume restore %s", pvr.Name), nil
+		}
+	}
+
+	// the built-in data mover moves the data with the kopia repositories
+	if req.Spec.RepositoryType == velerov1api.BackupRepositoryTypeKopia {
+		dataUploads := new(velerov2alpha1api.DataUploadList)
+		if err := r.List(ctx, dataUploads, client.InNamespace(req.Namespace)); err != nil {
+			return "", errors.Wrap(err, "error listing data uploads")
+		}
+		for _, du := range dataUploads.Items {
+			if !isRepoVolume(du.Spec.SourceNamespace, du.Spec.BackupStorageLocation) || !datamover.IsBuiltInUploader(du.Spec.DataMover) {
+				continue
+			}
+			switch du.Status.Phase {
+			case velerov2alpha1api.DataUploadPhaseCompleted, velerov2alpha1api.DataUploadPhaseFailed, velerov2alpha1api.DataUploadPhaseCanceled:
+			default:
+				return fmt.Sprintf("data upload %s", du.Name), nil
+			}
+		}
+
+		dataDownloads := new(velerov2alpha1api.DataDownloadList)
+		if err := r.List(ctx, dataDownloads, client.InNamespace(req.Namespace)); err != nil {
+			return "", errors.Wrap(err, "error listing data downloads")
+		}
+		for _, dd := range dataDownloads.Items {
+			if !isRepoVolume(dd.Spec.SourceNamespace, dd.Spec.BackupStorageLocation) || !datamover.IsBuiltInUploader(dd.Spec.DataMover) {
+				continue
+			}
+			switch dd.Status.Phase {
+			case velerov2alpha1api.DataDownloadPhaseCompleted, velerov2alpha1api.DataDownloadPhaseFailed, velerov2alpha1api.DataDownloadPhaseCanceled:
+			default:
+				return fmt.Sprintf("data download %s", dd.Name), nil
+			}
+		}
+	}
+
+	jobs := new(batchv1api.JobList)
+	if err := r.List(ctx, jobs, client.InNamespace(req.Namespace), client.MatchingLabels{maintenance.RepositoryNameLabel: req.Name}); err != nil {
+		return "", errors.Wrap(err, "error listing maintenance jobs")
+	}
+	for _, job := range jobs.Items {
+		if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+			return fmt.Sprintf("maintenance job %s", job.Name), nil
+		}
+	}
+
+	return "", nil
+}
+
+// prepareRepositoryKey sets the key the repository is connected to with before the repository is
+// prepared. The key escrowed for a kopia repository in its BSL is used first. Otherwise a kopia
+// repository that doesn't exist yet gets a new random key before it's initialized, while a kopia
+// repository initialized by a previous version keeps the key shared by all the repositories.
+// The key of the repository is escrowed before the repository is initialized with it. The restic
+// repositories always use the shared key.
+func (r *BackupRepoReconciler) prepareRepositoryKey(ctx context.Context, req *velerov1api.BackupRepository, bsl *velerov1api.BackupStorageLocation, log logrus.FieldLogger) error {
+	if req.Spec.RepositoryType != velerov1api.BackupRepositoryTypeKopia {
+		if req.Spec.RepositoryKeySecret != nil {
+			return errors.New("restic repositories can't have a key of their own, they use the key shared by all the repositories")
+		}
+		return nil
+	}
+
+	escrowed, err := r.syncRepositoryKey(ctx, req, bsl, log)
+	if err != nil {
+		return err
+	}
+
+	if escrowed == nil && req.Spec.RepositoryKeySecret == nil {
+		// without escrowed keys, the repository either doesn't exist yet, or was initialized
+		// with the shared key by a previous version
+		err := r.repositoryManager.ConnectToRepo(req)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, repo.ErrRepositoryNotInitialized) || bsl.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			return err
+		}
+
+		newKey, err := repokey.NewRepositoryKey(ctx, r.Client, req)
+		if err != nil {
+			return errors.Wrap(err, "error generating backup repository key")
+		}
+		if err := r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+			rr.Spec.RepositoryKeySecret = newKey
+		}); err != nil {
+			return err
+		}
+		log.Info("Generated the key of the new backup repository")
+	}
+
+	if req.Spec.RepositoryKeySecret == nil || req.Status.PendingRepositoryKeySecret != nil ||
+		bsl.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil
+	}
+	if escrowed != nil && escrowed.Current == req.Spec.RepositoryKeySecret.Key {
+		return nil
+	}
+	if err := r.escrowRepositoryKeys(ctx, req, bsl, req.Spec.RepositoryKeySecret.Key, "", log); err != nil {
+		return errors.Wrap(err, "error escrowing the backup repository key")
+	}
+	return nil
+}
+
+// syncRepositoryKey moves the kopia repository to the current key escrowed for it in its BSL,
+// so the repositories initialized or rotated by the other clusters sharing the BSL can be
+// connected to. The repository stays on its key while this cluster rotates it. It returns the
+// escrowed keys, or nil if no keys are escrowed for the repository.
+func (r *BackupRepoReconciler) syncRepositoryKey(ctx context.Context, req *velerov1api.BackupRepository, bsl *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (*repokey.EscrowedKeys, error) {
+	escrowed, err := r.getEscrowedKeys(ctx, req, bsl, log)
+	if err != nil || escrowed == nil {
+		return escrowed, err
+	}
+
+	selector, err := repokey.ImportRepositoryKeys(ctx, r.Client, req, escrowed)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status.PendingRepositoryKeySecret != nil || reflect.DeepEqual(selector, req.Spec.RepositoryKeySecret) {
+		return escrowed, nil
+	}
+
+	log.WithField("key", escrowed.Current).Info("Moving the backup repository to its escrowed key")
+	return escrowed, r.patchBackupRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+		rr.Spec.RepositoryKeySecret = selector
+	})
+}
+
+// getEscrowedKeys returns the keys escrowed for the kopia repository in its BSL, or nil if no
+// keys are escrowed for the repository.
+func (r *BackupRepoReconciler) getEscrowedKeys(ctx context.Context, req *velerov1api.BackupRepository, bsl *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (*repokey.EscrowedKeys, error) {
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(bsl, pluginManager, log)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup store")
+	}
+
+	wrapped, err := backupStore.GetRepositoryKeys(req.Spec.VolumeNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the escrowed keys of the backup repository")
+	}
+	if wrapped == nil {
+		return nil, nil
+	}
+	defer wrapped.Close()
+
+	escrowKey, err := repokey.GetEscrowKey(ctx, r.Client, r.namespace)
+	if err != nil {
+		return nil, err
+	}
+	return repokey.UnwrapRepositoryKeys(escrowKey, wrapped)
+}
+
+// escrowRepositoryKeys stores the keys of the kopia repository, wrapped with the escrow key, next
+// to the repository in its BSL. The keys escrowed before, by this or other clusters, are kept, as
+// the clusters that didn't pick up the current key yet, or copies of the BSL, can still need them.
+func (r *BackupRepoReconciler) escrowRepositoryKeys(ctx context.Context, req *velerov1api.BackupRepository, bsl *velerov1api.BackupStorageLocation, current, pending string, log logrus.FieldLogger) error {
+	keys, err := repokey.RepositoryKeys(ctx, r.Client, req)
+	if err != nil {
+		return err
+	}
+
+	previous, err := r.getEscrowedKeys(ctx, req, bsl, log)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		for name, value := range previous.Keys {
+			if _, ok := keys[name]; !ok {
+				keys[name] = value
+			}
+		}
+	}
+
+	escrowKey, err := repokey.GetEscrowKey(ctx, r.Client, r.namespace)
+	if err != nil {
+		return err
+	}
+	wrapped, err := repokey.WrapRepositoryKeys(escrowKey, &repokey.EscrowedKeys{Current: current, Pending: pending, Keys: keys})
+	if err != nil {
+		return err
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(bsl, pluginManager, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup store")
+	}
+	if err := backupStore.PutRepositoryKeys(req.Spec.VolumeNamespace, wrapped); err != nil {
+		return errors.Wrap(err, "error storing the escrowed keys of the backup repository")
+	}
+	return nil
+}
+
 func (r *BackupRepoReconciler) checkNotReadyRepo(ctx context.Context, req *velerov1api.BackupRepository, bsl *velerov1api.BackupStorageLocation, log logrus.FieldLogger) (bool, error) {
 	log.Info("Checking backup repository for readiness")
 
@@ -563,6 +930,10 @@ func (r *BackupRepoReconciler) checkNotReadyRepo(ctx context.Context, req *veler
 		}
 	}
 
+	if err := r.prepareRepositoryKey(ctx, req, bsl, log); err != nil {
+		return false, r.patchBackupRepository(ctx, req, repoNotReady(err.Error()))
+	}
+
 	// we need to ensure it (first check, if check fails, attempt to init)
 	// because we don't know if it's been successfully initialized yet.
 	if err := ensureRepo(req, r.repositoryManager); err != nil {
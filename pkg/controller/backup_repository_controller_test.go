@@ -14,11 +14,15 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/kopia/kopia/repo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -31,8 +35,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/repository"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	"github.com/vmware-tanzu/velero/pkg/repository/maintenance"
 	repomaintenance "github.com/vmware-tanzu/velero/pkg/repository/maintenance"
 	repomanager "github.com/vmware-tanzu/velero/pkg/repository/manager"
@@ -61,6 +70,9 @@ func mockBackupRepoReconciler(t *testing.T, mockOn string, arg any, ret ...any)
 		velerotest.NewLogger(),
 		velerotest.NewFakeControllerRuntimeClient(t),
 		mgr,
+		repository.NewRepoLocker(),
+		newFakeRepoPluginManager(),
+		NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 		testMaintenanceFrequency,
 		"fake-repo-config",
 		3,
@@ -71,6 +83,33 @@ func mockBackupRepoReconciler(t *testing.T, mockOn string, arg any, ret ...any)
 	)
 }
 
+// newFakeRepoPluginManager returns a plugin manager for the reconcilers getting the backup stores
+// of the BSLs.
+func newFakeRepoPluginManager() func(logrus.FieldLogger) clientmgmt.Manager {
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return()
+	return func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+}
+
+// newEscrowBackupStore returns a backup store keeping the escrowed keys of the repositories in memory.
+func newEscrowBackupStore() *persistencemocks.BackupStore {
+	escrowed := map[string][]byte{}
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetRepositoryKeys", mock.Anything).Return(func(volumeNamespace string) (io.ReadCloser, error) {
+		data, ok := escrowed[volumeNamespace]
+		if !ok {
+			return nil, nil
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	})
+	backupStore.On("PutRepositoryKeys", mock.Anything, mock.Anything).Return(func(volumeNamespace string, keys io.Reader) error {
+		data, err := io.ReadAll(keys)
+		escrowed[volumeNamespace] = data
+		return err
+	})
+	return backupStore
+}
+
 func mockBackupRepositoryCR() *velerov1api.BackupRepository {
 	return &velerov1api.BackupRepository{
 		ObjectMeta: metav1.ObjectMeta{
@@ -130,6 +169,8 @@ func TestCheckNotReadyRepo(t *testing.T) {
 		rr.Spec.VolumeNamespace = "volume-ns-1"
 		rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
 		reconciler := mockBackupRepoReconciler(t, "PrepareRepo", rr, nil)
+		// the repository was initialized with the common key by a previous version
+		reconciler.repositoryManager.(*repomokes.Manager).On("ConnectToRepo", rr).Return(nil)
 		err := reconciler.Client.Create(t.Context(), rr)
 		require.NoError(t, err)
 		location := velerov1api.BackupStorageLocation{
@@ -582,6 +623,9 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
 				&mgr,
+				repository.NewRepoLocker(),
+				newFakeRepoPluginManager(),
+				NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 				test.userDefinedFreq,
 				"",
 				3,
@@ -716,6 +760,9 @@ func TestNeedInvalidBackupRepo(t *testing.T) {
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
 				nil,
+				repository.NewRepoLocker(),
+				newFakeRepoPluginManager(),
+				NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 				time.Duration(0),
 				"",
 				3,
@@ -1534,6 +1581,9 @@ func TestDeleteOldMaintenanceJob(t *testing.T) {
 				velerotest.NewLogger(),
 				crClient,
 				mgr,
+				repository.NewRepoLocker(),
+				newFakeRepoPluginManager(),
+				NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 				time.Duration(0),
 				"",
 				test.keptJobNumber,
@@ -1698,6 +1748,9 @@ func TestDeleteOldMaintenanceJobWithConfigMap(t *testing.T) {
 				velerotest.NewLogger(),
 				crClient,
 				mgr,
+				repository.NewRepoLocker(),
+				newFakeRepoPluginManager(),
+				NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 				time.Duration(0),
 				"",
 				test.serverKeepJobs,
@@ -1757,6 +1810,9 @@ func TestInitializeRepoWithRepositoryTypes(t *testing.T) {
 			velerotest.NewLogger(),
 			fakeClient,
 			mgr,
+			repository.NewRepoLocker(),
+			newFakeRepoPluginManager(),
+			NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 			testMaintenanceFrequency,
 			"",
 			3,
@@ -1801,8 +1857,9 @@ func TestInitializeRepoWithRepositoryTypes(t *testing.T) {
 			},
 		}
 
-		fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(rr, location).Build()
+		fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(rr, location, escrowKeySecret()).Build()
 		mgr := &repomokes.Manager{}
+		mgr.On("ConnectToRepo", rr).Return(repo.ErrRepositoryNotInitialized)
 		mgr.On("PrepareRepo", rr).Return(nil)
 
 		reconciler := NewBackupRepoReconciler(
@@ -1810,6 +1867,9 @@ func TestInitializeRepoWithRepositoryTypes(t *testing.T) {
 			velerotest.NewLogger(),
 			fakeClient,
 			mgr,
+			repository.NewRepoLocker(),
+			newFakeRepoPluginManager(),
+			NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 			testMaintenanceFrequency,
 			"",
 			3,
@@ -1825,6 +1885,9 @@ func TestInitializeRepoWithRepositoryTypes(t *testing.T) {
 		// Verify ResticIdentifier is NOT set for kopia
 		assert.Empty(t, rr.Spec.ResticIdentifier)
 		assert.Equal(t, velerov1api.BackupRepositoryPhaseReady, rr.Status.Phase)
+		// the new repository is initialized with a key of its own
+		require.NotNil(t, rr.Spec.RepositoryKeySecret)
+		assert.Equal(t, "velero-repo-key-repo", rr.Spec.RepositoryKeySecret.Name)
 	})
 
 	// Test for empty repository type (defaults to restic)
@@ -1862,6 +1925,9 @@ func TestInitializeRepoWithRepositoryTypes(t *testing.T) {
 			velerotest.NewLogger(),
 			fakeClient,
 			mgr,
+			repository.NewRepoLocker(),
+			newFakeRepoPluginManager(),
+			NewFakeSingleObjectBackupStoreGetter(newEscrowBackupStore()),
 			testMaintenanceFrequency,
 			"",
 			3,
@@ -1880,3 +1946,505 @@ func TestInitializeRepoWithRepositoryTypes(t *testing.T) {
 		assert.Equal(t, velerov1api.BackupRepositoryPhaseReady, rr.Status.Phase)
 	})
 }
+
+func TestKeyRotationDue(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	repoKey := builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-abcde").Result()
+
+	tests := []struct {
+		name     string
+		repo     func(*velerov1api.BackupRepository)
+		expected bool
+	}{
+		{
+			name: "restic repository",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeRestic
+			},
+		},
+		{
+			name: "kopia repository using the common key",
+			repo: func(*velerov1api.BackupRepository) {},
+		},
+		{
+			name: "rotation requested for a kopia repository using the common key",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Annotations = map[string]string{velerov1api.RepositoryKeyRotationRequestedAnnotation: now.Format(time.RFC3339)}
+			},
+			expected: true,
+		},
+		{
+			name: "kopia repository using its own key",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryKeySecret = repoKey
+			},
+		},
+		{
+			name: "incomplete rotation",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryKeySecret = repoKey
+				rr.Status.PendingRepositoryKeySecret = repoKey
+			},
+			expected: true,
+		},
+		{
+			name: "rotation requested after the last rotation",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryKeySecret = repoKey
+				rr.Annotations = map[string]string{velerov1api.RepositoryKeyRotationRequestedAnnotation: now.Format(time.RFC3339)}
+				rr.Status.LastKeyRotationTime = &metav1.Time{Time: now.Add(-time.Hour)}
+			},
+			expected: true,
+		},
+		{
+			name: "rotation requested before the last rotation",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryKeySecret = repoKey
+				rr.Annotations = map[string]string{velerov1api.RepositoryKeyRotationRequestedAnnotation: now.Format(time.RFC3339)}
+				rr.Status.LastKeyRotationTime = &metav1.Time{Time: now.Add(500 * time.Millisecond)}
+			},
+		},
+		{
+			name: "invalid rotation request",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryKeySecret = repoKey
+				rr.Annotations = map[string]string{velerov1api.RepositoryKeyRotationRequestedAnnotation: "now"}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := mockBackupRepositoryCR()
+			rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+			test.repo(rr)
+			assert.Equal(t, test.expected, keyRotationDue(rr))
+		})
+	}
+}
+
+// escrowKeySecret returns the secret of the key the keys of the repositories are escrowed with.
+func escrowKeySecret() *corev1api.Secret {
+	return builder.ForSecret(velerov1api.DefaultNamespace, "velero-repo-keys-escrow").
+		Data(map[string][]byte{"key": bytes.Repeat([]byte("k"), 32)}).Result()
+}
+
+func TestRotateKeyIfDue(t *testing.T) {
+	bsl := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	newReconciler := func(t *testing.T, changeKeyErr error, objs ...runtime.Object) (*BackupRepoReconciler, *repomokes.Manager) {
+		t.Helper()
+		mgr := &repomokes.Manager{}
+		mgr.On("ChangeKey", mock.Anything, mock.Anything).Return(changeKeyErr)
+		reconciler := mockBackupRepoReconciler(t, "", nil, nil)
+		reconciler.Client = velerotest.NewFakeControllerRuntimeClient(t, objs...)
+		reconciler.repositoryManager = mgr
+		return reconciler, mgr
+	}
+	newRepo := func() *velerov1api.BackupRepository {
+		rr := mockBackupRepositoryCR()
+		rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+		rr.Spec.BackupStorageLocation = "default"
+		rr.Spec.VolumeNamespace = "ns-1"
+		rr.Status.Phase = velerov1api.BackupRepositoryPhaseReady
+		rr.Annotations = map[string]string{velerov1api.RepositoryKeyRotationRequestedAnnotation: time.Now().UTC().Format(time.RFC3339)}
+		return rr
+	}
+	getKeySecret := func(t *testing.T, c client.Client) *corev1api.Secret {
+		t.Helper()
+		secret := &corev1api.Secret{}
+		require.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "velero-repo-key-repo"}, secret))
+		return secret
+	}
+	getEscrowedKeys := func(t *testing.T, reconciler *BackupRepoReconciler, rr *velerov1api.BackupRepository) *repokey.EscrowedKeys {
+		t.Helper()
+		escrowed, err := reconciler.getEscrowedKeys(t.Context(), rr, bsl, reconciler.logger)
+		require.NoError(t, err)
+		require.NotNil(t, escrowed)
+		return escrowed
+	}
+
+	t.Run("move off the common key on request", func(t *testing.T) {
+		rr := newRepo()
+		reconciler, mgr := newReconciler(t, nil, rr, escrowKeySecret())
+
+		require.NoError(t, reconciler.rotateKeyIfDue(t.Context(), rr, bsl, reconciler.logger))
+
+		require.NotNil(t, rr.Spec.RepositoryKeySecret)
+		assert.Equal(t, "velero-repo-key-repo", rr.Spec.RepositoryKeySecret.Name)
+		assert.Nil(t, rr.Status.PendingRepositoryKeySecret)
+		assert.NotNil(t, rr.Status.LastKeyRotationTime)
+		mgr.AssertCalled(t, "ChangeKey", mock.Anything, rr.Spec.RepositoryKeySecret)
+		secret := getKeySecret(t, reconciler.Client)
+		assert.Contains(t, secret.Data, rr.Spec.RepositoryKeySecret.Key)
+		assert.Equal(t, &repokey.EscrowedKeys{
+			Current: rr.Spec.RepositoryKeySecret.Key,
+			Keys:    map[string]string{rr.Spec.RepositoryKeySecret.Key: string(secret.Data[rr.Spec.RepositoryKeySecret.Key])},
+		}, getEscrowedKeys(t, reconciler, rr))
+	})
+
+	t.Run("complete an interrupted rotation", func(t *testing.T) {
+		rr := newRepo()
+		secret := builder.ForSecret(velerov1api.DefaultNamespace, "velero-repo-key-repo").Data(map[string][]byte{
+			"repository-password-old": []byte("old"),
+			"repository-password-new": []byte("new"),
+		}).Result()
+		rr.Spec.RepositoryKeySecret = builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-old").Result()
+		rr.Status.PendingRepositoryKeySecret = builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-new").Result()
+		reconciler, mgr := newReconciler(t, nil, rr, secret, escrowKeySecret())
+
+		require.NoError(t, reconciler.rotateKeyIfDue(t.Context(), rr, bsl, reconciler.logger))
+
+		assert.Equal(t, "repository-password-new", rr.Spec.RepositoryKeySecret.Key)
+		assert.Nil(t, rr.Status.PendingRepositoryKeySecret)
+		mgr.AssertCalled(t, "ChangeKey", mock.Anything, rr.Spec.RepositoryKeySecret)
+		// the previous keys are kept
+		assert.Equal(t, secret.Data, getKeySecret(t, reconciler.Client).Data)
+		assert.Equal(t, &repokey.EscrowedKeys{
+			Current: "repository-password-new",
+			Keys:    map[string]string{"repository-password-old": "old", "repository-password-new": "new"},
+		}, getEscrowedKeys(t, reconciler, rr))
+	})
+
+	t.Run("change key fails", func(t *testing.T) {
+		rr := newRepo()
+		reconciler, _ := newReconciler(t, errors.New("fake-change-key-error"), rr, escrowKeySecret())
+
+		require.EqualError(t, reconciler.rotateKeyIfDue(t.Context(), rr, bsl, reconciler.logger), "error changing backup repository key: fake-change-key-error")
+
+		// the new key is kept, so the next rotation completes the change if it happened
+		assert.Nil(t, rr.Spec.RepositoryKeySecret)
+		require.NotNil(t, rr.Status.PendingRepositoryKeySecret)
+		assert.Contains(t, getKeySecret(t, reconciler.Client).Data, rr.Status.PendingRepositoryKeySecret.Key)
+
+		// the new key is escrowed before the repository is changed to it
+		escrowed := getEscrowedKeys(t, reconciler, rr)
+		assert.Empty(t, escrowed.Current)
+		assert.Equal(t, rr.Status.PendingRepositoryKeySecret.Key, escrowed.Pending)
+		assert.Contains(t, escrowed.Keys, rr.Status.PendingRepositoryKeySecret.Key)
+	})
+
+	t.Run("new key can't be escrowed", func(t *testing.T) {
+		rr := newRepo()
+		reconciler, mgr := newReconciler(t, nil, rr)
+
+		require.ErrorContains(t, reconciler.rotateKeyIfDue(t.Context(), rr, bsl, reconciler.logger), "error escrowing the new backup repository key")
+
+		assert.Nil(t, rr.Spec.RepositoryKeySecret)
+		mgr.AssertNotCalled(t, "ChangeKey", mock.Anything, mock.Anything)
+	})
+
+	t.Run("wait for the repository lock", func(t *testing.T) {
+		rr := newRepo()
+		reconciler, mgr := newReconciler(t, nil, rr, escrowKeySecret())
+
+		// another operation the server runs on the repository through the repository manager
+		reconciler.repoLocker.Lock(rr.Name)
+		done := make(chan error)
+		go func() {
+			done <- reconciler.rotateKeyIfDue(t.Context(), rr, bsl, reconciler.logger)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("key rotated while the repository is locked")
+		case <-time.After(100 * time.Millisecond):
+		}
+		mgr.AssertNotCalled(t, "ChangeKey", mock.Anything, mock.Anything)
+
+		reconciler.repoLocker.Unlock(rr.Name)
+		require.NoError(t, <-done)
+		mgr.AssertCalled(t, "ChangeKey", mock.Anything, mock.Anything)
+	})
+
+	pvr := func(phase velerov1api.PodVolumeRestorePhase) *velerov1api.PodVolumeRestore {
+		pvr := builder.ForPodVolumeRestore(velerov1api.DefaultNamespace, "pvr-1").BackupStorageLocation("default").
+			UploaderType("kopia").Phase(phase).Result()
+		pvr.Spec.SourceNamespace = "ns-1"
+		return pvr
+	}
+	maintenanceJob := func(succeeded int) *batchv1api.Job {
+		return builder.ForJob(velerov1api.DefaultNamespace, "job-1").
+			ObjectMeta(builder.WithLabels(maintenance.RepositoryNameLabel, "repo")).Succeeded(succeeded).Result()
+	}
+	operationTests := []struct {
+		name      string
+		operation runtime.Object
+		postponed bool
+	}{
+		{
+			name: "pod volume backup in progress",
+			operation: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").
+				BackupStorageLocation("default").UploaderType("kopia").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+			postponed: true,
+		},
+		{
+			name: "pod volume backup of another repository in progress",
+			operation: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-2").
+				BackupStorageLocation("default").UploaderType("kopia").Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		},
+		{
+			name: "pod volume backup of the restic repository in progress",
+			operation: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").
+				BackupStorageLocation("default").UploaderType("restic").Phase(velerov1api.PodVolumeBackupPhaseNew).Result(),
+		},
+		{
+			name:      "pod volume restore accepted",
+			operation: pvr(velerov1api.PodVolumeRestorePhaseAccepted),
+			postponed: true,
+		},
+		{
+			name:      "pod volume restore completed",
+			operation: pvr(velerov1api.PodVolumeRestorePhaseCompleted),
+		},
+		{
+			name: "data upload prepared",
+			operation: builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").SourceNamespace("ns-1").
+				BackupStorageLocation("default").Phase(velerov2alpha1api.DataUploadPhasePrepared).Result(),
+			postponed: true,
+		},
+		{
+			name: "data upload of another data mover in progress",
+			operation: builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").SourceNamespace("ns-1").DataMover("other").
+				BackupStorageLocation("default").Phase(velerov2alpha1api.DataUploadPhaseInProgress).Result(),
+		},
+		{
+			name: "data download in progress",
+			operation: builder.ForDataDownload(velerov1api.DefaultNamespace, "dd-1").SourceNamespace("ns-1").
+				BackupStorageLocation("default").Phase(velerov2alpha1api.DataDownloadPhaseInProgress).Result(),
+			postponed: true,
+		},
+		{
+			name: "data download failed",
+			operation: builder.ForDataDownload(velerov1api.DefaultNamespace, "dd-1").SourceNamespace("ns-1").
+				BackupStorageLocation("default").Phase(velerov2alpha1api.DataDownloadPhaseFailed).Result(),
+		},
+		{
+			name:      "maintenance job running",
+			operation: maintenanceJob(0),
+			postponed: true,
+		},
+		{
+			name:      "maintenance job succeeded",
+			operation: maintenanceJob(1),
+		},
+	}
+	for _, test := range operationTests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := newRepo()
+			reconciler, mgr := newReconciler(t, nil, rr, escrowKeySecret(), test.operation)
+
+			require.NoError(t, reconciler.rotateKeyIfDue(t.Context(), rr, bsl, reconciler.logger))
+
+			if test.postponed {
+				// the rotation stays due, so it's retried in a later reconcile
+				mgr.AssertNotCalled(t, "ChangeKey", mock.Anything, mock.Anything)
+				assert.Nil(t, rr.Spec.RepositoryKeySecret)
+				assert.Nil(t, rr.Status.PendingRepositoryKeySecret)
+				assert.True(t, keyRotationDue(rr))
+			} else {
+				mgr.AssertCalled(t, "ChangeKey", mock.Anything, mock.Anything)
+				assert.NotNil(t, rr.Spec.RepositoryKeySecret)
+			}
+		})
+	}
+}
+
+func TestPrepareRepositoryKey(t *testing.T) {
+	bsl := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	newRepo := func() *velerov1api.BackupRepository {
+		rr := mockBackupRepositoryCR()
+		rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+		rr.Spec.BackupStorageLocation = "default"
+		rr.Spec.VolumeNamespace = "ns-1"
+		return rr
+	}
+	escrow := func(t *testing.T, reconciler *BackupRepoReconciler, escrowKey []byte, escrowed *repokey.EscrowedKeys) {
+		t.Helper()
+		wrapped, err := repokey.WrapRepositoryKeys(escrowKey, escrowed)
+		require.NoError(t, err)
+		backupStore, err := reconciler.backupStoreGetter.Get(bsl, nil, reconciler.logger)
+		require.NoError(t, err)
+		require.NoError(t, backupStore.PutRepositoryKeys("ns-1", wrapped))
+	}
+
+	tests := []struct {
+		name       string
+		repo       func(*velerov1api.BackupRepository)
+		bsl        *velerov1api.BackupStorageLocation
+		objs       []runtime.Object
+		connectErr error
+		escrowed   *repokey.EscrowedKeys
+		escrowKey  []byte
+		expectErr  string
+		expectKey  *corev1api.SecretKeySelector
+		// expectEscrow is whether the key of the repository is escrowed
+		expectEscrow bool
+	}{
+		{
+			name:         "new repository gets a key before it's initialized",
+			connectErr:   fmt.Errorf("error to connect backup repo: %w", repo.ErrRepositoryNotInitialized),
+			expectKey:    builder.ForSecretKeySelector("velero-repo-key-repo", "").Result(),
+			expectEscrow: true,
+		},
+		{
+			name: "repository initialized with the common key keeps it",
+		},
+		{
+			name:       "connection error",
+			connectErr: errors.New("fake-connect-error"),
+			expectErr:  "fake-connect-error",
+		},
+		{
+			name: "new repository of a read-only BSL",
+			bsl: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").
+				AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			connectErr: repo.ErrRepositoryNotInitialized,
+			expectErr:  repo.ErrRepositoryNotInitialized.Error(),
+		},
+		{
+			name: "escrowed key",
+			escrowed: &repokey.EscrowedKeys{
+				Current: "repository-password-abcde",
+				Keys:    map[string]string{"repository-password-abcde": "key-1"},
+			},
+			expectKey:    builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-abcde").Result(),
+			expectEscrow: true,
+		},
+		{
+			name: "keys escrowed with another escrow key",
+			escrowed: &repokey.EscrowedKeys{
+				Current: "repository-password-abcde",
+				Keys:    map[string]string{"repository-password-abcde": "key-1"},
+			},
+			escrowKey: bytes.Repeat([]byte("x"), 32),
+			expectErr: "unable to unwrap the escrowed keys",
+		},
+		{
+			name: "key not escrowed yet",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryKeySecret = builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-abcde").Result()
+			},
+			objs: []runtime.Object{
+				builder.ForSecret(velerov1api.DefaultNamespace, "velero-repo-key-repo").
+					Data(map[string][]byte{"repository-password-abcde": []byte("key-1")}).Result(),
+			},
+			expectKey:    builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-abcde").Result(),
+			expectEscrow: true,
+		},
+		{
+			name: "restic repository with a key",
+			repo: func(rr *velerov1api.BackupRepository) {
+				rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeRestic
+				rr.Spec.RepositoryKeySecret = builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-abcde").Result()
+			},
+			expectErr: "restic repositories can't have a key of their own, they use the key shared by all the repositories",
+			expectKey: builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-abcde").Result(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := newRepo()
+			if test.repo != nil {
+				test.repo(rr)
+			}
+			location := bsl
+			if test.bsl != nil {
+				location = test.bsl
+			}
+
+			mgr := &repomokes.Manager{}
+			mgr.On("ConnectToRepo", mock.Anything).Return(test.connectErr)
+			reconciler := mockBackupRepoReconciler(t, "", nil, nil)
+			reconciler.Client = velerotest.NewFakeControllerRuntimeClient(t, append(test.objs, rr, escrowKeySecret())...)
+			reconciler.repositoryManager = mgr
+
+			if test.escrowed != nil {
+				escrowKey := test.escrowKey
+				if escrowKey == nil {
+					escrowKey = escrowKeySecret().Data["key"]
+				}
+				escrow(t, reconciler, escrowKey, test.escrowed)
+			}
+
+			err := reconciler.prepareRepositoryKey(t.Context(), rr, location, reconciler.logger)
+			if test.expectErr != "" {
+				require.ErrorContains(t, err, test.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if test.expectKey == nil {
+				assert.Nil(t, rr.Spec.RepositoryKeySecret)
+			} else {
+				require.NotNil(t, rr.Spec.RepositoryKeySecret)
+				assert.Equal(t, test.expectKey.Name, rr.Spec.RepositoryKeySecret.Name)
+				if test.expectKey.Key != "" {
+					assert.Equal(t, test.expectKey.Key, rr.Spec.RepositoryKeySecret.Key)
+				}
+			}
+
+			if test.expectEscrow {
+				escrowed, err := reconciler.getEscrowedKeys(t.Context(), rr, location, reconciler.logger)
+				require.NoError(t, err)
+				require.NotNil(t, escrowed)
+				assert.Equal(t, rr.Spec.RepositoryKeySecret.Key, escrowed.Current)
+
+				keys, err := repokey.RepositoryKeys(t.Context(), reconciler.Client, rr)
+				require.NoError(t, err)
+				assert.Equal(t, keys, escrowed.Keys)
+			}
+		})
+	}
+}
+
+func TestSyncRepositoryKey(t *testing.T) {
+	bsl := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	oldKey := builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-old").Result()
+	newKey := builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-new").Result()
+
+	for _, test := range []struct {
+		name      string
+		pending   *corev1api.SecretKeySelector
+		expectKey *corev1api.SecretKeySelector
+	}{
+		{
+			name:      "key rotated by another cluster",
+			expectKey: newKey,
+		},
+		{
+			name:      "key being rotated by this cluster",
+			pending:   builder.ForSecretKeySelector("velero-repo-key-repo", "repository-password-pending").Result(),
+			expectKey: oldKey,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rr := mockBackupRepositoryCR()
+			rr.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+			rr.Spec.VolumeNamespace = "ns-1"
+			rr.Spec.RepositoryKeySecret = oldKey
+			rr.Status.PendingRepositoryKeySecret = test.pending
+			reconciler := mockBackupRepoReconciler(t, "", nil, nil)
+			reconciler.Client = velerotest.NewFakeControllerRuntimeClient(t, rr, escrowKeySecret())
+
+			wrapped, err := repokey.WrapRepositoryKeys(escrowKeySecret().Data["key"], &repokey.EscrowedKeys{
+				Current: newKey.Key,
+				Keys:    map[string]string{oldKey.Key: "old", newKey.Key: "new"},
+			})
+			require.NoError(t, err)
+			backupStore, err := reconciler.backupStoreGetter.Get(bsl, nil, reconciler.logger)
+			require.NoError(t, err)
+			require.NoError(t, backupStore.PutRepositoryKeys("ns-1", wrapped))
+
+			escrowed, err := reconciler.syncRepositoryKey(t.Context(), rr, bsl, reconciler.logger)
+			require.NoError(t, err)
+			assert.Equal(t, newKey.Key, escrowed.Current)
+			assert.Equal(t, test.expectKey, rr.Spec.RepositoryKeySecret)
+
+			// the escrowed keys are imported either way
+			keys, err := repokey.RepositoryKeys(t.Context(), reconciler.Client, rr)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{oldKey.Key: "old", newKey.Key: "new"}, keys)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// BackupScopeTracker decides which backups can run concurrently. Backups are admitted
+// when the namespaces and cluster-scoped resources they select don't overlap with the
+// ones of the running backups, the other backups wait in a queue.
+type BackupScopeTracker interface {
+	// Admit returns true if the backup can start, and tracks it as running until it's
+	// released. Otherwise the backup is queued, and its position in the queue, starting
+	// at 1, is returned.
+	Admit(backup *velerov1api.Backup) (bool, int)
+	// Release informs the tracker that a backup is no longer running or waiting to run.
+	Release(ns, name string)
+}
+
+type backupScopeTracker struct {
+	lock       sync.Mutex
+	maxRunning int
+	running    map[string]backupScope
+	// queue holds the backups waiting to run, ordered by creation time.
+	queue []queuedBackup
+}
+
+type queuedBackup struct {
+	key     string
+	created time.Time
+	scope   backupScope
+}
+
+// NewBackupScopeTracker returns a new BackupScopeTracker which admits up to maxRunning
+// backups at the same time.
+func NewBackupScopeTracker(maxRunning int) BackupScopeTracker {
+	if maxRunning < 1 {
+		maxRunning = 1
+	}
+	return &backupScopeTracker{
+		maxRunning: maxRunning,
+		running:    map[string]backupScope{},
+	}
+}
+
+func (bt *backupScopeTracker) Admit(backup *velerov1api.Backup) (bool, int) {
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+
+	key := backupTrackerKey(backup.Namespace, backup.Name)
+	if _, ok := bt.running[key]; ok {
+		return true, 0
+	}
+
+	position := bt.enqueue(queuedBackup{
+		key:     key,
+		created: backup.CreationTimestamp.Time,
+		scope:   newBackupScope(backup),
+	})
+
+	// the backups ahead in the queue that can start take the free slots first
+	free := bt.maxRunning - len(bt.running)
+	for i := 0; i < position; i++ {
+		if !bt.blocked(i) {
+			free--
+		}
+	}
+	if free <= 0 || bt.blocked(position) {
+		return false, position + 1
+	}
+
+	bt.running[key] = bt.queue[position].scope
+	bt.queue = append(bt.queue[:position], bt.queue[position+1:]...)
+	return true, 0
+}
+
+func (bt *backupScopeTracker) Release(ns, name string) {
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+
+	key := backupTrackerKey(ns, name)
+	delete(bt.running, key)
+	for i := range bt.queue {
+		if bt.queue[i].key == key {
+			bt.queue = append(bt.queue[:i], bt.queue[i+1:]...)
+			break
+		}
+	}
+}
+
+// enqueue adds the backup to the queue, or updates its scope if it's already queued,
+// and returns its index in the queue.
+func (bt *backupScopeTracker) enqueue(backup queuedBackup) int {
+	for i := range bt.queue {
+		if bt.queue[i].key == backup.key {
+			bt.queue[i].scope = backup.scope
+			return i
+		}
+	}
+
+	bt.queue = append(bt.queue, backup)
+	sort.SliceStable(bt.queue, func(i, j int) bool {
+		if !bt.queue[i].created.Equal(bt.queue[j].created) {
+			return bt.queue[i].created.Before(bt.queue[j].created)
+		}
+		return bt.queue[i].key < bt.queue[j].key
+	})
+	for i := range bt.queue {
+		if bt.queue[i].key == backup.key {
+			return i
+		}
+	}
+	return len(bt.queue) - 1
+}
+
+// blocked returns true if the scope of the queued backup at the index overlaps with
+// the scope of a running backup or of a backup ahead of it in the queue.
+func (bt *backupScopeTracker) blocked(index int) bool {
+	scope := bt.queue[index].scope
+	for _, running := range bt.running {
+		if scope.overlaps(running) {
+			return true
+		}
+	}
+	for i := 0; i < index; i++ {
+		if scope.overlaps(bt.queue[i].scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// backupScope is the set of namespaces and cluster-scoped resources a backup selects.
+type backupScope struct {
+	// namespaceFilter selects the namespaces of the backup.
+	namespaceFilter *collections.IncludesExcludes
+	// namespaces lists the namespaces of the backup when it selects them by name,
+	// and namespacesListed is false when the backup selects them with wildcards.
+	namespaces       []string
+	namespacesListed bool
+	// clusterScoped is true if the backup includes cluster-scoped resources.
+	clusterScoped bool
+}
+
+func newBackupScope(backup *velerov1api.Backup) backupScope {
+	scope := backupScope{
+		namespaceFilter: collections.NewIncludesExcludes().
+			Includes(backup.Spec.IncludedNamespaces...).
+			Excludes(backup.Spec.ExcludedNamespaces...),
+		namespacesListed: len(backup.Spec.IncludedNamespaces) > 0,
+	}
+	for _, ns := range backup.Spec.IncludedNamespaces {
+		if strings.ContainsAny(ns, "*?[]{}") {
+			scope.namespaces, scope.namespacesListed = nil, false
+			break
+		}
+		if scope.namespaceFilter.ShouldInclude(ns) {
+			scope.namespaces = append(scope.namespaces, ns)
+		}
+	}
+
+	if collections.UseOldResourceFilters(backup.Spec) {
+		// cluster-scoped resources are included by default when backing up all namespaces
+		scope.clusterScoped = boolptr.IsSetToTrue(backup.Spec.IncludeClusterResources) ||
+			(backup.Spec.IncludeClusterResources == nil && scope.namespaceFilter.IncludeEverything())
+	} else {
+		scope.clusterScoped = len(backup.Spec.IncludedClusterScopedResources) > 0
+	}
+
+	return scope
+}
+
+// overlaps returns true if the backups could select the same items. Backups that select
+// their namespaces with wildcards are considered to overlap with any other backup unless
+// the other backup lists namespaces that are all excluded by the wildcards.
+func (s backupScope) overlaps(other backupScope) bool {
+	if s.clusterScoped && other.clusterScoped {
+		return true
+	}
+
+	switch {
+	case s.namespacesListed:
+		return other.includesAny(s.namespaces)
+	case other.namespacesListed:
+		return s.includesAny(other.namespaces)
+	default:
+		return true
+	}
+}
+
+func (s backupScope) includesAny(namespaces []string) bool {
+	for _, ns := range namespaces {
+		if s.namespaceFilter.ShouldInclude(ns) {
+			return true
+		}
+	}
+	return false
+}
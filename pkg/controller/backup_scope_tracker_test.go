@@ -0,0 +1,142 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestBackupScopeTracker(t *testing.T) {
+	now := time.Now()
+	backup := func(name string, created time.Duration) *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithCreationTimestamp(now.Add(created)))
+	}
+
+	bt := NewBackupScopeTracker(2)
+
+	team1 := backup("team-1", 0).IncludedNamespaces("team-1").Result()
+	admitted, position := bt.Admit(team1)
+	assert.True(t, admitted)
+	assert.Equal(t, 0, position)
+
+	// admitting a running backup again is a no-op
+	admitted, _ = bt.Admit(team1)
+	assert.True(t, admitted)
+
+	// overlapping namespaces are queued
+	team1Again := backup("team-1-again", time.Second).IncludedNamespaces("team-1", "team-3").Result()
+	admitted, position = bt.Admit(team1Again)
+	assert.False(t, admitted)
+	assert.Equal(t, 1, position)
+
+	// disjoint namespaces run concurrently, even if an earlier backup is queued
+	team2 := backup("team-2", 2*time.Second).IncludedNamespaces("team-2").Result()
+	admitted, _ = bt.Admit(team2)
+	assert.True(t, admitted)
+
+	// the number of running backups is limited
+	team4 := backup("team-4", 3*time.Second).IncludedNamespaces("team-4").Result()
+	admitted, position = bt.Admit(team4)
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+
+	// the earliest queued backup that can run takes the free slot
+	bt.Release(team1.Namespace, team1.Name)
+	admitted, position = bt.Admit(team4)
+	assert.False(t, admitted)
+	assert.Equal(t, 2, position)
+	admitted, _ = bt.Admit(team1Again)
+	assert.True(t, admitted)
+
+	bt.Release(team2.Namespace, team2.Name)
+	admitted, position = bt.Admit(team4)
+	assert.True(t, admitted)
+	assert.Equal(t, 0, position)
+}
+
+func TestBackupScopeOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		backup1  *velerov1api.Backup
+		backup2  *velerov1api.Backup
+		overlaps bool
+	}{
+		{
+			name:     "disjoint namespaces don't overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("ns-1").Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-2", "ns-3").Result(),
+			overlaps: false,
+		},
+		{
+			name:     "common namespaces overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("ns-1", "ns-2").Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-2").Result(),
+			overlaps: true,
+		},
+		{
+			name:     "excluded namespaces don't overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("ns-1").Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-*").ExcludedNamespaces("ns-1").IncludeClusterResources(false).Result(),
+			overlaps: false,
+		},
+		{
+			name:     "wildcards matching a listed namespace overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("ns-1").Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-*").Result(),
+			overlaps: true,
+		},
+		{
+			name:     "wildcards on both sides overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("a-*").Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("b-*").Result(),
+			overlaps: true,
+		},
+		{
+			name:     "backups of all namespaces overlap",
+			backup1:  builder.ForBackup("velero", "b1").Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-2").Result(),
+			overlaps: true,
+		},
+		{
+			name:     "backups including cluster-scoped resources overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("ns-1").IncludeClusterResources(true).Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-2").IncludedClusterScopedResources("storageclasses").Result(),
+			overlaps: true,
+		},
+		{
+			name:     "a single backup including cluster-scoped resources doesn't overlap",
+			backup1:  builder.ForBackup("velero", "b1").IncludedNamespaces("ns-1").IncludeClusterResources(true).Result(),
+			backup2:  builder.ForBackup("velero", "b2").IncludedNamespaces("ns-2").Result(),
+			overlaps: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scope1, scope2 := newBackupScope(test.backup1), newBackupScope(test.backup2)
+			assert.Equal(t, test.overlaps, scope1.overlaps(scope2))
+			assert.Equal(t, test.overlaps, scope2.overlaps(scope1))
+		})
+	}
+}
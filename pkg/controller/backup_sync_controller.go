@@ -40,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 
@@ -362,6 +363,11 @@ func (b *backupSyncReconciler) deleteOrphanedBackups(ctx context.Context, locati
 			continue
 		}
 
+		// dry-run backups are never persisted to object storage
+		if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+			continue
+		}
+
 		if err := b.client.Delete(ctx, &backupList.Items[i], &client.DeleteOptions{}); err != nil {
 			log.WithError(errors.WithStack(err)).Error("Error deleting orphaned backup from cluster")
 		} else {
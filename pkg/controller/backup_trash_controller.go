@@ -0,0 +1,200 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// backupPurgeRetryPeriod is how often the deletion of a deleted backup whose recovery window
+// passed is requested again, until the backup is purged.
+const backupPurgeRetryPeriod = time.Hour
+
+// backupTrashReconciler manages the deleted backups kept in the trash of their backup storage
+// location: it moves them out of the trash when their undeletion is requested by the
+// velero.io/undeletion-requested annotation, and requests their deletion once their recovery
+// window passes, which purges them and all their associated data.
+type backupTrashReconciler struct {
+	client            kbclient.Client
+	clock             clocks.WithTickerAndDelayedExecution
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	logger            logrus.FieldLogger
+}
+
+// NewBackupTrashReconciler constructs a new backupTrashReconciler.
+func NewBackupTrashReconciler(
+	client kbclient.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	logger logrus.FieldLogger,
+) *backupTrashReconciler {
+	return &backupTrashReconciler{
+		client:            client,
+		clock:             clocks.RealClock{},
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		logger:            logger,
+	}
+}
+
+// SetupWithManager only watches the deleted backups, the reconciliation is requeued until
+// their recovery window passes.
+func (r *backupTrashReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	deleted := func(obj kbclient.Object) bool {
+		backup, ok := obj.(*velerov1api.Backup)
+		return ok && backup.Status.Phase == velerov1api.BackupPhaseDeleted
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Backup{}, builder.WithPredicates(predicate.Funcs{
+			CreateFunc: func(ce event.CreateEvent) bool {
+				return deleted(ce.Object)
+			},
+			UpdateFunc: func(ue event.UpdateEvent) bool {
+				return deleted(ue.ObjectNew)
+			},
+			DeleteFunc: func(de event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(ge event.GenericEvent) bool {
+				return false
+			},
+		})).
+		Named(constant.ControllerBackupTrash).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+// +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;create
+
+func (r *backupTrashReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backup", req.String())
+
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Backup not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup %s", req.String())
+	}
+
+	if backup.Status.Phase != velerov1api.BackupPhaseDeleted {
+		return ctrl.Result{}, nil
+	}
+
+	if _, ok := backup.Annotations[velerov1api.BackupUndeletionRequestedAnnotation]; ok {
+		return ctrl.Result{}, r.undelete(ctx, backup, log)
+	}
+
+	softDeletion := backup.Status.SoftDeletion
+	if softDeletion != nil && softDeletion.PurgeTimestamp != nil {
+		if remaining := softDeletion.PurgeTimestamp.Sub(r.clock.Now()); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	if err := r.purge(ctx, backup, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: backupPurgeRetryPeriod}, nil
+}
+
+// undelete moves the objects of the backup out of the trash of its location, and restores the
+// phase the backup had before it was deleted.
+func (r *backupTrashReconciler) undelete(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		return errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation)
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting the backup store")
+	}
+	if err := backupStore.UntrashBackup(backup.Name); err != nil {
+		return errors.Wrapf(err, "error moving backup %s out of the trash", backup.Name)
+	}
+
+	original := backup.DeepCopy()
+	backup.Status.Phase = velerov1api.BackupPhaseCompleted
+	if backup.Status.SoftDeletion != nil && backup.Status.SoftDeletion.Phase != "" {
+		backup.Status.Phase = backup.Status.SoftDeletion.Phase
+	}
+	backup.Status.SoftDeletion = nil
+	delete(backup.Annotations, velerov1api.BackupUndeletionRequestedAnnotation)
+	if err := kube.PatchResource(original, backup, r.client); err != nil {
+		return errors.Wrapf(err, "error updating the phase of undeleted backup %s", backup.Name)
+	}
+
+	log.Info("Backup undeleted")
+	return nil
+}
+
+// purge requests the deletion of the backup whose recovery window passed, unless a deletion
+// request of the backup is pending.
+func (r *backupTrashReconciler) purge(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	dbrs := &velerov1api.DeleteBackupRequestList{}
+	if err := r.client.List(ctx, dbrs, kbclient.InNamespace(backup.Namespace), kbclient.MatchingLabels{
+		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+		velerov1api.BackupUIDLabel:  string(backup.UID),
+	}); err != nil {
+		return errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
+	}
+	for _, dbr := range dbrs.Items {
+		switch dbr.Status.Phase {
+		case "", velerov1api.DeleteBackupRequestPhaseNew, velerov1api.DeleteBackupRequestPhaseInProgress:
+			log.Debug("Backup already has a pending deletion request")
+			return nil
+		}
+	}
+
+	log.Info("Recovery window of the deleted backup passed, creating a deletion request to purge it")
+	dbr := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
+	dbr.SetNamespace(backup.Namespace)
+	if err := veleroclient.CreateRetryGenerateName(r.client, ctx, dbr); err != nil {
+		return errors.Wrap(err, "error creating DeleteBackupRequest")
+	}
+	return nil
+}
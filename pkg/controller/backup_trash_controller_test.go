@@ -0,0 +1,139 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupTrashReconcile(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	deleted := func(purgeAt time.Time, opts ...builder.ObjectMetaOpt) *velerov1api.Backup {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+			ObjectMeta(opts...).Phase(velerov1api.BackupPhaseDeleted).Result()
+		backup.Status.SoftDeletion = &velerov1api.BackupSoftDeletion{
+			Phase:          velerov1api.BackupPhasePartiallyFailed,
+			Timestamp:      &metav1.Time{Time: purgeAt.Add(-24 * time.Hour)},
+			PurgeTimestamp: &metav1.Time{Time: purgeAt},
+		}
+		return backup
+	}
+	undeletionRequested := builder.WithAnnotations(velerov1api.BackupUndeletionRequestedAnnotation, now.Format(time.RFC3339))
+
+	tests := []struct {
+		name          string
+		backup        *velerov1api.Backup
+		pendingDBR    bool
+		wantRequeue   time.Duration
+		wantPhase     velerov1api.BackupPhase
+		wantUntrashed bool
+		wantDBRs      int
+	}{
+		{
+			name:      "backup that isn't deleted is ignored",
+			backup:    builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			wantPhase: velerov1api.BackupPhaseCompleted,
+		},
+		{
+			name:        "deleted backup is requeued until its recovery window passes",
+			backup:      deleted(now.Add(time.Hour)),
+			wantRequeue: time.Hour,
+			wantPhase:   velerov1api.BackupPhaseDeleted,
+		},
+		{
+			name:          "undeleted backup is moved out of the trash with the phase it had before it was deleted",
+			backup:        deleted(now.Add(time.Hour), undeletionRequested),
+			wantPhase:     velerov1api.BackupPhasePartiallyFailed,
+			wantUntrashed: true,
+		},
+		{
+			name:        "deletion of a deleted backup whose recovery window passed is requested",
+			backup:      deleted(now.Add(-time.Second)),
+			wantRequeue: backupPurgeRetryPeriod,
+			wantPhase:   velerov1api.BackupPhaseDeleted,
+			wantDBRs:    1,
+		},
+		{
+			name:        "deletion isn't requested again while a deletion request is pending",
+			backup:      deleted(now.Add(-time.Second)),
+			pendingDBR:  true,
+			wantRequeue: backupPurgeRetryPeriod,
+			wantPhase:   velerov1api.BackupPhaseDeleted,
+			wantDBRs:    1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("aws").Bucket("bucket").Result()
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.backup, location)
+			if tc.pendingDBR {
+				dbr := builder.ForDeleteBackupRequest(velerov1api.DefaultNamespace, "backup-1-abcde").BackupName("backup-1").
+					ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1", velerov1api.BackupUIDLabel, string(tc.backup.UID))).Result()
+				require.NoError(t, client.Create(t.Context(), dbr))
+			}
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("UntrashBackup", "backup-1").Return(nil)
+
+			r := NewBackupTrashReconciler(
+				client,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				velerotest.NewLogger(),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			result, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: tc.backup.Namespace, Name: tc.backup.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRequeue, result.RequeueAfter)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, client.Get(t.Context(), types.NamespacedName{Namespace: tc.backup.Namespace, Name: tc.backup.Name}, backup))
+			assert.Equal(t, tc.wantPhase, backup.Status.Phase)
+			if tc.wantUntrashed {
+				backupStore.AssertCalled(t, "UntrashBackup", "backup-1")
+				assert.Nil(t, backup.Status.SoftDeletion)
+				assert.NotContains(t, backup.Annotations, velerov1api.BackupUndeletionRequestedAnnotation)
+			} else {
+				backupStore.AssertNotCalled(t, "UntrashBackup", "backup-1")
+			}
+
+			dbrs := &velerov1api.DeleteBackupRequestList{}
+			require.NoError(t, client.List(t.Context(), dbrs))
+			assert.Len(t, dbrs.Items, tc.wantDBRs)
+		})
+	}
+}
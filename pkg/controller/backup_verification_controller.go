@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// backupVerificationReconciler re-downloads the artifacts of backups and verifies them against
+// their checksum manifest, when requested by the velero.io/verification-requested annotation and,
+// if a frequency is configured, periodically.
+type backupVerificationReconciler struct {
+	client            kbclient.Client
+	clock             clocks.WithTickerAndDelayedExecution
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	frequency         time.Duration
+	logger            logrus.FieldLogger
+}
+
+// NewBackupVerificationReconciler constructs a new backupVerificationReconciler. Backups are
+// only verified on request when the frequency is 0.
+func NewBackupVerificationReconciler(
+	client kbclient.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	frequency time.Duration,
+	logger logrus.FieldLogger,
+) *backupVerificationReconciler {
+	return &backupVerificationReconciler{
+		client:            client,
+		clock:             clocks.RealClock{},
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		frequency:         frequency,
+		logger:            logger,
+	}
+}
+
+// SetupWithManager only watches the updates of backups changing the verification request
+// annotation, since the status of the backup is updated by the verification itself.
+func (r *backupVerificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Backup{}, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(ue event.UpdateEvent) bool {
+				return ue.ObjectOld.GetAnnotations()[velerov1api.BackupVerificationRequestedAnnotation] !=
+					ue.ObjectNew.GetAnnotations()[velerov1api.BackupVerificationRequestedAnnotation]
+			},
+			DeleteFunc: func(de event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(ge event.GenericEvent) bool {
+				return false
+			},
+		}))
+	if r.frequency > 0 {
+		s := kube.NewPeriodicalEnqueueSource(r.logger.WithField("controller", constant.ControllerBackupVerification), mgr.GetClient(), &velerov1api.BackupList{}, r.frequency, kube.PeriodicalEnqueueSourceOption{})
+		b = b.WatchesRawSource(s)
+	}
+	return b.Named(constant.ControllerBackupVerification).Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+
+func (r *backupVerificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("backup", req.String())
+
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Backup not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup %s", req.String())
+	}
+
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+		log.Debugf("Backup is %s, skipping verification", backup.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		return ctrl.Result{}, nil
+	}
+	if !r.verificationDue(backup) {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Verifying backup")
+	verification := r.verify(ctx, backup, log)
+	verification.Request = backup.Annotations[velerov1api.BackupVerificationRequestedAnnotation]
+
+	original := backup.DeepCopy()
+	backup.Status.Verification = verification
+	if err := kube.PatchResource(original, backup, r.client); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating the verification status of backup %s", req.String())
+	}
+
+	switch verification.Phase {
+	case velerov1api.BackupVerificationPhaseCorrupted:
+		log.Warnf("Backup is corrupted: %v", verification.Errors)
+	case velerov1api.BackupVerificationPhaseFailed:
+		log.Warnf("Backup couldn't be verified: %v", verification.Errors)
+	default:
+		log.Info("Backup verified")
+	}
+	return ctrl.Result{}, nil
+}
+
+// verificationDue returns whether the backup was requested to be verified with a request that
+// wasn't handled yet, or whether its last verification is older than the verification frequency.
+// The requests are identified by the value of their annotation rather than by the time they were
+// made at, so they don't depend on the clock of the client matching the clock of the server.
+func (r *backupVerificationReconciler) verificationDue(backup *velerov1api.Backup) bool {
+	var last time.Time
+	var handled string
+	if backup.Status.Verification != nil {
+		handled = backup.Status.Verification.Request
+		if backup.Status.Verification.Timestamp != nil {
+			last = backup.Status.Verification.Timestamp.Time
+		}
+	}
+
+	if request, ok := backup.Annotations[velerov1api.BackupVerificationRequestedAnnotation]; ok && request != handled {
+		return true
+	}
+
+	return r.frequency > 0 && !r.clock.Now().Before(last.Add(r.frequency))
+}
+
+// verify verifies the artifacts of the backup against its checksum manifest.
+func (r *backupVerificationReconciler) verify(ctx context.Context, backup *velerov1api.Backup, log logrus.FieldLogger) *velerov1api.BackupVerification {
+	verification := &velerov1api.BackupVerification{}
+	failed := func(err error) *velerov1api.BackupVerification {
+		verification.Phase = velerov1api.BackupVerificationPhaseFailed
+		verification.Errors = []string{err.Error()}
+		verification.Timestamp = &metav1.Time{Time: r.clock.Now()}
+		return verification
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{
+		Namespace: backup.Namespace,
+		Name:      backup.Spec.StorageLocation,
+	}, location); err != nil {
+		return failed(errors.Wrapf(err, "error getting backup storage location %s", backup.Spec.StorageLocation))
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return failed(errors.Wrap(err, "error getting a backup store"))
+	}
+
+	problems, err := backupStore.VerifyBackup(backup.Name)
+	if err != nil {
+		return failed(err)
+	}
+
+	verification.Timestamp = &metav1.Time{Time: r.clock.Now()}
+	if len(problems) > 0 {
+		verification.Phase = velerov1api.BackupVerificationPhaseCorrupted
+		verification.Errors = problems
+		return verification
+	}
+	verification.Phase = velerov1api.BackupVerificationPhaseVerified
+	return verification
+}
@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupVerificationReconcile(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	requested := func(request string) builder.ObjectMetaOpt {
+		return builder.WithAnnotations(velerov1api.BackupVerificationRequestedAnnotation, request)
+	}
+	verifiedAt := func(backup *velerov1api.Backup, at time.Time, request string) *velerov1api.Backup {
+		backup.Status.Verification = &velerov1api.BackupVerification{
+			Phase:     velerov1api.BackupVerificationPhaseVerified,
+			Timestamp: &metav1.Time{Time: at},
+			Request:   request,
+		}
+		return backup
+	}
+
+	tests := []struct {
+		name             string
+		backup           *velerov1api.Backup
+		frequency        time.Duration
+		problems         []string
+		verifyErr        error
+		wantVerification *velerov1api.BackupVerification
+	}{
+		{
+			name:   "backup without a verification request isn't verified",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).Result(),
+		},
+		{
+			name: "in progress backup isn't verified",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				ObjectMeta(requested("request-1")).Phase(velerov1api.BackupPhaseInProgress).Result(),
+		},
+		{
+			name: "requested verification of an unmodified backup",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				ObjectMeta(requested("request-1")).Phase(velerov1api.BackupPhaseCompleted).Result(),
+			wantVerification: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseVerified,
+				Timestamp: &metav1.Time{Time: now},
+				Request:   "request-1",
+			},
+		},
+		{
+			name: "requested verification of a corrupted backup",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				ObjectMeta(requested("request-1")).Phase(velerov1api.BackupPhasePartiallyFailed).Result(),
+			problems: []string{"backup-1-logs.gz: checksum mismatch"},
+			wantVerification: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseCorrupted,
+				Timestamp: &metav1.Time{Time: now},
+				Errors:    []string{"backup-1-logs.gz: checksum mismatch"},
+				Request:   "request-1",
+			},
+		},
+		{
+			name: "backup that can't be verified",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				ObjectMeta(requested("request-1")).Phase(velerov1api.BackupPhaseCompleted).Result(),
+			verifyErr: errors.New("backup has no checksum manifest"),
+			wantVerification: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseFailed,
+				Timestamp: &metav1.Time{Time: now},
+				Errors:    []string{"backup has no checksum manifest"},
+				Request:   "request-1",
+			},
+		},
+		{
+			name: "backup verified for the request isn't verified again",
+			backup: verifiedAt(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				ObjectMeta(requested("request-1")).Phase(velerov1api.BackupPhaseCompleted).Result(), now.Add(-time.Hour), "request-1"),
+			wantVerification: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseVerified,
+				Timestamp: &metav1.Time{Time: now.Add(-time.Hour)},
+				Request:   "request-1",
+			},
+		},
+		{
+			name: "backup verified for a previous request is verified again, regardless of the time of the verification",
+			backup: verifiedAt(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				ObjectMeta(requested("request-2")).Phase(velerov1api.BackupPhaseCompleted).Result(), now.Add(time.Hour), "request-1"),
+			wantVerification: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseVerified,
+				Timestamp: &metav1.Time{Time: now},
+				Request:   "request-2",
+			},
+		},
+		{
+			name: "backup whose last verification is older than the frequency is verified",
+			backup: verifiedAt(builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").
+				Phase(velerov1api.BackupPhaseCompleted).Result(), now.Add(-25*time.Hour), ""),
+			frequency: 24 * time.Hour,
+			wantVerification: &velerov1api.BackupVerification{
+				Phase:     velerov1api.BackupVerificationPhaseVerified,
+				Timestamp: &metav1.Time{Time: now},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("aws").Bucket("bucket").Result()
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.backup, location)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("VerifyBackup", "backup-1").Return(tc.problems, tc.verifyErr)
+
+			r := NewBackupVerificationReconciler(
+				client,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+				tc.frequency,
+				velerotest.NewLogger(),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			result, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: tc.backup.Namespace, Name: tc.backup.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, client.Get(t.Context(), types.NamespacedName{Namespace: tc.backup.Namespace, Name: tc.backup.Name}, backup))
+			if tc.wantVerification == nil {
+				assert.Nil(t, backup.Status.Verification)
+				backupStore.AssertNotCalled(t, "VerifyBackup", "backup-1")
+				return
+			}
+			require.NotNil(t, backup.Status.Verification)
+			assert.Equal(t, tc.wantVerification.Phase, backup.Status.Verification.Phase)
+			assert.Equal(t, tc.wantVerification.Errors, backup.Status.Verification.Errors)
+			assert.True(t, tc.wantVerification.Timestamp.Equal(backup.Status.Verification.Timestamp))
+			assert.Equal(t, tc.wantVerification.Request, backup.Status.Verification.Request)
+		})
+	}
+}
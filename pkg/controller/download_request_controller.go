@@ -18,6 +18,9 @@ package controller
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
@@ -34,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -106,6 +110,7 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			// Delete any request that is expired, regardless of the phase: it is not
 			// worth proceeding and trying/retrying to find it.
 			log.Debug("DownloadRequest has expired - deleting")
+			r.deleteDownloadContents(ctx, downloadRequest, log)
 			if err := r.client.Delete(ctx, downloadRequest); err != nil {
 				log.WithError(err).Error("Error deleting an expired download request")
 				return ctrl.Result{}, errors.WithStack(err)
@@ -119,7 +124,6 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	// Process a brand new request.
 	if downloadRequest.Status.Phase == "" || downloadRequest.Status.Phase == velerov1api.DownloadRequestPhaseNew {
-		backupName := downloadRequest.Spec.Target.Name
 		original := downloadRequest.DeepCopy()
 		defer func() {
 			// Always attempt to Patch the downloadRequest object and status for new DownloadRequest.
@@ -132,24 +136,14 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		// Update the expiration.
 		downloadRequest.Status.Expiration = &metav1.Time{Time: r.clock.Now().Add(persistence.DownloadURLTTL)}
 
-		if downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreLog ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResults ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreResourceList ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreItemOperations ||
-			downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindRestoreVolumeInfo {
-			restore := &velerov1api.Restore{}
-			if err := r.client.Get(ctx, kbclient.ObjectKey{
-				Namespace: downloadRequest.Namespace,
-				Name:      downloadRequest.Spec.Target.Name,
-			}, restore); err != nil {
-				if apierrors.IsNotFound(err) {
-					log.WithError(err).Error("fail to get restore for DownloadRequest")
-					return ctrl.Result{}, nil
-				}
-				log.Warnf("fail to get restore for DownloadRequest %s. Retry later.", err.Error())
-				return ctrl.Result{}, errors.WithStack(err)
+		backupName, err := r.getBackupName(ctx, downloadRequest)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.WithError(err).Error("fail to get restore for DownloadRequest")
+				return ctrl.Result{}, nil
 			}
-			backupName = restore.Spec.BackupName
+			log.Warnf("fail to get restore for DownloadRequest %s. Retry later.", err.Error())
+			return ctrl.Result{}, errors.WithStack(err)
 		}
 
 		backup := &velerov1api.Backup{}
@@ -165,6 +159,12 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 
+		// The files of a deleted backup are in the trash until it's undeleted or purged.
+		if backup.Status.Phase == velerov1api.BackupPhaseDeleted {
+			log.Errorf("backup %s for DownloadRequest is deleted", backupName)
+			return ctrl.Result{}, nil
+		}
+
 		location := &velerov1api.BackupStorageLocation{}
 		if err := r.client.Get(ctx, kbclient.ObjectKey{
 			Namespace: backup.Namespace,
@@ -204,9 +204,46 @@ func (r *downloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			_ = r.restoreItemOperationsMap.UpdateForRestore(backupStore, downloadRequest.Spec.Target.Name)
 		}
 
-		if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
-			log.Warnf("fail to get Backup metadata file's download URL %s, retry later: %s", downloadRequest.Spec.Target, err)
-			return ctrl.Result{}, errors.WithStack(err)
+		// the files of locations using client-side encryption are decrypted by the server and
+		// encrypted again with a one-time key, so the client doesn't need the keys of the location
+		var encryptionKey []byte
+		if location.Spec.Encryption != nil {
+			if encryptionKey, err = newDownloadEncryptionKey(); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		switch {
+		case isIncrementalContentsRequest(downloadRequest, backup):
+			// the tarball of an incremental backup only holds the items that changed since its
+			// parent backup, the full contents are rebuilt from the backups of its chain and
+			// staged in the location, which can't be written to if it's read-only
+			if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+				failDownloadRequest(downloadRequest, fmt.Sprintf("the contents of incremental backup %s are rebuilt from the backups of its chain and staged in backup storage location %s for download, "+
+					"which isn't possible since the location is read-only", backupName, location.Name), log)
+				return ctrl.Result{}, nil
+			}
+			if downloadRequest.Status.DownloadURL, err = putDownloadContents(downloadRequest, backup, backupStore, encryptionKey, log); err != nil {
+				log.Warnf("fail to rebuild the contents of incremental backup %s, retry later: %s", backupName, err)
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+		case encryptionKey != nil:
+			if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+				failDownloadRequest(downloadRequest, fmt.Sprintf("the files of backup storage location %s are encrypted and can't be decrypted for download, since the location is read-only", location.Name), log)
+				return ctrl.Result{}, nil
+			}
+			if downloadRequest.Status.DownloadURL, err = putDecryptedDownload(downloadRequest, backupStore, encryptionKey); err != nil {
+				log.Warnf("fail to decrypt %s for download, retry later: %s", downloadRequest.Spec.Target, err)
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+		default:
+			if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
+				log.Warnf("fail to get Backup metadata file's download URL %s, retry later: %s", downloadRequest.Spec.Target, err)
+				return ctrl.Result{}, errors.WithStack(err)
+			}
+		}
+		if encryptionKey != nil {
+			downloadRequest.Status.DecryptionKey = base64.StdEncoding.EncodeToString(encryptionKey)
 		}
 
 		downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseProcessed
@@ -236,3 +273,117 @@ func (r *downloadRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		WatchesRawSource(downloadRequestSource).
 		Complete(r)
 }
+
+// getBackupName returns the name of the backup the target file of the download request belongs to,
+// the backup of the restore for the files of restores.
+func (r *downloadRequestReconciler) getBackupName(ctx context.Context, downloadRequest *velerov1api.DownloadRequest) (string, error) {
+	switch downloadRequest.Spec.Target.Kind {
+	case velerov1api.DownloadTargetKindRestoreLog,
+		velerov1api.DownloadTargetKindRestoreResults,
+		velerov1api.DownloadTargetKindRestoreResourceList,
+		velerov1api.DownloadTargetKindRestoreItemOperations,
+		velerov1api.DownloadTargetKindRestoreVolumeInfo:
+		restore := &velerov1api.Restore{}
+		if err := r.client.Get(ctx, kbclient.ObjectKey{
+			Namespace: downloadRequest.Namespace,
+			Name:      downloadRequest.Spec.Target.Name,
+		}, restore); err != nil {
+			return "", err
+		}
+		return restore.Spec.BackupName, nil
+	default:
+		return downloadRequest.Spec.Target.Name, nil
+	}
+}
+
+// isIncrementalContentsRequest returns whether the download request is for the contents of an
+// incremental backup.
+func isIncrementalContentsRequest(downloadRequest *velerov1api.DownloadRequest, backup *velerov1api.Backup) bool {
+	return downloadRequest.Spec.Target.Kind == velerov1api.DownloadTargetKindBackupContents && backup.Status.ParentBackup != ""
+}
+
+// newDownloadEncryptionKey returns a random one-time key to encrypt the file staged for a
+// download request with.
+func newDownloadEncryptionKey() ([]byte, error) {
+	key := make([]byte, encryption.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "error generating the download encryption key")
+	}
+	return key, nil
+}
+
+// failDownloadRequest marks the download request as failed, it isn't processed again.
+func failDownloadRequest(downloadRequest *velerov1api.DownloadRequest, message string, log logrus.FieldLogger) {
+	log.Errorf("DownloadRequest failed: %s", message)
+	downloadRequest.Status.Phase = velerov1api.DownloadRequestPhaseFailed
+	downloadRequest.Status.Message = message
+}
+
+// putDownloadContents rebuilds the contents of an incremental backup from the backups of its
+// chain, stages them for the download request and returns their download URL.
+func putDownloadContents(downloadRequest *velerov1api.DownloadRequest, backup *velerov1api.Backup, backupStore persistence.BackupStore, encryptionKey []byte, log logrus.FieldLogger) (string, error) {
+	contents, err := downloadBackupContents(backup, backupStore, log)
+	if err != nil {
+		return "", err
+	}
+	defer closeAndRemoveFile(contents, log)
+
+	return backupStore.PutDownloadContents(downloadRequest.Name, contents, encryptionKey)
+}
+
+// putDecryptedDownload stages the target file of the download request, decrypted and encrypted
+// again with the one-time key of the request, and returns its download URL.
+func putDecryptedDownload(downloadRequest *velerov1api.DownloadRequest, backupStore persistence.BackupStore, encryptionKey []byte) (string, error) {
+	file, err := backupStore.GetDownloadObject(downloadRequest.Spec.Target)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return backupStore.PutDownloadContents(downloadRequest.Name, file, encryptionKey)
+}
+
+// deleteDownloadContents deletes the file staged for an expired download request, of the contents
+// of an incremental backup or of a location using client-side encryption. The deletion is
+// best-effort, the request is deleted anyway.
+func (r *downloadRequestReconciler) deleteDownloadContents(ctx context.Context, downloadRequest *velerov1api.DownloadRequest, log logrus.FieldLogger) {
+	if downloadRequest.Status.Phase != velerov1api.DownloadRequestPhaseProcessed {
+		return
+	}
+	// only the files of locations using client-side encryption are staged for the other targets
+	if downloadRequest.Spec.Target.Kind != velerov1api.DownloadTargetKindBackupContents && downloadRequest.Status.DecryptionKey == "" {
+		return
+	}
+
+	backupName, err := r.getBackupName(ctx, downloadRequest)
+	if err != nil {
+		log.WithError(err).Warn("Unable to get the backup of the download request, the contents staged for it aren't deleted")
+		return
+	}
+	backup := &velerov1api.Backup{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: downloadRequest.Namespace, Name: backupName}, backup); err != nil {
+		log.WithError(err).Warn("Unable to get the backup of the download request, the contents staged for it aren't deleted")
+		return
+	}
+	if !isIncrementalContentsRequest(downloadRequest, backup) && downloadRequest.Status.DecryptionKey == "" {
+		return
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		log.WithError(err).Warn("Unable to get the BSL of the download request, the contents staged for it aren't deleted")
+		return
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Warn("Unable to get a backup store, the contents staged for the download request aren't deleted")
+		return
+	}
+	if err := backupStore.DeleteDownloadContents(downloadRequest.Name); err != nil {
+		log.WithError(err).Warn("Unable to delete the contents staged for the download request")
+	}
+}
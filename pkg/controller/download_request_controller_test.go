@@ -18,11 +18,17 @@ package controller
 
 import (
 	"context"
+	"io"
+	"strings"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -33,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
@@ -162,6 +169,13 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectedReconcileErr: "",
 			expectedRequeue:      ctrl.Result{},
 		}),
+		Entry("backup contents request for deleted backup returns nil", request{
+			downloadRequest:      builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContents, "a-backup").Result(),
+			backup:               builder.ForBackup(velerov1api.DefaultNamespace, "a-backup").StorageLocation("a-location").Phase(velerov1api.BackupPhaseDeleted).Result(),
+			backupLocation:       builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").Result(),
+			expectedReconcileErr: "",
+			expectedRequeue:      ctrl.Result{},
+		}),
 		Entry("restore log request for nonexistent restore returns nil", request{
 			downloadRequest:      builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindRestoreLog, "a-backup-20170912150214").Result(),
 			restore:              builder.ForRestore(velerov1api.DefaultNamespace, "non-matching-restore").Phase(velerov1api.RestorePhaseCompleted).Backup("a-backup").Result(),
@@ -237,6 +251,20 @@ var _ = Describe("Download Request Reconciler", func() {
 			expectGetsURL:   true,
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("backup log request for an encrypted read-only location fails", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupLog, "a-backup").Result(),
+			backup:          defaultBackup(),
+			backupLocation: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").
+				AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Encryption(builder.ForSecretKeySelector("encryption", "key").Result()).Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
+		Entry("backup contents request of an incremental backup in a read-only location fails", request{
+			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase("").Target(velerov1api.DownloadTargetKindBackupContents, "a-backup").Result(),
+			backup:          builder.ForBackup(velerov1api.DefaultNamespace, "a-backup").StorageLocation("a-location").ParentBackup("a-parent-backup").Result(),
+			backupLocation: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "a-location").Provider("a-provider").Bucket("a-bucket").
+				AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
 		Entry("request with phase 'Processed' and not expired is not deleted", request{
 			downloadRequest: builder.ForDownloadRequest(velerov1api.DefaultNamespace, "a-download-request").Phase(velerov1api.DownloadRequestPhaseProcessed).Target(velerov1api.DownloadTargetKindBackupLog, "a-backup-20170912150214").Result(),
 			backup:          defaultBackup(),
@@ -262,3 +290,62 @@ var _ = Describe("Download Request Reconciler", func() {
 		}),
 	)
 })
+
+func TestPutDecryptedDownload(t *testing.T) {
+	target := velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLog, Name: "backup-1"}
+	downloadRequest := builder.ForDownloadRequest(velerov1api.DefaultNamespace, "request-1").Target(target.Kind, target.Name).Result()
+	key, err := newDownloadEncryptionKey()
+	require.NoError(t, err)
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetDownloadObject", target).Return(io.NopCloser(strings.NewReader("log")), nil)
+	var staged string
+	backupStore.On("PutDownloadContents", "request-1", mock.Anything, key).Run(func(args mock.Arguments) {
+		data, err := io.ReadAll(args.Get(1).(io.Reader))
+		require.NoError(t, err)
+		staged = string(data)
+	}).Return("https://download.url", nil)
+
+	url, err := putDecryptedDownload(downloadRequest, backupStore, key)
+	require.NoError(t, err)
+	assert.Equal(t, "https://download.url", url)
+	assert.Equal(t, "log", staged)
+}
+
+func TestPutDownloadContents(t *testing.T) {
+	parentIndex := archive.NewContentIndex("", nil)
+	parentIndex.Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1"))
+	index := archive.NewContentIndex("backup-1", parentIndex)
+	index.Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1"))
+	index.Add("resources/configmaps/namespaces/ns-1/cm-2.json", []byte("cm-2"))
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").ParentBackup("backup-1").Result()
+	downloadRequest := builder.ForDownloadRequest(velerov1api.DefaultNamespace, "request-1").
+		Target(velerov1api.DownloadTargetKindBackupContents, "backup-2").Result()
+	require.True(t, isIncrementalContentsRequest(downloadRequest, backup))
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetBackupContentIndex", "backup-1").Return(parentIndex, nil)
+	backupStore.On("GetBackupContentIndex", "backup-2").Return(index, nil)
+	backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		Add("resources/configmaps/namespaces/ns-1/cm-1.json", []byte("cm-1")).
+		Done()), nil)
+	backupStore.On("GetBackupContents", "backup-2").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		Add("resources/configmaps/namespaces/ns-1/cm-2.json", []byte("cm-2")).
+		Done()), nil)
+	var stagedContents map[string]string
+	backupStore.On("PutDownloadContents", "request-1", mock.Anything, []byte(nil)).Run(func(args mock.Arguments) {
+		stagedContents = readTarball(t, args.Get(1).(io.Reader))
+	}).Return("https://download.url", nil)
+
+	url, err := putDownloadContents(downloadRequest, backup, backupStore, nil, velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, "https://download.url", url)
+	assert.Equal(t, map[string]string{
+		"metadata/version": "1",
+		"resources/configmaps/namespaces/ns-1/cm-1.json": "cm-1",
+		"resources/configmaps/namespaces/ns-1/cm-2.json": "cm-2",
+	}, stagedContents)
+}
@@ -19,11 +19,15 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	clocks "k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,6 +40,8 @@ import (
 	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	veleroutil "github.com/vmware-tanzu/velero/pkg/util/velero"
 )
@@ -47,6 +53,13 @@ const (
 	gcFailureBSLCannotGet    = "BSLCannotGet"
 	gcFailureBSLReadOnly     = "BSLReadOnly"
 	gcFailureBSLUnavailable  = "BSLUnavailable"
+	gcFailureMinRetained     = "MinRetainedSuccessfulBackups"
+	gcFailureLegalHold       = "LegalHold"
+	gcFailureRetention       = "Retention"
+
+	// gcDeletionDeferredReason is the reason of the events of the expired backups whose garbage
+	// collection is deferred.
+	gcDeletionDeferredReason = "DeletionDeferred"
 )
 
 // gcReconciler creates DeleteBackupRequests for expired backups.
@@ -55,6 +68,8 @@ type gcReconciler struct {
 	logger    logrus.FieldLogger
 	clock     clocks.WithTickerAndDelayedExecution
 	frequency time.Duration
+	recorder  record.EventRecorder
+	metrics   *metrics.ServerMetrics
 }
 
 // NewGCReconciler constructs a new gcReconciler.
@@ -62,12 +77,16 @@ func NewGCReconciler(
 	logger logrus.FieldLogger,
 	client client.Client,
 	frequency time.Duration,
+	recorder record.EventRecorder,
+	metrics *metrics.ServerMetrics,
 ) *gcReconciler {
 	gcr := &gcReconciler{
 		Client:    client,
 		logger:    logger,
 		clock:     clocks.RealClock{},
 		frequency: frequency,
+		recorder:  recorder,
+		metrics:   metrics,
 	}
 	if gcr.frequency <= 0 {
 		gcr.frequency = defaultGCFrequency
@@ -102,6 +121,8 @@ func (c *gcReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;watch;create;
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests/status,verbs=get
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := c.logger.WithField("gc backup", req.String())
@@ -132,10 +153,49 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 
 	log.Infof("Backup:%s has expired", backup.Name)
 
+	// deleted backups are purged by the backup trash controller once their recovery window passes
+	if backup.Status.Phase == velerov1api.BackupPhaseDeleted {
+		log.Info("Backup is already deleted, skipping")
+		return ctrl.Result{}, nil
+	}
+
+	// the incremental backups based on the backup can't be restored without it, it's
+	// garbage-collected once they're gone
+	children, err := incrementalChildren(ctx, c.Client, backup)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(children) > 0 {
+		log.Infof("Backup is the parent of incremental backup(s) %s, skipping", strings.Join(children, ", "))
+		return ctrl.Result{}, nil
+	}
+
 	if backup.Labels == nil {
 		backup.Labels = make(map[string]string)
 	}
 
+	if boolptr.IsSetToTrue(backup.Spec.LegalHold) {
+		log.Info("Backup cannot be garbage-collected because it's under legal hold")
+		backup.Labels[garbageCollectionFailure] = gcFailureLegalHold
+		if err := c.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup labels")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	retained, err := c.retainedBySchedule(ctx, backup)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if retained {
+		log.Infof("Backup cannot be garbage-collected because the retention policy of its schedule keeps it for the %s periods", backup.Annotations[velerov1api.RetentionPeriodsAnnotation])
+		backup.Labels[garbageCollectionFailure] = gcFailureRetention
+		if err := c.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup labels")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.Get(ctx, client.ObjectKey{
 		Namespace: req.Namespace,
@@ -168,6 +228,23 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		return ctrl.Result{}, nil
 	}
 
+	// the newest successful backups of the schedule and the backup storage location are kept
+	// until newer backups succeed
+	reason, err := c.minRetainedReason(ctx, backup, loc)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		log.Warnf("Backup cannot be garbage-collected because %s", reason)
+		c.recorder.Eventf(backup, corev1api.EventTypeWarning, gcDeletionDeferredReason, "Expired backup isn't garbage-collected because %s", reason)
+		c.metrics.RegisterBackupDeletionDeferred(backup.Labels[velerov1api.ScheduleNameLabel])
+		backup.Labels[garbageCollectionFailure] = gcFailureMinRetained
+		if err := c.Update(ctx, backup); err != nil {
+			log.WithError(err).Error("error updating backup labels")
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// remove gc fail error label after this point
 	delete(backup.Labels, garbageCollectionFailure)
 	if err := c.Update(ctx, backup); err != nil {
@@ -206,3 +283,93 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 
 	return ctrl.Result{}, nil
 }
+
+// retainedBySchedule returns whether the backup is pinned by the retention policy of its schedule.
+// The velero.io/retention-periods annotation of the backup is only honored while the schedule
+// exists and has a retention policy, so that the backup expires after its TTL once they're gone.
+func (c *gcReconciler) retainedBySchedule(ctx context.Context, backup *velerov1api.Backup) (bool, error) {
+	if _, ok := backup.Annotations[velerov1api.RetentionPeriodsAnnotation]; !ok {
+		return false, nil
+	}
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" {
+		return false, nil
+	}
+
+	schedule := &velerov1api.Schedule{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: scheduleName}, schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "error getting schedule %s", scheduleName)
+	}
+	return schedule.Spec.Retention != nil, nil
+}
+
+// minRetainedReason returns why the expired backup must be retained when it's one of the newest
+// successful backups its schedule or its backup storage location retains at least, and an empty
+// string otherwise.
+func (c *gcReconciler) minRetainedReason(ctx context.Context, backup *velerov1api.Backup, loc *velerov1api.BackupStorageLocation) (string, error) {
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+		return "", nil
+	}
+
+	if scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]; scheduleName != "" {
+		schedule := &velerov1api.Schedule{}
+		err := c.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: scheduleName}, schedule)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return "", errors.Wrapf(err, "error getting schedule %s", scheduleName)
+		}
+		if err == nil && schedule.Spec.MinRetainedSuccessfulBackups > 0 {
+			backups := &velerov1api.BackupList{}
+			if err := c.List(ctx, backups, client.InNamespace(backup.Namespace), client.MatchingLabels{velerov1api.ScheduleNameLabel: scheduleName}); err != nil {
+				return "", errors.Wrapf(err, "error listing the backups of schedule %s", scheduleName)
+			}
+			if isNewestSuccessfulBackup(backup, backups.Items, schedule.Spec.MinRetainedSuccessfulBackups) {
+				return fmt.Sprintf("it's one of the %d newest successful backups schedule %s retains", schedule.Spec.MinRetainedSuccessfulBackups, scheduleName), nil
+			}
+		}
+	}
+
+	if loc.Spec.MinRetainedSuccessfulBackups > 0 {
+		backups := &velerov1api.BackupList{}
+		if err := c.List(ctx, backups, client.InNamespace(backup.Namespace)); err != nil {
+			return "", errors.Wrap(err, "error listing backups")
+		}
+		var locationBackups []velerov1api.Backup
+		for _, b := range backups.Items {
+			if b.Spec.StorageLocation == loc.Name {
+				locationBackups = append(locationBackups, b)
+			}
+		}
+		if isNewestSuccessfulBackup(backup, locationBackups, loc.Spec.MinRetainedSuccessfulBackups) {
+			return fmt.Sprintf("it's one of the %d newest successful backups backup storage location %s retains", loc.Spec.MinRetainedSuccessfulBackups, loc.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// isNewestSuccessfulBackup returns whether the backup is one of the count newest completed
+// backups. Dry-run backups don't hold any data, so they're never counted.
+func isNewestSuccessfulBackup(backup *velerov1api.Backup, backups []velerov1api.Backup, count int) bool {
+	var completed []*velerov1api.Backup
+	for i := range backups {
+		if backups[i].Status.Phase == velerov1api.BackupPhaseCompleted && !boolptr.IsSetToTrue(backups[i].Spec.DryRun) {
+			completed = append(completed, &backups[i])
+		}
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		ti, tj := backupTime(completed[i]), backupTime(completed[j])
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return completed[i].Name > completed[j].Name
+	})
+
+	for i := 0; i < count && i < len(completed); i++ {
+		if completed[i].Name == backup.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -21,15 +21,18 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	testclocks "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -38,6 +41,8 @@ func mockGCReconciler(fakeClient kbclient.Client, fakeClock *testclocks.FakeCloc
 		velerotest.NewLogger(),
 		fakeClient,
 		freq,
+		record.NewFakeRecorder(10),
+		metrics.NewServerMetrics(),
 	)
 	gcr.clock = fakeClock
 	return gcr
@@ -52,7 +57,13 @@ func TestGCReconcile(t *testing.T) {
 		backup               *velerov1api.Backup
 		deleteBackupRequests []*velerov1api.DeleteBackupRequest
 		backupLocation       *velerov1api.BackupStorageLocation
+		incrementalChild     *velerov1api.Backup
+		schedule             *velerov1api.Schedule
+		otherBackups         []*velerov1api.Backup
 		expectError          bool
+		expectNoDeletion     bool
+		expectDeferred       bool
+		expectGCFailure      string
 	}{
 		{
 			name: "can't find backup - no error",
@@ -117,6 +128,104 @@ func TestGCReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:             "expired parent of an incremental backup is not deleted",
+			backup:           defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation:   defaultBackupLocation,
+			incrementalChild: builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").StorageLocation("default").ParentBackup("backup-1").Result(),
+			expectNoDeletion: true,
+		},
+		{
+			name:             "expired deleted backup is not deleted again",
+			backup:           defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Phase(velerov1api.BackupPhaseDeleted).Result(),
+			backupLocation:   defaultBackupLocation,
+			expectNoDeletion: true,
+		},
+		{
+			name:             "expired backup under legal hold is not deleted",
+			backup:           defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").LegalHold(true).Result(),
+			backupLocation:   defaultBackupLocation,
+			expectNoDeletion: true,
+			expectGCFailure:  gcFailureLegalHold,
+		},
+		{
+			name: "expired backup kept by the retention policy of its schedule is not deleted",
+			backup: defaultBackup().ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"), builder.WithAnnotations(velerov1api.RetentionPeriodsAnnotation, "daily")).
+				Phase(velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation:   defaultBackupLocation,
+			schedule:         builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(&velerov1api.ScheduleRetentionPolicy{Daily: 7}).Result(),
+			expectNoDeletion: true,
+			expectGCFailure:  gcFailureRetention,
+		},
+		{
+			name: "expired backup kept by the retention policy of a deleted schedule is deleted",
+			backup: defaultBackup().ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"), builder.WithAnnotations(velerov1api.RetentionPeriodsAnnotation, "daily")).
+				Phase(velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+		},
+		{
+			name: "expired backup kept by a removed retention policy is deleted",
+			backup: defaultBackup().ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"), builder.WithAnnotations(velerov1api.RetentionPeriodsAnnotation, "daily")).
+				Phase(velerov1api.BackupPhaseCompleted).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Result(),
+		},
+		{
+			name: "expired backup that is one of the newest successful backups of its schedule is not deleted",
+			backup: defaultBackup().ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).Phase(velerov1api.BackupPhaseCompleted).
+				StartTimestamp(fakeClock.Now().Add(-2 * time.Hour)).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").MinRetainedSuccessfulBackups(2).Result(),
+			otherBackups: []*velerov1api.Backup{
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).Phase(velerov1api.BackupPhaseCompleted).
+					StartTimestamp(fakeClock.Now().Add(-time.Hour)).StorageLocation("default").Result(),
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).Phase(velerov1api.BackupPhaseFailed).
+					StartTimestamp(fakeClock.Now()).StorageLocation("default").Result(),
+			},
+			expectNoDeletion: true,
+			expectDeferred:   true,
+		},
+		{
+			name: "expired backup that is older than the newest successful backups of its schedule is deleted",
+			backup: defaultBackup().ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).Phase(velerov1api.BackupPhaseCompleted).
+				StartTimestamp(fakeClock.Now().Add(-2 * time.Hour)).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: defaultBackupLocation,
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").MinRetainedSuccessfulBackups(1).Result(),
+			otherBackups: []*velerov1api.Backup{
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).Phase(velerov1api.BackupPhaseCompleted).
+					StartTimestamp(fakeClock.Now().Add(-time.Hour)).StorageLocation("default").Result(),
+			},
+		},
+		{
+			name: "expired backup that is the newest successful backup of its storage location is not deleted",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).
+				StartTimestamp(fakeClock.Now().Add(-2 * time.Hour)).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).MinRetainedSuccessfulBackups(1).Result(),
+			otherBackups: []*velerov1api.Backup{
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").Phase(velerov1api.BackupPhaseCompleted).
+					StartTimestamp(fakeClock.Now().Add(-time.Hour)).StorageLocation("other").Result(),
+			},
+			expectNoDeletion: true,
+			expectDeferred:   true,
+		},
+		{
+			name: "expired backup that is the newest successful backup of its storage location besides dry-run backups is not deleted",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).
+				StartTimestamp(fakeClock.Now().Add(-2 * time.Hour)).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).MinRetainedSuccessfulBackups(1).Result(),
+			otherBackups: []*velerov1api.Backup{
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").Phase(velerov1api.BackupPhaseCompleted).DryRun(true).
+					StartTimestamp(fakeClock.Now().Add(-time.Hour)).StorageLocation("default").Result(),
+			},
+			expectNoDeletion: true,
+			expectDeferred:   true,
+		},
+		{
+			name: "expired dry-run backup is deleted even if it's the newest backup of its storage location",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseCompleted).DryRun(true).
+				StartTimestamp(fakeClock.Now().Add(-time.Hour)).Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			backupLocation: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Phase(velerov1api.BackupStorageLocationPhaseAvailable).MinRetainedSuccessfulBackups(1).Result(),
+		},
 		{
 			name:           "BSL is unavailable",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -142,11 +251,44 @@ func TestGCReconcile(t *testing.T) {
 				initObjs = append(initObjs, dbr)
 			}
 
+			if test.incrementalChild != nil {
+				initObjs = append(initObjs, test.incrementalChild)
+			}
+
+			if test.schedule != nil {
+				initObjs = append(initObjs, test.schedule)
+			}
+
+			for _, backup := range test.otherBackups {
+				initObjs = append(initObjs, backup)
+			}
+
 			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, initObjs...)
 			reconciler := mockGCReconciler(fakeClient, fakeClock, defaultGCFrequency)
+			recorder := reconciler.recorder.(*record.FakeRecorder)
 			_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
 			gotErr := err != nil
 			assert.Equal(t, test.expectError, gotErr)
+
+			if test.expectNoDeletion {
+				dbrs := &velerov1api.DeleteBackupRequestList{}
+				require.NoError(t, fakeClient.List(t.Context(), dbrs))
+				assert.Empty(t, dbrs.Items)
+			}
+
+			backup := &velerov1api.Backup{}
+			require.NoError(t, fakeClient.Get(t.Context(), kbclient.ObjectKeyFromObject(test.backup), backup))
+			if test.expectDeferred {
+				assert.Equal(t, gcFailureMinRetained, backup.Labels[garbageCollectionFailure])
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, "Warning DeletionDeferred Expired backup isn't garbage-collected")
+			} else {
+				assert.NotEqual(t, gcFailureMinRetained, backup.Labels[garbageCollectionFailure])
+				assert.Empty(t, recorder.Events)
+			}
+			if test.expectGCFailure != "" {
+				assert.Equal(t, test.expectGCFailure, backup.Labels[garbageCollectionFailure])
+			}
 		})
 	}
 }
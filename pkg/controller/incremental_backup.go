@@ -0,0 +1,215 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// maxIncrementalBackupChain is the maximum number of incremental backups based on the same
+// full backup. The next backup of the schedule is a full backup, which bounds the number of
+// backups a restore has to read, and how long the backups of a chain are retained.
+const maxIncrementalBackupChain = 24
+
+// setParentBackup initializes the content index of the backup, and for incremental backups
+// finds the parent backup, the latest successful backup of the same schedule in the same
+// storage location, and loads its content index. The backup is a full backup if there's no
+// parent backup, or if the chain of the parent backup reached its maximum length.
+func (b *backupReconciler) setParentBackup(request *pkgbackup.Request, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
+	request.ContentIndex = archive.NewContentIndex("", nil)
+	if !boolptr.IsSetToTrue(request.Spec.Incremental) {
+		return nil
+	}
+
+	parent, err := findParentBackup(context.Background(), b.kbClient, request.Backup)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		log.Info("No parent backup found, taking a full backup")
+		return nil
+	}
+
+	parentIndex, err := backupStore.GetBackupContentIndex(parent.Name)
+	if err != nil {
+		return errors.Wrapf(err, "error getting the content index of parent backup %s", parent.Name)
+	}
+	if parentIndex == nil {
+		log.Infof("Parent backup %s has no content index, taking a full backup", parent.Name)
+		return nil
+	}
+	if parentIndex.Depth+1 > maxIncrementalBackupChain {
+		log.Infof("The chain of parent backup %s reached %d incremental backups, taking a full backup", parent.Name, maxIncrementalBackupChain)
+		return nil
+	}
+
+	log.Infof("Taking an incremental backup based on backup %s", parent.Name)
+	request.ContentIndex = archive.NewContentIndex(parent.Name, parentIndex)
+	request.ParentContentIndex = parentIndex
+	request.Status.ParentBackup = parent.Name
+	return nil
+}
+
+// findParentBackup returns the latest Completed or PartiallyFailed backup created before the
+// backup by the same schedule in the same storage location, or nil if there's none.
+func findParentBackup(ctx context.Context, client kbclient.Client, backup *velerov1api.Backup) (*velerov1api.Backup, error) {
+	backups := &velerov1api.BackupList{}
+	if err := client.List(ctx, backups, kbclient.InNamespace(backup.Namespace), kbclient.MatchingLabels{
+		velerov1api.ScheduleNameLabel: backup.Labels[velerov1api.ScheduleNameLabel],
+	}); err != nil {
+		return nil, errors.Wrap(err, "error listing the backups of the schedule")
+	}
+
+	var parent *velerov1api.Backup
+	for i := range backups.Items {
+		candidate := &backups.Items[i]
+		if candidate.Name == backup.Name ||
+			candidate.Spec.StorageLocation != backup.Spec.StorageLocation ||
+			!candidate.CreationTimestamp.Before(&backup.CreationTimestamp) ||
+			boolptr.IsSetToTrue(candidate.Spec.DryRun) {
+			continue
+		}
+		if candidate.Status.Phase != velerov1api.BackupPhaseCompleted && candidate.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+			continue
+		}
+		if parent == nil || parent.CreationTimestamp.Before(&candidate.CreationTimestamp) {
+			parent = candidate
+		}
+	}
+	return parent, nil
+}
+
+// incrementalChildren returns the names of the incremental backups based on the backup,
+// which can't be restored without it.
+func incrementalChildren(ctx context.Context, client kbclient.Client, backup *velerov1api.Backup) ([]string, error) {
+	backups := &velerov1api.BackupList{}
+	if err := client.List(ctx, backups, kbclient.InNamespace(backup.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing backups")
+	}
+
+	var children []string
+	for _, candidate := range backups.Items {
+		if candidate.Status.ParentBackup == backup.Name && candidate.Spec.StorageLocation == backup.Spec.StorageLocation {
+			children = append(children, candidate.Name)
+		}
+	}
+	sort.Strings(children)
+	return children, nil
+}
+
+// downloadBackupContents downloads the contents of the backup to a temp file. For incremental
+// backups, the contents are rebuilt from the backups of the parent chain.
+func downloadBackupContents(backup *velerov1api.Backup, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
+	if backup.Status.ParentBackup == "" {
+		return downloadToTempFile(backup.Name, backupStore, logger)
+	}
+
+	var layers []archive.IncrementalLayer
+	visited := sets.New[string]()
+	for name := backup.Name; name != ""; {
+		if visited.Has(name) {
+			return nil, errors.Errorf("the parent chain of backup %s has a cycle at backup %s", backup.Name, name)
+		}
+		visited.Insert(name)
+
+		index, err := backupStore.GetBackupContentIndex(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting the content index of backup %s", name)
+		}
+		if index == nil {
+			return nil, errors.Errorf("backup %s of the parent chain of backup %s has no content index", name, backup.Name)
+		}
+
+		layerName := name
+		layers = append(layers, archive.IncrementalLayer{
+			Index: index,
+			Open: func() (io.ReadCloser, error) {
+				contents, err := backupStore.GetBackupContents(layerName)
+				return contents, errors.Wrapf(err, "error downloading backup %s of the parent chain", layerName)
+			},
+		})
+		name = index.Parent
+	}
+
+	file, err := os.CreateTemp("", backup.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating Backup temp file")
+	}
+
+	if err := archive.MergeIncremental(file, layers); err != nil {
+		closeAndRemoveFile(file, logger)
+		return nil, errors.Wrap(err, "error rebuilding the contents of the incremental backup")
+	}
+
+	logger.WithField("backup", backup.Name).Debugf("Rebuilt the contents of the incremental backup from %d backups", len(layers))
+
+	if _, err := file.Seek(0, 0); err != nil {
+		closeAndRemoveFile(file, logger)
+		return nil, errors.Wrap(err, "error resetting Backup file offset")
+	}
+
+	return file, nil
+}
+
+// stripParentContents rewrites the finalized contents of an incremental backup, which are
+// finalized from the contents rebuilt from its parent chain, without the item files whose
+// content is the same in its parent backup, so that the backup only stores the item files that
+// changed, as when it was taken.
+func stripParentContents(backup *velerov1api.Backup, contents *os.File, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
+	parentIndex, err := backupStore.GetBackupContentIndex(backup.Status.ParentBackup)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting the content index of parent backup %s", backup.Status.ParentBackup)
+	}
+	if parentIndex == nil {
+		return nil, errors.Errorf("parent backup %s has no content index", backup.Status.ParentBackup)
+	}
+
+	if _, err := contents.Seek(0, 0); err != nil {
+		return nil, errors.Wrap(err, "error resetting Backup file offset")
+	}
+
+	file, err := os.CreateTemp("", backup.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating Backup temp file")
+	}
+
+	if err := archive.StripUnchanged(file, contents, parentIndex); err != nil {
+		closeAndRemoveFile(file, logger)
+		return nil, err
+	}
+
+	if _, err := file.Seek(0, 0); err != nil {
+		closeAndRemoveFile(file, logger)
+		return nil, errors.Wrap(err, "error resetting Backup file offset")
+	}
+
+	return file, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestFindParentBackup(t *testing.T) {
+	now := time.Now()
+	scheduleBackup := func(name string, created time.Time, phase velerov1api.BackupPhase) *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1"), builder.WithCreationTimestamp(created)).
+			StorageLocation("default").
+			Phase(phase)
+	}
+
+	tests := []struct {
+		name    string
+		backups []runtime.Object
+		want    string
+	}{
+		{
+			name: "no previous backup",
+			backups: []runtime.Object{
+				scheduleBackup("backup-2", now.Add(time.Hour), velerov1api.BackupPhaseCompleted).Result(),
+			},
+		},
+		{
+			name: "the latest successful backup of the schedule in the same location is the parent",
+			backups: []runtime.Object{
+				scheduleBackup("backup-1", now.Add(-3*time.Hour), velerov1api.BackupPhaseCompleted).Result(),
+				scheduleBackup("backup-2", now.Add(-2*time.Hour), velerov1api.BackupPhasePartiallyFailed).Result(),
+				scheduleBackup("backup-3", now.Add(-time.Hour), velerov1api.BackupPhaseFailed).Result(),
+				scheduleBackup("backup-4", now.Add(-30*time.Minute), velerov1api.BackupPhaseCompleted).StorageLocation("other").Result(),
+				builder.ForBackup(velerov1api.DefaultNamespace, "backup-5").ObjectMeta(builder.WithCreationTimestamp(now.Add(-time.Minute))).
+					StorageLocation("default").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			},
+			want: "backup-2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, tc.backups...)
+			backup := scheduleBackup("backup-new", now, velerov1api.BackupPhaseInProgress).Result()
+
+			parent, err := findParentBackup(t.Context(), client, backup)
+			require.NoError(t, err)
+			if tc.want == "" {
+				assert.Nil(t, parent)
+				return
+			}
+			require.NotNil(t, parent)
+			assert.Equal(t, tc.want, parent.Name)
+		})
+	}
+}
+
+func TestIncrementalChildren(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").StorageLocation("default").ParentBackup("backup-1").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").StorageLocation("default").ParentBackup("backup-2").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "backup-4").StorageLocation("other").ParentBackup("backup-1").Result(),
+	)
+
+	children, err := incrementalChildren(t.Context(), client, builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Result())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-2"}, children)
+
+	children, err = incrementalChildren(t.Context(), client, builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").StorageLocation("default").Result())
+	require.NoError(t, err)
+	assert.Empty(t, children)
+}
+
+func TestDownloadBackupContents(t *testing.T) {
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetBackupContentIndex", "backup-1").Return(&archive.ContentIndex{}, nil)
+	backupStore.On("GetBackupContentIndex", "backup-2").Return(&archive.ContentIndex{
+		Parent:     "backup-1",
+		Depth:      1,
+		Tombstones: []string{"resources/configmaps/namespaces/ns-1/cm-2.json"},
+	}, nil)
+	backupStore.On("GetBackupContents", "backup-1").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		AddItems("configmaps",
+			builder.ForConfigMap("ns-1", "cm-1").Result(),
+			builder.ForConfigMap("ns-1", "cm-2").Result(),
+		).
+		Done()), nil)
+	backupStore.On("GetBackupContents", "backup-2").Return(io.NopCloser(velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-3").Result()).
+		Done()), nil)
+
+	file, err := downloadBackupContents(builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").ParentBackup("backup-1").Result(), backupStore, velerotest.NewLogger())
+	require.NoError(t, err)
+	defer closeAndRemoveFile(file, velerotest.NewLogger())
+
+	gzr, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	var files []string
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		files = append(files, header.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"metadata/version",
+		"resources/configmaps/namespaces/ns-1/cm-3.json",
+		"resources/configmaps/namespaces/ns-1/cm-1.json",
+	}, files)
+
+	// a backup of the chain without a content index can't be restored
+	backupStore.On("GetBackupContentIndex", "backup-3").Return(&archive.ContentIndex{Parent: "backup-missing", Depth: 1}, nil)
+	backupStore.On("GetBackupContentIndex", "backup-missing").Return(nil, nil)
+	_, err = downloadBackupContents(builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").ParentBackup("backup-missing").Result(), backupStore, velerotest.NewLogger())
+	require.EqualError(t, err, "backup backup-missing of the parent chain of backup backup-3 has no content index")
+}
@@ -33,6 +33,7 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -54,6 +55,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -330,6 +332,26 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate namespace mapping rules
+	for _, err := range pkgrestoreUtil.ValidateNamespaceMappingRules(restore.Spec.NamespaceMappingRules) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace mapping rules: %v", err))
+	}
+
+	// validate name mapping
+	for _, err := range pkgrestoreUtil.ValidateNameMapping(restore.Spec.NameMapping) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid name mapping: %v", err))
+	}
+
+	// validate pre hooks
+	for _, err := range pkgrestoreUtil.ValidatePreHooks(restore.Spec.Hooks.PreHooks) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid pre hooks: %v", err))
+	}
+
+	// validate readiness check
+	if restore.Spec.ReadinessCheck != nil && restore.Spec.ReadinessCheck.Timeout.Duration < 0 {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Invalid readiness check: the timeout cannot be negative")
+	}
+
 	// validate that only one exists orLabelSelector or just labelSelector (singular)
 	if restore.Spec.OrLabelSelectors != nil && restore.Spec.LabelSelector != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "encountered labelSelector as well as orLabelSelectors in restore spec, only one can be specified")
@@ -363,9 +385,34 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 	if restore.Spec.ExistingResourcePolicy != "" && !pkgrestoreUtil.IsResourcePolicyValid(string(restore.Spec.ExistingResourcePolicy)) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid ExistingResourcePolicy: %s", restore.Spec.ExistingResourcePolicy))
 	}
+	if restore.Spec.ExistingResourcePolicy == api.PolicyTypeRecreate && len(restore.Spec.RecreateResources) == 0 {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "RecreateResources must be specified when ExistingResourcePolicy is recreate")
+	}
 
-	// if ScheduleName is specified, fill in BackupName with the most recent successful backup from
-	// the schedule
+	// validate ResourceOrdering
+	if restore.Spec.ResourceOrdering != "" && !pkgrestoreUtil.IsResourceOrderingValid(string(restore.Spec.ResourceOrdering)) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid ResourceOrdering: %s", restore.Spec.ResourceOrdering))
+	}
+
+	// validate PreflightPolicy
+	if restore.Spec.PreflightPolicy != "" && !pkgrestoreUtil.IsPreflightPolicyValid(string(restore.Spec.PreflightPolicy)) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid PreflightPolicy: %s", restore.Spec.PreflightPolicy))
+	}
+
+	// validate the selection of the backup of the schedule
+	if restore.Spec.ScheduleName == "" && restore.Spec.RestoreAsOf != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "RestoreAsOf can only be specified with ScheduleName")
+	}
+	if restore.Spec.ScheduleName == "" && restore.Spec.BackupSelectionPolicy != "" {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "BackupSelectionPolicy can only be specified with ScheduleName")
+	}
+	if restore.Spec.BackupSelectionPolicy != "" && !pkgrestoreUtil.IsBackupSelectionPolicyValid(string(restore.Spec.BackupSelectionPolicy)) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid BackupSelectionPolicy: %s", restore.Spec.BackupSelectionPolicy))
+		return backupInfo{}, nil
+	}
+
+	// if ScheduleName is specified, fill in BackupName with the most recent backup from the
+	// schedule selected by the backup selection policy, as of RestoreAsOf if specified
 	if restore.Spec.ScheduleName != "" {
 		selector := labels.SelectorFromSet(labels.Set(map[string]string{
 			api.ScheduleNameLabel: restore.Spec.ScheduleName,
@@ -380,12 +427,23 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No backups found for schedule")
 		}
 
-		if backup := mostRecentCompletedBackup(backupList.Items); backup.Name != "" {
-			restore.Spec.BackupName = backup.Name
-		} else {
-			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "No completed backups found for schedule")
+		policy := restore.Spec.BackupSelectionPolicy
+		if policy == "" {
+			policy = api.BackupSelectionPolicyCompleted
+		}
+		backup := selectScheduleBackup(backupList.Items, restore.Spec.RestoreAsOf, policy)
+		if backup.Name == "" {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, noScheduleBackupMessage(restore.Spec.RestoreAsOf, policy))
 			return backupInfo{}, nil
 		}
+		restore.Spec.BackupName = backup.Name
+		restore.Status.BackupResolution = &api.RestoreBackupResolution{
+			BackupName:           backup.Name,
+			BackupPhase:          backup.Status.Phase,
+			BackupStartTimestamp: backup.Status.StartTimestamp,
+			SelectionPolicy:      policy,
+			RestoreAsOf:          restore.Spec.RestoreAsOf,
+		}
 	}
 
 	info, err := r.fetchBackupInfo(restore.Spec.BackupName)
@@ -394,6 +452,16 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		return backupInfo{}, nil
 	}
 
+	if boolptr.IsSetToTrue(info.backup.Spec.DryRun) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s is a dry-run backup and has no data to restore", info.backup.Name))
+		return backupInfo{}, nil
+	}
+
+	if info.backup.Status.Phase == api.BackupPhaseDeleted {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s is deleted, undelete it to restore it", info.backup.Name))
+		return backupInfo{}, nil
+	}
+
 	if !veleroutil.BSLIsAvailable(*info.location) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("The BSL %s is unavailable, cannot retrieve the backup", info.location.Name))
 		return backupInfo{}, nil
@@ -440,9 +508,11 @@ func backupXorScheduleProvided(restore *api.Restore) bool {
 	return true
 }
 
-// mostRecentCompletedBackup returns the most recent backup that's
-// completed from a list of backups.
-func mostRecentCompletedBackup(backups []api.Backup) api.Backup {
+// selectScheduleBackup returns the most recent backup completed at or before asOf, if not nil,
+// from a list of backups of a schedule, whose phase is allowed by the backup selection policy.
+// The PreferCompleted policy only falls back to the PartiallyFailed backups if none is
+// Completed.
+func selectScheduleBackup(backups []api.Backup, asOf *metav1.Time, policy api.BackupSelectionPolicy) api.Backup {
 	sort.Slice(backups, func(i, j int) bool {
 		// Use .After() because we want descending sort.
 
@@ -456,13 +526,43 @@ func mostRecentCompletedBackup(backups []api.Backup) api.Backup {
 		return iStartTime.After(jStartTime)
 	})
 
+	var partiallyFailed api.Backup
 	for _, backup := range backups {
-		if backup.Status.Phase == api.BackupPhaseCompleted {
+		if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+			continue
+		}
+		// a backup started before asOf but completed after it can contain the state after asOf
+		if asOf != nil && (backup.Status.CompletionTimestamp == nil || backup.Status.CompletionTimestamp.After(asOf.Time)) {
+			continue
+		}
+
+		switch backup.Status.Phase {
+		case api.BackupPhaseCompleted:
 			return backup
+		case api.BackupPhasePartiallyFailed:
+			if policy == api.BackupSelectionPolicyAllowPartiallyFailed {
+				return backup
+			}
+			if policy == api.BackupSelectionPolicyPreferCompleted && partiallyFailed.Name == "" {
+				partiallyFailed = backup
+			}
 		}
 	}
 
-	return api.Backup{}
+	return partiallyFailed
+}
+
+// noScheduleBackupMessage returns the validation error of a restore from a schedule without
+// any backup to select.
+func noScheduleBackupMessage(asOf *metav1.Time, policy api.BackupSelectionPolicy) string {
+	msg := "No completed backups found for schedule"
+	if policy != api.BackupSelectionPolicyCompleted {
+		msg = "No completed or partially failed backups found for schedule"
+	}
+	if asOf != nil {
+		msg += fmt.Sprintf(" completed at or before %s", asOf.UTC().Format(time.RFC3339))
+	}
+	return msg
 }
 
 // fetchBackupInfo checks the backup lister for a backup that matches the given name. If it doesn't
@@ -519,7 +619,7 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	}
 	actionsResolver := framework.NewRestoreItemActionResolverV2(actions)
 
-	backupFile, err := downloadToTempFile(restore.Spec.BackupName, backupStore, restoreLog)
+	backupFile, err := downloadBackupContents(info.backup, backupStore, restoreLog)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
@@ -661,10 +761,46 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		r.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	// a restore whose enforced preflight checks failed restored nothing
+	if len(restoreReq.PreflightFailures) > 0 {
+		for _, failure := range restoreReq.PreflightFailures {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Preflight check failed: %s", failure))
+		}
+		restore.Status.Phase = api.RestorePhaseFailedValidation
+		r.metrics.RegisterRestoreValidationFailed(restore.Spec.ScheduleName)
+		r.logger.Debug("Restore FailedValidation by its preflight checks")
+		return nil
+	}
+
+	// a dry-run restore reports what it would do to the items instead of the restored
+	// resources, and has no operations to wait for or restore to finalize
+	if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		restore.Status.DryRunResult = restoreReq.DryRunResult()
+		if err := putRestoreDryRunReport(restore, restoreReq.DryRunReport, backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restore dry-run report to backup storage")
+		}
+
+		if restore.Status.Errors > 0 {
+			restore.Status.Phase = api.RestorePhasePartiallyFailed
+		} else {
+			restore.Status.Phase = api.RestorePhaseCompleted
+		}
+		r.logger.Debugf("Dry-run restore %s", restore.Status.Phase)
+		return nil
+	}
+
 	if err := putRestoredResourceList(restore, restoreReq.RestoredResourceList(), backupStore); err != nil {
 		r.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
 	}
 
+	// the original version of the updated items is needed to roll back the restore
+	if len(restoreReq.OriginalItems) > 0 {
+		if err := putRestoreOriginalItems(restore, restoreReq.OriginalItems, backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restore original items to backup storage")
+			restore.Status.OriginalItemsNotSaved = len(restoreReq.OriginalItems)
+		}
+	}
+
 	if err := putOperationsForRestore(restore, *restoreReq.GetItemOperationsList(), backupStore); err != nil {
 		r.logger.WithError(err).Error("Error uploading restore item action operation resource list to backup storage")
 	}
@@ -794,6 +930,38 @@ func putRestoredResourceList(restore *api.Restore, list map[string][]string, bac
 	return nil
 }
 
+func putRestoreOriginalItems(restore *api.Restore, items []*unstructured.Unstructured, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(items); err != nil {
+		return errors.Wrap(err, "error encoding restore original items to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreOriginalItems(restore.Name, buf)
+}
+
+func putRestoreDryRunReport(restore *api.Restore, report []api.RestoreDryRunItem, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(report); err != nil {
+		return errors.Wrap(err, "error encoding restore dry-run report to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreDryRunReport(restore.Name, buf)
+}
+
 func putOperationsForRestore(restore *api.Restore, operations []*itemoperation.RestoreOperation, backupStore persistence.BackupStore) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -238,6 +238,7 @@ func TestRestoreReconcile(t *testing.T) {
 		expectedFinalPhase              string
 		addValidFinalizer               bool
 		emptyVolumeInfo                 bool
+		preflightFailures               []string
 	}{
 		{
 			name:                     "restore with both namespace in both includedNamespaces and excludedNamespaces fails validation",
@@ -257,6 +258,63 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with an invalid namespace mapping rule fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).NamespaceMappingRules(velerov1api.NamespaceMappingRule{Source: "ns", Target: "ns-dr"}).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid namespace mapping rules: invalid namespace mapping rule "ns": the pattern must contain a single "*" wildcard or be a regular expression starting with "^"`},
+		},
+		{
+			name:                     "restore with an empty name mapping fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).NameMapping(&velerov1api.RestoreNameMapping{IncludedResources: []string{"pods"}}).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid name mapping: name mapping must have names, a prefix or a suffix"},
+		},
+		{
+			name:                     "restore with a negative readiness timeout fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ReadinessCheck(-time.Minute).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid readiness check: the timeout cannot be negative"},
+		},
+		{
+			name:                     "restore with an invalid preflight policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PreflightPolicy("Skip").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid PreflightPolicy: Skip"},
+		},
+		{
+			name:                     "restore with an invalid pre hook fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PreHooks(velerov1api.RestorePreHookSpec{Name: "maintenance", Hooks: []velerov1api.RestorePreHook{{}}}).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid pre hooks: hook 0 of pre hook "maintenance" must be either an exec or a job`},
+		},
+		{
+			name:                 "restore whose enforced preflight checks failed fails validation",
+			location:             defaultStorageLocation,
+			restore:              NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PreflightPolicy(velerov1api.RestorePreflightPolicyEnforce).Result(),
+			backup:               defaultBackup().StorageLocation("default").Result(),
+			preflightFailures:    []string{"storage class gold of PersistentVolumeClaim ns-1/pvc-1 doesn't exist"},
+			expectedErr:          false,
+			expectedPhase:        string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:    &timestamp,
+			expectedRestorerCall: NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).PreflightPolicy(velerov1api.RestorePreflightPolicyEnforce).Result(),
+			expectedFinalPhase:   string(velerov1api.RestorePhaseFailedValidation),
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -335,6 +393,28 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedCompletedTime: &timestamp,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).ExistingResourcePolicy("update").Result(),
 		},
+		{
+			name:                  "valid restore with recreate existingresourcepolicy gets executed",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ExistingResourcePolicy("recreate").RecreateResources("deployments.apps").Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).ExistingResourcePolicy("recreate").RecreateResources("deployments.apps").Result(),
+		},
+		{
+			name:                  "invalid restore with recreate existingresourcepolicy and no recreate resources errors",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "norecreateresources", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ExistingResourcePolicy("recreate").Result(),
+			backup:                defaultBackup().StorageLocation("default").Result(),
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseFailedValidation),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  nil, // this restore should fail validation and not be passed to the restorer
+		},
 		{
 			name:                  "invalid restore with invalid existingresourcepolicy errors",
 			location:              defaultStorageLocation,
@@ -463,6 +543,15 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedCompletedTime: &timestamp,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                     "restoring a deleted backup fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Phase(velerov1api.BackupPhaseDeleted).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Backup backup-1 is deleted, undelete it to restore it"},
+		},
 		{
 			name:                     "Restore creation is rejected when BSL is unavailable",
 			location:                 builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
@@ -488,7 +577,7 @@ func TestRestoreReconcile(t *testing.T) {
 			var (
 				fakeClient       = velerotest.NewFakeControllerRuntimeClientBuilder(t).Build()
 				fakeGlobalClient = velerotest.NewFakeControllerRuntimeClient(t)
-				restorer         = &fakeRestorer{kbClient: fakeClient}
+				restorer         = &fakeRestorer{kbClient: fakeClient, preflightFailures: test.preflightFailures}
 				logger           = velerotest.NewLogger()
 				pluginManager    = &pluginmocks.Manager{}
 				backupStore      = &persistencemocks.BackupStore{}
@@ -555,9 +644,11 @@ func TestRestoreReconcile(t *testing.T) {
 				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
-				backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
-				backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
-				backupStore.On("PutRestoreVolumeInfo", test.restore.Name, mock.Anything).Return(nil)
+				if len(test.preflightFailures) == 0 {
+					backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
+					backupStore.On("PutRestoreVolumeInfo", test.restore.Name, mock.Anything).Return(nil)
+				}
 				if test.emptyVolumeInfo == true {
 					backupStore.On("GetBackupVolumeInfos", test.backup.Name).Return(nil, nil)
 				} else {
@@ -670,6 +761,13 @@ func TestRestoreReconcile(t *testing.T) {
 			// the mock stores the pointer, which gets modified after
 			assert.Equal(t, test.expectedRestorerCall.Spec, restorer.calledWithArg.Spec)
 			assert.Equal(t, test.expectedRestorerCall.Status.Phase, restorer.calledWithArg.Status.Phase)
+
+			if len(test.preflightFailures) > 0 {
+				res := new(velerov1api.Restore)
+				require.NoError(t, fakeClient.Get(t.Context(), types.NamespacedName{Namespace: test.restore.Namespace, Name: test.restore.Name}, res))
+				assert.Equal(t, velerov1api.RestorePhaseFailedValidation, res.Status.Phase)
+				assert.Equal(t, []string{"Preflight check failed: storage class gold of PersistentVolumeClaim ns-1/pvc-1 doesn't exist"}, res.Status.ValidationErrors)
+			}
 		})
 	}
 }
@@ -750,6 +848,7 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 			StorageLocation("default").
 			Phase(velerov1api.BackupPhaseCompleted).
 			StartTimestamp(now).
+			CompletionTimestamp(now).
 			Result(),
 	))
 
@@ -768,6 +867,42 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 	r.validateAndComplete(restore)
 	assert.Nil(t, restore.Status.ValidationErrors)
 	assert.Equal(t, "foo", restore.Spec.BackupName)
+	require.NotNil(t, restore.Status.BackupResolution)
+	assert.Equal(t, "foo", restore.Status.BackupResolution.BackupName)
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, restore.Status.BackupResolution.BackupPhase)
+	assert.Equal(t, velerov1api.BackupSelectionPolicyCompleted, restore.Status.BackupResolution.SelectionPolicy)
+
+	// restore as of a time before the backups of the schedule: fail validation
+	restore = &velerov1api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "restore-1",
+		},
+		Spec: velerov1api.RestoreSpec{
+			ScheduleName: "schedule-1",
+			RestoreAsOf:  &metav1.Time{Time: now.Add(-time.Hour)},
+		},
+	}
+	r.validateAndComplete(restore)
+	assert.Contains(t, restore.Status.ValidationErrors, "No completed backups found for schedule completed at or before "+now.Add(-time.Hour).UTC().Format(time.RFC3339))
+	assert.Empty(t, restore.Spec.BackupName)
+
+	// restore as of a time from a backup: fail validation
+	restore = &velerov1api.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "restore-1",
+		},
+		Spec: velerov1api.RestoreSpec{
+			BackupName:            "foo",
+			RestoreAsOf:           &metav1.Time{Time: now},
+			BackupSelectionPolicy: velerov1api.BackupSelectionPolicyPreferCompleted,
+		},
+	}
+	r.validateAndComplete(restore)
+	assert.Contains(t, restore.Status.ValidationErrors, "RestoreAsOf can only be specified with ScheduleName")
+	assert.Contains(t, restore.Status.ValidationErrors, "BackupSelectionPolicy can only be specified with ScheduleName")
+	assert.Nil(t, restore.Status.BackupResolution)
 }
 
 func TestValidateAndCompleteWithResourceModifierSpecified(t *testing.T) {
@@ -931,7 +1066,7 @@ func TestBackupXorScheduleProvided(t *testing.T) {
 	assert.True(t, backupXorScheduleProvided(r))
 }
 
-func TestMostRecentCompletedBackup(t *testing.T) {
+func TestSelectScheduleBackup(t *testing.T) {
 	backups := []velerov1api.Backup{
 		{
 			ObjectMeta: metav1.ObjectMeta{
@@ -975,7 +1110,7 @@ func TestMostRecentCompletedBackup(t *testing.T) {
 		},
 	}
 
-	assert.Empty(t, mostRecentCompletedBackup(backups).Name)
+	assert.Empty(t, selectScheduleBackup(backups, nil, velerov1api.BackupSelectionPolicyCompleted).Name)
 
 	now := time.Now()
 
@@ -1000,7 +1135,88 @@ func TestMostRecentCompletedBackup(t *testing.T) {
 	}
 	backups = append(backups, expected)
 
-	assert.Equal(t, expected, mostRecentCompletedBackup(backups))
+	assert.Equal(t, expected, selectScheduleBackup(backups, nil, velerov1api.BackupSelectionPolicyCompleted))
+}
+
+func TestSelectScheduleBackupAsOfAndPolicy(t *testing.T) {
+	now := time.Date(2026, 1, 1, 14, 0, 0, 0, time.UTC)
+	backup := func(name string, started time.Time, duration time.Duration, phase velerov1api.BackupPhase) velerov1api.Backup {
+		return *builder.ForBackup(velerov1api.DefaultNamespace, name).StartTimestamp(started).CompletionTimestamp(started.Add(duration)).Phase(phase).Result()
+	}
+	backups := []velerov1api.Backup{
+		backup("backup-1", now.Add(-3*time.Hour), 10*time.Minute, velerov1api.BackupPhaseCompleted),
+		backup("backup-2", now.Add(-2*time.Hour), 10*time.Minute, velerov1api.BackupPhasePartiallyFailed),
+		backup("backup-3", now.Add(-time.Hour), 10*time.Minute, velerov1api.BackupPhaseFailed),
+		backup("backup-4", now.Add(-10*time.Minute), 10*time.Minute, velerov1api.BackupPhasePartiallyFailed),
+		backup("backup-5", now.Add(time.Hour), 10*time.Minute, velerov1api.BackupPhaseCompleted),
+		*builder.ForBackup(velerov1api.DefaultNamespace, "backup-6").StartTimestamp(now.Add(-30 * time.Minute)).CompletionTimestamp(now.Add(-20 * time.Minute)).
+			Phase(velerov1api.BackupPhaseCompleted).DryRun(true).Result(),
+		// started before the time, but completed after it
+		backup("backup-7", now.Add(-5*time.Minute), 10*time.Minute, velerov1api.BackupPhaseCompleted),
+	}
+
+	tests := []struct {
+		name    string
+		backups []velerov1api.Backup
+		asOf    *metav1.Time
+		policy  velerov1api.BackupSelectionPolicy
+		want    string
+	}{
+		{
+			name:    "most recent completed backup",
+			backups: backups,
+			policy:  velerov1api.BackupSelectionPolicyCompleted,
+			want:    "backup-5",
+		},
+		{
+			name:    "most recent completed backup completed at or before the time",
+			backups: backups,
+			asOf:    &metav1.Time{Time: now},
+			policy:  velerov1api.BackupSelectionPolicyCompleted,
+			want:    "backup-1",
+		},
+		{
+			name:    "completed backup is preferred over a more recent partially failed backup",
+			backups: backups,
+			asOf:    &metav1.Time{Time: now},
+			policy:  velerov1api.BackupSelectionPolicyPreferCompleted,
+			want:    "backup-1",
+		},
+		{
+			name:    "most recent partially failed backup when there is no completed backup",
+			backups: backups[1:4],
+			asOf:    &metav1.Time{Time: now},
+			policy:  velerov1api.BackupSelectionPolicyPreferCompleted,
+			want:    "backup-4",
+		},
+		{
+			name:    "most recent completed or partially failed backup completed at or before the time",
+			backups: backups,
+			asOf:    &metav1.Time{Time: now},
+			policy:  velerov1api.BackupSelectionPolicyAllowPartiallyFailed,
+			want:    "backup-4",
+		},
+		{
+			name:    "no backup completed at or before the time",
+			backups: backups,
+			asOf:    &metav1.Time{Time: now.Add(-4 * time.Hour)},
+			policy:  velerov1api.BackupSelectionPolicyAllowPartiallyFailed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, selectScheduleBackup(tc.backups, tc.asOf, tc.policy).Name)
+		})
+	}
+}
+
+func TestNoScheduleBackupMessage(t *testing.T) {
+	asOf := &metav1.Time{Time: time.Date(2026, 1, 1, 14, 0, 0, 0, time.UTC)}
+
+	assert.Equal(t, "No completed backups found for schedule", noScheduleBackupMessage(nil, velerov1api.BackupSelectionPolicyCompleted))
+	assert.Equal(t, "No completed or partially failed backups found for schedule completed at or before 2026-01-01T14:00:00Z",
+		noScheduleBackupMessage(asOf, velerov1api.BackupSelectionPolicyPreferCompleted))
 }
 
 func NewRestore(ns, name, backup, includeNS, includeResource string, phase velerov1api.RestorePhase) *builder.RestoreBuilder {
@@ -1021,8 +1237,9 @@ func NewRestore(ns, name, backup, includeNS, includeResource string, phase veler
 
 type fakeRestorer struct {
 	mock.Mock
-	calledWithArg velerov1api.Restore
-	kbClient      client.Client
+	calledWithArg     velerov1api.Restore
+	kbClient          client.Client
+	preflightFailures []string
 }
 
 func (r *fakeRestorer) Restore(
@@ -1045,6 +1262,7 @@ func (r *fakeRestorer) RestoreWithResolvers(req *pkgrestore.Request,
 		r.kbClient, volumeSnapshotterGetter)
 
 	r.calledWithArg = *req.Restore
+	req.PreflightFailures = r.preflightFailures
 
 	return res.Get(0).(results.Result), res.Get(1).(results.Result)
 }
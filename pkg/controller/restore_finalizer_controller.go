@@ -19,11 +19,14 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
 	corev1api "k8s.io/api/core/v1"
 	storagev1api "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -43,8 +46,16 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
+)
+
+const (
+	defaultRestoreReadinessTimeout = 10 * time.Minute
+	restoreReadinessPollInterval   = 5 * time.Second
 )
 
 type restoreFinalizerReconciler struct {
@@ -165,13 +176,14 @@ func (r *restoreFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 
 	finalizerCtx := &finalizerContext{
-		logger:           log,
-		restore:          restore,
-		crClient:         r.crClient,
-		volumeInfo:       volumeInfo,
-		restoredPVCList:  restoredPVCList,
-		multiHookTracker: r.multiHookTracker,
-		resourceTimeout:  r.resourceTimeout,
+		logger:            log,
+		restore:           restore,
+		crClient:          r.crClient,
+		volumeInfo:        volumeInfo,
+		restoredPVCList:   restoredPVCList,
+		restoredResources: restoredResourceList,
+		multiHookTracker:  r.multiHookTracker,
+		resourceTimeout:   r.resourceTimeout,
 		restoreItemOperationList: restoreItemOperationList{
 			items: restoreItemOperations,
 		},
@@ -286,6 +298,7 @@ type finalizerContext struct {
 	crClient                 client.Client
 	volumeInfo               []*volume.BackupVolumeInfo
 	restoredPVCList          map[string]struct{}
+	restoredResources        map[string][]string
 	restoreItemOperationList restoreItemOperationList
 	multiHookTracker         *hook.MultiHookTracker
 	resourceTimeout          time.Duration
@@ -301,6 +314,9 @@ func (ctx *finalizerContext) execute() (results.Result, results.Result) { //noli
 	rehErrs := ctx.WaitRestoreExecHook()
 	errs.Merge(&rehErrs)
 
+	wrErrs := ctx.waitWorkloadsReady()
+	errs.Merge(&wrErrs)
+
 	return warnings, errs
 }
 
@@ -318,10 +334,7 @@ func (ctx *finalizerContext) patchDynamicPVWithVolumeInfo() (errs results.Result
 	for _, volumeItem := range ctx.volumeInfo {
 		if (volumeItem.BackupMethod == volume.PodVolumeBackup || volumeItem.BackupMethod == volume.CSISnapshot) && volumeItem.PVInfo != nil {
 			// Determine restored PVC namespace
-			restoredNamespace := volumeItem.PVCNamespace
-			if remapped, ok := ctx.restore.Spec.NamespaceMapping[restoredNamespace]; ok {
-				restoredNamespace = remapped
-			}
+			restoredNamespace, _ := pkgrestoreUtil.MapNamespace(ctx.restore, volumeItem.PVCNamespace)
 
 			// Check if PVC was restored in previous phase
 			pvcKey := fmt.Sprintf("%s/%s", restoredNamespace, volumeItem.PVCName)
@@ -502,3 +515,120 @@ func (ctx *finalizerContext) WaitRestoreExecHook() (errs results.Result) {
 
 	return errs
 }
+
+// restoredWorkload is a workload created or updated by a restore whose readiness is verified.
+type restoredWorkload struct {
+	velerov1api.RestoreWorkloadReadiness
+	// done is whether the workload is ready or can't become ready anymore
+	done bool
+}
+
+// restoredWorkloads returns the workloads created or updated by the restore from the list of
+// restored resources, whose entries are like "namespace/name(action)".
+func restoredWorkloads(restoredResources map[string][]string) []*restoredWorkload {
+	var workloads []*restoredWorkload
+	for _, key := range []string{"apps/v1/Deployment", "apps/v1/StatefulSet", "apps/v1/DaemonSet", "batch/v1/Job", "v1/PersistentVolumeClaim"} {
+		kind := key[strings.LastIndex(key, "/")+1:]
+		for _, entry := range restoredResources[key] {
+			item, action, found := strings.Cut(strings.TrimSuffix(entry, ")"), "(")
+			if !found || (action != pkgrestore.ItemRestoreResultCreated && action != pkgrestore.ItemRestoreResultUpdated) {
+				continue
+			}
+			namespace, name, _ := strings.Cut(item, "/")
+			workloads = append(workloads, &restoredWorkload{
+				RestoreWorkloadReadiness: velerov1api.RestoreWorkloadReadiness{Kind: kind, Namespace: namespace, Name: name},
+			})
+		}
+	}
+	return workloads
+}
+
+// waitWorkloadsReady waits for the workloads created or updated by the restore to become ready,
+// if the restore has a readiness check, and records their readiness in the restore's status.
+// The workloads that are not ready within the timeout are reported as errors.
+func (ctx *finalizerContext) waitWorkloadsReady() (errs results.Result) {
+	if ctx.restore.Spec.ReadinessCheck == nil || boolptr.IsSetToTrue(ctx.restore.Spec.DryRun) {
+		return errs
+	}
+
+	timeout := ctx.restore.Spec.ReadinessCheck.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultRestoreReadinessTimeout
+	}
+
+	workloads := restoredWorkloads(ctx.restoredResources)
+	ctx.logger.Infof("Waiting up to %s for %d restored workloads to become ready", timeout, len(workloads))
+
+	err := wait.PollUntilContextTimeout(context.Background(), restoreReadinessPollInterval, timeout, true, func(context.Context) (bool, error) {
+		done := true
+		for _, workload := range workloads {
+			if workload.done {
+				continue
+			}
+			ctx.checkWorkloadReadiness(workload)
+			done = done && workload.done
+		}
+		return done, nil
+	})
+	if err != nil {
+		ctx.logger.WithError(err).Warn("Not all the restored workloads are ready")
+	}
+
+	readiness := &velerov1api.RestoreReadiness{}
+	for _, workload := range workloads {
+		readiness.Workloads = append(readiness.Workloads, workload.RestoreWorkloadReadiness)
+		if workload.Ready {
+			readiness.WorkloadsReady++
+			continue
+		}
+		readiness.WorkloadsNotReady++
+		errs.Add(workload.Namespace, errors.Errorf("%s %s/%s is not ready: %s", workload.Kind, workload.Namespace, workload.Name, workload.Message))
+	}
+	ctx.restore.Status.Readiness = readiness
+	ctx.logger.Infof("%d restored workloads are ready, %d are not ready", readiness.WorkloadsReady, readiness.WorkloadsNotReady)
+
+	return errs
+}
+
+// checkWorkloadReadiness gets the workload and updates its readiness.
+func (ctx *finalizerContext) checkWorkloadReadiness(workload *restoredWorkload) {
+	key := client.ObjectKey{Namespace: workload.Namespace, Name: workload.Name}
+	var obj client.Object
+	switch workload.Kind {
+	case "Deployment":
+		obj = &appsv1api.Deployment{}
+	case "StatefulSet":
+		obj = &appsv1api.StatefulSet{}
+	case "DaemonSet":
+		obj = &appsv1api.DaemonSet{}
+	case "Job":
+		obj = &batchv1api.Job{}
+	default:
+		obj = &corev1api.PersistentVolumeClaim{}
+	}
+	if err := ctx.crClient.Get(context.Background(), key, obj); err != nil {
+		workload.Message = fmt.Sprintf("error getting the %s: %v", workload.Kind, err)
+		// the workload was deleted since it was restored
+		workload.done = apierrors.IsNotFound(err)
+		return
+	}
+
+	switch obj := obj.(type) {
+	case *appsv1api.Deployment:
+		workload.Ready, workload.Message = kubeutil.IsDeploymentReady(obj)
+	case *appsv1api.StatefulSet:
+		workload.Ready, workload.Message = kubeutil.IsStatefulSetReady(obj)
+	case *appsv1api.DaemonSet:
+		workload.Ready, workload.Message = kubeutil.IsDaemonSetReady(obj)
+	case *batchv1api.Job:
+		var failed bool
+		workload.Ready, failed, workload.Message = kubeutil.IsJobComplete(obj)
+		workload.done = failed
+	case *corev1api.PersistentVolumeClaim:
+		workload.Ready = kubeutil.IsPVCBound(obj)
+		if !workload.Ready {
+			workload.Message = fmt.Sprintf("the claim is %s", obj.Status.Phase)
+		}
+	}
+	workload.done = workload.done || workload.Ready
+}
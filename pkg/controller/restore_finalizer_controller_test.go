@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -560,6 +561,65 @@ func TestWaitRestoreExecHook(t *testing.T) {
 	}
 }
 
+func TestWaitWorkloadsReady(t *testing.T) {
+	deployment := builder.ForDeployment("ns-1", "deploy-1").Result()
+	deployment.Status = appsv1api.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1}
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Phase(corev1api.ClaimPending).Result()
+	restoredResources := map[string][]string{
+		"apps/v1/Deployment":       {"ns-1/deploy-1(created)", "ns-1/deploy-2(skipped)"},
+		"apps/v1/StatefulSet":      {"ns-1/sts-1(updated)"},
+		"v1/PersistentVolumeClaim": {"ns-1/pvc-1(created)"},
+	}
+
+	tests := []struct {
+		name          string
+		restore       *velerov1api.Restore
+		wantReadiness *velerov1api.RestoreReadiness
+		wantErrs      []string
+	}{
+		{
+			name:    "restore without a readiness check is not verified",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		},
+		{
+			name:    "dry-run restore is not verified",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").DryRun(true).ReadinessCheck(time.Millisecond).Result(),
+		},
+		{
+			name:    "workloads that are not ready are reported as errors",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ReadinessCheck(time.Millisecond).Result(),
+			wantReadiness: &velerov1api.RestoreReadiness{
+				WorkloadsReady:    1,
+				WorkloadsNotReady: 2,
+				Workloads: []velerov1api.RestoreWorkloadReadiness{
+					{Kind: "Deployment", Namespace: "ns-1", Name: "deploy-1", Ready: true},
+					{Kind: "StatefulSet", Namespace: "ns-1", Name: "sts-1", Message: `error getting the StatefulSet: statefulsets.apps "sts-1" not found`},
+					{Kind: "PersistentVolumeClaim", Namespace: "ns-1", Name: "pvc-1", Message: "the claim is Pending"},
+				},
+			},
+			wantErrs: []string{
+				`StatefulSet ns-1/sts-1 is not ready: error getting the StatefulSet: statefulsets.apps "sts-1" not found`,
+				"PersistentVolumeClaim ns-1/pvc-1 is not ready: the claim is Pending",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &finalizerContext{
+				logger:            velerotest.NewLogger(),
+				crClient:          velerotest.NewFakeControllerRuntimeClient(t, deployment, pvc),
+				restore:           tc.restore,
+				restoredResources: restoredResources,
+			}
+
+			errs := ctx.waitWorkloadsReady()
+			assert.Equal(t, tc.wantReadiness, tc.restore.Status.Readiness)
+			assert.Equal(t, tc.wantErrs, errs.Namespaces["ns-1"])
+		})
+	}
+}
+
 // test finishprocessing with mocks of kube client to simulate connection refused
 func Test_restoreFinalizerReconciler_finishProcessing(t *testing.T) {
 	type args struct {
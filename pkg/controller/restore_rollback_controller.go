@@ -0,0 +1,202 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/constant"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// restoreRollbackReconciler processes the RestoreRollbacks: it deletes the items created by the
+// restore, and reverts the items updated by the restore, from the restored resource list and the
+// original items the restore uploaded to the backup storage location.
+type restoreRollbackReconciler struct {
+	client.Client
+	namespace         string
+	logger            logrus.FieldLogger
+	rollbacker        restore.Rollbacker
+	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	clock             clock.Clock
+}
+
+// NewRestoreRollbackReconciler creates a new restore rollback reconciler.
+func NewRestoreRollbackReconciler(
+	logger logrus.FieldLogger,
+	namespace string,
+	client client.Client,
+	rollbacker restore.Rollbacker,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) *restoreRollbackReconciler {
+	return &restoreRollbackReconciler{
+		Client:            client,
+		namespace:         namespace,
+		logger:            logger,
+		rollbacker:        rollbacker,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		clock:             clock.RealClock{},
+	}
+}
+
+func (r *restoreRollbackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.RestoreRollback{}).
+		Named(constant.ControllerRestoreRollback).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=restorerollbacks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=restorerollbacks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=get
+
+func (r *restoreRollbackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithFields(logrus.Fields{
+		"controller":      constant.ControllerRestoreRollback,
+		"restorerollback": req.String(),
+	})
+
+	rollback := &velerov1api.RestoreRollback{}
+	if err := r.Get(ctx, req.NamespacedName, rollback); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find the restorerollback")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("Error getting restorerollback")
+		return ctrl.Result{}, err
+	}
+
+	if rollback.Status.Phase != "" && rollback.Status.Phase != velerov1api.RestoreRollbackPhaseNew {
+		log.Debug("The restorerollback has been processed, skip")
+		return ctrl.Result{}, nil
+	}
+
+	if rollback.Spec.RestoreName == "" {
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.New("spec.restoreName is required"))
+	}
+	log = log.WithField("restore", rollback.Spec.RestoreName)
+
+	restoreObj := &velerov1api.Restore{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: rollback.Namespace, Name: rollback.Spec.RestoreName}, restoreObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.Errorf("restore %s not found", rollback.Spec.RestoreName))
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting restore %s", rollback.Spec.RestoreName)
+	}
+
+	switch restoreObj.Status.Phase {
+	case velerov1api.RestorePhaseCompleted, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
+	default:
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback,
+			errors.Errorf("restore %s is in phase %q, only the completed, partially failed or failed restores can be rolled back", restoreObj.Name, restoreObj.Status.Phase))
+	}
+	if boolptr.IsSetToTrue(restoreObj.Spec.DryRun) {
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.Errorf("restore %s is a dry run, there is nothing to roll back", restoreObj.Name))
+	}
+
+	rollback, err := r.patchRestoreRollback(ctx, rollback, func(res *velerov1api.RestoreRollback) {
+		res.Status.Phase = velerov1api.RestoreRollbackPhaseInProgress
+		res.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	})
+	if err != nil {
+		log.WithError(err).Error("Error patching restorerollback's phase to InProgress")
+		return ctrl.Result{}, err
+	}
+
+	info, err := fetchBackupInfoInternal(r.Client, r.namespace, restoreObj.Spec.BackupName)
+	if err != nil {
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.Wrap(err, "error getting backup info"))
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+	backupStore, err := r.backupStoreGetter.Get(info.location, pluginManager, log)
+	if err != nil {
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.Wrap(err, "error getting backup store"))
+	}
+
+	restoredResources, err := backupStore.GetRestoredResourceList(restoreObj.Name)
+	if err != nil {
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.Wrap(err, "error getting the restored resource list"))
+	}
+	originalItems, err := backupStore.GetRestoreOriginalItems(restoreObj.Name)
+	if err != nil {
+		return ctrl.Result{}, r.patchRestoreRollbackWithError(ctx, rollback, errors.Wrap(err, "error getting the original items of the restore"))
+	}
+
+	result := r.rollbacker.Rollback(log, restoreObj, restoredResources, originalItems)
+	if restoreObj.Status.OriginalItemsNotSaved > 0 {
+		result.Errors = append(result.Errors, fmt.Sprintf("the version before the restore of %d items updated by the restore couldn't be saved, they weren't reverted",
+			restoreObj.Status.OriginalItemsNotSaved))
+	}
+	log.Infof("Rolled back restore: %d items deleted, %d items reverted, %d items skipped, %d errors",
+		result.ItemsDeleted, result.ItemsReverted, result.ItemsSkipped, len(result.Errors))
+
+	_, err = r.patchRestoreRollback(ctx, rollback, func(res *velerov1api.RestoreRollback) {
+		res.Status.Phase = velerov1api.RestoreRollbackPhaseCompleted
+		if len(result.Errors) > 0 {
+			res.Status.Phase = velerov1api.RestoreRollbackPhasePartiallyFailed
+		}
+		res.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		res.Status.ItemsDeleted = result.ItemsDeleted
+		res.Status.ItemsReverted = result.ItemsReverted
+		res.Status.ItemsSkipped = result.ItemsSkipped
+		res.Status.Errors = result.Errors
+	})
+	if err != nil {
+		log.WithError(err).Error("Error patching restorerollback's final status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *restoreRollbackReconciler) patchRestoreRollback(ctx context.Context, rollback *velerov1api.RestoreRollback, mutate func(*velerov1api.RestoreRollback)) (*velerov1api.RestoreRollback, error) {
+	original := rollback.DeepCopy()
+	mutate(rollback)
+	if err := r.Patch(ctx, rollback, client.MergeFrom(original)); err != nil {
+		return nil, errors.Wrap(err, "error patching the restorerollback")
+	}
+	return rollback, nil
+}
+
+func (r *restoreRollbackReconciler) patchRestoreRollbackWithError(ctx context.Context, rollback *velerov1api.RestoreRollback, err error) error {
+	_, err = r.patchRestoreRollback(ctx, rollback, func(res *velerov1api.RestoreRollback) {
+		res.Status.Phase = velerov1api.RestoreRollbackPhaseFailed
+		if res.Status.StartTimestamp == nil {
+			res.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		}
+		res.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		res.Status.Errors = []string{err.Error()}
+	})
+	return err
+}
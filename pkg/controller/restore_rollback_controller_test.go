@@ -0,0 +1,201 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/restore"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeRollbacker struct {
+	result            restore.RollbackResult
+	restoredResources map[string][]string
+	originalItems     []*unstructured.Unstructured
+	called            bool
+}
+
+func (r *fakeRollbacker) Rollback(_ logrus.FieldLogger, _ *velerov1api.Restore, restoredResources map[string][]string, originalItems []*unstructured.Unstructured) restore.RollbackResult {
+	r.called = true
+	r.restoredResources = restoredResources
+	r.originalItems = originalItems
+	return r.result
+}
+
+func TestRestoreRollbackReconcile(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	restoredResources := map[string][]string{"v1/ConfigMap": {"ns-1/cm-1(created)", "ns-1/cm-2(updated)"}}
+	originalItems := []*unstructured.Unstructured{{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap"}}}
+
+	tests := []struct {
+		name         string
+		restoreName  string
+		restore      *velerov1api.Restore
+		result       restore.RollbackResult
+		wantPhase    velerov1api.RestoreRollbackPhase
+		wantErrors   []string
+		wantRollback bool
+		wantDeleted  int
+		wantReverted int
+		wantSkipped  int
+	}{
+		{
+			name:         "completed restore is rolled back",
+			restoreName:  "restore-1",
+			restore:      builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+			result:       restore.RollbackResult{ItemsDeleted: 2, ItemsReverted: 1, ItemsSkipped: 1},
+			wantPhase:    velerov1api.RestoreRollbackPhaseCompleted,
+			wantRollback: true,
+			wantDeleted:  2,
+			wantReverted: 1,
+			wantSkipped:  1,
+		},
+		{
+			name:         "rollback with errors is partially failed",
+			restoreName:  "restore-1",
+			restore:      builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhasePartiallyFailed).Result(),
+			result:       restore.RollbackResult{ItemsDeleted: 1, Errors: []string{"error deleting configmaps ns-1/cm-1: forbidden"}},
+			wantPhase:    velerov1api.RestoreRollbackPhasePartiallyFailed,
+			wantErrors:   []string{"error deleting configmaps ns-1/cm-1: forbidden"},
+			wantRollback: true,
+			wantDeleted:  1,
+		},
+		{
+			name:        "rollback of a restore whose original items weren't saved is partially failed",
+			restoreName: "restore-1",
+			restore: func() *velerov1api.Restore {
+				res := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result()
+				res.Status.OriginalItemsNotSaved = 2
+				return res
+			}(),
+			result:       restore.RollbackResult{ItemsDeleted: 1},
+			wantPhase:    velerov1api.RestoreRollbackPhasePartiallyFailed,
+			wantErrors:   []string{"the version before the restore of 2 items updated by the restore couldn't be saved, they weren't reverted"},
+			wantRollback: true,
+			wantDeleted:  1,
+		},
+		{
+			name:       "rollback without a restore name fails",
+			restore:    builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+			wantPhase:  velerov1api.RestoreRollbackPhaseFailed,
+			wantErrors: []string{"spec.restoreName is required"},
+		},
+		{
+			name:        "rollback of a restore that doesn't exist fails",
+			restoreName: "restore-2",
+			restore:     builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).Result(),
+			wantPhase:   velerov1api.RestoreRollbackPhaseFailed,
+			wantErrors:  []string{"restore restore-2 not found"},
+		},
+		{
+			name:        "rollback of an in progress restore fails",
+			restoreName: "restore-1",
+			restore:     builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseInProgress).Result(),
+			wantPhase:   velerov1api.RestoreRollbackPhaseFailed,
+			wantErrors:  []string{`restore restore-1 is in phase "InProgress", only the completed, partially failed or failed restores can be rolled back`},
+		},
+		{
+			name:        "rollback of a dry-run restore fails",
+			restoreName: "restore-1",
+			restore:     builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").DryRun(true).Phase(velerov1api.RestorePhaseCompleted).Result(),
+			wantPhase:   velerov1api.RestoreRollbackPhaseFailed,
+			wantErrors:  []string{"restore restore-1 is a dry run, there is nothing to roll back"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rollback := &velerov1api.RestoreRollback{
+				ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "rollback-1"},
+				Spec:       velerov1api.RestoreRollbackSpec{RestoreName: tc.restoreName},
+			}
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").Result()
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("aws").Bucket("bucket").Result()
+			client := velerotest.NewFakeControllerRuntimeClient(t, rollback, tc.restore, backup, location)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetRestoredResourceList", "restore-1").Return(restoredResources, nil)
+			backupStore.On("GetRestoreOriginalItems", "restore-1").Return(originalItems, nil)
+
+			rollbacker := &fakeRollbacker{result: tc.result}
+			r := NewRestoreRollbackReconciler(
+				velerotest.NewLogger(),
+				velerov1api.DefaultNamespace,
+				client,
+				rollbacker,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+			)
+			r.clock = testclocks.NewFakeClock(now)
+
+			result, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: rollback.Namespace, Name: rollback.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, ctrl.Result{}, result)
+
+			res := &velerov1api.RestoreRollback{}
+			require.NoError(t, client.Get(t.Context(), types.NamespacedName{Namespace: rollback.Namespace, Name: rollback.Name}, res))
+			assert.Equal(t, tc.wantPhase, res.Status.Phase)
+			assert.Equal(t, tc.wantErrors, res.Status.Errors)
+			assert.Equal(t, tc.wantDeleted, res.Status.ItemsDeleted)
+			assert.Equal(t, tc.wantReverted, res.Status.ItemsReverted)
+			assert.Equal(t, tc.wantSkipped, res.Status.ItemsSkipped)
+			require.NotNil(t, res.Status.CompletionTimestamp)
+			assert.True(t, res.Status.CompletionTimestamp.Time.Equal(now))
+
+			assert.Equal(t, tc.wantRollback, rollbacker.called)
+			if tc.wantRollback {
+				assert.Equal(t, restoredResources, rollbacker.restoredResources)
+				assert.Equal(t, originalItems, rollbacker.originalItems)
+			}
+		})
+	}
+}
+
+func TestRestoreRollbackReconcileSkipsProcessedRollbacks(t *testing.T) {
+	rollback := &velerov1api.RestoreRollback{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "rollback-1"},
+		Spec:       velerov1api.RestoreRollbackSpec{RestoreName: "restore-1"},
+		Status:     velerov1api.RestoreRollbackStatus{Phase: velerov1api.RestoreRollbackPhaseCompleted},
+	}
+	client := velerotest.NewFakeControllerRuntimeClient(t, rollback)
+
+	rollbacker := &fakeRollbacker{}
+	r := NewRestoreRollbackReconciler(velerotest.NewLogger(), velerov1api.DefaultNamespace, client, rollbacker, nil, nil)
+
+	result, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: rollback.Namespace, Name: rollback.Name}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.False(t, rollbacker.called)
+}
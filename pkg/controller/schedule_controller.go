@@ -19,11 +19,14 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	cron "github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -37,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/constant"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -123,6 +127,9 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	errs = append(errs, validateRetention(schedule.Spec.Retention)...)
+	windows, windowErrs := parseScheduleWindows(schedule.Spec)
+	errs = append(errs, windowErrs...)
 	if len(errs) > 0 {
 		schedule.Status.Phase = velerov1.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
@@ -158,11 +165,23 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, nil
 	}
 
+	if err := c.enforceRetention(ctx, schedule, scheduleLocation(cronSchedule)); err != nil {
+		log.WithError(err).Error("Error enforcing the retention policy of the schedule")
+	}
+
 	// Check for the schedule being due to run.
 	// If there are backup created by this schedule still in New or InProgress state,
 	// skip current backup creation to avoid running overlap backups.
 	// As the schedule must be validated before checking whether it's due, we cannot put the checking log in Predicate
+	// The runs the windows of the schedule don't allow are skipped or deferred according to its blackout policy.
 	if c.ifDue(schedule, cronSchedule) && !c.checkIfBackupInNewOrProgress(schedule) {
+		blackedOut, err := c.checkBlackout(ctx, schedule, cronSchedule, windows)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error checking the windows of schedule %s", req.String())
+		}
+		if blackedOut {
+			return ctrl.Result{}, nil
+		}
 		if err := c.submitBackup(ctx, schedule); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
 		}
@@ -211,6 +230,236 @@ func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.
 	return schedule, nil
 }
 
+// validateRetention returns the validation errors of the retention policy of a schedule.
+func validateRetention(policy *velerov1.ScheduleRetentionPolicy) []string {
+	if policy == nil {
+		return nil
+	}
+	if policy.Hourly < 0 || policy.Daily < 0 || policy.Weekly < 0 || policy.Monthly < 0 || policy.Yearly < 0 {
+		return []string{"invalid retention: the number of periods cannot be negative"}
+	}
+	if policy.Hourly+policy.Daily+policy.Weekly+policy.Monthly+policy.Yearly == 0 {
+		return []string{"invalid retention: the policy must keep the backups of at least one period"}
+	}
+	return nil
+}
+
+// scheduleWindow is a parsed window of a schedule.
+type scheduleWindow struct {
+	spec     velerov1.ScheduleWindow
+	start    cron.Schedule
+	duration time.Duration
+}
+
+// contains returns whether the window contains the time, i.e. it started in the duration of
+// the window before the time.
+func (w scheduleWindow) contains(t time.Time) bool {
+	return !w.start.Next(t.Add(-w.duration)).After(t)
+}
+
+func (w scheduleWindow) String() string {
+	return fmt.Sprintf("%q for %s", w.spec.Schedule, w.duration)
+}
+
+// scheduleWindows are the parsed allowed and blackout windows of a schedule.
+type scheduleWindows struct {
+	allowed  []scheduleWindow
+	blackout []scheduleWindow
+}
+
+// check returns why the windows don't allow a backup to start at the time, or an empty
+// string if they allow it.
+func (w scheduleWindows) check(t time.Time) string {
+	for _, window := range w.blackout {
+		if window.contains(t) {
+			return fmt.Sprintf("in blackout window %s", window)
+		}
+	}
+	if len(w.allowed) == 0 {
+		return ""
+	}
+	for _, window := range w.allowed {
+		if window.contains(t) {
+			return ""
+		}
+	}
+	return "outside of the allowed windows"
+}
+
+// parseScheduleWindows returns the parsed windows of a schedule, and their validation errors.
+func parseScheduleWindows(spec velerov1.ScheduleSpec) (scheduleWindows, []string) {
+	var windows scheduleWindows
+	var validationErrors []string
+	parse := func(kind string, specs []velerov1.ScheduleWindow) []scheduleWindow {
+		var parsed []scheduleWindow
+		for _, spec := range specs {
+			window, err := parseScheduleWindow(spec)
+			if err != nil {
+				validationErrors = append(validationErrors, fmt.Sprintf("invalid %s window %q: %v", kind, spec.Schedule, err))
+				continue
+			}
+			parsed = append(parsed, window)
+		}
+		return parsed
+	}
+	windows.allowed = parse("allowed", spec.AllowedWindows)
+	windows.blackout = parse("blackout", spec.BlackoutWindows)
+
+	switch spec.BlackoutPolicy {
+	case "", velerov1.ScheduleBlackoutPolicySkip, velerov1.ScheduleBlackoutPolicyDefer:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf("invalid blackout policy %q: valid values are %s, %s",
+			spec.BlackoutPolicy, velerov1.ScheduleBlackoutPolicySkip, velerov1.ScheduleBlackoutPolicyDefer))
+	}
+	return windows, validationErrors
+}
+
+func parseScheduleWindow(spec velerov1.ScheduleWindow) (window scheduleWindow, err error) {
+	if spec.Duration.Duration <= 0 {
+		return window, errors.New("the duration must be positive")
+	}
+	// cron.ParseStandard panics on empty string, and possibly under other scenarios as well
+	if spec.Schedule == "" {
+		return window, errors.New("the schedule must be a non-empty valid Cron expression")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("invalid schedule: %v", r)
+		}
+	}()
+
+	start, err := cron.ParseStandard(spec.Schedule)
+	if err != nil {
+		return window, errors.Wrap(err, "invalid schedule")
+	}
+	// the "@every" schedules are relative to the time they're evaluated at, they can't define
+	// when a window starts
+	if _, ok := start.(*cron.SpecSchedule); !ok {
+		return window, errors.New("invalid schedule: @every isn't supported for windows")
+	}
+	return scheduleWindow{spec: spec, start: start, duration: spec.Duration.Duration}, nil
+}
+
+// retentionPeriods are the periods of the retention policy, from the shortest to the longest,
+// with the number of periods the policy keeps a backup of, and the key of the period of a time.
+var retentionPeriods = []struct {
+	name  string
+	count func(*velerov1.ScheduleRetentionPolicy) int
+	key   func(time.Time) string
+}{
+	{"hourly", func(p *velerov1.ScheduleRetentionPolicy) int { return p.Hourly }, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+	{"daily", func(p *velerov1.ScheduleRetentionPolicy) int { return p.Daily }, func(t time.Time) string { return t.Format("2006-01-02") }},
+	{"weekly", func(p *velerov1.ScheduleRetentionPolicy) int { return p.Weekly }, func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	}},
+	{"monthly", func(p *velerov1.ScheduleRetentionPolicy) int { return p.Monthly }, func(t time.Time) string { return t.Format("2006-01") }},
+	{"yearly", func(p *velerov1.ScheduleRetentionPolicy) int { return p.Yearly }, func(t time.Time) string { return t.Format("2006") }},
+}
+
+// scheduleLocation returns the time zone of a schedule, set with the CRON_TZ prefix of its Cron
+// expression, or the time zone of the Velero server.
+func scheduleLocation(cronSchedule cron.Schedule) *time.Location {
+	if spec, ok := cronSchedule.(*cron.SpecSchedule); ok && spec.Location != nil {
+		return spec.Location
+	}
+	return time.Local
+}
+
+// retainedBackups returns the periods the retention policy keeps each of the completed backups
+// for, by backup name: the newest backup of each of the latest periods, in the time zone of the
+// schedule, that have a completed backup, up to the number of periods of the policy. Dry-run
+// backups don't hold any data, so they're never retained.
+func retainedBackups(policy *velerov1.ScheduleRetentionPolicy, backups []velerov1.Backup, location *time.Location) map[string][]string {
+	var completed []*velerov1.Backup
+	for i := range backups {
+		if backups[i].Status.Phase == velerov1.BackupPhaseCompleted && !boolptr.IsSetToTrue(backups[i].Spec.DryRun) {
+			completed = append(completed, &backups[i])
+		}
+	}
+	sort.SliceStable(completed, func(i, j int) bool {
+		return backupTime(completed[i]).After(backupTime(completed[j]))
+	})
+
+	retained := make(map[string][]string)
+	for _, period := range retentionPeriods {
+		remaining := period.count(policy)
+		lastKey := ""
+		for _, backup := range completed {
+			if remaining <= 0 {
+				break
+			}
+			key := period.key(backupTime(backup).In(location))
+			if key == lastKey {
+				continue
+			}
+			lastKey = key
+			remaining--
+			retained[backup.Name] = append(retained[backup.Name], period.name)
+		}
+	}
+	return retained
+}
+
+// backupTime returns the time a backup started, or was created if it didn't start.
+func backupTime(backup *velerov1.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
+
+// enforceRetention pins the completed backups of the schedule its retention policy keeps by
+// recording the periods they're kept for in their velero.io/retention-periods annotation, which
+// the garbage collection honors while the schedule has a retention policy, and expires the other
+// completed backups so that they're garbage-collected. The expiration of the pinned backups is
+// left alone, so they expire after their TTL again once the schedule or its retention policy is
+// removed.
+func (c *scheduleReconciler) enforceRetention(ctx context.Context, schedule *velerov1.Schedule, location *time.Location) error {
+	backupList := &velerov1.BackupList{}
+	if err := c.List(ctx, backupList, client.InNamespace(schedule.Namespace), client.MatchingLabels{velerov1.ScheduleNameLabel: schedule.Name}); err != nil {
+		return errors.Wrapf(err, "error listing the backups of schedule %s", kube.NamespaceAndName(schedule))
+	}
+
+	policy := schedule.Spec.Retention
+	var retained map[string][]string
+	if policy != nil {
+		retained = retainedBackups(policy, backupList.Items, location)
+	}
+
+	now := c.clock.Now()
+	for i := range backupList.Items {
+		backup := &backupList.Items[i]
+		if backup.Status.Phase != velerov1.BackupPhaseCompleted {
+			continue
+		}
+
+		original := backup.DeepCopy()
+		if periods, ok := retained[backup.Name]; ok {
+			metav1.SetMetaDataAnnotation(&backup.ObjectMeta, velerov1.RetentionPeriodsAnnotation, strings.Join(periods, ","))
+		} else {
+			if policy != nil && (backup.Status.Expiration == nil || backup.Status.Expiration.After(now)) {
+				backup.Status.Expiration = &metav1.Time{Time: now}
+			}
+			delete(backup.Annotations, velerov1.RetentionPeriodsAnnotation)
+		}
+
+		if equality.Semantic.DeepEqual(original, backup) {
+			continue
+		}
+		c.logger.WithFields(logrus.Fields{
+			"schedule":   kube.NamespaceAndName(schedule),
+			"backup":     backup.Name,
+			"expiration": backup.Status.Expiration,
+			"periods":    backup.Annotations[velerov1.RetentionPeriodsAnnotation],
+		}).Info("Updating the retention of the backup for the retention policy of the schedule")
+		if err := c.Patch(ctx, backup, client.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error updating the retention of backup %s", backup.Name)
+		}
+	}
+	return nil
+}
+
 // checkIfBackupInNewOrProgress check whether there are backups created by this schedule still in New or InProgress state
 func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Schedule) bool {
 	log := c.logger.WithField("schedule", kube.NamespaceAndName(schedule))
@@ -229,8 +478,8 @@ func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Sch
 	}
 
 	for _, backup := range backupList.Items {
-		if backup.Status.Phase == velerov1.BackupPhaseNew || backup.Status.Phase == velerov1.BackupPhaseInProgress {
-			log.Debugf("%s/%s still has backups that are in InProgress or New...", schedule.Namespace, schedule.Name)
+		if backup.Status.Phase == velerov1.BackupPhaseNew || backup.Status.Phase == velerov1.BackupPhaseQueued || backup.Status.Phase == velerov1.BackupPhaseInProgress {
+			log.Debugf("%s/%s still has backups that are in InProgress, Queued or New...", schedule.Namespace, schedule.Name)
 			return true
 		}
 	}
@@ -250,6 +499,56 @@ func (c *scheduleReconciler) ifDue(schedule *velerov1.Schedule, cronSchedule cro
 	return true
 }
 
+// checkBlackout returns whether the windows of the schedule don't allow its due run to start
+// now. If they don't, the run is skipped or deferred according to the blackout policy of the
+// schedule, which is recorded in its status.
+func (c *scheduleReconciler) checkBlackout(ctx context.Context, schedule *velerov1.Schedule, cronSchedule cron.Schedule, windows scheduleWindows) (bool, error) {
+	now := c.clock.Now()
+	reason := windows.check(now)
+	if reason == "" {
+		return false, nil
+	}
+
+	policy := schedule.Spec.BlackoutPolicy
+	if policy == "" {
+		policy = velerov1.ScheduleBlackoutPolicySkip
+	}
+	_, scheduledTime := getNextRunTime(schedule, cronSchedule, now)
+	log := c.logger.WithFields(logrus.Fields{
+		"schedule":      kube.NamespaceAndName(schedule),
+		"scheduledTime": scheduledTime,
+		"policy":        policy,
+	})
+
+	// a deferred run is only recorded once
+	if last := schedule.Status.LastBlackout; policy == velerov1.ScheduleBlackoutPolicyDefer && last != nil &&
+		last.Policy == policy && last.ScheduledTime != nil && last.ScheduledTime.Equal(&metav1.Time{Time: scheduledTime}) {
+		log.Debugf("Schedule is due %s, the run stays deferred", reason)
+		return true, nil
+	}
+
+	original := schedule.DeepCopy()
+	schedule.Status.LastBlackout = &velerov1.ScheduleBlackout{
+		Policy:        policy,
+		ScheduledTime: &metav1.Time{Time: scheduledTime},
+		Timestamp:     &metav1.Time{Time: now},
+		Reason:        reason,
+	}
+	if policy == velerov1.ScheduleBlackoutPolicySkip {
+		schedule.Status.LastSkipped = &metav1.Time{Time: now}
+	}
+	if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
+		return true, errors.Wrapf(err, "error updating Schedule's LastBlackout to %v", schedule.Status.LastBlackout)
+	}
+
+	if policy == velerov1.ScheduleBlackoutPolicySkip {
+		log.Infof("Schedule is due %s, skipping the run", reason)
+	} else {
+		log.Infof("Schedule is due %s, deferring the run", reason)
+	}
+	return true, nil
+}
+
 // submitBackup create a backup from schedule.
 func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov1.Schedule) error {
 	c.logger.WithField("schedule", schedule.Namespace+"/"+schedule.Name).Info("Schedule is due, going to submit backup.")
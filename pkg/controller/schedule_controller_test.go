@@ -35,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 // Test reconcile function of schedule controller. Pause is not covered as event filter will not allow it through
@@ -44,6 +45,9 @@ func TestReconcileOfSchedule(t *testing.T) {
 	newScheduleBuilder := func(phase velerov1.SchedulePhase) *builder.ScheduleBuilder {
 		return builder.ForSchedule("ns", "name").Phase(phase)
 	}
+	window := func(schedule string, duration time.Duration) velerov1.ScheduleWindow {
+		return velerov1.ScheduleWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}}
+	}
 
 	tests := []struct {
 		name                      string
@@ -55,6 +59,7 @@ func TestReconcileOfSchedule(t *testing.T) {
 		expectedBackupCreate      *velerov1.Backup
 		expectedLastBackup        string
 		expectedLastSkipped       string
+		expectedLastBlackout      velerov1.ScheduleBlackoutPolicy
 		backup                    *velerov1.Backup
 		reconcilerSkipImmediately bool
 	}{
@@ -149,6 +154,44 @@ func TestReconcileOfSchedule(t *testing.T) {
 			expectedPhase: string(velerov1.SchedulePhaseEnabled),
 			backup:        builder.ForBackup("ns", "name-20220905120000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(velerov1.BackupPhaseNew).Result(),
 		},
+		{
+			name:                     "schedule with an invalid window gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").BlackoutWindows(window("@every 1h", time.Hour)).Result(),
+			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid blackout window "@every 1h": invalid schedule: @every isn't supported for windows`},
+		},
+		{
+			name:                 "schedule due in a blackout window skips the run",
+			schedule:             newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").BlackoutWindows(window("0 9 * * *", 8*time.Hour)).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedLastBackup:   "2000-01-01 00:00:00",
+			expectedLastSkipped:  "2017-01-01 12:00:00",
+			expectedLastBlackout: velerov1.ScheduleBlackoutPolicySkip,
+		},
+		{
+			name: "schedule due in a blackout window defers the run",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").
+				BlackoutWindows(window("0 9 * * *", 8*time.Hour)).BlackoutPolicy(velerov1.ScheduleBlackoutPolicyDefer).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedLastBackup:   "2000-01-01 00:00:00",
+			expectedLastBlackout: velerov1.ScheduleBlackoutPolicyDefer,
+		},
+		{
+			name:                 "schedule due outside of its allowed windows skips the run",
+			schedule:             newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").AllowedWindows(window("0 22 * * *", 8*time.Hour)).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedLastBackup:   "2000-01-01 00:00:00",
+			expectedLastSkipped:  "2017-01-01 12:00:00",
+			expectedLastBlackout: velerov1.ScheduleBlackoutPolicySkip,
+		},
+		{
+			name: "schedule due in its allowed windows triggers a backup",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").
+				AllowedWindows(window("0 22 * * *", 8*time.Hour), window("CRON_TZ=Asia/Shanghai 0 19 * * *", 2*time.Hour)).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
 	}
 
 	for _, test := range tests {
@@ -205,6 +248,13 @@ func TestReconcileOfSchedule(t *testing.T) {
 				require.NotNil(t, schedule.Status.LastSkipped)
 				assert.Equal(t, parseTime(test.expectedLastSkipped).Unix(), schedule.Status.LastSkipped.Unix())
 			}
+			if len(test.expectedLastBlackout) > 0 {
+				require.NoError(t, err)
+				require.NotNil(t, schedule.Status.LastBlackout)
+				assert.Equal(t, test.expectedLastBlackout, schedule.Status.LastBlackout.Policy)
+			} else if test.schedule != nil {
+				assert.Nil(t, schedule.Status.LastBlackout)
+			}
 
 			// we expect reconcile to flip SkipImmediately to false if it's true or the server is configured to skip immediately and the schedule doesn't have it set
 			if scheduleb4reconcile.Spec.SkipImmediately != nil && *scheduleb4reconcile.Spec.SkipImmediately ||
@@ -480,3 +530,149 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	result = reconciler.checkIfBackupInNewOrProgress(testSchedule)
 	assert.True(t, result)
 }
+
+func TestValidateRetention(t *testing.T) {
+	assert.Empty(t, validateRetention(nil))
+	assert.Empty(t, validateRetention(&velerov1.ScheduleRetentionPolicy{Hourly: 24, Daily: 7}))
+	assert.Equal(t, []string{"invalid retention: the number of periods cannot be negative"}, validateRetention(&velerov1.ScheduleRetentionPolicy{Daily: -1}))
+	assert.Equal(t, []string{"invalid retention: the policy must keep the backups of at least one period"}, validateRetention(&velerov1.ScheduleRetentionPolicy{}))
+}
+
+func TestParseScheduleWindows(t *testing.T) {
+	window := func(schedule string, duration time.Duration) velerov1.ScheduleWindow {
+		return velerov1.ScheduleWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}}
+	}
+
+	_, errs := parseScheduleWindows(velerov1.ScheduleSpec{
+		AllowedWindows:  []velerov1.ScheduleWindow{window("0 22 * * *", 0), window("", time.Hour)},
+		BlackoutWindows: []velerov1.ScheduleWindow{window("not a cron", time.Hour), window("@weekly", time.Hour)},
+		BlackoutPolicy:  "Postpone",
+	})
+	assert.Equal(t, []string{
+		`invalid allowed window "0 22 * * *": the duration must be positive`,
+		`invalid allowed window "": the schedule must be a non-empty valid Cron expression`,
+		`invalid blackout window "not a cron": invalid schedule: expected exactly 5 fields, found 3: [not a cron]`,
+		`invalid blackout policy "Postpone": valid values are Skip, Defer`,
+	}, errs)
+
+	windows, errs := parseScheduleWindows(velerov1.ScheduleSpec{
+		// 22:00 to 06:00 every night
+		AllowedWindows: []velerov1.ScheduleWindow{window("0 22 * * *", 8*time.Hour)},
+		// a change freeze from December 20th to January 5th
+		BlackoutWindows: []velerov1.ScheduleWindow{window("0 0 20 12 *", 16*24*time.Hour)},
+	})
+	require.Empty(t, errs)
+
+	tests := []struct {
+		time   string
+		reason string
+	}{
+		{time: "2017-03-01 21:59:59", reason: "outside of the allowed windows"},
+		{time: "2017-03-01 22:00:00"},
+		{time: "2017-03-02 05:59:59"},
+		{time: "2017-03-02 06:00:00", reason: "outside of the allowed windows"},
+		{time: "2017-12-31 23:00:00", reason: `in blackout window "0 0 20 12 *" for 384h0m0s`},
+		{time: "2018-01-05 01:00:00"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.reason, windows.check(parseTime(test.time)), test.time)
+	}
+}
+
+func TestRetainedBackups(t *testing.T) {
+	backup := func(name string, phase velerov1.BackupPhase, start string) velerov1.Backup {
+		startTime, err := time.Parse(time.RFC3339, start)
+		require.NoError(t, err)
+		return *builder.ForBackup("ns", name).Phase(phase).StartTimestamp(startTime).Result()
+	}
+
+	backups := []velerov1.Backup{
+		backup("backup-5", velerov1.BackupPhaseCompleted, "2026-02-15T12:00:00Z"),
+		backup("backup-1", velerov1.BackupPhaseCompleted, "2026-03-02T10:30:00Z"),
+		backup("backup-2", velerov1.BackupPhaseCompleted, "2026-03-02T10:00:00Z"),
+		backup("backup-3", velerov1.BackupPhaseCompleted, "2026-03-02T09:00:00Z"),
+		backup("backup-4", velerov1.BackupPhaseCompleted, "2026-03-01T23:00:00Z"),
+		backup("backup-6", velerov1.BackupPhaseFailed, "2026-03-02T11:00:00Z"),
+	}
+	// dry-run backups aren't retained
+	dryRun := backup("backup-7", velerov1.BackupPhaseCompleted, "2026-03-02T11:30:00Z")
+	dryRun.Spec.DryRun = boolptr.True()
+	backups = append(backups, dryRun)
+
+	retained := retainedBackups(&velerov1.ScheduleRetentionPolicy{Hourly: 2, Daily: 2, Weekly: 2, Monthly: 2}, backups, time.UTC)
+	assert.Equal(t, map[string][]string{
+		"backup-1": {"hourly", "daily", "weekly", "monthly"},
+		"backup-3": {"hourly"},
+		"backup-4": {"daily", "weekly"},
+		"backup-5": {"monthly"},
+	}, retained)
+
+	// in Tokyo, backup-4 was taken on Monday March 2nd, the same day and week as backup-1
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	retained = retainedBackups(&velerov1.ScheduleRetentionPolicy{Daily: 2, Weekly: 2}, backups, tokyo)
+	assert.Equal(t, map[string][]string{
+		"backup-1": {"daily", "weekly"},
+		"backup-5": {"daily", "weekly"},
+	}, retained)
+}
+
+func TestScheduleLocation(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	cronSchedule, err := cron.ParseStandard("CRON_TZ=Asia/Shanghai 0 3 * * *")
+	require.NoError(t, err)
+	assert.Equal(t, shanghai, scheduleLocation(cronSchedule))
+
+	cronSchedule, err = cron.ParseStandard("@every 1h")
+	require.NoError(t, err)
+	assert.Equal(t, time.Local, scheduleLocation(cronSchedule))
+}
+
+func TestEnforceRetention(t *testing.T) {
+	require.NoError(t, velerov1.AddToScheme(scheme.Scheme))
+
+	now, err := time.Parse(time.RFC3339, "2026-03-02T12:00:00Z")
+	require.NoError(t, err)
+	backup := func(name string, phase velerov1.BackupPhase, start time.Time) *velerov1.Backup {
+		return builder.ForBackup("ns", name).
+			ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).
+			Phase(phase).
+			StartTimestamp(start).
+			TTL(48 * time.Hour).
+			Expiration(start.Add(48 * time.Hour)).
+			Result()
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		backup("backup-1", velerov1.BackupPhaseCompleted, now.Add(-time.Hour)),
+		backup("backup-2", velerov1.BackupPhaseCompleted, now.Add(-25*time.Hour)),
+		backup("backup-3", velerov1.BackupPhaseInProgress, now.Add(-25*time.Hour)),
+	).Build()
+	reconciler := NewScheduleReconciler("ns", velerotest.NewLogger(), client, metrics.NewServerMetrics(), false)
+	reconciler.clock = testclocks.NewFakeClock(now)
+
+	schedule := builder.ForSchedule("ns", "name").Retention(&velerov1.ScheduleRetentionPolicy{Daily: 1}).Result()
+	require.NoError(t, reconciler.enforceRetention(ctx, schedule, time.UTC))
+
+	get := func(name string) *velerov1.Backup {
+		backup := &velerov1.Backup{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: "ns", Name: name}, backup))
+		return backup
+	}
+	pinned := get("backup-1")
+	require.NotNil(t, pinned.Status.Expiration)
+	assert.True(t, pinned.Status.Expiration.Time.Equal(now.Add(47*time.Hour)))
+	assert.Equal(t, "daily", pinned.Annotations[velerov1.RetentionPeriodsAnnotation])
+	assert.True(t, get("backup-2").Status.Expiration.Time.Equal(now))
+	assert.True(t, get("backup-3").Status.Expiration.Time.Equal(now.Add(23*time.Hour)))
+
+	// once the retention policy is removed, the pinned backup expires after its TTL again
+	schedule.Spec.Retention = nil
+	require.NoError(t, reconciler.enforceRetention(ctx, schedule, time.UTC))
+	unpinned := get("backup-1")
+	require.NotNil(t, unpinned.Status.Expiration)
+	assert.True(t, unpinned.Status.Expiration.Time.Equal(now.Add(47*time.Hour)))
+	assert.NotContains(t, unpinned.Annotations, velerov1.RetentionPeriodsAnnotation)
+}
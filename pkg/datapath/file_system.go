@@ -118,7 +118,7 @@ func (fs *fileSystemBR) Init(ctx context.Context, param any) error {
 	}
 
 	fs.uploaderProv, err = provider.NewUploaderProvider(ctx, fs.client, initParam.UploaderType, fs.requestorType, initParam.RepoIdentifier,
-		fs.backupLocation, fs.backupRepo, initParam.CredentialGetter, repokey.RepoKeySelector(), fs.log)
+		fs.backupLocation, fs.backupRepo, initParam.CredentialGetter, repokey.RepoKeySelector(fs.backupRepo), fs.log)
 	if err != nil {
 		return errors.Wrapf(err, "error creating uploader %s", initParam.UploaderType)
 	}
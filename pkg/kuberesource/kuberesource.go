@@ -21,9 +21,12 @@ import (
 )
 
 var (
+	APIServices               = schema.GroupResource{Group: "apiregistration.k8s.io", Resource: "apiservices"}
 	ClusterRoleBindings       = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
 	ClusterRoles              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
+	ConfigMaps                = schema.GroupResource{Group: "", Resource: "configmaps"}
 	CustomResourceDefinitions = schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}
+	Ingresses                 = schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}
 	Jobs                      = schema.GroupResource{Group: "batch", Resource: "jobs"}
 	Namespaces                = schema.GroupResource{Group: "", Resource: "namespaces"}
 	PersistentVolumeClaims    = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
@@ -31,11 +34,14 @@ var (
 	Pods                      = schema.GroupResource{Group: "", Resource: "pods"}
 	ServiceAccounts           = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
 	Secrets                   = schema.GroupResource{Group: "", Resource: "secrets"}
+	Services                  = schema.GroupResource{Group: "", Resource: "services"}
+	StatefulSets              = schema.GroupResource{Group: "apps", Resource: "statefulsets"}
 	VolumeSnapshotClasses     = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotclasses"}
 	VolumeSnapshots           = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshots"}
 	VolumeGroupSnapshots      = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumegroupsnapshots"}
 	VolumeSnapshotContents    = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
 	PriorityClasses           = schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}
+	Roles                     = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "roles"}
 	DataUploads               = schema.GroupResource{Group: "velero.io", Resource: "datauploads"}
 	VGSKind                   = "VolumeGroupSnapshot"
 )
@@ -0,0 +1,173 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ResourceResolver returns the group resource of a kind, used to resolve the target of owner
+// references.
+type ResourceResolver func(gvk schema.GroupVersionKind) (schema.GroupResource, error)
+
+// NewItem returns the manifest item for a Kubernetes object, with the edges to the owners
+// of the object, for PVCs and pods to the PV, PVCs, ConfigMaps, Secrets and ServiceAccount
+// they refer to, and for APIServices to their backing Service. Owner references whose kind
+// can't be resolved are only recorded by UID.
+func NewItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, resolve ResourceResolver) (*Item, error) {
+	gvk := obj.GroupVersionKind()
+	item := &Item{
+		Resource:    groupResource.String(),
+		APIGroup:    gvk.Group,
+		APIVersion:  gvk.Version,
+		Kind:        gvk.Kind,
+		Namespace:   obj.GetNamespace(),
+		Name:        obj.GetName(),
+		UID:         string(obj.GetUID()),
+		Labels:      obj.GetLabels(),
+		Annotations: obj.GetAnnotations(),
+	}
+
+	for _, ref := range obj.GetOwnerReferences() {
+		item.Owners = append(item.Owners, string(ref.UID))
+
+		edge := Edge{
+			Type:      EdgeTypeOwnerReference,
+			Namespace: obj.GetNamespace(),
+			Name:      ref.Name,
+			UID:       string(ref.UID),
+		}
+		if resolve != nil {
+			if gv, err := schema.ParseGroupVersion(ref.APIVersion); err == nil {
+				if gr, err := resolve(gv.WithKind(ref.Kind)); err == nil {
+					edge.Resource = gr.String()
+				}
+			}
+		}
+		item.Edges = appendEdges(item.Edges, edge)
+	}
+
+	switch groupResource {
+	case kuberesource.PersistentVolumeClaims:
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if pvc.Spec.VolumeName != "" {
+			item.Edges = appendEdges(item.Edges, Edge{
+				Type:     EdgeTypePersistentVolume,
+				Resource: kuberesource.PersistentVolumes.String(),
+				Name:     pvc.Spec.VolumeName,
+			})
+		}
+	case kuberesource.Pods:
+		pod := new(corev1api.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		item.Edges = appendEdges(item.Edges, podEdges(pod)...)
+	case kuberesource.APIServices:
+		// read the fields directly to avoid depending on the kube-aggregator types
+		namespace, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "spec", "service", "namespace")
+		name, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "spec", "service", "name")
+		if name != "" {
+			item.Edges = appendEdges(item.Edges, Edge{
+				Type:      EdgeTypeService,
+				Resource:  kuberesource.Services.String(),
+				Namespace: namespace,
+				Name:      name,
+			})
+		}
+	}
+
+	return item, nil
+}
+
+// podEdges returns the edges from a pod to the PVCs, ConfigMaps, Secrets and ServiceAccount it
+// refers to.
+func podEdges(pod *corev1api.Pod) []Edge {
+	var edges []Edge
+	add := func(edgeType EdgeType, resource schema.GroupResource, name string) {
+		if name == "" {
+			return
+		}
+		edges = appendEdges(edges, Edge{
+			Type:      edgeType,
+			Resource:  resource.String(),
+			Namespace: pod.Namespace,
+			Name:      name,
+		})
+	}
+
+	add(EdgeTypeServiceAccount, kuberesource.ServiceAccounts, pod.Spec.ServiceAccountName)
+
+	for _, secret := range pod.Spec.ImagePullSecrets {
+		add(EdgeTypeSecret, kuberesource.Secrets, secret.Name)
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			add(EdgeTypePersistentVolumeClaim, kuberesource.PersistentVolumeClaims, volume.PersistentVolumeClaim.ClaimName)
+		case volume.ConfigMap != nil:
+			add(EdgeTypeConfigMap, kuberesource.ConfigMaps, volume.ConfigMap.Name)
+		case volume.Secret != nil:
+			add(EdgeTypeSecret, kuberesource.Secrets, volume.Secret.SecretName)
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					add(EdgeTypeConfigMap, kuberesource.ConfigMaps, source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					add(EdgeTypeSecret, kuberesource.Secrets, source.Secret.Name)
+				}
+			}
+		}
+	}
+
+	containers := append([]corev1api.Container{}, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add(EdgeTypeConfigMap, kuberesource.ConfigMaps, envFrom.ConfigMapRef.Name)
+			}
+			if envFrom.SecretRef != nil {
+				add(EdgeTypeSecret, kuberesource.Secrets, envFrom.SecretRef.Name)
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add(EdgeTypeConfigMap, kuberesource.ConfigMaps, env.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				add(EdgeTypeSecret, kuberesource.Secrets, env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	return edges
+}
@@ -0,0 +1,407 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest implements the object graph manifest stored alongside a backup,
+// as described in design/graph-manifest.md. The manifest records every backed-up item
+// with its selectable fields and the edges to the items it depends on, so that the
+// content of a backup can be inspected without downloading the backup tarball.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ManifestError is the type of the sentinel errors returned by the manifest.
+type ManifestError string
+
+func (e ManifestError) Error() string {
+	return string(e)
+}
+
+// ItemAlreadyExists is returned when adding an item that is already in the manifest.
+const ItemAlreadyExists = ManifestError("item already exists in manifest")
+
+// EdgeType is the kind of relationship an edge describes.
+type EdgeType string
+
+const (
+	// EdgeTypeOwnerReference is an edge from an item to one of its owners.
+	EdgeTypeOwnerReference EdgeType = "OwnerReference"
+
+	// EdgeTypePersistentVolume is an edge from a PVC to the PV it's bound to.
+	EdgeTypePersistentVolume EdgeType = "PersistentVolume"
+
+	// EdgeTypePersistentVolumeClaim is an edge from a pod to a PVC it mounts.
+	EdgeTypePersistentVolumeClaim EdgeType = "PersistentVolumeClaim"
+
+	// EdgeTypeConfigMap is an edge from a pod to a ConfigMap it mounts or reads its environment from.
+	EdgeTypeConfigMap EdgeType = "ConfigMap"
+
+	// EdgeTypeSecret is an edge from a pod to a Secret it mounts, reads its environment from
+	// or pulls its images with.
+	EdgeTypeSecret EdgeType = "Secret"
+
+	// EdgeTypeServiceAccount is an edge from a pod to the ServiceAccount it runs as.
+	EdgeTypeServiceAccount EdgeType = "ServiceAccount"
+
+	// EdgeTypeService is an edge from an APIService to the Service backing it.
+	EdgeTypeService EdgeType = "Service"
+
+	// EdgeTypeItemBlock is an edge from an item to a related item returned by an ItemBlockAction plugin.
+	EdgeTypeItemBlock EdgeType = "ItemBlock"
+)
+
+// Edge is a reference from an item to another item it depends on. The target
+// may not be in the manifest if it was excluded from the backup.
+type Edge struct {
+	// Type is the kind of relationship between the items.
+	Type EdgeType `json:"type"`
+
+	// Resource is the group resource of the target item, e.g. "deployments.apps".
+	// It may be empty for owner references whose kind couldn't be resolved.
+	Resource string `json:"resource,omitempty"`
+
+	// Namespace of the target item. Empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the target item.
+	Name string `json:"name"`
+
+	// UID of the target item, if known.
+	UID string `json:"uid,omitempty"`
+}
+
+// Item represents a Kubernetes resource within a backup based on its selectable criteria.
+// It is not the whole Kubernetes resource as retrieved from the API server, but rather a
+// collection of important fields needed for filtering and building the dependency graph.
+type Item struct {
+	// Resource is the group resource of the item, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// APIGroup which this Item belongs to. Empty for the core group.
+	APIGroup string `json:"apiGroup,omitempty"`
+
+	// APIVersion is the version of the APIGroup that the Item was backed up with.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the Item.
+	Kind string `json:"kind"`
+
+	// Namespace which contains this item. Empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the Item.
+	Name string `json:"name"`
+
+	// UID generated by Kubernetes. Only used to link items within the manifest.
+	UID string `json:"uid,omitempty"`
+
+	// Labels that the Item had at backup time.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations that the Item had at backup time.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Owners is a list of UIDs of the items that own this item.
+	Owners []string `json:"owners,omitempty"`
+
+	// Edges is a list of the items this item depends on.
+	Edges []Edge `json:"edges,omitempty"`
+
+	// manifest is the Manifest in which this item is contained.
+	manifest *Manifest
+}
+
+// String returns the item as "<resource>/<namespace>/<name>", or "<resource>/<name>"
+// for cluster-scoped items.
+func (i *Item) String() string {
+	return itemString(i.Resource, i.Namespace, i.Name)
+}
+
+// OwnerItems returns the items in the manifest that own the current item.
+func (i *Item) OwnerItems() []*Item {
+	if i.manifest == nil {
+		return nil
+	}
+	i.manifest.lock.RLock()
+	defer i.manifest.lock.RUnlock()
+
+	var owners []*Item
+	for _, uid := range i.Owners {
+		if owner, ok := i.manifest.Index[uid]; ok {
+			owners = append(owners, owner)
+		}
+	}
+	return owners
+}
+
+// Dependencies returns the items in the manifest the current item has an edge to.
+func (i *Item) Dependencies() []*Item {
+	if i.manifest == nil {
+		return nil
+	}
+	i.manifest.lock.RLock()
+	defer i.manifest.lock.RUnlock()
+
+	var dependencies []*Item
+	seen := sets.New[string]()
+	for _, edge := range i.Edges {
+		target := i.manifest.get(edge.Resource, edge.Namespace, edge.Name)
+		if target == nil && edge.UID != "" {
+			target = i.manifest.Index[edge.UID]
+		}
+		if target == nil || seen.Has(target.String()) {
+			continue
+		}
+		seen.Insert(target.String())
+		dependencies = append(dependencies, target)
+	}
+	return dependencies
+}
+
+// Children returns the items in the manifest that refer to the current item as an owner.
+func (i *Item) Children() []*Item {
+	if i.manifest == nil || i.UID == "" {
+		return nil
+	}
+	i.manifest.lock.RLock()
+	defer i.manifest.lock.RUnlock()
+
+	var children []*Item
+	for _, item := range i.manifest.items() {
+		for _, uid := range item.Owners {
+			if uid == i.UID {
+				children = append(children, item)
+				break
+			}
+		}
+	}
+	return children
+}
+
+// NamedItems maps an item name to the item.
+type NamedItems map[string]*Item
+
+// NamespacedItems maps a given namespace to all of its contained items. Cluster-scoped
+// items are in the "" namespace.
+type NamespacedItems map[string]NamedItems
+
+// KindNamespaces maps a group resource to a map of namespaces and their items.
+type KindNamespaces map[string]NamespacedItems
+
+// Manifest is the object graph of the items in a backup. It's safe for concurrent use.
+type Manifest struct {
+	// Kinds holds the top level map of all resources in a manifest.
+	Kinds KindNamespaces `json:"kinds"`
+
+	// Index is used to look up an individual item quickly based on UID.
+	Index map[string]*Item `json:"-"`
+
+	// pendingEdges holds the edges recorded for items that haven't been added yet,
+	// keyed by the item's string representation.
+	pendingEdges map[string][]Edge
+
+	lock sync.RWMutex
+}
+
+// New returns an empty Manifest.
+func New() *Manifest {
+	return &Manifest{
+		Kinds:        KindNamespaces{},
+		Index:        map[string]*Item{},
+		pendingEdges: map[string][]Edge{},
+	}
+}
+
+// Add adds an item to the appropriate resource and namespace within the manifest.
+// It returns (true, nil) if the item is added, and (false, ItemAlreadyExists) if an
+// item with the same resource, namespace and name is already in the manifest.
+func (m *Manifest) Add(item *Item) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.get(item.Resource, item.Namespace, item.Name) != nil {
+		return false, ItemAlreadyExists
+	}
+
+	if pending, ok := m.pendingEdges[item.String()]; ok {
+		item.Edges = appendEdges(item.Edges, pending...)
+		delete(m.pendingEdges, item.String())
+	}
+
+	m.add(item)
+	return true, nil
+}
+
+// AddEdges records edges from the item identified by resource, namespace and name.
+// The item doesn't need to be in the manifest yet, the edges are attached to it when
+// it's added.
+func (m *Manifest) AddEdges(resource, namespace, name string, edges ...Edge) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if item := m.get(resource, namespace, name); item != nil {
+		item.Edges = appendEdges(item.Edges, edges...)
+		return
+	}
+	key := itemString(resource, namespace, name)
+	m.pendingEdges[key] = appendEdges(m.pendingEdges[key], edges...)
+}
+
+// Get returns the item identified by resource, namespace and name, or nil if it's not in the
+// manifest.
+func (m *Manifest) Get(resource, namespace, name string) *Item {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.get(resource, namespace, name)
+}
+
+// Items returns all items in the manifest, sorted by their string representation.
+func (m *Manifest) Items() []*Item {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.items()
+}
+
+// Len returns the number of items in the manifest.
+func (m *Manifest) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	count := 0
+	for _, namespaces := range m.Kinds {
+		for _, names := range namespaces {
+			count += len(names)
+		}
+	}
+	return count
+}
+
+// Set returns the string representation of all items in the manifest. This is useful
+// for comparing two manifests and determining if they have any overlapping resources.
+func (m *Manifest) Set() sets.Set[string] {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	set := sets.New[string]()
+	for _, item := range m.items() {
+		set.Insert(item.String())
+	}
+	return set
+}
+
+// MarshalJSON encodes the manifest. The index isn't encoded since it can be rebuilt from the items.
+func (m *Manifest) MarshalJSON() ([]byte, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return json.Marshal(struct {
+		Kinds KindNamespaces `json:"kinds"`
+	}{Kinds: m.Kinds})
+}
+
+// UnmarshalJSON decodes the manifest and rebuilds its index.
+func (m *Manifest) UnmarshalJSON(data []byte) error {
+	decoded := struct {
+		Kinds KindNamespaces `json:"kinds"`
+	}{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Kinds = KindNamespaces{}
+	m.Index = map[string]*Item{}
+	m.pendingEdges = map[string][]Edge{}
+	for _, namespaces := range decoded.Kinds {
+		for _, names := range namespaces {
+			for _, item := range names {
+				m.add(item)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manifest) add(item *Item) {
+	namespaces, ok := m.Kinds[item.Resource]
+	if !ok {
+		namespaces = NamespacedItems{}
+		m.Kinds[item.Resource] = namespaces
+	}
+	names, ok := namespaces[item.Namespace]
+	if !ok {
+		names = NamedItems{}
+		namespaces[item.Namespace] = names
+	}
+	names[item.Name] = item
+
+	if item.UID != "" {
+		m.Index[item.UID] = item
+	}
+	item.manifest = m
+}
+
+func (m *Manifest) get(resource, namespace, name string) *Item {
+	return m.Kinds[resource][namespace][name]
+}
+
+func (m *Manifest) items() []*Item {
+	var items []*Item
+	for _, namespaces := range m.Kinds {
+		for _, names := range namespaces {
+			for _, item := range names {
+				items = append(items, item)
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].String() < items[j].String()
+	})
+	return items
+}
+
+func appendEdges(edges []Edge, newEdges ...Edge) []Edge {
+	for _, newEdge := range newEdges {
+		found := false
+		for _, edge := range edges {
+			if edge == newEdge {
+				found = true
+				break
+			}
+		}
+		if !found {
+			edges = append(edges, newEdge)
+		}
+	}
+	return edges
+}
+
+func itemString(resource, namespace, name string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s/%s", resource, name)
+	}
+	return fmt.Sprintf("%s/%s/%s", resource, namespace, name)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: content}
+}
+
+func TestManifestAdd(t *testing.T) {
+	m := New()
+
+	added, err := m.Add(&Item{Resource: "pods", Namespace: "ns-1", Name: "pod-1", UID: "uid-1"})
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = m.Add(&Item{Resource: "pods", Namespace: "ns-1", Name: "pod-1", UID: "uid-1"})
+	assert.Equal(t, ItemAlreadyExists, err)
+	assert.False(t, added)
+
+	_, err = m.Add(&Item{Resource: "persistentvolumes", Name: "pv-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, []string{"persistentvolumes/pv-1", "pods/ns-1/pod-1"}, sortedSet(m))
+	assert.Equal(t, "pods/ns-1/pod-1", m.Index["uid-1"].String())
+	assert.Nil(t, m.Get("pods", "ns-2", "pod-1"))
+}
+
+func TestManifestAddEdges(t *testing.T) {
+	m := New()
+	edge := Edge{Type: EdgeTypeItemBlock, Resource: "pods", Namespace: "ns-1", Name: "pod-2"}
+
+	// edges recorded before the item is added are attached when it's added
+	m.AddEdges("pods", "ns-1", "pod-1", edge)
+	m.AddEdges("pods", "ns-1", "pod-1", edge)
+	_, err := m.Add(&Item{Resource: "pods", Namespace: "ns-1", Name: "pod-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []Edge{edge}, m.Get("pods", "ns-1", "pod-1").Edges)
+
+	// edges recorded after the item is added are attached directly
+	other := Edge{Type: EdgeTypeItemBlock, Resource: "pods", Namespace: "ns-1", Name: "pod-3"}
+	m.AddEdges("pods", "ns-1", "pod-1", other)
+	assert.Equal(t, []Edge{edge, other}, m.Get("pods", "ns-1", "pod-1").Edges)
+}
+
+func TestItemRelations(t *testing.T) {
+	m := New()
+	deployment := &Item{Resource: "deployments.apps", Namespace: "ns-1", Name: "deploy-1", UID: "deploy-uid"}
+	replicaSet := &Item{
+		Resource:  "replicasets.apps",
+		Namespace: "ns-1",
+		Name:      "rs-1",
+		UID:       "rs-uid",
+		Owners:    []string{"deploy-uid"},
+	}
+	pod := &Item{
+		Resource:  "pods",
+		Namespace: "ns-1",
+		Name:      "pod-1",
+		UID:       "pod-uid",
+		Owners:    []string{"rs-uid"},
+		Edges: []Edge{
+			{Type: EdgeTypeOwnerReference, Resource: "replicasets.apps", Namespace: "ns-1", Name: "rs-1", UID: "rs-uid"},
+			{Type: EdgeTypePersistentVolumeClaim, Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1"},
+			{Type: EdgeTypeSecret, Resource: "secrets", Namespace: "ns-1", Name: "not-backed-up"},
+		},
+	}
+	pvc := &Item{Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1"}
+	for _, item := range []*Item{deployment, replicaSet, pod, pvc} {
+		_, err := m.Add(item)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []*Item{replicaSet}, pod.OwnerItems())
+	assert.Equal(t, []*Item{deployment}, replicaSet.OwnerItems())
+	assert.Empty(t, deployment.OwnerItems())
+
+	assert.Equal(t, []*Item{replicaSet}, deployment.Children())
+	assert.Equal(t, []*Item{pod}, replicaSet.Children())
+	assert.Empty(t, pod.Children())
+
+	assert.Equal(t, []*Item{replicaSet, pvc}, pod.Dependencies())
+}
+
+func TestManifestJSON(t *testing.T) {
+	m := New()
+	_, err := m.Add(&Item{Resource: "replicasets.apps", APIGroup: "apps", APIVersion: "v1", Kind: "ReplicaSet", Namespace: "ns-1", Name: "rs-1", UID: "rs-uid"})
+	require.NoError(t, err)
+	_, err = m.Add(&Item{
+		Resource:   "pods",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  "ns-1",
+		Name:       "pod-1",
+		UID:        "pod-uid",
+		Labels:     map[string]string{"app": "foo"},
+		Owners:     []string{"rs-uid"},
+		Edges:      []Edge{{Type: EdgeTypeServiceAccount, Resource: "serviceaccounts", Namespace: "ns-1", Name: "default"}},
+	})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+
+	decoded := New()
+	require.NoError(t, json.Unmarshal(data, decoded))
+
+	assert.Equal(t, m.Set(), decoded.Set())
+	pod := decoded.Get("pods", "ns-1", "pod-1")
+	require.NotNil(t, pod)
+	assert.Equal(t, map[string]string{"app": "foo"}, pod.Labels)
+	assert.Equal(t, m.Get("pods", "ns-1", "pod-1").Edges, pod.Edges)
+	// the index and the links between the items are rebuilt
+	assert.Equal(t, []*Item{decoded.Index["rs-uid"]}, pod.OwnerItems())
+}
+
+func TestNewItem(t *testing.T) {
+	resolve := func(gvk schema.GroupVersionKind) (schema.GroupResource, error) {
+		if gvk.Kind == "ReplicaSet" {
+			return schema.GroupResource{Group: "apps", Resource: "replicasets"}, nil
+		}
+		return schema.GroupResource{}, errors.New("not found")
+	}
+
+	pod := builder.ForPod("ns-1", "pod-1").
+		ObjectMeta(
+			builder.WithLabels("app", "foo"),
+			builder.WithUID("pod-uid"),
+		).
+		ServiceAccount("sa-1").
+		Volumes(
+			builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result(),
+			&corev1api.Volume{Name: "config", VolumeSource: corev1api.VolumeSource{
+				ConfigMap: &corev1api.ConfigMapVolumeSource{LocalObjectReference: corev1api.LocalObjectReference{Name: "cm-1"}},
+			}},
+			&corev1api.Volume{Name: "creds", VolumeSource: corev1api.VolumeSource{
+				Secret: &corev1api.SecretVolumeSource{SecretName: "secret-1"},
+			}},
+		).
+		Containers(&corev1api.Container{
+			Name: "c1",
+			EnvFrom: []corev1api.EnvFromSource{
+				{SecretRef: &corev1api.SecretEnvSource{LocalObjectReference: corev1api.LocalObjectReference{Name: "secret-2"}}},
+			},
+			Env: []corev1api.EnvVar{
+				{Name: "FOO", ValueFrom: &corev1api.EnvVarSource{
+					ConfigMapKeyRef: &corev1api.ConfigMapKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "cm-1"}, Key: "foo"},
+				}},
+			},
+		}).
+		Result()
+	pod.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs-1", UID: types.UID("rs-uid")},
+		{APIVersion: "example.io/v1", Kind: "Unknown", Name: "unknown-1", UID: types.UID("unknown-uid")},
+	}
+
+	item, err := NewItem(toUnstructured(t, pod), kuberesource.Pods, resolve)
+	require.NoError(t, err)
+
+	assert.Equal(t, "pods/ns-1/pod-1", item.String())
+	assert.Equal(t, "pod-uid", item.UID)
+	assert.Equal(t, map[string]string{"app": "foo"}, item.Labels)
+	assert.Equal(t, []string{"rs-uid", "unknown-uid"}, item.Owners)
+	assert.Equal(t, []Edge{
+		{Type: EdgeTypeOwnerReference, Resource: "replicasets.apps", Namespace: "ns-1", Name: "rs-1", UID: "rs-uid"},
+		{Type: EdgeTypeOwnerReference, Namespace: "ns-1", Name: "unknown-1", UID: "unknown-uid"},
+		{Type: EdgeTypeServiceAccount, Resource: "serviceaccounts", Namespace: "ns-1", Name: "sa-1"},
+		{Type: EdgeTypePersistentVolumeClaim, Resource: "persistentvolumeclaims", Namespace: "ns-1", Name: "pvc-1"},
+		{Type: EdgeTypeConfigMap, Resource: "configmaps", Namespace: "ns-1", Name: "cm-1"},
+		{Type: EdgeTypeSecret, Resource: "secrets", Namespace: "ns-1", Name: "secret-1"},
+		{Type: EdgeTypeSecret, Resource: "secrets", Namespace: "ns-1", Name: "secret-2"},
+	}, item.Edges)
+
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
+	item, err = NewItem(toUnstructured(t, pvc), kuberesource.PersistentVolumeClaims, resolve)
+	require.NoError(t, err)
+	assert.Equal(t, []Edge{
+		{Type: EdgeTypePersistentVolume, Resource: "persistentvolumes", Name: "pv-1"},
+	}, item.Edges)
+
+	apiService := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiregistration.k8s.io/v1",
+		"kind":       "APIService",
+		"metadata":   map[string]any{"name": "v1beta1.metrics.k8s.io"},
+		"spec": map[string]any{
+			"service": map[string]any{"namespace": "kube-system", "name": "metrics-server"},
+		},
+	}}
+	item, err = NewItem(apiService, kuberesource.APIServices, resolve)
+	require.NoError(t, err)
+	assert.Equal(t, []Edge{
+		{Type: EdgeTypeService, Resource: "services", Namespace: "kube-system", Name: "metrics-server"},
+	}, item.Edges)
+}
+
+func sortedSet(m *Manifest) []string {
+	var list []string
+	for _, item := range m.Items() {
+		list = append(list, item.String())
+	}
+	return list
+}
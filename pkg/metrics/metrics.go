@@ -42,6 +42,7 @@ const (
 	backupDeletionAttemptTotal    = "backup_deletion_attempt_total"
 	backupDeletionSuccessTotal    = "backup_deletion_success_total"
 	backupDeletionFailureTotal    = "backup_deletion_failure_total"
+	backupDeletionDeferredTotal   = "backup_deletion_deferred_total"
 	backupLastSuccessfulTimestamp = "backup_last_successful_timestamp"
 	backupItemsTotalGauge         = "backup_items_total"
 	backupItemsErrorsGauge        = "backup_items_errors"
@@ -179,6 +180,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupDeletionDeferredTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupDeletionDeferredTotal,
+					Help:      "Total number of garbage collections of expired backups deferred to retain the minimum number of successful backups",
+				},
+				[]string{scheduleLabel},
+			),
 			backupDurationSeconds: prometheus.NewHistogramVec(
 				prometheus.HistogramOpts{
 					Namespace: metricNamespace,
@@ -468,6 +477,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupDeletionFailureTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
+	if c, ok := m.metrics[backupDeletionDeferredTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[backupItemsTotalGauge].(*prometheus.GaugeVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -547,6 +559,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupDeletionFailureTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[backupDeletionDeferredTotal].(*prometheus.CounterVec); ok {
+		c.DeleteLabelValues(scheduleName)
+	}
 	if g, ok := m.metrics[backupLastSuccessfulTimestamp].(*prometheus.GaugeVec); ok {
 		g.DeleteLabelValues(scheduleName)
 	}
@@ -773,6 +788,13 @@ func (m *ServerMetrics) RegisterBackupDeletionFailed(backupSchedule string) {
 	}
 }
 
+// RegisterBackupDeletionDeferred records the number of deferred garbage collections of expired backups
+func (m *ServerMetrics) RegisterBackupDeletionDeferred(backupSchedule string) {
+	if c, ok := m.metrics[backupDeletionDeferredTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
 // RegisterBackupDeletionSuccess records the number of successful backup deletions
 func (m *ServerMetrics) RegisterBackupDeletionSuccess(backupSchedule string) {
 	if c, ok := m.metrics[backupDeletionSuccessTotal].(*prometheus.CounterVec); ok {
@@ -277,6 +277,7 @@ func TestInitScheduleWithEmptyName(t *testing.T) {
 		backupDeletionAttemptTotal,
 		backupDeletionSuccessTotal,
 		backupDeletionFailureTotal,
+		backupDeletionDeferredTotal,
 		backupItemsTotalGauge,
 		backupItemsErrorsGauge,
 		backupWarningTotal,
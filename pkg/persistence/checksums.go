@@ -0,0 +1,509 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+const (
+	// ChecksumAlgorithmSHA256 is the algorithm of the digests of the checksum manifest.
+	ChecksumAlgorithmSHA256 = "sha256"
+
+	// DefaultChecksumKeySecret is the name of the Secret holding the key the checksum manifests
+	// of the backups are signed with, unless another Secret is configured.
+	DefaultChecksumKeySecret = "velero-backup-checksums-key"
+
+	checksumKeySecretKey = "key"
+	checksumKeySize      = 32
+	checksumKeyIDLength  = 16
+)
+
+// errChecksumSignatureMismatch is returned when the signature of a checksum manifest signed with
+// the key of this cluster doesn't match its content, i.e. the manifest was modified.
+var errChecksumSignatureMismatch = errors.New("checksum manifest signature mismatch")
+
+// checksumUnknownKeyError is returned when a checksum manifest wasn't signed with the key of this
+// cluster, so its signature can't be verified.
+type checksumUnknownKeyError struct {
+	keyID        string
+	clusterKeyID string
+}
+
+func (e *checksumUnknownKeyError) Error() string {
+	return fmt.Sprintf("checksum manifest was signed with an unknown key %s, the checksum key of this cluster is %s", e.keyID, e.clusterKeyID)
+}
+
+// BackupChecksums is the checksum manifest of a backup. It records the digest of every artifact
+// of the backup in object storage, and of every file in the backup tarball, so the backup can be
+// verified to be unmodified. The backup metadata file isn't recorded, since the status of the
+// backup is updated after the backup is uploaded.
+type BackupChecksums struct {
+	// Algorithm is the algorithm of the digests.
+	Algorithm string `json:"algorithm"`
+
+	// Artifacts maps the file name of every artifact in the backup directory to its digest.
+	Artifacts map[string]string `json:"artifacts"`
+
+	// Items maps the path of every file in the backup tarball to its digest.
+	Items map[string]string `json:"items,omitempty"`
+
+	// KeyID identifies the key the manifest was signed with, so a manifest signed with another
+	// key than the one of the cluster is told apart from a modified manifest.
+	KeyID string `json:"keyID,omitempty"`
+
+	// Signature is the HMAC-SHA256 of the manifest without its signature, keyed with the
+	// checksum key of the cluster, which isn't stored in the backup storage locations, so a
+	// manifest modified along with the artifacts it records can't be signed again.
+	Signature string `json:"signature,omitempty"`
+}
+
+func newBackupChecksums() *BackupChecksums {
+	return &BackupChecksums{
+		Algorithm: ChecksumAlgorithmSHA256,
+		Artifacts: map[string]string{},
+	}
+}
+
+// signature returns the HMAC-SHA256 of the manifest without its signature. The maps of the
+// manifest are encoded with sorted keys, so the encoding is the same for the same content.
+func (c *BackupChecksums) signature(key []byte) (string, error) {
+	unsigned := *c
+	unsigned.Signature = ""
+	data, err := json.Marshal(&unsigned)
+	if err != nil {
+		return "", errors.Wrap(err, "error encoding the checksum manifest")
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// checksumKeyID returns the identifier of the key, the beginning of its SHA-256, which doesn't
+// disclose the key.
+func checksumKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:])[:checksumKeyIDLength]
+}
+
+// sign sets the key identifier and the signature of the manifest.
+func (c *BackupChecksums) sign(key []byte) error {
+	if len(key) == 0 {
+		return errors.New("no key to sign the checksum manifest with")
+	}
+	c.KeyID = checksumKeyID(key)
+	signature, err := c.signature(key)
+	if err != nil {
+		return err
+	}
+	c.Signature = signature
+	return nil
+}
+
+// verifySignature returns a checksumUnknownKeyError if the manifest wasn't signed with the key, and
+// errChecksumSignatureMismatch if its signature doesn't match its content.
+func (c *BackupChecksums) verifySignature(key []byte) error {
+	if c.Signature == "" {
+		return errors.New("checksum manifest isn't signed")
+	}
+	if len(key) == 0 {
+		return errors.Errorf("no checksum key is configured to verify the checksum manifest signature with, it was signed with key %s", c.KeyID)
+	}
+	// manifests signed before the key identifier was recorded can only be verified against the key
+	if c.KeyID != "" && c.KeyID != checksumKeyID(key) {
+		return errors.WithStack(&checksumUnknownKeyError{keyID: c.KeyID, clusterKeyID: checksumKeyID(key)})
+	}
+	signature, err := c.signature(key)
+	if err != nil {
+		return err
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	actual, err := hex.DecodeString(c.Signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return errors.WithStack(errChecksumSignatureMismatch)
+	}
+	return nil
+}
+
+// ChecksumKeySelector returns the SecretKeySelector of the key the checksum manifests of the
+// backups are signed with, in the given Secret.
+func ChecksumKeySelector(secretName string) *corev1api.SecretKeySelector {
+	return builder.ForSecretKeySelector(secretName, checksumKeySecretKey).Result()
+}
+
+// EnsureChecksumKey creates the Secret holding a random key the checksum manifests of the
+// backups are signed with, if it doesn't exist. An existing Secret, e.g. one copied from the
+// cluster that created the backups, is used as is. The key is only kept in the cluster, never
+// in the backup storage locations.
+func EnsureChecksumKey(secretClient corev1client.SecretsGetter, namespace, secretName string) error {
+	_, err := secretClient.Secrets(namespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+	if err == nil {
+		return nil
+	}
+
+	raw := make([]byte, checksumKeySize)
+	if _, err := rand.Read(raw); err != nil {
+		return errors.Wrap(err, "error generating the checksum key")
+	}
+
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      secretName,
+		},
+		Type: corev1api.SecretTypeOpaque,
+		Data: map[string][]byte{
+			checksumKeySecretKey: []byte(base64.StdEncoding.EncodeToString(raw)),
+		},
+	}
+
+	if _, err = secretClient.Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		return errors.Wrapf(err, "error creating %s secret", secretName)
+	}
+
+	return nil
+}
+
+// digestReader computes the digest of the data read through it.
+type digestReader struct {
+	io.Reader
+	hash hash.Hash
+}
+
+func newDigestReader(r io.Reader) *digestReader {
+	h := sha256.New()
+	return &digestReader{Reader: io.TeeReader(r, h), hash: h}
+}
+
+func (r *digestReader) digest() string {
+	return hex.EncodeToString(r.hash.Sum(nil))
+}
+
+// tarballDigester computes the digest of a gzipped tarball, and of every file in it, from the data
+// written to it. It's used to hash the tarball while it's uploaded or downloaded, without reading
+// it twice.
+type tarballDigester struct {
+	hash  hash.Hash
+	pw    *io.PipeWriter
+	done  chan struct{}
+	items map[string]string
+	err   error
+}
+
+func newTarballDigester() *tarballDigester {
+	pr, pw := io.Pipe()
+	d := &tarballDigester{
+		hash:  sha256.New(),
+		pw:    pw,
+		done:  make(chan struct{}),
+		items: map[string]string{},
+	}
+
+	go func() {
+		defer close(d.done)
+		d.err = d.digestItems(pr)
+		// keep reading so the writer isn't blocked when the tarball can't be read
+		_, _ = io.Copy(io.Discard, pr)
+	}()
+
+	return d
+}
+
+func (d *tarballDigester) digestItems(r io.Reader) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, "error creating gzip reader")
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading tar")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		h := sha256.New()
+		if _, err := io.Copy(h, tr); err != nil { //nolint:gosec // Internal usage. No need to check.
+			return errors.Wrapf(err, "error reading %s", header.Name)
+		}
+		d.items[header.Name] = hex.EncodeToString(h.Sum(nil))
+	}
+}
+
+func (d *tarballDigester) Write(p []byte) (int, error) {
+	d.hash.Write(p)
+	// the reading side never stops before the pipe is closed, so this can't fail
+	return d.pw.Write(p)
+}
+
+// finish returns the digest of the tarball and of the files in it, or an error if the
+// data written isn't a gzipped tarball.
+func (d *tarballDigester) finish() (string, map[string]string, error) {
+	d.pw.Close()
+	<-d.done
+	return hex.EncodeToString(d.hash.Sum(nil)), d.items, d.err
+}
+
+// putObjectWithDigest uploads an artifact of the backup and records its digest in the checksum
+// manifest. The digests of the files in it are recorded too for the backup tarball.
+func (s *objectBackupStore) putObjectWithDigest(checksums *BackupChecksums, backup, key string, file io.Reader, log logrus.FieldLogger) error {
+	if file == nil {
+		return nil
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if checksums == nil {
+		return s.objectStore.PutObject(s.bucket, key, file)
+	}
+
+	if key == s.layout.getBackupContentsKey(backup) {
+		digester := newTarballDigester()
+		if err := s.objectStore.PutObject(s.bucket, key, io.TeeReader(file, digester)); err != nil {
+			digester.finish()
+			return err
+		}
+
+		digest, items, err := digester.finish()
+		checksums.Artifacts[path.Base(key)] = digest
+		if err != nil {
+			log.WithError(err).Warnf("Error computing the digests of the files in %s", path.Base(key))
+			checksums.Items = nil
+			return nil
+		}
+		checksums.Items = items
+		return nil
+	}
+
+	reader := newDigestReader(file)
+	if err := s.objectStore.PutObject(s.bucket, key, reader); err != nil {
+		return err
+	}
+	checksums.Artifacts[path.Base(key)] = reader.digest()
+	return nil
+}
+
+// putBackupChecksums signs and uploads the checksum manifest of the backup. The manifest is
+// uploaded unsigned if no checksum key is configured, so the digests are still recorded.
+func (s *objectBackupStore) putBackupChecksums(backup string, checksums *BackupChecksums) error {
+	checksums.KeyID = ""
+	checksums.Signature = ""
+	if len(s.checksumKey) == 0 {
+		s.logger.WithField("backup", backup).Warn("No checksum key is configured, uploading the checksum manifest unsigned")
+	} else if err := checksums.sign(s.checksumKey); err != nil {
+		return err
+	}
+
+	buf, errs := encode.ToJSONGzip(checksums, "backup checksums")
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupChecksumsKey(backup), buf)
+}
+
+// GetBackupChecksums returns the checksum manifest of the backup, or nil if the backup was
+// created before the checksum manifest was introduced. It returns an error if the manifest
+// wasn't signed with the checksum key, or if its signature doesn't match its content.
+func (s *objectBackupStore) GetBackupChecksums(name string) (*BackupChecksums, error) {
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupChecksumsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	checksums := newBackupChecksums()
+	if err := decode(res, checksums); err != nil {
+		return nil, err
+	}
+	if err := checksums.verifySignature(s.checksumKey); err != nil {
+		return nil, err
+	}
+
+	return checksums, nil
+}
+
+// putBackupArtifact uploads an artifact of an existing backup, and updates its digest in the
+// checksum manifest of the backup, if the backup has one. The artifact is uploaded even if the
+// manifest can't be verified, but the manifest is left as is then, since signing it again would
+// vouch for digests that can't be trusted.
+func (s *objectBackupStore) putBackupArtifact(backup, key string, file io.Reader) error {
+	log := s.logger.WithField("backup", backup)
+	checksums, err := s.GetBackupChecksums(backup)
+	if err != nil {
+		log.WithError(err).Warnf("Error getting the backup checksums, %s isn't recorded in the checksum manifest", path.Base(key))
+		checksums = nil
+	}
+
+	if err := s.putObjectWithDigest(checksums, backup, key, file, log); err != nil {
+		return err
+	}
+	if checksums == nil {
+		return nil
+	}
+	return s.putBackupChecksums(backup, checksums)
+}
+
+// VerifyBackup downloads every artifact recorded in the checksum manifest of the backup and
+// returns the artifacts, and the files of the backup tarball, whose digest doesn't match. It
+// returns an error if the backup has no checksum manifest, if the manifest wasn't signed with the
+// checksum key of the cluster, or if an artifact can't be downloaded.
+func (s *objectBackupStore) VerifyBackup(name string) ([]string, error) {
+	checksums, err := s.GetBackupChecksums(name)
+	if errors.Is(err, errChecksumSignatureMismatch) {
+		return []string{fmt.Sprintf("%s: signature mismatch, the checksum manifest was modified", path.Base(s.layout.getBackupChecksumsKey(name)))}, nil
+	}
+	var unknownKey *checksumUnknownKeyError
+	if errors.As(err, &unknownKey) {
+		return nil, errors.Wrap(err, "unable to verify the backup, configure the checksum key of the cluster that created it")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the backup checksums")
+	}
+	if checksums == nil {
+		return nil, errors.New("backup has no checksum manifest")
+	}
+	if checksums.Algorithm != ChecksumAlgorithmSHA256 {
+		return nil, errors.Errorf("unsupported checksum algorithm %q", checksums.Algorithm)
+	}
+
+	artifacts := make([]string, 0, len(checksums.Artifacts))
+	for artifact := range checksums.Artifacts {
+		artifacts = append(artifacts, artifact)
+	}
+	sort.Strings(artifacts)
+
+	var problems []string
+	for _, artifact := range artifacts {
+		artifactProblems, err := s.verifyArtifact(name, artifact, checksums)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, artifactProblems...)
+	}
+
+	return problems, nil
+}
+
+func (s *objectBackupStore) verifyArtifact(backup, artifact string, checksums *BackupChecksums) ([]string, error) {
+	res, err := tryGet(s.objectStore, s.bucket, path.Join(s.layout.getBackupDir(backup), artifact))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error downloading %s", artifact)
+	}
+	if res == nil {
+		return []string{fmt.Sprintf("%s is missing", artifact)}, nil
+	}
+	defer res.Close()
+
+	if path.Base(s.layout.getBackupContentsKey(backup)) != artifact {
+		reader := newDigestReader(res)
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return nil, errors.Wrapf(err, "error downloading %s", artifact)
+		}
+		if reader.digest() != checksums.Artifacts[artifact] {
+			return []string{fmt.Sprintf("%s: checksum mismatch", artifact)}, nil
+		}
+		return nil, nil
+	}
+
+	digester := newTarballDigester()
+	if _, err := io.Copy(digester, res); err != nil {
+		digester.finish()
+		return nil, errors.Wrapf(err, "error downloading %s", artifact)
+	}
+	digest, items, itemsErr := digester.finish()
+
+	var problems []string
+	if digest != checksums.Artifacts[artifact] {
+		problems = append(problems, fmt.Sprintf("%s: checksum mismatch", artifact))
+	}
+	if itemsErr != nil {
+		if len(checksums.Items) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %v", artifact, itemsErr))
+		}
+		return problems, nil
+	}
+
+	paths := make([]string, 0, len(checksums.Items))
+	for item := range checksums.Items {
+		paths = append(paths, item)
+	}
+	sort.Strings(paths)
+	for _, item := range paths {
+		digest, ok := items[item]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: %s is missing", artifact, item))
+		case digest != checksums.Items[item]:
+			problems = append(problems, fmt.Sprintf("%s: %s checksum mismatch", artifact, item))
+		}
+	}
+
+	var unexpected []string
+	for item := range items {
+		if _, ok := checksums.Items[item]; !ok {
+			unexpected = append(unexpected, item)
+		}
+	}
+	sort.Strings(unexpected)
+	for _, item := range unexpected {
+		problems = append(problems, fmt.Sprintf("%s: unexpected file %s", artifact, item))
+	}
+
+	return problems, nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPutBackupRecordsChecksums(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("foo", "")
+
+	contents := velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Result()).
+		Done().Bytes()
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:                 "backup-1",
+		Metadata:             newStringReadSeeker("metadata"),
+		Contents:             bytes.NewReader(contents),
+		Log:                  newStringReadSeeker("log"),
+		BackupItemOperations: newStringReadSeeker("backupItemOperations"),
+	}))
+
+	checksums, err := harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	require.NotNil(t, checksums)
+	assert.Equal(t, ChecksumAlgorithmSHA256, checksums.Algorithm)
+	assert.Equal(t, map[string]string{
+		"backup-1.tar.gz":                 sha256Hex(contents),
+		"backup-1-logs.gz":                sha256Hex([]byte("log")),
+		"backup-1-itemoperations.json.gz": sha256Hex([]byte("backupItemOperations")),
+	}, checksums.Artifacts)
+	assert.Len(t, checksums.Items, 2)
+	assert.Equal(t, sha256Hex([]byte("1")), checksums.Items["metadata/version"])
+
+	// artifacts updated after the backup is uploaded update the checksum manifest
+	require.NoError(t, harness.PutBackupItemOperations("backup-1", newStringReadSeeker("updated")))
+	checksums, err = harness.GetBackupChecksums("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex([]byte("updated")), checksums.Artifacts["backup-1-itemoperations.json.gz"])
+
+	problems, err := harness.VerifyBackup("backup-1")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// the checksum manifest can't be read without the key it was signed with
+	harness.checksumKey = []byte("another-key")
+	_, err = harness.GetBackupChecksums("backup-1")
+	var unknownKey *checksumUnknownKeyError
+	require.ErrorAs(t, err, &unknownKey)
+	assert.Equal(t, checksumKeyID([]byte("checksum-key")), unknownKey.keyID)
+
+	// but the artifacts can still be updated, without updating the checksum manifest
+	manifest := harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-checksums.json.gz"]
+	require.NoError(t, harness.PutBackupItemOperations("backup-1", newStringReadSeeker("updated again")))
+	assert.Equal(t, []byte("updated again"), harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-itemoperations.json.gz"])
+	assert.Equal(t, manifest, harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-checksums.json.gz"])
+
+	// and backups are still uploaded without the checksum key, with an unsigned checksum manifest
+	harness.checksumKey = nil
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "backup-2",
+		Metadata: newStringReadSeeker("metadata"),
+		Log:      newStringReadSeeker("log"),
+	}))
+	_, err = harness.GetBackupChecksums("backup-2")
+	require.EqualError(t, err, "checksum manifest isn't signed")
+}
+
+// forgeChecksums replaces the checksum manifest of backup-1 with one recording the digest of
+// the given logs, updated by the given function.
+func forgeChecksums(t *testing.T, data BucketData, logs []byte, update func(*BackupChecksums)) {
+	t.Helper()
+
+	checksums := newBackupChecksums()
+	require.NoError(t, decode(bytes.NewReader(data["backups/backup-1/backup-1-checksums.json.gz"]), checksums))
+	checksums.Artifacts["backup-1-logs.gz"] = sha256Hex(logs)
+	if update != nil {
+		update(checksums)
+	}
+
+	buf, errs := encode.ToJSONGzip(checksums, "backup checksums")
+	require.Empty(t, errs)
+	data["backups/backup-1/backup-1-checksums.json.gz"] = buf.Bytes()
+}
+
+func TestVerifyBackup(t *testing.T) {
+	contents := velerotest.NewTarWriter(t).
+		Add("metadata/version", []byte("1")).
+		AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Result()).
+		Done().Bytes()
+
+	tests := []struct {
+		name         string
+		modify       func(data BucketData)
+		noChecksums  bool
+		wantProblems []string
+		wantErr      string
+	}{
+		{
+			name: "unmodified backup has no problems",
+		},
+		{
+			name: "modified artifact is reported",
+			modify: func(data BucketData) {
+				data["backups/backup-1/backup-1-logs.gz"] = []byte("modified")
+			},
+			wantProblems: []string{"backup-1-logs.gz: checksum mismatch"},
+		},
+		{
+			name: "missing artifact is reported",
+			modify: func(data BucketData) {
+				delete(data, "backups/backup-1/backup-1-resource-list.json.gz")
+			},
+			wantProblems: []string{"backup-1-resource-list.json.gz is missing"},
+		},
+		{
+			name: "modified, missing and unexpected files of the tarball are reported",
+			modify: func(data BucketData) {
+				data["backups/backup-1/backup-1.tar.gz"] = velerotest.NewTarWriter(t).
+					Add("metadata/version", []byte("2")).
+					Add("resources/secrets/namespaces/ns-1/secret-1.json", []byte("{}")).
+					Done().Bytes()
+			},
+			wantProblems: []string{
+				"backup-1.tar.gz: checksum mismatch",
+				"backup-1.tar.gz: metadata/version checksum mismatch",
+				"backup-1.tar.gz: resources/configmaps/namespaces/ns-1/cm-1.json is missing",
+				"backup-1.tar.gz: unexpected file resources/secrets/namespaces/ns-1/secret-1.json",
+			},
+		},
+		{
+			name: "artifact modified along with the checksum manifest is reported",
+			modify: func(data BucketData) {
+				data["backups/backup-1/backup-1-logs.gz"] = []byte("modified")
+				forgeChecksums(t, data, []byte("modified"), nil)
+			},
+			wantProblems: []string{"backup-1-checksums.json.gz: signature mismatch, the checksum manifest was modified"},
+		},
+		{
+			name: "checksum manifest signed with another key can't be verified",
+			modify: func(data BucketData) {
+				forgeChecksums(t, data, []byte("log"), func(checksums *BackupChecksums) {
+					require.NoError(t, checksums.sign([]byte("another-key")))
+				})
+			},
+			wantErr: "unable to verify the backup, configure the checksum key of the cluster that created it: checksum manifest was signed with an unknown key " +
+				checksumKeyID([]byte("another-key")) + ", the checksum key of this cluster is " + checksumKeyID([]byte("checksum-key")),
+		},
+		{
+			name: "unsigned checksum manifest can't be verified",
+			modify: func(data BucketData) {
+				forgeChecksums(t, data, []byte("log"), func(checksums *BackupChecksums) {
+					checksums.KeyID = ""
+					checksums.Signature = ""
+				})
+			},
+			wantErr: "error getting the backup checksums: checksum manifest isn't signed",
+		},
+		{
+			name:        "backup without checksum manifest can't be verified",
+			noChecksums: true,
+			wantErr:     "backup has no checksum manifest",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("foo", "")
+			require.NoError(t, harness.PutBackup(BackupInfo{
+				Name:               "backup-1",
+				Metadata:           newStringReadSeeker("metadata"),
+				Contents:           bytes.NewReader(contents),
+				Log:                newStringReadSeeker("log"),
+				BackupResourceList: newStringReadSeeker("resourceList"),
+			}))
+
+			data := harness.objectStore.Data[harness.bucket]
+			if tc.noChecksums {
+				delete(data, "backups/backup-1/backup-1-checksums.json.gz")
+			}
+			if tc.modify != nil {
+				tc.modify(data)
+			}
+
+			problems, err := harness.VerifyBackup("backup-1")
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantProblems, problems)
+		})
+	}
+}
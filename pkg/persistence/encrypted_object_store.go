@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+)
+
+// encryptedObjectStore encrypts the objects uploaded to the wrapped object store, and decrypts
+// the objects downloaded from it. Objects that aren't encrypted fail to be downloaded, unless
+// they're allowed, in which case they're downloaded as is.
+type encryptedObjectStore struct {
+	velero.ObjectStore
+	keyring          *encryption.Keyring
+	allowUnencrypted bool
+}
+
+func (s *encryptedObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	encrypted, err := s.keyring.Encrypt(body)
+	if err != nil {
+		return err
+	}
+	return s.ObjectStore.PutObject(bucket, key, encrypted)
+}
+
+func (s *encryptedObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	res, err := s.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader = res
+	if s.allowUnencrypted {
+		var encrypted bool
+		if encrypted, reader, err = encryption.IsEncrypted(res); err != nil {
+			res.Close()
+			return nil, err
+		}
+		if !encrypted {
+			return &readCloser{Reader: reader, Closer: res}, nil
+		}
+	}
+
+	decrypted, err := s.keyring.Decrypt(reader)
+	if err != nil {
+		res.Close()
+		return nil, errors.Wrapf(err, "error decrypting %s", key)
+	}
+	return &readCloser{Reader: decrypted, Closer: res}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// LocationKeyring returns the keyring of the encryption keys of the location, or nil if the
+// objects of the location aren't encrypted.
+func LocationKeyring(location *velerov1api.BackupStorageLocation, secretStore credentials.SecretStore) (*encryption.Keyring, error) {
+	keys, err := LocationKeys(location, secretStore)
+	if err != nil || len(keys) == 0 {
+		return nil, err
+	}
+	return encryption.NewKeyring(keys[0], keys[1:]...)
+}
+
+// LocationKeys returns the encryption keys of the location, the active key first, followed by
+// the keys it was rotated from.
+func LocationKeys(location *velerov1api.BackupStorageLocation, secretStore credentials.SecretStore) ([][]byte, error) {
+	if location.Spec.Encryption == nil {
+		return nil, nil
+	}
+	if location.Spec.Encryption.KeySecret == nil {
+		return nil, errors.New("backup storage location encryption has no key secret")
+	}
+
+	selectors := []*corev1api.SecretKeySelector{location.Spec.Encryption.KeySecret}
+	for i := range location.Spec.Encryption.PreviousKeySecrets {
+		selectors = append(selectors, &location.Spec.Encryption.PreviousKeySecrets[i])
+	}
+
+	var keys [][]byte
+	for _, selector := range selectors {
+		data, err := secretStore.Get(selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get encryption key %s/%s", selector.Name, selector.Key)
+		}
+		key, err := encryption.ParseKey([]byte(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key %s/%s", selector.Name, selector.Key)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
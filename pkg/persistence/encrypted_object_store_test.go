@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	credentialmocks "github.com/vmware-tanzu/velero/internal/credentials/mocks"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+)
+
+func TestEncryptedBackupStore(t *testing.T) {
+	oldKey := builder.ForSecretKeySelector("encryption", "old").Result()
+	newKey := builder.ForSecretKeySelector("encryption", "new").Result()
+	missingKey := builder.ForSecretKeySelector("encryption", "missing").Result()
+	secretStore := &credentialmocks.SecretStore{}
+	secretStore.On("Get", oldKey).Return(string(bytes.Repeat([]byte{1}, encryption.KeySize)), nil)
+	secretStore.On("Get", newKey).Return(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, encryption.KeySize)), nil)
+	secretStore.On("Get", missingKey).Return("", errors.New("secret not found"))
+	secretStore.On("Get", ChecksumKeySelector(DefaultChecksumKeySecret)).Return("checksum-key", nil)
+
+	objectStore := newInMemoryObjectStore("bucket")
+	getStore := func(location *velerov1api.BackupStorageLocation) (BackupStore, error) {
+		getter := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), secretStore, DefaultChecksumKeySecret)
+		return getter.Get(location, objectStoreGetter{"provider-1": objectStore}, velerotest.NewLogger())
+	}
+	location := func() *builder.BackupStorageLocationBuilder {
+		return builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Provider("provider-1").Bucket("bucket")
+	}
+
+	// a backup stored before encryption was enabled
+	plainStore, err := getStore(location().Result())
+	require.NoError(t, err)
+	require.NoError(t, plainStore.PutBackup(BackupInfo{
+		Name:     "backup-1",
+		Metadata: newStringReadSeeker(`{"apiVersion":"velero.io/v1","kind":"Backup","metadata":{"name":"backup-1"}}`),
+		Contents: newStringReadSeeker("contents-1"),
+		Log:      newStringReadSeeker("log-1"),
+	}))
+
+	store, err := getStore(location().Encryption(oldKey).Result())
+	require.NoError(t, err)
+	require.NoError(t, store.PutBackup(BackupInfo{
+		Name:     "backup-2",
+		Metadata: newStringReadSeeker(`{"apiVersion":"velero.io/v1","kind":"Backup","metadata":{"name":"backup-2"}}`),
+		Contents: newStringReadSeeker("contents-2"),
+		Log:      newStringReadSeeker("log-2"),
+	}))
+
+	// the objects are encrypted in the bucket
+	for key, data := range objectStore.Data["bucket"] {
+		if !bytes.Contains([]byte(key), []byte("backup-2")) {
+			continue
+		}
+		encrypted, _, err := encryption.IsEncrypted(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.True(t, encrypted, key)
+	}
+	assert.NotContains(t, string(objectStore.Data["bucket"]["backups/backup-2/backup-2.tar.gz"]), "contents-2")
+
+	// the objects are decrypted transparently
+	readContents := func(store BackupStore, name string) string {
+		rc, err := store.GetBackupContents(name)
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "contents-2", readContents(store, "backup-2"))
+
+	// the backup stored before encryption was enabled is rejected, unless unencrypted objects are
+	// allowed while the location is migrated
+	_, err = store.GetBackupContents("backup-1")
+	require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+	migratingStore, err := getStore(location().Encryption(oldKey).AllowUnencryptedObjects(true).Result())
+	require.NoError(t, err)
+	assert.Equal(t, "contents-1", readContents(migratingStore, "backup-1"))
+	assert.Equal(t, "contents-2", readContents(migratingStore, "backup-2"))
+	backup, err := store.GetBackupMetadata("backup-2")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-2", backup.Name)
+
+	// the backups encrypted with a previous key stay readable after the key is rotated
+	rotatedStore, err := getStore(location().Encryption(newKey, *oldKey).Result())
+	require.NoError(t, err)
+	assert.Equal(t, "contents-2", readContents(rotatedStore, "backup-2"))
+
+	// the backups encrypted with a key that isn't in the location can't be read
+	newStore, err := getStore(location().Encryption(newKey).Result())
+	require.NoError(t, err)
+	_, err = newStore.GetBackupContents("backup-2")
+	require.ErrorContains(t, err, "object is encrypted with unknown key")
+
+	// the files staged for download are only encrypted with the one-time key of the request
+	downloadKey := bytes.Repeat([]byte{3}, encryption.KeySize)
+	file, err := store.GetDownloadObject(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupLog, Name: "backup-2"})
+	require.NoError(t, err)
+	_, err = store.PutDownloadContents("request-1", file, downloadKey)
+	require.NoError(t, err)
+	file.Close()
+	downloadKeyring, err := encryption.NewKeyring(downloadKey)
+	require.NoError(t, err)
+	decrypted, err := downloadKeyring.Decrypt(bytes.NewReader(objectStore.Data["bucket"]["downloads/request-1.tar.gz"]))
+	require.NoError(t, err)
+	data, err := io.ReadAll(decrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "log-2", string(data))
+
+	_, err = getStore(location().Encryption(missingKey).Result())
+	require.EqualError(t, err, "unable to get encryption key encryption/missing: secret not found")
+}
@@ -20,9 +20,13 @@ package mocks
 import (
 	io "io"
 
+	archive "github.com/vmware-tanzu/velero/pkg/archive"
+
 	mock "github.com/stretchr/testify/mock"
 	itemoperation "github.com/vmware-tanzu/velero/pkg/itemoperation"
 
+	manifest "github.com/vmware-tanzu/velero/pkg/manifest"
+
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 
 	results "github.com/vmware-tanzu/velero/pkg/util/results"
@@ -32,6 +36,8 @@ import (
 	volume "github.com/vmware-tanzu/velero/internal/volume"
 
 	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+
+	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // BackupStore is an autogenerated mock type for the BackupStore type
@@ -85,6 +91,24 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteDownloadContents provides a mock function with given fields: downloadRequest
+func (_m *BackupStore) DeleteDownloadContents(downloadRequest string) error {
+	ret := _m.Called(downloadRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDownloadContents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(downloadRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -163,6 +187,96 @@ func (_m *BackupStore) GetBackupItemOperations(name string) ([]*itemoperation.Ba
 	return r0, r1
 }
 
+// GetBackupChecksums provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupChecksums(name string) (*persistence.BackupChecksums, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackupChecksums")
+	}
+
+	var r0 *persistence.BackupChecksums
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*persistence.BackupChecksums, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *persistence.BackupChecksums); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.BackupChecksums)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupContentIndex provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupContentIndex(name string) (*archive.ContentIndex, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackupContentIndex")
+	}
+
+	var r0 *archive.ContentIndex
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*archive.ContentIndex, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *archive.ContentIndex); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archive.ContentIndex)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupManifest provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupManifest(name string) (*manifest.Manifest, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackupManifest")
+	}
+
+	var r0 *manifest.Manifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*manifest.Manifest, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *manifest.Manifest); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*manifest.Manifest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupMetadata provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	ret := _m.Called(name)
@@ -343,6 +457,36 @@ func (_m *BackupStore) GetCSIVolumeSnapshots(name string) ([]*volumesnapshotv1.V
 	return r0, r1
 }
 
+// GetDownloadObject provides a mock function with given fields: target
+func (_m *BackupStore) GetDownloadObject(target v1.DownloadTarget) (io.ReadCloser, error) {
+	ret := _m.Called(target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDownloadObject")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) (io.ReadCloser, error)); ok {
+		return rf(target)
+	}
+	if rf, ok := ret.Get(0).(func(v1.DownloadTarget) io.ReadCloser); ok {
+		r0 = rf(target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(v1.DownloadTarget) error); ok {
+		r1 = rf(target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDownloadURL provides a mock function with given fields: target
 func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error) {
 	ret := _m.Called(target)
@@ -401,6 +545,36 @@ func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup,
 	return r0, r1
 }
 
+// GetRepositoryKeys provides a mock function with given fields: volumeNamespace
+func (_m *BackupStore) GetRepositoryKeys(volumeNamespace string) (io.ReadCloser, error) {
+	ret := _m.Called(volumeNamespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepositoryKeys")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (io.ReadCloser, error)); ok {
+		return rf(volumeNamespace)
+	}
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(volumeNamespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(volumeNamespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreItemOperations provides a mock function with given fields: name
 func (_m *BackupStore) GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error) {
 	ret := _m.Called(name)
@@ -431,6 +605,36 @@ func (_m *BackupStore) GetRestoreItemOperations(name string) ([]*itemoperation.R
 	return r0, r1
 }
 
+// GetRestoreOriginalItems provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreOriginalItems(name string) ([]*unstructured.Unstructured, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRestoreOriginalItems")
+	}
+
+	var r0 []*unstructured.Unstructured
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*unstructured.Unstructured, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*unstructured.Unstructured); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*unstructured.Unstructured)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRestoreResults provides a mock function with given fields: name
 func (_m *BackupStore) GetRestoreResults(name string) (map[string]results.Result, error) {
 	ret := _m.Called(name)
@@ -575,6 +779,24 @@ func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader
 	return r0
 }
 
+// PutBackupDryRunReport provides a mock function with given fields: backup, report
+func (_m *BackupStore) PutBackupDryRunReport(backup string, report io.Reader) error {
+	ret := _m.Called(backup, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBackupDryRunReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackupItemOperations provides a mock function with given fields: backup, backupItemOperations
 func (_m *BackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
 	ret := _m.Called(backup, backupItemOperations)
@@ -629,6 +851,70 @@ func (_m *BackupStore) PutBackupVolumeInfos(name string, volumeInfo io.Reader) e
 	return r0
 }
 
+// PutDownloadContents provides a mock function with given fields: downloadRequest, contents, encryptionKey
+func (_m *BackupStore) PutDownloadContents(downloadRequest string, contents io.Reader, encryptionKey []byte) (string, error) {
+	ret := _m.Called(downloadRequest, contents, encryptionKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutDownloadContents")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader, []byte) (string, error)); ok {
+		return rf(downloadRequest, contents, encryptionKey)
+	}
+	if rf, ok := ret.Get(0).(func(string, io.Reader, []byte) string); ok {
+		r0 = rf(downloadRequest, contents, encryptionKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, io.Reader, []byte) error); ok {
+		r1 = rf(downloadRequest, contents, encryptionKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRepositoryKeys provides a mock function with given fields: volumeNamespace, keys
+func (_m *BackupStore) PutRepositoryKeys(volumeNamespace string, keys io.Reader) error {
+	ret := _m.Called(volumeNamespace, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRepositoryKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(volumeNamespace, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreDryRunReport provides a mock function with given fields: restore, report
+func (_m *BackupStore) PutRestoreDryRunReport(restore string, report io.Reader) error {
+	ret := _m.Called(restore, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRestoreDryRunReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreItemOperations provides a mock function with given fields: restore, restoreItemOperations
 func (_m *BackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
 	ret := _m.Called(restore, restoreItemOperations)
@@ -665,6 +951,24 @@ func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reade
 	return r0
 }
 
+// PutRestoreOriginalItems provides a mock function with given fields: restore, items
+func (_m *BackupStore) PutRestoreOriginalItems(restore string, items io.Reader) error {
+	ret := _m.Called(restore, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutRestoreOriginalItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreResults provides a mock function with given fields: backup, restore, _a2
 func (_m *BackupStore) PutRestoreResults(backup string, restore string, _a2 io.Reader) error {
 	ret := _m.Called(backup, restore, _a2)
@@ -719,6 +1023,72 @@ func (_m *BackupStore) PutRestoredResourceList(restore string, _a1 io.Reader) er
 	return r0
 }
 
+// TrashBackup provides a mock function with given fields: name
+func (_m *BackupStore) TrashBackup(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UntrashBackup provides a mock function with given fields: name
+func (_m *BackupStore) UntrashBackup(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntrashBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyBackup provides a mock function with given fields: name
+func (_m *BackupStore) VerifyBackup(name string) ([]string, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBackup")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewBackupStore creates a new instance of BackupStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBackupStore(t interface {
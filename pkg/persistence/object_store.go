@@ -27,15 +27,19 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 )
 
@@ -50,7 +54,9 @@ type BackupInfo struct {
 	BackupItemOperations,
 	BackupResourceList,
 	CSIVolumeSnapshotClasses,
-	BackupVolumeInfo io.Reader
+	BackupVolumeInfo,
+	Manifest,
+	ContentIndex io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -72,13 +78,33 @@ type BackupStore interface {
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
 	PutBackupVolumeInfos(name string, volumeInfo io.Reader) error
+	// PutBackupDryRunReport stores the items, volumes and hooks a dry-run backup would process.
+	PutBackupDryRunReport(backup string, report io.Reader) error
 	GetBackupVolumeInfos(name string) ([]*volume.BackupVolumeInfo, error)
+	// GetBackupManifest returns the object graph manifest of the backup, or nil if the
+	// backup was created before the manifest was introduced.
+	GetBackupManifest(name string) (*manifest.Manifest, error)
+	// GetBackupContentIndex returns the content hashes of the item files of the backup, or nil
+	// if the backup was created before the content index was introduced.
+	GetBackupContentIndex(name string) (*archive.ContentIndex, error)
+	// GetBackupChecksums returns the checksum manifest of the backup, after verifying its
+	// signature, or nil if the backup was created before the checksum manifest was introduced.
+	GetBackupChecksums(name string) (*BackupChecksums, error)
+	// VerifyBackup re-downloads the artifacts of the backup and returns the ones, and the
+	// files of the backup tarball, whose digest doesn't match its checksum manifest.
+	VerifyBackup(name string) ([]string, error)
 	GetRestoreResults(name string) (map[string]results.Result, error)
 
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
 	DeleteBackup(name string) error
+	// TrashBackup moves the objects of the backup to the trash of the backup store, where
+	// they're kept during the recovery window of the deleted backup.
+	TrashBackup(name string) error
+	// UntrashBackup moves the objects of the backup from the trash of the backup store back
+	// to the backups of the backup store.
+	UntrashBackup(name string) error
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
@@ -86,10 +112,32 @@ type BackupStore interface {
 	PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error
 	GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error)
 	PutRestoreVolumeInfo(restore string, volumeInfo io.Reader) error
+	PutRestoreDryRunReport(restore string, report io.Reader) error
+	// PutRestoreOriginalItems stores the in-cluster version of the items updated by the restore,
+	// as they were before the restore updated them.
+	PutRestoreOriginalItems(restore string, items io.Reader) error
+	// GetRestoreOriginalItems returns the in-cluster version of the items updated by the restore,
+	// as they were before the restore updated them, or nil if the restore didn't update any item.
+	GetRestoreOriginalItems(name string) ([]*unstructured.Unstructured, error)
 	DeleteRestore(name string) error
 	GetRestoredResourceList(name string) (map[string][]string, error)
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+	// GetDownloadObject returns the target file of a download request, decrypted if the
+	// location uses client-side encryption.
+	GetDownloadObject(target velerov1api.DownloadTarget) (io.ReadCloser, error)
+	// PutDownloadContents stages the contents of the target file of a download request, when
+	// it can't be downloaded as is, and returns their download URL. The contents are encrypted
+	// with the given one-time key instead of the encryption key of the location, if any.
+	PutDownloadContents(downloadRequest string, contents io.Reader, encryptionKey []byte) (string, error)
+	// DeleteDownloadContents deletes the backup contents staged for a download request.
+	DeleteDownloadContents(downloadRequest string) error
+
+	// PutRepositoryKeys stores the escrowed keys of the kopia repository of the volume namespace.
+	PutRepositoryKeys(volumeNamespace string, keys io.Reader) error
+	// GetRepositoryKeys returns the escrowed keys of the kopia repository of the volume namespace,
+	// or nil if no keys are escrowed for the repository.
+	GetRepositoryKeys(volumeNamespace string) (io.ReadCloser, error)
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
@@ -100,6 +148,8 @@ type objectBackupStore struct {
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+	// checksumKey is the key the checksum manifests of the backups are signed with.
+	checksumKey []byte
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -115,12 +165,16 @@ type ObjectBackupStoreGetter interface {
 }
 
 type objectBackupStoreGetter struct {
-	credentialStore credentials.FileStore
+	credentialStore   credentials.FileStore
+	secretStore       credentials.SecretStore
+	checksumKeySecret string
 }
 
 // NewObjectBackupStoreGetter returns a ObjectBackupStoreGetter that can get a velero.BackupStore.
-func NewObjectBackupStoreGetter(credentialStore credentials.FileStore) ObjectBackupStoreGetter {
-	return &objectBackupStoreGetter{credentialStore: credentialStore}
+// The secret store is used to get the encryption keys of the locations using client-side encryption,
+// and the key the checksum manifests of the backups are signed with, from the checksum key Secret.
+func NewObjectBackupStoreGetter(credentialStore credentials.FileStore, secretStore credentials.SecretStore, checksumKeySecret string) ObjectBackupStoreGetter {
+	return &objectBackupStoreGetter{credentialStore: credentialStore, secretStore: secretStore, checksumKeySecret: checksumKeySecret}
 }
 
 func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
@@ -184,16 +238,36 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		return nil, err
 	}
 
+	keyring, err := LocationKeyring(location, b.secretStore)
+	if err != nil {
+		return nil, err
+	}
+	if keyring != nil {
+		objectStore = &encryptedObjectStore{
+			ObjectStore:      objectStore,
+			keyring:          keyring,
+			allowUnencrypted: location.Spec.Encryption.AllowUnencryptedObjects,
+		}
+	}
+
 	log := logger.WithFields(logrus.Fields(map[string]any{
 		"bucket": bucket,
 		"prefix": prefix,
 	}))
 
+	// the backup store is still usable without the checksum key, the checksum manifests just
+	// can't be signed or verified
+	checksumKey, err := b.secretStore.Get(ChecksumKeySelector(b.checksumKeySecret))
+	if err != nil {
+		log.WithError(err).Warn("Unable to get the checksum manifest signing key")
+	}
+
 	return &objectBackupStore{
 		objectStore: objectStore,
 		bucket:      bucket,
 		layout:      NewObjectStoreLayout(prefix),
 		logger:      log,
+		checksumKey: []byte(checksumKey),
 	}, nil
 }
 
@@ -247,10 +321,13 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	log := s.logger.WithField("backup", info.Name)
+	checksums := newBackupChecksums()
+
+	if err := s.putObjectWithDigest(checksums, info.Name, s.layout.getBackupLogKey(info.Name), info.Log, log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
-		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
+		log.WithError(err).Error("Error uploading log file")
 	}
 
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
@@ -258,7 +335,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.putObjectWithDigest(checksums, info.Name, s.layout.getBackupContentsKey(info.Name), info.Contents, log); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -273,10 +350,12 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name): info.CSIVolumeSnapshotClasses,
 		s.layout.getBackupResultsKey(info.Name):            info.BackupResults,
 		s.layout.getBackupVolumeInfoKey(info.Name):         info.BackupVolumeInfo,
+		s.layout.getBackupManifestKey(info.Name):           info.Manifest,
+		s.layout.getBackupContentIndexKey(info.Name):       info.ContentIndex,
 	}
 
 	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
+		if err := s.putObjectWithDigest(checksums, info.Name, key, reader, log); err != nil {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
@@ -289,6 +368,13 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		}
 	}
 
+	// the checksum manifest is uploaded last, so it covers every artifact of the backup
+	if err := s.putBackupChecksums(info.Name, checksums); err != nil {
+		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
+		deleteMetadataErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
+		return kerrors.NewAggregate([]error{err, deleteErr, deleteMetadataErr})
+	}
+
 	return nil
 }
 
@@ -514,7 +600,47 @@ func (s *objectBackupStore) GetBackupVolumeInfos(name string) ([]*volume.BackupV
 }
 
 func (s *objectBackupStore) PutBackupVolumeInfos(name string, volumeInfo io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getBackupVolumeInfoKey(name), volumeInfo)
+	return s.putBackupArtifact(name, s.layout.getBackupVolumeInfoKey(name), volumeInfo)
+}
+
+func (s *objectBackupStore) GetBackupManifest(name string) (*manifest.Manifest, error) {
+	// if the manifest file doesn't exist, we don't want to return an error, since
+	// a backup created by an older version of Velero would not have this file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupManifestKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	graph := manifest.New()
+	if err := decode(res, graph); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+func (s *objectBackupStore) GetBackupContentIndex(name string) (*archive.ContentIndex, error) {
+	// if the content index file doesn't exist, we don't want to return an error, since
+	// a backup created by an older version of Velero would not have this file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupContentIndexKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	index := archive.NewContentIndex("", nil)
+	if err := decode(res, index); err != nil {
+		return nil, err
+	}
+
+	return index, nil
 }
 
 func (s *objectBackupStore) GetRestoreResults(name string) (map[string]results.Result, error) {
@@ -563,6 +689,65 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) TrashBackup(name string) error {
+	return s.moveObjects(s.layout.getBackupDir(name), s.layout.getTrashedBackupDir(name))
+}
+
+func (s *objectBackupStore) UntrashBackup(name string) error {
+	return s.moveObjects(s.layout.getTrashedBackupDir(name), s.layout.getBackupDir(name))
+}
+
+// moveObjects moves the objects under the prefix to the destination prefix. Object stores
+// can't rename objects, so every object is copied first, and the sources are only deleted once
+// all the copies succeeded. If a copy fails, the copies already made are deleted and the sources
+// are left as they were, so that the move can be retried.
+func (s *objectBackupStore) moveObjects(prefix, destination string) error {
+	objects, err := s.objectStore.ListObjects(s.bucket, prefix)
+	if err != nil {
+		return err
+	}
+
+	var copied []string
+	for _, key := range objects {
+		target := destination + strings.TrimPrefix(key, prefix)
+		s.logger.WithFields(logrus.Fields{
+			"key":    key,
+			"target": target,
+		}).Debug("Trying to copy object")
+		if err := s.copyObject(key, target); err != nil {
+			for _, copy := range copied {
+				if err := s.objectStore.DeleteObject(s.bucket, copy); err != nil {
+					s.logger.WithError(err).WithField("key", copy).Warn("Error deleting the copy of an object")
+				}
+			}
+			return err
+		}
+		copied = append(copied, target)
+	}
+
+	var errs []error
+	for _, key := range objects {
+		s.logger.WithFields(logrus.Fields{
+			"key": key,
+		}).Debug("Trying to delete object")
+		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.WithStack(kerrors.NewAggregate(errs))
+}
+
+func (s *objectBackupStore) copyObject(key, target string) error {
+	rc, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return errors.Wrapf(err, "error getting object %s", key)
+	}
+	defer rc.Close()
+
+	return errors.Wrapf(s.objectStore.PutObject(s.bucket, target, rc), "error putting object %s", target)
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -602,47 +787,74 @@ func (s *objectBackupStore) PutRestoreVolumeInfo(restore string, volumeInfo io.R
 	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreVolumeInfoKey(restore), volumeInfo)
 }
 
+func (s *objectBackupStore) PutBackupDryRunReport(backup string, report io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupDryRunReportKey(backup), report)
+}
+
+func (s *objectBackupStore) PutRestoreDryRunReport(restore string, report io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreDryRunReportKey(restore), report)
+}
+
+func (s *objectBackupStore) PutRestoreOriginalItems(restore string, items io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreOriginalItemsKey(restore), items)
+}
+
 func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
+	return s.putBackupArtifact(backup, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
 }
 
 func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(backup), backupContents)
+	return s.putBackupArtifact(backup, s.layout.getBackupContentsKey(backup), backupContents)
 }
 
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
-	switch target.Kind {
-	case velerov1api.DownloadTargetKindBackupContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindBackupLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindBackupItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupItemOperationsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindRestoreItemOperations:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreItemOperationsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindBackupResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindRestoreLog:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindRestoreResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindRestoreResourceList:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResourceListKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindBackupResults:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindBackupVolumeInfos:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeInfoKey(target.Name), DownloadURLTTL)
-	case velerov1api.DownloadTargetKindRestoreVolumeInfo:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreVolumeInfoKey(target.Name), DownloadURLTTL)
-	default:
-		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
+	key, err := s.layout.getDownloadTargetKey(target)
+	if err != nil {
+		return "", err
+	}
+	return s.objectStore.CreateSignedURL(s.bucket, key, DownloadURLTTL)
+}
+
+func (s *objectBackupStore) GetDownloadObject(target velerov1api.DownloadTarget) (io.ReadCloser, error) {
+	key, err := s.layout.getDownloadTargetKey(target)
+	if err != nil {
+		return nil, err
 	}
+	return s.objectStore.GetObject(s.bucket, key)
+}
+
+func (s *objectBackupStore) PutDownloadContents(downloadRequest string, contents io.Reader, encryptionKey []byte) (string, error) {
+	objectStore := s.objectStore
+	if encryptionKey != nil {
+		keyring, err := encryption.NewKeyring(encryptionKey)
+		if err != nil {
+			return "", err
+		}
+		// the contents are only encrypted with the one-time key of the request, not with the
+		// encryption key of the location
+		if encrypted, ok := objectStore.(*encryptedObjectStore); ok {
+			objectStore = encrypted.ObjectStore
+		}
+		objectStore = &encryptedObjectStore{ObjectStore: objectStore, keyring: keyring}
+	}
+
+	key := s.layout.getDownloadContentsKey(downloadRequest)
+	if err := seekAndPutObject(objectStore, s.bucket, key, contents); err != nil {
+		return "", err
+	}
+	return objectStore.CreateSignedURL(s.bucket, key, DownloadURLTTL)
+}
+
+func (s *objectBackupStore) DeleteDownloadContents(downloadRequest string) error {
+	return errors.WithStack(s.objectStore.DeleteObject(s.bucket, s.layout.getDownloadContentsKey(downloadRequest)))
+}
+
+func (s *objectBackupStore) PutRepositoryKeys(volumeNamespace string, keys io.Reader) error {
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRepositoryKeysKey(volumeNamespace), keys)
+}
+
+func (s *objectBackupStore) GetRepositoryKeys(volumeNamespace string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getRepositoryKeysKey(volumeNamespace))
 }
 
 func (s *objectBackupStore) GetRestoredResourceList(name string) (map[string][]string, error) {
@@ -664,6 +876,25 @@ func (s *objectBackupStore) GetRestoredResourceList(name string) (map[string][]s
 	return list, nil
 }
 
+func (s *objectBackupStore) GetRestoreOriginalItems(name string) ([]*unstructured.Unstructured, error) {
+	// the original items are only stored for the restores that updated items
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreOriginalItemsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var items []*unstructured.Unstructured
+	if err := decode(res, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
 func seekToBeginning(r io.Reader) error {
 	seeker, ok := r.(io.Seeker)
 	if !ok {
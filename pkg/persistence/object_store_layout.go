@@ -20,6 +20,10 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -35,12 +39,14 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 	}
 
 	subdirs := map[string]string{
-		"backups":  path.Join(prefix, "backups") + "/",
-		"restores": path.Join(prefix, "restores") + "/",
-		"restic":   path.Join(prefix, "restic") + "/",
-		"metadata": path.Join(prefix, "metadata") + "/",
-		"plugins":  path.Join(prefix, "plugins") + "/",
-		"kopia":    path.Join(prefix, "kopia") + "/",
+		"backups":   path.Join(prefix, "backups") + "/",
+		"restores":  path.Join(prefix, "restores") + "/",
+		"restic":    path.Join(prefix, "restic") + "/",
+		"metadata":  path.Join(prefix, "metadata") + "/",
+		"plugins":   path.Join(prefix, "plugins") + "/",
+		"kopia":     path.Join(prefix, "kopia") + "/",
+		"trash":     path.Join(prefix, "trash") + "/",
+		"downloads": path.Join(prefix, "downloads") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -65,6 +71,64 @@ func (l *ObjectStoreLayout) getBackupDir(backup string) string {
 	return path.Join(l.subdirs["backups"], backup) + "/"
 }
 
+// getTrashedBackupDir returns the prefix the objects of a deleted backup are kept under
+// during its recovery window.
+func (l *ObjectStoreLayout) getTrashedBackupDir(backup string) string {
+	return path.Join(l.subdirs["trash"], backup) + "/"
+}
+
+// getDownloadContentsKey returns the key the contents rebuilt for a download request are
+// staged at while the request is processed.
+func (l *ObjectStoreLayout) getDownloadContentsKey(downloadRequest string) string {
+	return path.Join(l.subdirs["downloads"], fmt.Sprintf("%s.tar.gz", downloadRequest))
+}
+
+// getRepositoryKeysKey returns the key the escrowed keys of the kopia repository of the volume
+// namespace are stored at, next to the directory of the repository.
+func (l *ObjectStoreLayout) getRepositoryKeysKey(volumeNamespace string) string {
+	return path.Join(l.subdirs["kopia"], fmt.Sprintf("%s.keys", volumeNamespace))
+}
+
+// getDownloadTargetKey returns the key of the target file of a download request.
+func (l *ObjectStoreLayout) getDownloadTargetKey(target velerov1api.DownloadTarget) (string, error) {
+	switch target.Kind {
+	case velerov1api.DownloadTargetKindBackupContents:
+		return l.getBackupContentsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupLog:
+		return l.getBackupLogKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
+		return l.getBackupVolumeSnapshotsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupItemOperations:
+		return l.getBackupItemOperationsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreItemOperations:
+		return l.getRestoreItemOperationsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupResourceList:
+		return l.getBackupResourceListKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreLog:
+		return l.getRestoreLogKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreResults:
+		return l.getRestoreResultsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreResourceList:
+		return l.getRestoreResourceListKey(target.Name), nil
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
+		return l.getCSIVolumeSnapshotKey(target.Name), nil
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
+		return l.getCSIVolumeSnapshotContentsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupResults:
+		return l.getBackupResultsKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupVolumeInfos:
+		return l.getBackupVolumeInfoKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreVolumeInfo:
+		return l.getRestoreVolumeInfoKey(target.Name), nil
+	case velerov1api.DownloadTargetKindRestoreDryRunReport:
+		return l.getRestoreDryRunReportKey(target.Name), nil
+	case velerov1api.DownloadTargetKindBackupDryRunReport:
+		return l.getBackupDryRunReportKey(target.Name), nil
+	default:
+		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
+	}
+}
+
 func (l *ObjectStoreLayout) getRestoreDir(restore string) string {
 	return path.Join(l.subdirs["restores"], restore) + "/"
 }
@@ -133,6 +197,30 @@ func (l *ObjectStoreLayout) getBackupVolumeInfoKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-volumeinfo.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupManifestKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-manifest.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupContentIndexKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-content-index.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupChecksumsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checksums.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupDryRunReportKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-dry-run-report.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreVolumeInfoKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("%s-volumeinfo.json.gz", restore))
 }
+
+func (l *ObjectStoreLayout) getRestoreOriginalItemsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-original-items.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestoreDryRunReportKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-dry-run-report.json.gz", restore))
+}
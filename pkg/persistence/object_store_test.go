@@ -31,14 +31,18 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	credentialmocks "github.com/vmware-tanzu/velero/internal/credentials/mocks"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -63,6 +67,7 @@ func newObjectBackupStoreTestHarness(bucket, prefix string) *objectBackupStoreTe
 			bucket:      bucket,
 			layout:      NewObjectStoreLayout(prefix),
 			logger:      velerotest.NewLogger(),
+			checksumKey: []byte("checksum-key"),
 		},
 		objectStore: objectStore,
 		bucket:      bucket,
@@ -229,6 +234,8 @@ func TestPutBackup(t *testing.T) {
 		backupItemOperations io.Reader
 		resourceList         io.Reader
 		backupVolumeInfo     io.Reader
+		manifest             io.Reader
+		contentIndex         io.Reader
 		expectedErr          string
 		expectedKeys         []string
 	}{
@@ -242,6 +249,8 @@ func TestPutBackup(t *testing.T) {
 			backupItemOperations: newStringReadSeeker("backupItemOperations"),
 			resourceList:         newStringReadSeeker("resourceList"),
 			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			manifest:             newStringReadSeeker("manifest"),
+			contentIndex:         newStringReadSeeker("contentIndex"),
 			expectedErr:          "",
 			expectedKeys: []string{
 				"backups/backup-1/velero-backup.json",
@@ -252,6 +261,9 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-itemoperations.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-manifest.json.gz",
+				"backups/backup-1/backup-1-content-index.json.gz",
+				"backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 		{
@@ -265,6 +277,8 @@ func TestPutBackup(t *testing.T) {
 			backupItemOperations: newStringReadSeeker("backupItemOperations"),
 			resourceList:         newStringReadSeeker("resourceList"),
 			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			manifest:             newStringReadSeeker("manifest"),
+			contentIndex:         newStringReadSeeker("contentIndex"),
 			expectedErr:          "",
 			expectedKeys: []string{
 				"prefix-1/backups/backup-1/velero-backup.json",
@@ -275,6 +289,9 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-itemoperations.json.gz",
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
 				"prefix-1/backups/backup-1/backup-1-volumeinfo.json.gz",
+				"prefix-1/backups/backup-1/backup-1-manifest.json.gz",
+				"prefix-1/backups/backup-1/backup-1-content-index.json.gz",
+				"prefix-1/backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 		{
@@ -321,6 +338,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-itemoperations.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 		{
@@ -340,6 +358,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-checksums.json.gz",
 			},
 		},
 	}
@@ -358,6 +377,8 @@ func TestPutBackup(t *testing.T) {
 				BackupItemOperations: tc.backupItemOperations,
 				BackupResourceList:   tc.resourceList,
 				BackupVolumeInfo:     tc.backupVolumeInfo,
+				Manifest:             tc.manifest,
+				ContentIndex:         tc.contentIndex,
 			}
 			err := harness.PutBackup(backupInfo)
 
@@ -622,6 +643,71 @@ func TestDeleteBackup(t *testing.T) {
 	}
 }
 
+func TestTrashBackup(t *testing.T) {
+	for _, prefix := range []string{"", "velero-backups/"} {
+		t.Run("prefix "+prefix, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("foo", prefix)
+			storageData := BucketData{
+				prefix + "backups/backup-1/velero-backup.json": encodeToBytes(builder.ForBackup("", "backup-1").Result()),
+				prefix + "backups/backup-1/backup-1.tar.gz":    []byte("contents"),
+				prefix + "backups/backup-2/velero-backup.json": encodeToBytes(builder.ForBackup("", "backup-2").Result()),
+			}
+			for key, obj := range storageData {
+				require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj)))
+			}
+
+			require.NoError(t, harness.TrashBackup("backup-1"))
+
+			backups, err := harness.ListBackups()
+			require.NoError(t, err)
+			assert.Equal(t, []string{"backup-2"}, backups)
+			assert.Equal(t, BucketData{
+				prefix + "trash/backup-1/velero-backup.json":   storageData[prefix+"backups/backup-1/velero-backup.json"],
+				prefix + "trash/backup-1/backup-1.tar.gz":      storageData[prefix+"backups/backup-1/backup-1.tar.gz"],
+				prefix + "backups/backup-2/velero-backup.json": storageData[prefix+"backups/backup-2/velero-backup.json"],
+			}, harness.objectStore.Data[harness.bucket])
+			require.NoError(t, harness.IsValid())
+
+			require.NoError(t, harness.UntrashBackup("backup-1"))
+			assert.Equal(t, storageData, harness.objectStore.Data[harness.bucket])
+		})
+	}
+}
+
+// failingPutObjectStore is an in-memory object store that fails to put the object with the
+// given key.
+type failingPutObjectStore struct {
+	*inMemoryObjectStore
+	failKey string
+}
+
+func (o *failingPutObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	if key == o.failKey {
+		return errors.New("put failed")
+	}
+	return o.inMemoryObjectStore.PutObject(bucket, key, body)
+}
+
+func TestTrashBackupCopyFailure(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("foo", "")
+	storageData := BucketData{
+		"backups/backup-1/velero-backup.json": encodeToBytes(builder.ForBackup("", "backup-1").Result()),
+		"backups/backup-1/backup-1.tar.gz":    []byte("contents"),
+		"backups/backup-1/backup-1-logs.gz":   []byte("logs"),
+	}
+	for key, obj := range storageData {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj)))
+	}
+	// depending on the listing order, other objects were already copied when the copy fails
+	harness.objectBackupStore.objectStore = &failingPutObjectStore{
+		inMemoryObjectStore: harness.objectStore,
+		failKey:             "trash/backup-1/backup-1.tar.gz",
+	}
+
+	require.Error(t, harness.TrashBackup("backup-1"))
+	assert.Equal(t, storageData, harness.objectStore.Data[harness.bucket])
+}
+
 func TestDeleteRestore(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -724,6 +810,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup-20170913154901/my-backup-20170913154901-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemOperations:  "backups/my-backup-20170913154901/my-backup-20170913154901-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup-20170913154901/my-backup-20170913154901-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupDryRunReport:    "backups/my-backup-20170913154901/my-backup-20170913154901-dry-run-report.json.gz",
 			},
 		},
 		{
@@ -746,6 +833,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindRestoreResults:        "restores/my-backup/restore-my-backup-results.gz",
 				velerov1api.DownloadTargetKindRestoreItemOperations: "restores/my-backup/restore-my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindRestoreResourceList:   "restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreDryRunReport:   "restores/my-backup/restore-my-backup-dry-run-report.json.gz",
 			},
 		},
 		{
@@ -795,6 +883,47 @@ func TestGetDownloadURL(t *testing.T) {
 	}
 }
 
+func TestPutDownloadContents(t *testing.T) {
+	for _, prefix := range []string{"", "velero-backups/"} {
+		t.Run("prefix "+prefix, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("foo", prefix)
+
+			url, err := harness.PutDownloadContents("backup-1-request", newStringReadSeeker("contents"), nil)
+			require.NoError(t, err)
+			assert.Equal(t, "a-url", url)
+			assert.Equal(t, BucketData{prefix + "downloads/backup-1-request.tar.gz": []byte("contents")}, harness.objectStore.Data[harness.bucket])
+			require.NoError(t, harness.IsValid())
+
+			require.NoError(t, harness.DeleteDownloadContents("backup-1-request"))
+			assert.Empty(t, harness.objectStore.Data[harness.bucket])
+		})
+	}
+}
+
+func TestRepositoryKeys(t *testing.T) {
+	for _, prefix := range []string{"", "velero-backups/"} {
+		t.Run("prefix "+prefix, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("foo", prefix)
+
+			// no escrowed keys should not error
+			res, err := harness.GetRepositoryKeys("ns-1")
+			require.NoError(t, err)
+			assert.Nil(t, res)
+
+			require.NoError(t, harness.PutRepositoryKeys("ns-1", newStringReadSeeker("keys")))
+			assert.Equal(t, BucketData{prefix + "kopia/ns-1.keys": []byte("keys")}, harness.objectStore.Data[harness.bucket])
+			require.NoError(t, harness.IsValid())
+
+			res, err = harness.GetRepositoryKeys("ns-1")
+			require.NoError(t, err)
+			defer res.Close()
+			data, err := io.ReadAll(res)
+			require.NoError(t, err)
+			assert.Equal(t, "keys", string(data))
+		})
+	}
+}
+
 func TestGetCSIVolumeSnapshotClasses(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -874,6 +1003,18 @@ func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore
 	return res, nil
 }
 
+// newChecksumKeySecretStore returns a secret store holding the checksum key, or returning the
+// given error for it.
+func newChecksumKeySecretStore(err error) credentials.SecretStore {
+	secretStore := &credentialmocks.SecretStore{}
+	if err != nil {
+		secretStore.On("Get", ChecksumKeySelector(DefaultChecksumKeySecret)).Return("", err)
+	} else {
+		secretStore.On("Get", ChecksumKeySelector(DefaultChecksumKeySecret)).Return("checksum-key", nil)
+	}
+	return secretStore
+}
+
 // TestNewObjectBackupStore runs the NewObjectBackupStoreGetter constructor and ensures
 // that it provides a BackupStore with a correctly constructed ObjectBackupStore or
 // that an appropriate error is returned.
@@ -884,6 +1025,7 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 		objectStoreGetter objectStoreGetter
 		credFileStore     credentials.FileStore
 		fileStoreErr      error
+		checksumKeyErr    error
 		wantBucket        string
 		wantPrefix        string
 		wantErr           string
@@ -914,6 +1056,16 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 			credFileStore: velerotest.NewFakeCredentialsFileStore("", fmt.Errorf("secret does not exist")),
 			wantErr:       "unable to get credentials: secret does not exist",
 		},
+		{
+			name:     "when the checksum key can't be retrieved, a backup store without checksum key is retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credFileStore:  velerotest.NewFakeCredentialsFileStore("", nil),
+			checksumKeyErr: fmt.Errorf("secret does not exist"),
+			wantBucket:     "bucket",
+		},
 		{
 			name:     "when Bucket has a leading and trailing slash, they are both stripped",
 			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("/bucket/").Result(),
@@ -947,7 +1099,7 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			getter := NewObjectBackupStoreGetter(tc.credFileStore)
+			getter := NewObjectBackupStoreGetter(tc.credFileStore, newChecksumKeySecretStore(tc.checksumKeyErr), DefaultChecksumKeySecret)
 			res, err := getter.Get(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.EqualError(t, err, tc.wantErr)
@@ -959,6 +1111,11 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 
 				assert.Equal(t, tc.wantBucket, store.bucket)
 				assert.Equal(t, tc.wantPrefix, store.layout.rootPrefix)
+				if tc.checksumKeyErr != nil {
+					assert.Empty(t, store.checksumKey)
+				} else {
+					assert.Equal(t, []byte("checksum-key"), store.checksumKey)
+				}
 			}
 		})
 	}
@@ -980,7 +1137,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket but no prefix has config initialized with bucket and empty prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), newChecksumKeySecretStore(nil), DefaultChecksumKeySecret),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -989,7 +1146,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket and prefix has config initialized with bucket and prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Prefix("prefix").Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), newChecksumKeySecretStore(nil), DefaultChecksumKeySecret),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "prefix",
@@ -998,7 +1155,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with CACert is initialized with caCert",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).CACert([]byte("cacert-data")).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), newChecksumKeySecretStore(nil), DefaultChecksumKeySecret),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -1010,7 +1167,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Credential(
 				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
 			).Result(),
-			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil)),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil), newChecksumKeySecretStore(nil), DefaultChecksumKeySecret),
 			wantConfig: map[string]string{
 				"bucket":          "bucket",
 				"prefix":          "",
@@ -1111,6 +1268,59 @@ func TestGetBackupVolumeInfos(t *testing.T) {
 		})
 	}
 }
+func TestGetBackupManifest(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// file not found should not error
+	graph, err := harness.GetBackupManifest("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, graph)
+
+	want := manifest.New()
+	_, err = want.Add(&manifest.Item{Resource: "pods", APIVersion: "v1", Kind: "Pod", Namespace: "ns-1", Name: "pod-1", UID: "uid-1"})
+	require.NoError(t, err)
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+	require.NoError(t, json.NewEncoder(gzw).Encode(want))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-manifest.json.gz", obj))
+
+	graph, err = harness.GetBackupManifest("test-backup")
+	require.NoError(t, err)
+	require.NotNil(t, graph)
+	assert.Equal(t, want.Set(), graph.Set())
+	require.NotNil(t, graph.Index["uid-1"])
+	assert.Equal(t, "pods/ns-1/pod-1", graph.Index["uid-1"].String())
+}
+
+func TestGetBackupContentIndex(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// file not found should not error
+	index, err := harness.GetBackupContentIndex("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, index)
+
+	want := archive.NewContentIndex("parent-backup", archive.NewContentIndex("", nil))
+	want.Add("resources/pods/namespaces/ns-1/pod-1.json", []byte("pod-1"))
+	want.Tombstones = []string{"resources/pods/namespaces/ns-1/pod-2.json"}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+	require.NoError(t, json.NewEncoder(gzw).Encode(want))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-content-index.json.gz", obj))
+
+	index, err = harness.GetBackupContentIndex("test-backup")
+	require.NoError(t, err)
+	require.NotNil(t, index)
+	assert.Equal(t, "parent-backup", index.Parent)
+	assert.Equal(t, 1, index.Depth)
+	assert.Equal(t, want.Hashes, index.Hashes)
+	assert.Equal(t, want.Tombstones, index.Tombstones)
+}
+
 func TestGetRestoreResults(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -1169,6 +1379,38 @@ func TestGetRestoredResourceList(t *testing.T) {
 	assert.Equal(t, list["pod"], res["pod"])
 }
 
+func TestGetRestoreOriginalItems(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// file not found should not error
+	items, err := harness.GetRestoreOriginalItems("test-restore")
+	require.NoError(t, err)
+	assert.Nil(t, items)
+
+	// file containing invalid data should error
+	harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-original-items.json.gz", newStringReadSeeker("foo"))
+	_, err = harness.GetRestoreOriginalItems("test-restore")
+	require.Error(t, err)
+
+	// file containing gzipped json data should return correctly
+	cm := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"namespace": "test-ns", "name": "cm-1"},
+		"data":       map[string]any{"key": "value"},
+	}}
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode([]*unstructured.Unstructured{cm}))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.PutRestoreOriginalItems("test-restore", obj))
+	items, err = harness.GetRestoreOriginalItems("test-restore")
+
+	require.NoError(t, err)
+	assert.Equal(t, []*unstructured.Unstructured{cm}, items)
+}
+
 func TestPutBackupVolumeInfos(t *testing.T) {
 	tests := []struct {
 		name         string
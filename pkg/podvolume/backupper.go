@@ -31,6 +31,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/volumehelper"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/label"
@@ -208,19 +209,24 @@ func resultsKey(ns, name string) string {
 	return fmt.Sprintf("%s/%s", ns, name)
 }
 
-func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
+func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pod *corev1api.Pod, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
+	pods, ns, err := volumehelper.GetPodsAndNamespace(resPolicies, nil, pvc, pod, b.crClient)
+	if err != nil {
+		return nil, err
+	}
+
 	if pvc != nil {
 		pv := new(corev1api.PersistentVolume)
 		err := b.crClient.Get(context.TODO(), ctrlclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName)
 		}
-		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc)
+		vfd := resourcepolicies.NewVolumeFilterData(pv, nil, pvc, pods, ns)
 		return resPolicies.GetMatchAction(vfd)
 	}
 
 	if volume != nil {
-		vfd := resourcepolicies.NewVolumeFilterData(nil, volume, pvc)
+		vfd := resourcepolicies.NewVolumeFilterData(nil, volume, pvc, pods, ns)
 		return resPolicies.GetMatchAction(vfd)
 	}
 
@@ -328,7 +334,7 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 
 		if resPolicies != nil {
-			if action, err := b.getMatchAction(resPolicies, pvc, &volume); err != nil {
+			if action, err := b.getMatchAction(resPolicies, pod, pvc, &volume); err != nil {
 				errs = append(errs, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName))
 				continue
 			} else if action != nil && action.Type == resourcepolicies.Skip {
@@ -48,6 +48,8 @@ type RestoreData struct {
 	Pod                             *corev1api.Pod
 	PodVolumeBackups                []*velerov1api.PodVolumeBackup
 	SourceNamespace, BackupLocation string
+	// SourceName is the name of the pod in the backup, if the restore renames it.
+	SourceName string
 }
 
 // Restorer can execute pod volume restores of volumes in a pod.
@@ -117,7 +119,13 @@ func newRestorer(
 }
 
 func (r *restorer) RestorePodVolumes(data RestoreData, tracker *volume.RestoreVolumeInfoTracker) []error {
-	volumesToRestore := getVolumeBackupInfoForPod(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+	sourcePod := data.Pod
+	if data.SourceName != "" && data.SourceName != data.Pod.Name {
+		// the pod volume backups are matched with the name of the pod in the backup
+		sourcePod = data.Pod.DeepCopy()
+		sourcePod.Name = data.SourceName
+	}
+	volumesToRestore := getVolumeBackupInfoForPod(data.PodVolumeBackups, sourcePod, data.SourceNamespace)
 	if len(volumesToRestore) == 0 {
 		return nil
 	}
@@ -0,0 +1,168 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keys
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+)
+
+const (
+	escrowKeySecretName = "velero-repo-keys-escrow"
+	escrowKeySecretKey  = "key"
+)
+
+// EscrowedKeys are the keys of a kopia backup repository escrowed next to the
+// repository in its backup storage location, wrapped with the escrow key, so the
+// repository can be connected to from the clusters sharing the escrow key.
+type EscrowedKeys struct {
+	// Current is the name of the key the repository is protected with, or empty
+	// if the repository uses the key shared by all the repositories.
+	Current string `json:"current,omitempty"`
+
+	// Pending is the name of the key the repository is being rotated to.
+	Pending string `json:"pending,omitempty"`
+
+	// Keys maps the names of the keys the repository was protected with, or is
+	// being rotated to, to their values.
+	Keys map[string]string `json:"keys"`
+}
+
+// EnsureEscrowKey creates the Secret holding a random key the keys of the
+// backup repositories are wrapped with before they're escrowed in the backup
+// storage locations, if it doesn't exist. The escrow key is only kept in the
+// cluster, it has to be copied to the clusters connecting to the repositories.
+func EnsureEscrowKey(secretClient corev1client.SecretsGetter, namespace string) error {
+	_, err := secretClient.Secrets(namespace).Get(context.TODO(), escrowKeySecretName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+	if err == nil {
+		return nil
+	}
+
+	raw := make([]byte, encryption.KeySize)
+	if _, err := rand.Read(raw); err != nil {
+		return errors.Wrap(err, "error generating the escrow key")
+	}
+
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      escrowKeySecretName,
+		},
+		Type: corev1api.SecretTypeOpaque,
+		Data: map[string][]byte{
+			escrowKeySecretKey: []byte(base64.StdEncoding.EncodeToString(raw)),
+		},
+	}
+
+	if _, err = secretClient.Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		return errors.Wrapf(err, "error creating %s secret", escrowKeySecretName)
+	}
+
+	return nil
+}
+
+// GetEscrowKey returns the key the keys of the backup repositories are wrapped
+// with before they're escrowed.
+func GetEscrowKey(ctx context.Context, kbClient client.Client, namespace string) ([]byte, error) {
+	secret := new(corev1api.Secret)
+	if err := kbClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: escrowKeySecretName}, secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting %s secret", escrowKeySecretName)
+	}
+
+	key, err := encryption.ParseKey(secret.Data[escrowKeySecretKey])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid escrow key in %s secret", escrowKeySecretName)
+	}
+	return key, nil
+}
+
+// WrapRepositoryKeys returns the encryption of the escrowed keys with the escrow key.
+func WrapRepositoryKeys(escrowKey []byte, escrowed *EscrowedKeys) (io.Reader, error) {
+	keyring, err := encryption.NewKeyring(escrowKey)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(escrowed)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding the escrowed keys")
+	}
+
+	wrapped, err := keyring.Encrypt(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, wrapped); err != nil {
+		return nil, errors.Wrap(err, "error wrapping the escrowed keys")
+	}
+	return bytes.NewReader(buf.Bytes()), nil
+}
+
+// UnwrapRepositoryKeys returns the escrowed keys decrypted with the escrow key.
+// It returns an error if the keys were wrapped with another escrow key.
+func UnwrapRepositoryKeys(escrowKey []byte, wrapped io.Reader) (*EscrowedKeys, error) {
+	keyring, err := encryption.NewKeyring(escrowKey)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, wrapped, err := encryption.IsEncrypted(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return nil, errors.New("escrowed keys aren't wrapped")
+	}
+
+	// the escrowed keys fail to decrypt if they were wrapped with another escrow key
+	unwrapped, err := keyring.Decrypt(wrapped)
+	if err != nil {
+		return nil, escrowKeyMismatch(err)
+	}
+	data, err := io.ReadAll(unwrapped)
+	if err != nil {
+		return nil, escrowKeyMismatch(err)
+	}
+
+	escrowed := new(EscrowedKeys)
+	if err := json.Unmarshal(data, escrowed); err != nil {
+		return nil, errors.Wrap(err, "error decoding the escrowed keys")
+	}
+	return escrowed, nil
+}
+
+func escrowKeyMismatch(err error) error {
+	return errors.Wrapf(err, "unable to unwrap the escrowed keys, they were wrapped with another escrow key than the one of the %s secret, "+
+		"copy the secret from the cluster that escrowed them", escrowKeySecretName)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keys
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestEnsureEscrowKey(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	require.NoError(t, EnsureEscrowKey(kubeClient.CoreV1(), velerov1api.DefaultNamespace))
+
+	secret, err := kubeClient.CoreV1().Secrets(velerov1api.DefaultNamespace).Get(context.TODO(), escrowKeySecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	key := secret.Data[escrowKeySecretKey]
+	assert.Len(t, key, 44)
+
+	// an existing escrow key is kept
+	require.NoError(t, EnsureEscrowKey(kubeClient.CoreV1(), velerov1api.DefaultNamespace))
+	secret, err = kubeClient.CoreV1().Secrets(velerov1api.DefaultNamespace).Get(context.TODO(), escrowKeySecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, key, secret.Data[escrowKeySecretKey])
+
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, secret)
+	escrowKey, err := GetEscrowKey(context.TODO(), kbClient, velerov1api.DefaultNamespace)
+	require.NoError(t, err)
+	assert.Len(t, escrowKey, 32)
+}
+
+func TestGetEscrowKey(t *testing.T) {
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+	_, err := GetEscrowKey(context.TODO(), kbClient, velerov1api.DefaultNamespace)
+	require.ErrorContains(t, err, "error getting velero-repo-keys-escrow secret")
+
+	kbClient = velerotest.NewFakeControllerRuntimeClient(t, builder.ForSecret(velerov1api.DefaultNamespace, escrowKeySecretName).
+		Data(map[string][]byte{escrowKeySecretKey: []byte("too-short")}).Result())
+	_, err = GetEscrowKey(context.TODO(), kbClient, velerov1api.DefaultNamespace)
+	require.ErrorContains(t, err, "invalid escrow key in velero-repo-keys-escrow secret")
+}
+
+func TestWrapRepositoryKeys(t *testing.T) {
+	escrowKey := []byte(strings.Repeat("a", 32))
+	escrowed := &EscrowedKeys{
+		Current: "repository-password-abcde",
+		Pending: "repository-password-fghij",
+		Keys: map[string]string{
+			"repository-password-abcde": "key-1",
+			"repository-password-fghij": "key-2",
+		},
+	}
+
+	wrapped, err := WrapRepositoryKeys(escrowKey, escrowed)
+	require.NoError(t, err)
+
+	unwrapped, err := UnwrapRepositoryKeys(escrowKey, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, escrowed, unwrapped)
+
+	// the escrowed keys can't be unwrapped with another escrow key
+	wrapped, err = WrapRepositoryKeys(escrowKey, escrowed)
+	require.NoError(t, err)
+	_, err = UnwrapRepositoryKeys([]byte(strings.Repeat("b", 32)), wrapped)
+	require.ErrorContains(t, err, "unable to unwrap the escrowed keys, they were wrapped with another escrow key than the one of the velero-repo-keys-escrow secret")
+
+	_, err = UnwrapRepositoryKeys(escrowKey, strings.NewReader(`{"current":"repository-password-abcde"}`))
+	require.EqualError(t, err, "escrowed keys aren't wrapped")
+}
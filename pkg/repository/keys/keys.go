@@ -19,14 +19,22 @@ package keys
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"reflect"
 
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/label"
 )
 
 const (
@@ -34,6 +42,9 @@ const (
 	credentialsKey        = "repository-password"
 
 	encryptionKey = "static-passw0rd"
+
+	repositoryKeySecretPrefix = "velero-repo-key-"
+	repositoryKeySize         = 32
 )
 
 func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespace string) error {
@@ -66,11 +77,133 @@ func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespac
 }
 
 // RepoKeySelector returns the SecretKeySelector which can be used to fetch
-// the backup repository key.
-func RepoKeySelector() *corev1api.SecretKeySelector {
-	// For now, all backup repos share the same key so we don't need the repoName to fetch it.
-	// When we move to full-backup encryption, we'll likely have a separate key per backup repo
-	// (all within the Velero server's namespace) so RepoKeySelector will need to select the key
-	// for that repo.
+// the key of the backup repository. The repositories that don't have a key of
+// their own use the key shared by all the repositories.
+func RepoKeySelector(repo *velerov1api.BackupRepository) *corev1api.SecretKeySelector {
+	if repo != nil && repo.Spec.RepositoryKeySecret != nil {
+		return repo.Spec.RepositoryKeySecret
+	}
 	return builder.ForSecretKeySelector(credentialsSecretName, credentialsKey).Result()
 }
+
+// RepositoryKeySecretName returns the name of the Secret holding the keys of
+// the backup repository.
+func RepositoryKeySecretName(repoName string) string {
+	return label.GetValidName(repositoryKeySecretPrefix + repoName)
+}
+
+// NewRepositoryKey generates a random key for the backup repository, adds it to
+// the Secret holding the keys of the repository, and returns the SecretKeySelector
+// of the new key. The Secret isn't owned by the repository, so the key outlives it.
+func NewRepositoryKey(ctx context.Context, kbClient client.Client, repo *velerov1api.BackupRepository) (*corev1api.SecretKeySelector, error) {
+	raw := make([]byte, repositoryKeySize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, errors.Wrap(err, "error generating repository key")
+	}
+
+	selector := builder.ForSecretKeySelector(
+		RepositoryKeySecretName(repo.Name),
+		fmt.Sprintf("%s-%s", credentialsKey, utilrand.String(5)),
+	).Result()
+
+	if err := addRepositoryKeys(ctx, kbClient, repo, map[string][]byte{
+		selector.Key: []byte(base64.StdEncoding.EncodeToString(raw)),
+	}); err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// RepositoryKeys returns the keys of the backup repository by name: the keys
+// held by the Secret holding the keys of the repository, and the key the
+// repository is protected with, if it's held by another Secret.
+func RepositoryKeys(ctx context.Context, kbClient client.Client, repo *velerov1api.BackupRepository) (map[string]string, error) {
+	keys := map[string]string{}
+
+	name := RepositoryKeySecretName(repo.Name)
+	secret := new(corev1api.Secret)
+	err := kbClient.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: name}, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, errors.WithStack(err)
+	}
+	for key, value := range secret.Data {
+		keys[key] = string(value)
+	}
+
+	if selector := repo.Spec.RepositoryKeySecret; selector != nil && selector.Name != name {
+		secret := new(corev1api.Secret)
+		if err := kbClient.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: selector.Name}, secret); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		value, ok := secret.Data[selector.Key]
+		if !ok {
+			return nil, errors.Errorf("key %s not found in %s secret", selector.Key, selector.Name)
+		}
+		keys[selector.Key] = string(value)
+	}
+
+	return keys, nil
+}
+
+// ImportRepositoryKeys adds the escrowed keys of the backup repository to the
+// Secret holding the keys of the repository, and returns the SecretKeySelector
+// of the key the repository is protected with, or nil if the repository uses
+// the key shared by all the repositories.
+func ImportRepositoryKeys(ctx context.Context, kbClient client.Client, repo *velerov1api.BackupRepository, escrowed *EscrowedKeys) (*corev1api.SecretKeySelector, error) {
+	if escrowed.Current != "" {
+		if _, ok := escrowed.Keys[escrowed.Current]; !ok {
+			return nil, errors.Errorf("escrowed keys don't include the current key %s", escrowed.Current)
+		}
+	}
+
+	keys := make(map[string][]byte, len(escrowed.Keys))
+	for name, value := range escrowed.Keys {
+		keys[name] = []byte(value)
+	}
+	if err := addRepositoryKeys(ctx, kbClient, repo, keys); err != nil {
+		return nil, err
+	}
+
+	if escrowed.Current == "" {
+		return nil, nil
+	}
+	return builder.ForSecretKeySelector(RepositoryKeySecretName(repo.Name), escrowed.Current).Result(), nil
+}
+
+// addRepositoryKeys adds the keys to the Secret holding the keys of the backup
+// repository, creating it if it doesn't exist. The keys already in the Secret
+// are kept.
+func addRepositoryKeys(ctx context.Context, kbClient client.Client, repo *velerov1api.BackupRepository, keys map[string][]byte) error {
+	name := RepositoryKeySecretName(repo.Name)
+
+	secret := new(corev1api.Secret)
+	err := kbClient.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		secret = builder.ForSecret(repo.Namespace, name).
+			ObjectMeta(builder.WithLabelsMap(repo.Labels)).
+			Data(keys).
+			Result()
+		if err := kbClient.Create(ctx, secret); err != nil {
+			return errors.Wrapf(err, "error creating %s secret", name)
+		}
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	original := secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range keys {
+		secret.Data[key] = value
+	}
+	if reflect.DeepEqual(secret.Data, original.Data) {
+		return nil
+	}
+	if err := kbClient.Patch(ctx, secret, client.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error adding keys to %s secret", name)
+	}
+	return nil
+}
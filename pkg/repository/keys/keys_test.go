@@ -17,14 +17,111 @@ limitations under the License.
 package keys
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestRepoKeySelector(t *testing.T) {
-	selector := RepoKeySelector()
+	selector := RepoKeySelector(nil)
 
 	require.Equal(t, credentialsSecretName, selector.Name)
 	require.Equal(t, credentialsKey, selector.Key)
+
+	repo := &velerov1api.BackupRepository{}
+	require.Equal(t, credentialsSecretName, RepoKeySelector(repo).Name)
+
+	repo.Spec.RepositoryKeySecret = builder.ForSecretKeySelector("velero-repo-key-repo-1", "repository-password-abcde").Result()
+	require.Equal(t, repo.Spec.RepositoryKeySecret, RepoKeySelector(repo))
+}
+
+func TestNewRepositoryKey(t *testing.T) {
+	repo := &velerov1api.BackupRepository{}
+	repo.Namespace = velerov1api.DefaultNamespace
+	repo.Name = "repo-1"
+	repo.Labels = map[string]string{velerov1api.StorageLocationLabel: "default"}
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+
+	getSecret := func() *corev1api.Secret {
+		secret := new(corev1api.Secret)
+		require.NoError(t, kbClient.Get(context.TODO(), client.ObjectKey{Namespace: repo.Namespace, Name: "velero-repo-key-repo-1"}, secret))
+		return secret
+	}
+
+	first, err := NewRepositoryKey(context.TODO(), kbClient, repo)
+	require.NoError(t, err)
+	assert.Equal(t, "velero-repo-key-repo-1", first.Name)
+	secret := getSecret()
+	assert.Equal(t, "default", secret.Labels[velerov1api.StorageLocationLabel])
+	require.Len(t, secret.Data, 1)
+	assert.Len(t, secret.Data[first.Key], 44)
+
+	second, err := NewRepositoryKey(context.TODO(), kbClient, repo)
+	require.NoError(t, err)
+	assert.Equal(t, first.Name, second.Name)
+	assert.NotEqual(t, first.Key, second.Key)
+	secret = getSecret()
+	require.Len(t, secret.Data, 2)
+	assert.NotEqual(t, secret.Data[first.Key], secret.Data[second.Key])
+
+	keys, err := RepositoryKeys(context.TODO(), kbClient, repo)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		first.Key:  string(secret.Data[first.Key]),
+		second.Key: string(secret.Data[second.Key]),
+	}, keys)
+
+	// the key the repository is protected with can be held by another secret
+	require.NoError(t, kbClient.Create(context.TODO(), builder.ForSecret(repo.Namespace, "custom-key").
+		Data(map[string][]byte{"password": []byte("custom")}).Result()))
+	repo.Spec.RepositoryKeySecret = builder.ForSecretKeySelector("custom-key", "password").Result()
+	keys, err = RepositoryKeys(context.TODO(), kbClient, repo)
+	require.NoError(t, err)
+	assert.Len(t, keys, 3)
+	assert.Equal(t, "custom", keys["password"])
+}
+
+func TestImportRepositoryKeys(t *testing.T) {
+	repo := &velerov1api.BackupRepository{}
+	repo.Namespace = velerov1api.DefaultNamespace
+	repo.Name = "repo-1"
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+
+	// the repositories using the shared key have no key of their own
+	selector, err := ImportRepositoryKeys(context.TODO(), kbClient, repo, &EscrowedKeys{})
+	require.NoError(t, err)
+	assert.Nil(t, selector)
+
+	selector, err = ImportRepositoryKeys(context.TODO(), kbClient, repo, &EscrowedKeys{
+		Current: "repository-password-abcde",
+		Keys:    map[string]string{"repository-password-abcde": "key-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, builder.ForSecretKeySelector("velero-repo-key-repo-1", "repository-password-abcde").Result(), selector)
+
+	// the keys already in the secret are kept
+	selector, err = ImportRepositoryKeys(context.TODO(), kbClient, repo, &EscrowedKeys{
+		Current: "repository-password-fghij",
+		Keys:    map[string]string{"repository-password-fghij": "key-2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "repository-password-fghij", selector.Key)
+
+	keys, err := RepositoryKeys(context.TODO(), kbClient, repo)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"repository-password-abcde": "key-1", "repository-password-fghij": "key-2"}, keys)
+
+	_, err = ImportRepositoryKeys(context.TODO(), kbClient, repo, &EscrowedKeys{
+		Current: "repository-password-klmno",
+		Keys:    map[string]string{"repository-password-abcde": "key-1"},
+	})
+	require.EqualError(t, err, "escrowed keys don't include the current key repository-password-klmno")
 }
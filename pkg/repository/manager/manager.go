@@ -23,6 +23,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
@@ -59,6 +60,11 @@ type Manager interface {
 	// available snapshots in a repo.
 	BatchForget(context.Context, *velerov1api.BackupRepository, []string) []error
 
+	// ChangeKey changes the password of a repo to the one held by the new key. Unlike
+	// the other operations, it doesn't lock the repo: the caller holds the exclusive
+	// lock of the repo until the repo is switched to the new key.
+	ChangeKey(repo *velerov1api.BackupRepository, newKey *corev1api.SecretKeySelector) error
+
 	// DefaultMaintenanceFrequency returns the default maintenance frequency from the specific repo
 	DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error)
 }
@@ -221,6 +227,19 @@ func (m *manager) BatchForget(ctx context.Context, repo *velerov1api.BackupRepos
 	return prd.BatchForget(context.Background(), snapshots, param)
 }
 
+func (m *manager) ChangeKey(repo *velerov1api.BackupRepository, newKey *corev1api.SecretKeySelector) error {
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return prd.ChangeKey(context.Background(), param, newKey)
+}
+
 func (m *manager) DefaultMaintenanceFrequency(repo *velerov1api.BackupRepository) (time.Duration, error) {
 	prd, err := m.getRepositoryProvider(repo)
 	if err != nil {
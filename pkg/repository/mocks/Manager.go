@@ -9,6 +9,8 @@ import (
 
 	time "time"
 
+	corev1 "k8s.io/api/core/v1"
+
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
@@ -37,6 +39,24 @@ func (_m *Manager) BatchForget(_a0 context.Context, _a1 *v1.BackupRepository, _a
 	return r0
 }
 
+// ChangeKey provides a mock function with given fields: repo, newKey
+func (_m *Manager) ChangeKey(repo *v1.BackupRepository, newKey *corev1.SecretKeySelector) error {
+	ret := _m.Called(repo, newKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository, *corev1.SecretKeySelector) error); ok {
+		r0 = rf(repo, newKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ConnectToRepo provides a mock function with given fields: repo
 func (_m *Manager) ConnectToRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
@@ -20,6 +20,8 @@ import (
 	"context"
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
+
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
@@ -60,6 +62,10 @@ type Provider interface {
 	// BatchForget is to delete a list of snapshots from the repository
 	BatchForget(ctx context.Context, snapshotIDs []string, param RepoParam) []error
 
+	// ChangeKey changes the password of the repository to the one held by the new key,
+	// the snapshots in the repository stay readable with the new password
+	ChangeKey(ctx context.Context, param RepoParam, newKey *corev1api.SecretKeySelector) error
+
 	// DefaultMaintenanceFrequency returns the default frequency to run maintenance
 	DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration
 }
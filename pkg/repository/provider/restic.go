@@ -21,7 +21,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/pkg/repository/restic"
@@ -90,6 +92,10 @@ func (r *resticRepositoryProvider) BatchForget(ctx context.Context, snapshotIDs
 	return errs
 }
 
+func (r *resticRepositoryProvider) ChangeKey(ctx context.Context, param RepoParam, newKey *corev1api.SecretKeySelector) error {
+	return errors.New("changing the key of restic repositories isn't supported")
+}
+
 func (r *resticRepositoryProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return r.svc.DefaultMaintenanceFrequency()
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/kopia/kopia/repo"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -63,8 +64,9 @@ var funcTable = localFuncTable{
 }
 
 const (
-	repoOpDescMaintain = "repo maintenance"
-	repoOpDescForget   = "forget"
+	repoOpDescMaintain  = "repo maintenance"
+	repoOpDescForget    = "forget"
+	repoOpDescChangeKey = "change key"
 
 	repoConnectDesc = "unified repo"
 )
@@ -372,17 +374,71 @@ func (urp *unifiedRepoProvider) BatchForget(ctx context.Context, snapshotIDs []s
 	return errs
 }
 
+func (urp *unifiedRepoProvider) ChangeKey(ctx context.Context, param RepoParam, newKey *corev1api.SecretKeySelector) error {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":  param.BackupLocation.Name,
+		"repo name": param.BackupRepo.Name,
+		"repo UID":  param.BackupRepo.UID,
+	})
+
+	log.Debug("Start to change repo key")
+
+	if param.BackupLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return errors.Errorf("cannot change the key of backup repo for read-only backup storage location %s/%s", param.BackupLocation.Namespace, param.BackupLocation.Name)
+	}
+
+	newPassword, err := getRepoPassword(urp.credentialGetter.FromSecret, newKey)
+	if err != nil {
+		return errors.Wrap(err, "error to get new repo password")
+	}
+
+	if err := urp.ConnectToRepo(ctx, param); err != nil {
+		// a previous change may have completed without the repo being moved to the new key,
+		// then the repo can only be connected with the new key
+		newParam := RepoParam{
+			BackupLocation: param.BackupLocation,
+			BackupRepo:     param.BackupRepo.DeepCopy(),
+		}
+		newParam.BackupRepo.Spec.RepositoryKeySecret = newKey
+		if urp.ConnectToRepo(ctx, newParam) == nil {
+			log.Info("Repo key has already been changed")
+			return nil
+		}
+
+		return err
+	}
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescChangeKey),
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "error to get repo options")
+	}
+
+	err = urp.repoService.ChangePassword(ctx, *repoOption, newPassword)
+	if err != nil {
+		return errors.Wrap(err, "error to change repo key")
+	}
+
+	log.Debug("Change repo key complete")
+
+	return nil
+}
+
 func (urp *unifiedRepoProvider) DefaultMaintenanceFrequency(ctx context.Context, param RepoParam) time.Duration {
 	return urp.repoService.DefaultMaintenanceFrequency()
 }
 
 func (urp *unifiedRepoProvider) GetPassword(param any) (string, error) {
-	_, ok := param.(RepoParam)
+	repoParam, ok := param.(RepoParam)
 	if !ok {
 		return "", errors.Errorf("invalid parameter, expect %T, actual %T", RepoParam{}, param)
 	}
 
-	repoPassword, err := getRepoPassword(urp.credentialGetter.FromSecret)
+	repoPassword, err := getRepoPassword(urp.credentialGetter.FromSecret, repokey.RepoKeySelector(repoParam.BackupRepo))
 	if err != nil {
 		return "", errors.Wrap(err, "error to get repo password")
 	}
@@ -427,12 +483,12 @@ func (urp *unifiedRepoProvider) GetStoreOptions(param any) (map[string]string, e
 	return storeOptions, nil
 }
 
-func getRepoPassword(secretStore credentials.SecretStore) (string, error) {
+func getRepoPassword(secretStore credentials.SecretStore, selector *corev1api.SecretKeySelector) (string, error) {
 	if secretStore == nil {
 		return "", errors.New("invalid credentials interface")
 	}
 
-	rawPass, err := secretStore.Get(repokey.RepoKeySelector())
+	rawPass, err := secretStore.Get(selector)
 	if err != nil {
 		return "", errors.Wrap(err, "error to get password")
 	}
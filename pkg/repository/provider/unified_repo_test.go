@@ -34,6 +34,7 @@ import (
 	velerocredentials "github.com/vmware-tanzu/velero/internal/credentials"
 	credmock "github.com/vmware-tanzu/velero/internal/credentials/mocks"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
 	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
 	reposervicenmocks "github.com/vmware-tanzu/velero/pkg/repository/udmrepo/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -521,7 +522,7 @@ func TestGetRepoPassword(t *testing.T) {
 				},
 			}
 
-			password, err := getRepoPassword(urp.credentialGetter.FromSecret)
+			password, err := getRepoPassword(urp.credentialGetter.FromSecret, repokey.RepoKeySelector(nil))
 
 			require.Equal(t, tc.expected, password)
 
@@ -789,6 +790,127 @@ func TestPrepareRepo(t *testing.T) {
 	}
 }
 
+func TestChangeKey(t *testing.T) {
+	bsl := velerov1api.BackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-bsl",
+			Namespace: velerov1api.DefaultNamespace,
+		},
+	}
+	newKey := &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: "velero-repo-key-fake-repo"},
+		Key:                  "repository-password-new",
+	}
+	storageFuncTable := localFuncTable{
+		getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string, map[string]string) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+		getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		readOnlyBSL         bool
+		newPasswordError    error
+		retFuncInit         func(context.Context, udmrepo.RepoOptions, bool) error
+		changePasswordError error
+		expectChange        bool
+		expectedErr         string
+	}{
+		{
+			name:        "bsl is readonly",
+			readOnlyBSL: true,
+			expectedErr: "cannot change the key of backup repo for read-only backup storage location velero/fake-bsl",
+		},
+		{
+			name:             "get new password fail",
+			newPasswordError: errors.New("fake-password-error"),
+			expectedErr:      "error to get new repo password: error to get password: fake-password-error",
+		},
+		{
+			name: "connect fail",
+			retFuncInit: func(context.Context, udmrepo.RepoOptions, bool) error {
+				return errors.New("fake-connect-error")
+			},
+			expectedErr: "error to connect backup repo: fake-connect-error",
+		},
+		{
+			name: "key already changed",
+			retFuncInit: func(ctx context.Context, repoOption udmrepo.RepoOptions, createNew bool) error {
+				if repoOption.RepoPassword == "new-password" {
+					return nil
+				}
+				return errors.New("fake-connect-error")
+			},
+		},
+		{
+			name: "change password fail",
+			retFuncInit: func(context.Context, udmrepo.RepoOptions, bool) error {
+				return nil
+			},
+			changePasswordError: errors.New("fake-change-error"),
+			expectChange:        true,
+			expectedErr:         "error to change repo key: fake-change-error",
+		},
+		{
+			name: "succeed",
+			retFuncInit: func(context.Context, udmrepo.RepoOptions, bool) error {
+				return nil
+			},
+			expectChange: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			funcTable = storageFuncTable
+
+			secretStore := new(credmock.SecretStore)
+			secretStore.On("Get", repokey.RepoKeySelector(nil)).Return("old-password", nil)
+			secretStore.On("Get", newKey).Return("new-password", tc.newPasswordError)
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			repoService.On("Init", mock.Anything, mock.Anything, false).Return(tc.retFuncInit)
+			repoService.On("ChangePassword", mock.Anything, mock.MatchedBy(func(repoOption udmrepo.RepoOptions) bool {
+				return repoOption.RepoPassword == "old-password"
+			}), "new-password").Return(tc.changePasswordError)
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: secretStore,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			if tc.readOnlyBSL {
+				bsl.Spec.AccessMode = velerov1api.BackupStorageLocationAccessModeReadOnly
+			} else {
+				bsl.Spec.AccessMode = velerov1api.BackupStorageLocationAccessModeReadWrite
+			}
+
+			err := urp.ChangeKey(t.Context(), RepoParam{
+				BackupLocation: &bsl,
+				BackupRepo:     &velerov1api.BackupRepository{},
+			}, newKey)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+
+			if tc.expectChange {
+				repoService.AssertCalled(t, "ChangePassword", mock.Anything, mock.Anything, "new-password")
+			} else {
+				repoService.AssertNotCalled(t, "ChangePassword", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
 func TestForget(t *testing.T) {
 	var backupRepo *reposervicenmocks.BackupRepo
 
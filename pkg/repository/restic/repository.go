@@ -46,7 +46,7 @@ type RepositoryService struct {
 }
 
 func (r *RepositoryService) InitRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
-	return r.exec(restic.InitCommand(repo.Spec.ResticIdentifier), bsl)
+	return r.exec(restic.InitCommand(repo.Spec.ResticIdentifier), bsl, repo)
 }
 
 func (r *RepositoryService) ConnectToRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
@@ -57,27 +57,27 @@ func (r *RepositoryService) ConnectToRepo(bsl *velerov1api.BackupStorageLocation
 	// "--last" is replaced by "--latest=1" in restic v0.12.1
 	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--latest=1")
 
-	return r.exec(snapshotsCmd, bsl)
+	return r.exec(snapshotsCmd, bsl, repo)
 }
 
 func (r *RepositoryService) PruneRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
-	return r.exec(restic.PruneCommand(repo.Spec.ResticIdentifier), bsl)
+	return r.exec(restic.PruneCommand(repo.Spec.ResticIdentifier), bsl, repo)
 }
 
 func (r *RepositoryService) UnlockRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
-	return r.exec(restic.UnlockCommand(repo.Spec.ResticIdentifier), bsl)
+	return r.exec(restic.UnlockCommand(repo.Spec.ResticIdentifier), bsl, repo)
 }
 
 func (r *RepositoryService) Forget(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository, snapshotID string) error {
-	return r.exec(restic.ForgetCommand(repo.Spec.ResticIdentifier, snapshotID), bsl)
+	return r.exec(restic.ForgetCommand(repo.Spec.ResticIdentifier, snapshotID), bsl, repo)
 }
 
 func (r *RepositoryService) DefaultMaintenanceFrequency() time.Duration {
 	return restic.DefaultMaintenanceFrequency
 }
 
-func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation) error {
-	file, err := r.credentialsFileStore.Path(repokey.RepoKeySelector())
+func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
+	file, err := r.credentialsFileStore.Path(repokey.RepoKeySelector(repo))
 	if err != nil {
 		return err
 	}
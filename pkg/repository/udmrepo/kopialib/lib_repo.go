@@ -207,6 +207,45 @@ func (ks *kopiaRepoService) Maintain(ctx context.Context, repoOption udmrepo.Rep
 	return nil
 }
 
+func (ks *kopiaRepoService) ChangePassword(ctx context.Context, repoOption udmrepo.RepoOptions, newPassword string) error {
+	repoConfig := repoOption.ConfigFilePath
+	if repoConfig == "" {
+		return errors.New("invalid config file path")
+	}
+
+	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
+		return errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+	}
+
+	repoCtx := kopia.SetupKopiaLog(ctx, ks.logger)
+
+	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		c := r.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	// the password only wraps the format blob, so changing it re-encrypts the format blob
+	// and leaves the contents of the repository untouched
+	err = repo.DirectWriteSession(repoCtx, r.(repo.DirectRepository), repo.WriteSessionOptions{
+		Purpose: "UdmRepoChangePassword",
+	}, func(ctx context.Context, dw repo.DirectRepositoryWriter) error {
+		return dw.FormatManager().ChangePassword(ctx, newPassword)
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error to change repo password")
+	}
+
+	return nil
+}
+
 func (ks *kopiaRepoService) DefaultMaintenanceFrequency() time.Duration {
 	return defaultMaintainCheckPeriod
 }
@@ -264,6 +264,80 @@ func TestMaintain(t *testing.T) {
 	}
 }
 
+func TestChangePassword(t *testing.T) {
+	var directRpo *repomocks.DirectRepository
+	testCases := []struct {
+		name               string
+		repoOptions        udmrepo.RepoOptions
+		returnRepo         *repomocks.DirectRepository
+		repoOpen           func(context.Context, string, string, *repo.Options) (repo.Repository, error)
+		newRepoWriterError error
+		expectedErr        string
+	}{
+		{
+			name:        "invalid config file",
+			expectedErr: "invalid config file path",
+		},
+		{
+			name: "config file doesn't exist",
+			repoOptions: udmrepo.RepoOptions{
+				ConfigFilePath: "fake-file",
+			},
+			expectedErr: "repo config fake-file doesn't exist: stat fake-file: no such file or directory",
+		},
+		{
+			name: "repo open fail",
+			repoOptions: udmrepo.RepoOptions{
+				ConfigFilePath: "/tmp",
+			},
+			repoOpen: func(context.Context, string, string, *repo.Options) (repo.Repository, error) {
+				return nil, errors.New("fake-repo-open-error")
+			},
+			expectedErr: "error to open repo: fake-repo-open-error",
+		},
+		{
+			name: "write session fail",
+			repoOptions: udmrepo.RepoOptions{
+				ConfigFilePath: "/tmp",
+			},
+			repoOpen: func(context.Context, string, string, *repo.Options) (repo.Repository, error) {
+				return directRpo, nil
+			},
+			returnRepo:         new(repomocks.DirectRepository),
+			newRepoWriterError: errors.New("fake-new-direct-writer-error"),
+			expectedErr:        "error to change repo password: unable to create direct writer: fake-new-direct-writer-error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			service := kopiaRepoService{
+				logger: velerotest.NewLogger(),
+			}
+
+			if tc.repoOpen != nil {
+				kopiaRepoOpen = tc.repoOpen
+			}
+
+			if tc.returnRepo != nil {
+				directRpo = tc.returnRepo
+				tc.returnRepo.On("NewDirectWriter", mock.Anything, mock.Anything).Return(ctx, nil, tc.newRepoWriterError)
+				tc.returnRepo.On("Close", mock.Anything).Return(nil)
+			}
+
+			err := service.ChangePassword(ctx, tc.repoOptions, "fake-new-password")
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestWriteInitParameters(t *testing.T) {
 	var directRpo *repomocks.DirectRepository
 	assertFullMaintIntervalEqual := func(expected, actual *maintenance.Params) bool {
@@ -16,6 +16,24 @@ type BackupRepoService struct {
 	mock.Mock
 }
 
+// ChangePassword provides a mock function with given fields: ctx, repoOption, newPassword
+func (_m *BackupRepoService) ChangePassword(ctx context.Context, repoOption udmrepo.RepoOptions, newPassword string) error {
+	ret := _m.Called(ctx, repoOption, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions, string) error); ok {
+		r0 = rf(ctx, repoOption, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DefaultMaintenanceFrequency provides a mock function with given fields:
 func (_m *BackupRepoService) DefaultMaintenanceFrequency() time.Duration {
 	ret := _m.Called()
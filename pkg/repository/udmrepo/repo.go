@@ -90,6 +90,12 @@ type BackupRepoService interface {
 	// repoOption: options to maintain the backup repository.
 	Maintain(ctx context.Context, repoOption RepoOptions) error
 
+	// ChangePassword changes the password of a backup repository that has been created/connected,
+	// the data in the repository stays readable with the new password.
+	// repoOption: options to open the backup repository with the current password.
+	// newPassword: the new password of the backup repository.
+	ChangePassword(ctx context.Context, repoOption RepoOptions, newPassword string) error
+
 	// DefaultMaintenanceFrequency returns the defgault frequency of maintenance, callers refer this
 	// frequency to maintain the backup repository to get the best maintenance performance
 	DefaultMaintenanceFrequency() time.Duration
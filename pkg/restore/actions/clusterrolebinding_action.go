@@ -24,9 +24,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
-// ClusterRoleBindingAction handle namespace remappings for role bindings
+// ClusterRoleBindingAction handle namespace remappings and renamed roles and service accounts for cluster role bindings
 type ClusterRoleBindingAction struct {
 	logger logrus.FieldLogger
 }
@@ -42,8 +43,7 @@ func (a *ClusterRoleBindingAction) AppliesTo() (velero.ResourceSelector, error)
 }
 
 func (a *ClusterRoleBindingAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
-	namespaceMapping := input.Restore.Spec.NamespaceMapping
-	if len(namespaceMapping) == 0 {
+	if !pkgrestoreUtil.HasNamespaceMapping(input.Restore) && !pkgrestoreUtil.HasNameMapping(input.Restore) {
 		return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: input.Item.UnstructuredContent()}), nil
 	}
 
@@ -52,11 +52,8 @@ func (a *ClusterRoleBindingAction) Execute(input *velero.RestoreItemActionExecut
 		return nil, errors.WithStack(err)
 	}
 
-	for i, subject := range clusterRoleBinding.Subjects {
-		if newNamespace, ok := namespaceMapping[subject.Namespace]; ok {
-			clusterRoleBinding.Subjects[i].Namespace = newNamespace
-		}
-	}
+	mapRoleRef(input.Restore, "", &clusterRoleBinding.RoleRef)
+	mapSubjects(input.Restore, clusterRoleBinding.Subjects)
 
 	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(clusterRoleBinding)
 	if err != nil {
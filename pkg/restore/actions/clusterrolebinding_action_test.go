@@ -43,6 +43,7 @@ func TestClusterRoleBindingActionExecute(t *testing.T) {
 		name             string
 		namespaces       []string
 		namespaceMapping map[string]string
+		mappingRules     []api.NamespaceMappingRule
 		expected         []string
 	}{
 		{
@@ -69,6 +70,13 @@ func TestClusterRoleBindingActionExecute(t *testing.T) {
 			namespaceMapping: map[string]string{"a": "b", "c": "d"},
 			expected:         []string{"foo", "xyz"},
 		},
+		{
+			name:             "namespace mapping rules enabled, exact namespace mapping takes precedence",
+			namespaces:       []string{"foo", "team-a", "xyz"},
+			namespaceMapping: map[string]string{"foo": "bar"},
+			mappingRules:     []api.NamespaceMappingRule{{Source: "team-*", Target: "team-*-dr"}, {Source: "^f(.*)$", Target: "f$1-restored"}},
+			expected:         []string{"bar", "team-a-dr", "xyz"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -94,7 +102,8 @@ func TestClusterRoleBindingActionExecute(t *testing.T) {
 				ItemFromBackup: &unstructured.Unstructured{Object: roleBindingUnstructured},
 				Restore: &api.Restore{
 					Spec: api.RestoreSpec{
-						NamespaceMapping: tc.namespaceMapping,
+						NamespaceMapping:      tc.namespaceMapping,
+						NamespaceMappingRules: tc.mappingRules,
 					},
 				},
 			})
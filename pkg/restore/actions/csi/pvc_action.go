@@ -44,6 +44,7 @@ import (
 	uploaderUtil "github.com/vmware-tanzu/velero/pkg/uploader/util"
 	"github.com/vmware-tanzu/velero/pkg/util"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
 const (
@@ -99,11 +100,7 @@ func (p *pvcRestoreItemAction) Execute(
 
 	// If cross-namespace restore is configured, change the namespace
 	// for PVC object to be restored
-	newNamespace, ok := input.Restore.Spec.NamespaceMapping[pvc.GetNamespace()]
-	if !ok {
-		// Use original namespace
-		newNamespace = pvc.Namespace
-	}
+	newNamespace, _ := pkgrestoreUtil.MapNamespace(input.Restore, pvc.GetNamespace())
 
 	operationID := ""
 
@@ -154,6 +151,12 @@ func (p *pvcRestoreItemAction) Execute(
 			}
 			logger.Infof("DataDownload %s/%s is created successfully.",
 				dataDownload.Namespace, dataDownload.Name)
+
+			// the DataDownload of a dry-run restore is created with server-side dry run,
+			// there's no operation to track
+			if boolptr.IsSetToTrue(input.Restore.Spec.DryRun) {
+				operationID = ""
+			}
 		} else {
 			//CSI restore
 			vsName, nameOK := pvcFromBackup.Annotations[velerov1api.VolumeSnapshotLabel]
@@ -400,6 +403,8 @@ func newDataDownload(
 	pvc *corev1api.PersistentVolumeClaim,
 	newNamespace, operationID string,
 ) *velerov2alpha1.DataDownload {
+	// the PVC is restored with its new name if the restore renames it
+	targetName, _ := pkgrestoreUtil.MapName(restore, kuberesource.PersistentVolumeClaims, pvc.Namespace, pvc.Name)
 	dataDownload := &velerov2alpha1.DataDownload{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
@@ -425,7 +430,7 @@ func newDataDownload(
 		},
 		Spec: velerov2alpha1.DataDownloadSpec{
 			TargetVolume: velerov2alpha1.TargetVolumeSpec{
-				PVC:       pvc.Name,
+				PVC:       targetName,
 				Namespace: newNamespace,
 			},
 			BackupStorageLocation: dataUploadResult.BackupStorageLocation,
@@ -474,7 +479,11 @@ func restoreFromDataUploadResult(
 		newNamespace,
 		operationID,
 	)
-	err = crClient.Create(ctx, dataDownload)
+	var opts []crclient.CreateOption
+	if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		opts = append(opts, crclient.DryRunAll)
+	}
+	err = crClient.Create(ctx, dataDownload, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "fail to create DataDownload")
 	}
@@ -486,17 +495,15 @@ func (p *pvcRestoreItemAction) isResourceExist(
 	pvc corev1api.PersistentVolumeClaim,
 	restore velerov1api.Restore,
 ) bool {
-	// get target namespace to restore into, if different from source namespace
-	targetNamespace := pvc.Namespace
-	if target, ok := restore.Spec.NamespaceMapping[pvc.Namespace]; ok {
-		targetNamespace = target
-	}
+	// get target namespace and name to restore into, if different from the source ones
+	targetNamespace, _ := pkgrestoreUtil.MapNamespace(&restore, pvc.Namespace)
+	targetName, _ := pkgrestoreUtil.MapName(&restore, kuberesource.PersistentVolumeClaims, pvc.Namespace, pvc.Name)
 
 	tmpPVC := new(corev1api.PersistentVolumeClaim)
 	if err := p.crClient.Get(
 		context.Background(),
 		crclient.ObjectKey{
-			Name:      pvc.Name,
+			Name:      targetName,
 			Namespace: targetNamespace,
 		},
 		tmpPVC,
@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/csi"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
 // volumeSnapshotContentRestoreItemAction is a restore item action
@@ -81,7 +82,7 @@ func (p *volumeSnapshotContentRestoreItemAction) Execute(
 
 	// If cross-namespace restore is configured, change the namespace
 	// for VolumeSnapshot object to be restored
-	newNamespace, ok := input.Restore.Spec.NamespaceMapping[vsc.Spec.VolumeSnapshotRef.Namespace]
+	newNamespace, ok := pkgrestoreUtil.MapNamespace(input.Restore, vsc.Spec.VolumeSnapshotRef.Namespace)
 	if ok {
 		// Update the referenced VS namespace to the mapping one.
 		vsc.Spec.VolumeSnapshotRef.Namespace = newNamespace
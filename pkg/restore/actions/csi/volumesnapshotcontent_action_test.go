@@ -105,6 +105,18 @@ func TestVSCExecute(t *testing.T) {
 				DeletionPolicy(snapshotv1api.VolumeSnapshotContentRetain).
 				Status(&snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &snapshotHandleName}).Result(),
 		},
+		{
+			name: "VolumeSnapshotRef namespace is remapped by a namespace mapping rule",
+			vsc: builder.ForVolumeSnapshotContent("test").VolumeSnapshotRef("team-a", "vsName", "vsUID").
+				Status(&snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &snapshotHandleName}).Result(),
+			restore: builder.ForRestore("velero", "restore").ObjectMeta(builder.WithUID("restoreUID")).
+				NamespaceMappingRules(velerov1api.NamespaceMappingRule{Source: "team-*", Target: "team-*-dr"}).Result(),
+			expectErr: false,
+			expectedVSC: builder.ForVolumeSnapshotContent(newVscName).VolumeSnapshotRef("team-a-dr", newVscName, "").
+				Source(snapshotv1api.VolumeSnapshotContentSource{SnapshotHandle: &snapshotHandleName}).
+				DeletionPolicy(snapshotv1api.VolumeSnapshotContentRetain).
+				Status(&snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &snapshotHandleName}).Result(),
+		},
 	}
 
 	for _, test := range tests {
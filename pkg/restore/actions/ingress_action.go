@@ -0,0 +1,84 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	networkingv1api "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
+)
+
+// IngressAction rewrites the backend services and the TLS secrets of ingresses that are renamed
+// by the restore's name mapping.
+type IngressAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewIngressAction(logger logrus.FieldLogger) *IngressAction {
+	return &IngressAction{logger: logger}
+}
+
+func (a *IngressAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"ingresses.networking.k8s.io"},
+	}, nil
+}
+
+func (a *IngressAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !pkgrestoreUtil.HasNameMapping(input.Restore) || input.Item.GetObjectKind().GroupVersionKind().Version != "v1" {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	ingress := new(networkingv1api.Ingress)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(input.Item.UnstructuredContent(), ingress); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	mapIngressBackend(input.Restore, ingress.Namespace, ingress.Spec.DefaultBackend)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for i := range rule.HTTP.Paths {
+			mapIngressBackend(input.Restore, ingress.Namespace, &rule.HTTP.Paths[i].Backend)
+		}
+	}
+	for i, tls := range ingress.Spec.TLS {
+		ingress.Spec.TLS[i].SecretName, _ = pkgrestoreUtil.MapName(input.Restore, kuberesource.Secrets, ingress.Namespace, tls.SecretName)
+	}
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ingress)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
+}
+
+func mapIngressBackend(restore *velerov1api.Restore, namespace string, backend *networkingv1api.IngressBackend) {
+	if backend == nil || backend.Service == nil {
+		return
+	}
+	backend.Service.Name, _ = pkgrestoreUtil.MapName(restore, kuberesource.Services, namespace, backend.Service.Name)
+}
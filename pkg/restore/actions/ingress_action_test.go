@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1api "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestIngressActionAppliesTo(t *testing.T) {
+	action := NewIngressAction(velerotest.NewLogger())
+	actual, err := action.AppliesTo()
+	require.NoError(t, err)
+	assert.Equal(t, velero.ResourceSelector{IncludedResources: []string{"ingresses.networking.k8s.io"}}, actual)
+}
+
+func TestIngressActionExecute(t *testing.T) {
+	backend := func(service string) networkingv1api.IngressBackend {
+		return networkingv1api.IngressBackend{
+			Service: &networkingv1api.IngressServiceBackend{Name: service, Port: networkingv1api.ServiceBackendPort{Number: 80}},
+		}
+	}
+	ingress := func(defaultService, pathService, secret string) *networkingv1api.Ingress {
+		defaultBackend := backend(defaultService)
+		return &networkingv1api.Ingress{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ingress-1"},
+			Spec: networkingv1api.IngressSpec{
+				DefaultBackend: &defaultBackend,
+				TLS:            []networkingv1api.IngressTLS{{Hosts: []string{"example.com"}, SecretName: secret}},
+				Rules: []networkingv1api.IngressRule{
+					{
+						Host: "example.com",
+						IngressRuleValue: networkingv1api.IngressRuleValue{
+							HTTP: &networkingv1api.HTTPIngressRuleValue{
+								Paths: []networkingv1api.HTTPIngressPath{{Path: "/", Backend: backend(pathService)}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mapping  *velerov1api.RestoreNameMapping
+		expected *networkingv1api.Ingress
+	}{
+		{
+			name:     "no name mapping",
+			expected: ingress("default-svc", "web-svc", "tls-1"),
+		},
+		{
+			name:     "backend services and TLS secrets are renamed",
+			mapping:  &velerov1api.RestoreNameMapping{Names: map[string]string{"web-svc": "web-svc-2"}, Suffix: "-clone"},
+			expected: ingress("default-svc-clone", "web-svc-2", "tls-1-clone"),
+		},
+		{
+			name:     "only the included resources are renamed",
+			mapping:  &velerov1api.RestoreNameMapping{IncludedResources: []string{"services"}, Suffix: "-clone"},
+			expected: ingress("default-svc-clone", "web-svc-clone", "tls-1"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ingress("default-svc", "web-svc", "tls-1"))
+			require.NoError(t, err)
+
+			action := NewIngressAction(velerotest.NewLogger())
+			res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           &unstructured.Unstructured{Object: item},
+				ItemFromBackup: &unstructured.Unstructured{Object: item},
+				Restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NameMapping(tc.mapping).Result(),
+			})
+			require.NoError(t, err)
+
+			actual := new(networkingv1api.Ingress)
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), actual))
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
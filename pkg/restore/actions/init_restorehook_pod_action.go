@@ -19,11 +19,13 @@ package actions
 import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
 // InitRestoreHookPodAction is a RestoreItemAction plugin applicable to pods that runs
@@ -49,10 +51,18 @@ func (a *InitRestoreHookPodAction) Execute(input *velero.RestoreItemActionExecut
 	a.logger.Infof("Executing InitRestoreHookPodAction")
 	// handle any init container restore hooks for the pod
 	restoreHooks, err := hook.GetRestoreHooksFromSpec(&input.Restore.Spec.Hooks)
-	nsMapping := input.Restore.Spec.NamespaceMapping
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// the hooks are applied according to the namespace the pod is restored into
+	nsMapping := map[string]string{}
+	if target, ok := pkgrestoreUtil.MapNamespace(input.Restore, metadata.GetNamespace()); ok {
+		nsMapping[metadata.GetNamespace()] = target
+	}
 	hookHandler := hook.InitContainerRestoreHookHandler{}
 	postHooksItem, err := hookHandler.HandleRestoreHooks(a.logger, kuberesource.Pods, input.Item, restoreHooks, nsMapping)
 	if err != nil {
@@ -25,8 +25,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
 type PodAction struct {
@@ -82,6 +84,8 @@ func (a *PodAction) Execute(input *velero.RestoreItemActionExecuteInput) (*veler
 		pod.Spec.InitContainers[i].VolumeMounts = preservedVolumeMounts
 	}
 
+	mapPodReferences(input.Restore, pod)
+
 	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -94,3 +98,88 @@ func (a *PodAction) Execute(input *velero.RestoreItemActionExecuteInput) (*veler
 	}
 	return restoreExecuteOutput, nil
 }
+
+// mapPodReferences rewrites the references of the pod to the items the restore renames.
+func mapPodReferences(restore *velerov1api.Restore, pod *corev1api.Pod) {
+	if !pkgrestoreUtil.HasNameMapping(restore) {
+		return
+	}
+	mapPodSpecReferences(restore, pod.Namespace, &pod.Spec)
+}
+
+// mapPodSpecReferences rewrites the references of the pod spec of a pod or of a pod template to
+// the items the restore renames: the claims, config maps and secrets of its volumes, projected
+// volumes included, the config maps and secrets of the env and envFrom of its containers, its
+// image pull secrets and its service account. The namespace is the namespace of the item in the
+// backup.
+func mapPodSpecReferences(restore *velerov1api.Restore, namespace string, spec *corev1api.PodSpec) {
+	mapConfigMap := func(name *string) {
+		*name, _ = pkgrestoreUtil.MapName(restore, kuberesource.ConfigMaps, namespace, *name)
+	}
+	mapSecret := func(name *string) {
+		*name, _ = pkgrestoreUtil.MapName(restore, kuberesource.Secrets, namespace, *name)
+	}
+
+	for i := range spec.Volumes {
+		vol := &spec.Volumes[i]
+		switch {
+		case vol.PersistentVolumeClaim != nil:
+			vol.PersistentVolumeClaim.ClaimName, _ = pkgrestoreUtil.MapName(restore, kuberesource.PersistentVolumeClaims, namespace, vol.PersistentVolumeClaim.ClaimName)
+		case vol.ConfigMap != nil:
+			mapConfigMap(&vol.ConfigMap.Name)
+		case vol.Secret != nil:
+			mapSecret(&vol.Secret.SecretName)
+		case vol.Projected != nil:
+			for _, source := range vol.Projected.Sources {
+				if source.ConfigMap != nil {
+					mapConfigMap(&source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					mapSecret(&source.Secret.Name)
+				}
+			}
+		}
+	}
+
+	mapEnv := func(env []corev1api.EnvVar, envFrom []corev1api.EnvFromSource) {
+		for _, e := range env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				mapConfigMap(&e.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				mapSecret(&e.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+		for _, from := range envFrom {
+			if from.ConfigMapRef != nil {
+				mapConfigMap(&from.ConfigMapRef.Name)
+			}
+			if from.SecretRef != nil {
+				mapSecret(&from.SecretRef.Name)
+			}
+		}
+	}
+	for _, container := range spec.InitContainers {
+		mapEnv(container.Env, container.EnvFrom)
+	}
+	for _, container := range spec.Containers {
+		mapEnv(container.Env, container.EnvFrom)
+	}
+	for _, container := range spec.EphemeralContainers {
+		mapEnv(container.Env, container.EnvFrom)
+	}
+
+	for i := range spec.ImagePullSecrets {
+		mapSecret(&spec.ImagePullSecrets[i].Name)
+	}
+
+	if spec.ServiceAccountName != "" {
+		spec.ServiceAccountName, _ = pkgrestoreUtil.MapName(restore, kuberesource.ServiceAccounts, namespace, spec.ServiceAccountName)
+		if spec.DeprecatedServiceAccount != "" {
+			spec.DeprecatedServiceAccount = spec.ServiceAccountName
+		}
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -249,3 +251,41 @@ func TestPodActionExecute(t *testing.T) {
 		})
 	}
 }
+
+func TestPodActionExecuteNameMapping(t *testing.T) {
+	pod := corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1"},
+		Spec: corev1api.PodSpec{
+			ServiceAccountName: "sa-1",
+			Volumes: []corev1api.Volume{
+				{Name: "data", VolumeSource: corev1api.VolumeSource{PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-1"}}},
+				{Name: "config", VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{LocalObjectReference: corev1api.LocalObjectReference{Name: "cm-1"}}}},
+				{Name: "creds", VolumeSource: corev1api.VolumeSource{Secret: &corev1api.SecretVolumeSource{SecretName: "secret-1"}}},
+			},
+		},
+	}
+	unstructuredPod, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pod)
+	require.NoError(t, err)
+
+	action := NewPodAction(velerotest.NewLogger())
+	res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+		Item:           &unstructured.Unstructured{Object: unstructuredPod},
+		ItemFromBackup: &unstructured.Unstructured{Object: unstructuredPod},
+		Restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+			NameMapping(&velerov1api.RestoreNameMapping{
+				IncludedResources: []string{"persistentvolumeclaims", "configmaps", "serviceaccounts"},
+				Names:             map[string]string{"pvc-1": "pvc-2"},
+				Suffix:            "-clone",
+			}).
+			Result(),
+	})
+	require.NoError(t, err)
+
+	var resPod corev1api.Pod
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), &resPod))
+
+	assert.Equal(t, "pvc-2", resPod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "cm-1-clone", resPod.Spec.Volumes[1].ConfigMap.Name)
+	assert.Equal(t, "secret-1", resPod.Spec.Volumes[2].Secret.SecretName)
+	assert.Equal(t, "sa-1-clone", resPod.Spec.ServiceAccountName)
+}
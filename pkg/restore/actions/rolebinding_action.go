@@ -23,10 +23,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
-// RoleBindingAction handle namespace remappings for role bindings
+// RoleBindingAction handle namespace remappings and renamed roles and service accounts for role bindings
 type RoleBindingAction struct {
 	logger logrus.FieldLogger
 }
@@ -42,8 +45,7 @@ func (a *RoleBindingAction) AppliesTo() (velero.ResourceSelector, error) {
 }
 
 func (a *RoleBindingAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
-	namespaceMapping := input.Restore.Spec.NamespaceMapping
-	if len(namespaceMapping) == 0 {
+	if !pkgrestoreUtil.HasNamespaceMapping(input.Restore) && !pkgrestoreUtil.HasNameMapping(input.Restore) {
 		return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: input.Item.UnstructuredContent()}), nil
 	}
 
@@ -52,11 +54,8 @@ func (a *RoleBindingAction) Execute(input *velero.RestoreItemActionExecuteInput)
 		return nil, errors.WithStack(err)
 	}
 
-	for i, subject := range roleBinding.Subjects {
-		if newNamespace, ok := namespaceMapping[subject.Namespace]; ok {
-			roleBinding.Subjects[i].Namespace = newNamespace
-		}
-	}
+	mapRoleRef(input.Restore, roleBinding.Namespace, &roleBinding.RoleRef)
+	mapSubjects(input.Restore, roleBinding.Subjects)
 
 	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(roleBinding)
 	if err != nil {
@@ -65,3 +64,27 @@ func (a *RoleBindingAction) Execute(input *velero.RestoreItemActionExecuteInput)
 
 	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
 }
+
+// mapRoleRef renames the role a binding refers to, if the restore renames it. The namespace is
+// the namespace of the binding in the backup, empty for a cluster role binding.
+func mapRoleRef(restore *api.Restore, namespace string, roleRef *rbacv1.RoleRef) {
+	switch roleRef.Kind {
+	case "Role":
+		roleRef.Name, _ = pkgrestoreUtil.MapName(restore, kuberesource.Roles, namespace, roleRef.Name)
+	case "ClusterRole":
+		roleRef.Name, _ = pkgrestoreUtil.MapName(restore, kuberesource.ClusterRoles, "", roleRef.Name)
+	}
+}
+
+// mapSubjects renames the service accounts of the subjects of a binding, and remaps the
+// namespaces of the subjects, if the restore renames or remaps them.
+func mapSubjects(restore *api.Restore, subjects []rbacv1.Subject) {
+	for i, subject := range subjects {
+		if subject.Kind == rbacv1.ServiceAccountKind {
+			subjects[i].Name, _ = pkgrestoreUtil.MapName(restore, kuberesource.ServiceAccounts, subject.Namespace, subject.Name)
+		}
+		if newNamespace, ok := pkgrestoreUtil.MapNamespace(restore, subject.Namespace); ok {
+			subjects[i].Namespace = newNamespace
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -43,6 +44,7 @@ func TestRoleBindingActionExecute(t *testing.T) {
 		name             string
 		namespaces       []string
 		namespaceMapping map[string]string
+		mappingRules     []api.NamespaceMappingRule
 		expected         []string
 	}{
 		{
@@ -69,6 +71,13 @@ func TestRoleBindingActionExecute(t *testing.T) {
 			namespaceMapping: map[string]string{"a": "b", "c": "d"},
 			expected:         []string{"foo", "xyz"},
 		},
+		{
+			name:             "namespace mapping rules enabled, exact namespace mapping takes precedence",
+			namespaces:       []string{"foo", "team-a", "xyz"},
+			namespaceMapping: map[string]string{"foo": "bar"},
+			mappingRules:     []api.NamespaceMappingRule{{Source: "team-*", Target: "team-*-dr"}, {Source: "^f(.*)$", Target: "f$1-restored"}},
+			expected:         []string{"bar", "team-a-dr", "xyz"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -94,7 +103,8 @@ func TestRoleBindingActionExecute(t *testing.T) {
 				ItemFromBackup: &unstructured.Unstructured{Object: roleBindingUnstructured},
 				Restore: &api.Restore{
 					Spec: api.RestoreSpec{
-						NamespaceMapping: tc.namespaceMapping,
+						NamespaceMapping:      tc.namespaceMapping,
+						NamespaceMappingRules: tc.mappingRules,
 					},
 				},
 			})
@@ -116,3 +126,38 @@ func TestRoleBindingActionExecute(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleBindingActionExecuteNameMapping(t *testing.T) {
+	roleBinding := rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "binding-1"},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "role-1"},
+		Subjects: []rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Namespace: "foo", Name: "sa-1"},
+			{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "user-1"},
+		},
+	}
+	roleBindingUnstructured, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&roleBinding)
+	require.NoError(t, err)
+
+	action := NewRoleBindingAction(test.NewLogger())
+	res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+		Item:           &unstructured.Unstructured{Object: roleBindingUnstructured},
+		ItemFromBackup: &unstructured.Unstructured{Object: roleBindingUnstructured},
+		Restore: &api.Restore{
+			Spec: api.RestoreSpec{
+				NamespaceMapping: map[string]string{"foo": "bar"},
+				NameMapping:      &api.RestoreNameMapping{Suffix: "-clone"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var resRoleBinding *rbacv1.RoleBinding
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), &resRoleBinding))
+
+	assert.Equal(t, "role-1-clone", resRoleBinding.RoleRef.Name)
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: rbacv1.ServiceAccountKind, Namespace: "bar", Name: "sa-1-clone"},
+		{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "user-1"},
+	}, resRoleBinding.Subjects)
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
 const annotationLastAppliedConfig = "kubectl.kubernetes.io/last-applied-configuration"
@@ -71,6 +72,9 @@ func (a *ServiceAction) Execute(input *velero.RestoreItemActionExecuteInput) (*v
 		}
 	}
 
+	// the selector selects the pods by the labels that are renamed along with the items
+	pkgrestoreUtil.MapLabels(input.Restore, service.Spec.Selector)
+
 	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(service)
 	if err != nil {
 		return nil, errors.WithStack(err)
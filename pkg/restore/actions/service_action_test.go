@@ -644,6 +644,28 @@ func TestServiceActionExecute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "selector labels in the label keys of the name mapping are renamed",
+			obj: corev1api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "svc-1",
+				},
+				Spec: corev1api.ServiceSpec{
+					ClusterIP: "None",
+					Selector:  map[string]string{"app": "web", "tier": "frontend"},
+				},
+			},
+			restore: builder.ForRestore(api.DefaultNamespace, "").NameMapping(&api.RestoreNameMapping{Suffix: "-clone", LabelKeys: []string{"app"}}).Result(),
+			expectedRes: corev1api.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "svc-1",
+				},
+				Spec: corev1api.ServiceSpec{
+					ClusterIP: "None",
+					Selector:  map[string]string{"app": "web-clone", "tier": "frontend"},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
+)
+
+// WorkloadAction rewrites the references of the pod templates of the workloads to the items
+// renamed by the restore's name mapping, and the governing service of the statefulsets.
+type WorkloadAction struct {
+	logger logrus.FieldLogger
+}
+
+func NewWorkloadAction(logger logrus.FieldLogger) *WorkloadAction {
+	return &WorkloadAction{logger: logger}
+}
+
+func (a *WorkloadAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"deployments", "statefulsets", "daemonsets", "replicasets", "replicationcontrollers", "jobs", "cronjobs"},
+	}, nil
+}
+
+func (a *WorkloadAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !pkgrestoreUtil.HasNameMapping(input.Restore) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	podSpecPath := []string{"spec", "template", "spec"}
+	if obj.GetKind() == "CronJob" {
+		podSpecPath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	}
+	content, found, err := unstructured.NestedMap(obj.Object, podSpecPath...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting the pod template spec")
+	}
+	if found {
+		podSpec := new(corev1api.PodSpec)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, podSpec); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		mapPodSpecReferences(input.Restore, obj.GetNamespace(), podSpec)
+		if content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(podSpec); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := unstructured.SetNestedMap(obj.Object, content, podSpecPath...); err != nil {
+			return nil, errors.Wrap(err, "error setting the pod template spec")
+		}
+	}
+
+	// the claims of the volume claim templates are renamed after the statefulset by the restore
+	if obj.GetKind() == "StatefulSet" {
+		serviceName, found, err := unstructured.NestedString(obj.Object, "spec", "serviceName")
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting the service name")
+		}
+		if found && serviceName != "" {
+			serviceName, _ = pkgrestoreUtil.MapName(input.Restore, kuberesource.Services, obj.GetNamespace(), serviceName)
+			if err := unstructured.SetNestedField(obj.Object, serviceName, "spec", "serviceName"); err != nil {
+				return nil, errors.Wrap(err, "error setting the service name")
+			}
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
@@ -0,0 +1,244 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestWorkloadActionAppliesTo(t *testing.T) {
+	action := NewWorkloadAction(velerotest.NewLogger())
+	actual, err := action.AppliesTo()
+	require.NoError(t, err)
+	assert.Equal(t, velero.ResourceSelector{
+		IncludedResources: []string{"deployments", "statefulsets", "daemonsets", "replicasets", "replicationcontrollers", "jobs", "cronjobs"},
+	}, actual)
+}
+
+func TestWorkloadActionExecute(t *testing.T) {
+	// podSpec returns a pod spec with references to other items, named with the name function
+	podSpec := func(name func(string) string) corev1api.PodSpec {
+		return corev1api.PodSpec{
+			ServiceAccountName: name("sa-1"),
+			ImagePullSecrets:   []corev1api.LocalObjectReference{{Name: name("pull-secret-1")}},
+			InitContainers: []corev1api.Container{
+				{
+					Name: "init",
+					EnvFrom: []corev1api.EnvFromSource{
+						{ConfigMapRef: &corev1api.ConfigMapEnvSource{LocalObjectReference: corev1api.LocalObjectReference{Name: name("cm-2")}}},
+					},
+				},
+			},
+			Containers: []corev1api.Container{
+				{
+					Name: "app",
+					Env: []corev1api.EnvVar{
+						{Name: "PLAIN", Value: "value"},
+						{Name: "FROM_CM", ValueFrom: &corev1api.EnvVarSource{ConfigMapKeyRef: &corev1api.ConfigMapKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: name("cm-3")}, Key: "key"}}},
+						{Name: "FROM_SECRET", ValueFrom: &corev1api.EnvVarSource{SecretKeyRef: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: name("secret-2")}, Key: "key"}}},
+					},
+					EnvFrom: []corev1api.EnvFromSource{
+						{SecretRef: &corev1api.SecretEnvSource{LocalObjectReference: corev1api.LocalObjectReference{Name: name("secret-3")}}},
+					},
+				},
+			},
+			Volumes: []corev1api.Volume{
+				{Name: "data", VolumeSource: corev1api.VolumeSource{PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: name("pvc-1")}}},
+				{Name: "config", VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{LocalObjectReference: corev1api.LocalObjectReference{Name: name("cm-1")}}}},
+				{Name: "creds", VolumeSource: corev1api.VolumeSource{Secret: &corev1api.SecretVolumeSource{SecretName: name("secret-1")}}},
+				{Name: "projected", VolumeSource: corev1api.VolumeSource{Projected: &corev1api.ProjectedVolumeSource{
+					Sources: []corev1api.VolumeProjection{
+						{ConfigMap: &corev1api.ConfigMapProjection{LocalObjectReference: corev1api.LocalObjectReference{Name: name("cm-4")}}},
+						{Secret: &corev1api.SecretProjection{LocalObjectReference: corev1api.LocalObjectReference{Name: name("secret-4")}}},
+					},
+				}}},
+			},
+		}
+	}
+	original := func(name string) string { return name }
+	renamed := func(name string) string { return name + "-clone" }
+	template := func(name func(string) string) corev1api.PodTemplateSpec {
+		return corev1api.PodTemplateSpec{Spec: podSpec(name)}
+	}
+	objectMeta := metav1.ObjectMeta{Namespace: "ns-1", Name: "workload-1"}
+
+	tests := []struct {
+		name    string
+		item    func(name func(string) string) runtime.Object
+		newItem func() runtime.Object
+	}{
+		{
+			name: "deployment",
+			item: func(name func(string) string) runtime.Object {
+				return &appsv1api.Deployment{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+					ObjectMeta: objectMeta,
+					Spec:       appsv1api.DeploymentSpec{Template: template(name)},
+				}
+			},
+			newItem: func() runtime.Object { return new(appsv1api.Deployment) },
+		},
+		{
+			name: "statefulset",
+			item: func(name func(string) string) runtime.Object {
+				return &appsv1api.StatefulSet{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"},
+					ObjectMeta: objectMeta,
+					Spec: appsv1api.StatefulSetSpec{
+						ServiceName: name("svc-1"),
+						Template:    template(name),
+						VolumeClaimTemplates: []corev1api.PersistentVolumeClaim{
+							{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+						},
+					},
+				}
+			},
+			newItem: func() runtime.Object { return new(appsv1api.StatefulSet) },
+		},
+		{
+			name: "daemonset",
+			item: func(name func(string) string) runtime.Object {
+				return &appsv1api.DaemonSet{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"},
+					ObjectMeta: objectMeta,
+					Spec:       appsv1api.DaemonSetSpec{Template: template(name)},
+				}
+			},
+			newItem: func() runtime.Object { return new(appsv1api.DaemonSet) },
+		},
+		{
+			name: "replicaset",
+			item: func(name func(string) string) runtime.Object {
+				return &appsv1api.ReplicaSet{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+					ObjectMeta: objectMeta,
+					Spec:       appsv1api.ReplicaSetSpec{Template: template(name)},
+				}
+			},
+			newItem: func() runtime.Object { return new(appsv1api.ReplicaSet) },
+		},
+		{
+			name: "job",
+			item: func(name func(string) string) runtime.Object {
+				return &batchv1api.Job{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+					ObjectMeta: objectMeta,
+					Spec:       batchv1api.JobSpec{Template: template(name)},
+				}
+			},
+			newItem: func() runtime.Object { return new(batchv1api.Job) },
+		},
+		{
+			name: "cronjob",
+			item: func(name func(string) string) runtime.Object {
+				return &batchv1api.CronJob{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+					ObjectMeta: objectMeta,
+					Spec: batchv1api.CronJobSpec{
+						Schedule:    "@every 1h",
+						JobTemplate: batchv1api.JobTemplateSpec{Spec: batchv1api.JobSpec{Template: template(name)}},
+					},
+				}
+			},
+			newItem: func() runtime.Object { return new(batchv1api.CronJob) },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, mapping := range []struct {
+				name     string
+				mapping  *velerov1api.RestoreNameMapping
+				expected func(string) string
+			}{
+				{name: "without name mapping", expected: original},
+				{name: "with name mapping", mapping: &velerov1api.RestoreNameMapping{Suffix: "-clone"}, expected: renamed},
+			} {
+				t.Run(mapping.name, func(t *testing.T) {
+					item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.item(original))
+					require.NoError(t, err)
+
+					action := NewWorkloadAction(velerotest.NewLogger())
+					res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+						Item:           &unstructured.Unstructured{Object: item},
+						ItemFromBackup: &unstructured.Unstructured{Object: item},
+						Restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NameMapping(mapping.mapping).Result(),
+					})
+					require.NoError(t, err)
+
+					actual := tc.newItem()
+					require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), actual))
+					assert.Equal(t, tc.item(mapping.expected), actual)
+				})
+			}
+		})
+	}
+}
+
+func TestWorkloadActionExecuteIncludedResources(t *testing.T) {
+	deployment := func(configMap, secret string) *appsv1api.Deployment {
+		return &appsv1api.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "deploy-1"},
+			Spec: appsv1api.DeploymentSpec{
+				Template: corev1api.PodTemplateSpec{
+					Spec: corev1api.PodSpec{
+						Volumes: []corev1api.Volume{
+							{Name: "config", VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{LocalObjectReference: corev1api.LocalObjectReference{Name: configMap}}}},
+							{Name: "creds", VolumeSource: corev1api.VolumeSource{Secret: &corev1api.SecretVolumeSource{SecretName: secret}}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment("cm-1", "secret-1"))
+	require.NoError(t, err)
+
+	action := NewWorkloadAction(velerotest.NewLogger())
+	res, err := action.Execute(&velero.RestoreItemActionExecuteInput{
+		Item:           &unstructured.Unstructured{Object: item},
+		ItemFromBackup: &unstructured.Unstructured{Object: item},
+		Restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+			NameMapping(&velerov1api.RestoreNameMapping{
+				IncludedResources: []string{"configmaps"},
+				Names:             map[string]string{"cm-1": "cm-2"},
+				Suffix:            "-clone",
+			}).
+			Result(),
+	})
+	require.NoError(t, err)
+
+	actual := new(appsv1api.Deployment)
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(res.UpdatedItem.UnstructuredContent(), actual))
+	assert.Equal(t, deployment("cm-2", "secret-1"), actual)
+}
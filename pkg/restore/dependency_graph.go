@@ -0,0 +1,348 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"container/heap"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// dependencyGraph holds the references between the items of the backup being restored.
+// It's used to compute the order in which the resources, and the items of each resource,
+// are restored when the restore's resource ordering is DependencyGraph.
+type dependencyGraph struct {
+	items *manifest.Manifest
+}
+
+// newDependencyGraph reads the items of the backup from the extracted backup directory and
+// builds the graph of their references. Items that can't be decoded are left out of the graph,
+// the error is reported when the items are selected for restore.
+func (ctx *restoreContext) newDependencyGraph(backupResources map[string]*archive.ResourceItems) *dependencyGraph {
+	graph := &dependencyGraph{items: manifest.New()}
+
+	for resource, resourceItems := range backupResources {
+		resourceForPath := ctx.resourcePathInBackup(resource)
+		for namespace, names := range resourceItems.ItemsByNamespace {
+			for _, name := range names {
+				itemPath := archive.GetItemFilePath(ctx.restoreDir, resourceForPath, namespace, name)
+				obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+				if err != nil {
+					ctx.log.WithError(err).Debugf("Skipping %s in the dependency graph", itemPath)
+					continue
+				}
+
+				// owner references are resolved by UID within the backup, so there's no need
+				// to resolve their kinds, which may not be served by the cluster yet
+				item, err := manifest.NewItem(obj, schema.ParseGroupResource(resource), nil)
+				if err != nil {
+					ctx.log.WithError(err).Debugf("Skipping %s in the dependency graph", itemPath)
+					continue
+				}
+				// key the item by its location in the backup, which is how the items are looked up
+				item.Namespace, item.Name = namespace, name
+				if _, err := graph.items.Add(item); err != nil {
+					ctx.log.WithError(err).Debugf("Skipping %s in the dependency graph", itemPath)
+				}
+			}
+		}
+	}
+
+	return graph
+}
+
+// resourceDependencies returns, for each resource in the graph, the other resources it depends on.
+// On top of the references recorded for the items, namespaced items depend on their namespace and
+// custom resources depend on their CRD, if those are in the backup.
+func (g *dependencyGraph) resourceDependencies() map[string]sets.Set[string] {
+	dependencies := map[string]sets.Set[string]{}
+	add := func(resource, dependency string) {
+		if resource == dependency || dependency == "" {
+			return
+		}
+		if dependencies[resource] == nil {
+			dependencies[resource] = sets.New[string]()
+		}
+		dependencies[resource].Insert(dependency)
+	}
+
+	for _, item := range g.items.Items() {
+		for _, dependency := range item.Dependencies() {
+			add(item.Resource, dependency.Resource)
+		}
+		if item.Namespace != "" && g.items.Get(kuberesource.Namespaces.String(), "", item.Namespace) != nil {
+			add(item.Resource, kuberesource.Namespaces.String())
+		}
+		if g.items.Get(kuberesource.CustomResourceDefinitions.String(), "", item.Resource) != nil {
+			add(item.Resource, kuberesource.CustomResourceDefinitions.String())
+		}
+	}
+
+	return dependencies
+}
+
+// orderResources reorders the resources so that every resource is restored after the resources
+// it depends on. Resources that don't depend on each other keep their order, which is the order
+// given by the resource priorities. A warning is returned for every dependency cycle, the resources
+// in a cycle are restored following the resource priorities.
+func (g *dependencyGraph) orderResources(resources []string) ([]string, results.Result) {
+	var warnings results.Result
+
+	ordered, cycles := topologicalOrder(resources, g.resourceDependencies())
+	for _, cycle := range cycles {
+		warnings.AddVeleroError(errors.Errorf("dependency cycle between resources %s, restoring them following the resource priorities", strings.Join(cycle, ", ")))
+	}
+
+	return ordered, warnings
+}
+
+// orderItems reorders the items of a resource in a namespace of the backup so that every item is
+// restored after the items of the same resource it depends on, e.g. its owners. The names of the
+// items in each dependency cycle are returned.
+func (g *dependencyGraph) orderItems(resource, namespace string, items []restoreableItem) ([]restoreableItem, [][]string) {
+	names := make([]string, 0, len(items))
+	itemsByName := make(map[string]restoreableItem, len(items))
+	dependencies := map[string]sets.Set[string]{}
+	for _, restoreable := range items {
+		names = append(names, restoreable.name)
+		itemsByName[restoreable.name] = restoreable
+
+		item := g.items.Get(resource, namespace, restoreable.name)
+		if item == nil {
+			continue
+		}
+		for _, dependency := range item.Dependencies() {
+			if dependency.Resource != resource || dependency.Namespace != namespace || dependency.Name == restoreable.name {
+				continue
+			}
+			if dependencies[restoreable.name] == nil {
+				dependencies[restoreable.name] = sets.New[string]()
+			}
+			dependencies[restoreable.name].Insert(dependency.Name)
+		}
+	}
+
+	orderedNames, cycles := topologicalOrder(names, dependencies)
+	ordered := make([]restoreableItem, 0, len(orderedNames))
+	for _, name := range orderedNames {
+		ordered = append(ordered, itemsByName[name])
+	}
+	return ordered, cycles
+}
+
+// hasItemDependencies returns true if the item depends on other items of the same resource in
+// the same namespace of the backup, which orderItems places before it.
+func (g *dependencyGraph) hasItemDependencies(resource, namespace, name string) bool {
+	item := g.items.Get(resource, namespace, name)
+	if item == nil {
+		return false
+	}
+	for _, dependency := range item.Dependencies() {
+		if dependency.Resource == resource && dependency.Namespace == namespace && dependency.Name != name {
+			return true
+		}
+	}
+	return false
+}
+
+// deletionOrder orders the items, given in the order they were restored in, so that every item
+// is deleted before the items it depends on, e.g. a pod before its persistent volume claim and the
+// namespaced items before their namespace. Items that don't depend on each other are deleted in the
+// reverse order they were restored in. The items in each dependency cycle are returned.
+func (g *dependencyGraph) deletionOrder(items []*manifest.Item) ([]*manifest.Item, [][]string) {
+	keys := make([]string, 0, len(items))
+	itemsByKey := make(map[string]*manifest.Item, len(items))
+	dependencies := map[string]sets.Set[string]{}
+	for _, item := range items {
+		key := item.String()
+		keys = append(keys, key)
+		itemsByKey[key] = item
+
+		dependencies[key] = sets.New[string]()
+		for _, dependency := range item.Dependencies() {
+			dependencies[key].Insert(dependency.String())
+		}
+		if item.Namespace != "" {
+			if namespace := g.items.Get(kuberesource.Namespaces.String(), "", item.Namespace); namespace != nil {
+				dependencies[key].Insert(namespace.String())
+			}
+		}
+		if crd := g.items.Get(kuberesource.CustomResourceDefinitions.String(), "", item.Resource); crd != nil {
+			dependencies[key].Insert(crd.String())
+		}
+		dependencies[key].Delete(key)
+	}
+
+	// the restore order puts every item after the items it depends on, so it's deleted before them
+	// in the reverse order
+	orderedKeys, cycles := topologicalOrder(keys, dependencies)
+	ordered := make([]*manifest.Item, 0, len(orderedKeys))
+	for i := len(orderedKeys) - 1; i >= 0; i-- {
+		ordered = append(ordered, itemsByKey[orderedKeys[i]])
+	}
+	return ordered, cycles
+}
+
+// topologicalOrder orders the nodes so that every node comes after the nodes it depends on.
+// Nodes that don't depend on each other keep their original order. Dependencies on nodes that
+// aren't in the list are ignored. The nodes of a dependency cycle are kept together in their
+// original order, and every cycle is returned so it can be reported.
+func topologicalOrder(nodes []string, dependencies map[string]sets.Set[string]) ([]string, [][]string) {
+	position := map[string]int{}
+	var unique []string
+	for _, node := range nodes {
+		if _, ok := position[node]; ok {
+			continue
+		}
+		position[node] = len(unique)
+		unique = append(unique, node)
+	}
+	dependenciesOf := func(node string) []string {
+		var list []string
+		for dependency := range dependencies[node] {
+			if _, ok := position[dependency]; ok && dependency != node {
+				list = append(list, dependency)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool { return position[list[i]] < position[list[j]] })
+		return list
+	}
+
+	// group the nodes in strongly connected components using Tarjan's algorithm, every
+	// component with more than one node is a dependency cycle
+	var (
+		components [][]string
+		component  = map[string]int{}
+		index      = map[string]int{}
+		lowLink    = map[string]int{}
+		onStack    = sets.New[string]()
+		stack      []string
+	)
+	var connect func(node string)
+	connect = func(node string) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack.Insert(node)
+
+		for _, dependency := range dependenciesOf(node) {
+			if _, visited := index[dependency]; !visited {
+				connect(dependency)
+				lowLink[node] = min(lowLink[node], lowLink[dependency])
+			} else if onStack.Has(dependency) {
+				lowLink[node] = min(lowLink[node], index[dependency])
+			}
+		}
+
+		if lowLink[node] == index[node] {
+			var members []string
+			for {
+				member := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack.Delete(member)
+				component[member] = len(components)
+				members = append(members, member)
+				if member == node {
+					break
+				}
+			}
+			sort.Slice(members, func(i, j int) bool { return position[members[i]] < position[members[j]] })
+			components = append(components, members)
+		}
+	}
+	for _, node := range unique {
+		if _, visited := index[node]; !visited {
+			connect(node)
+		}
+	}
+
+	// order the components with Kahn's algorithm, always picking the ready component whose
+	// first node comes first in the original order
+	pending := make([]int, len(components))
+	dependents := make([][]int, len(components))
+	for c, members := range components {
+		seen := sets.New[int]()
+		for _, member := range members {
+			for _, dependency := range dependenciesOf(member) {
+				d := component[dependency]
+				if d == c || seen.Has(d) {
+					continue
+				}
+				seen.Insert(d)
+				pending[c]++
+				dependents[d] = append(dependents[d], c)
+			}
+		}
+	}
+
+	ready := &componentQueue{position: func(c int) int { return position[components[c][0]] }}
+	for c := range components {
+		if pending[c] == 0 {
+			heap.Push(ready, c)
+		}
+	}
+	ordered := make([]string, 0, len(unique))
+	var cycles [][]string
+	for ready.Len() > 0 {
+		c := heap.Pop(ready).(int)
+		ordered = append(ordered, components[c]...)
+		if len(components[c]) > 1 {
+			cycles = append(cycles, components[c])
+		}
+		for _, dependent := range dependents[c] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				heap.Push(ready, dependent)
+			}
+		}
+	}
+
+	return ordered, cycles
+}
+
+// componentQueue is a priority queue of components ordered by the position of their first node.
+type componentQueue struct {
+	components []int
+	position   func(int) int
+}
+
+func (q *componentQueue) Len() int { return len(q.components) }
+
+func (q *componentQueue) Less(i, j int) bool {
+	return q.position(q.components[i]) < q.position(q.components[j])
+}
+
+func (q *componentQueue) Swap(i, j int) {
+	q.components[i], q.components[j] = q.components[j], q.components[i]
+}
+
+func (q *componentQueue) Push(x any) { q.components = append(q.components, x.(int)) }
+
+func (q *componentQueue) Pop() any {
+	last := q.components[len(q.components)-1]
+	q.components = q.components[:len(q.components)-1]
+	return last
+}
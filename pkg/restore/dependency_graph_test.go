@@ -0,0 +1,227 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/types"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+func TestTopologicalOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodes        []string
+		dependencies map[string]sets.Set[string]
+		want         []string
+		wantCycles   [][]string
+	}{
+		{
+			name:  "nodes without dependencies keep their order",
+			nodes: []string{"c", "a", "b", "a"},
+			want:  []string{"c", "a", "b"},
+		},
+		{
+			name:  "nodes come after their dependencies",
+			nodes: []string{"pods", "persistentvolumeclaims", "persistentvolumes", "serviceaccounts"},
+			dependencies: map[string]sets.Set[string]{
+				"pods":                   sets.New("persistentvolumeclaims", "serviceaccounts"),
+				"persistentvolumeclaims": sets.New("persistentvolumes"),
+			},
+			want: []string{"persistentvolumes", "persistentvolumeclaims", "serviceaccounts", "pods"},
+		},
+		{
+			name:  "dependencies on unknown nodes and on the node itself are ignored",
+			nodes: []string{"a", "b"},
+			dependencies: map[string]sets.Set[string]{
+				"a": sets.New("a", "unknown"),
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name:  "the nodes of a cycle are kept together in their original order",
+			nodes: []string{"d", "c", "b", "a"},
+			dependencies: map[string]sets.Set[string]{
+				"a": sets.New("c"),
+				"c": sets.New("a"),
+				"d": sets.New("c"),
+			},
+			want:       []string{"c", "a", "d", "b"},
+			wantCycles: [][]string{{"c", "a"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, cycles := topologicalOrder(tc.nodes, tc.dependencies)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantCycles, cycles)
+		})
+	}
+}
+
+func TestRestoreDependencyGraphOrdering(t *testing.T) {
+	ownedBy := func(kind, name, uid string) func(metav1.Object) {
+		return builder.WithOwnerReference([]metav1.OwnerReference{{APIVersion: "v1", Kind: kind, Name: name, UID: k8stypes.UID("uid-" + uid)}})
+	}
+
+	tests := []struct {
+		name          string
+		restore       *velerov1api.Restore
+		tarball       *test.TarWriter
+		apiResources  []*test.APIResource
+		want          []resourceID
+		wantWarnings  results.Result
+		wantResources []string
+	}{
+		{
+			name:    "resources are restored after the resources they depend on",
+			restore: defaultRestore().ResourceOrdering(velerov1api.RestoreResourceOrderingDependencyGraph).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(ownedBy("Deployment", "deploy-1", "deploy-1")).
+						ServiceAccount("sa-1").
+						Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).
+						Result(),
+				).
+				AddItems("persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("uid-deploy-1")).Result(),
+				),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVCs(),
+				test.PVs(),
+				test.ServiceAccounts(),
+				test.Deployments(),
+			},
+			wantResources: []string{"persistentvolumes", "persistentvolumeclaims", "serviceaccounts", "deployments.apps", "pods"},
+		},
+		{
+			name:    "the items of a resource are restored after their owners",
+			restore: defaultRestore().ResourceOrdering(velerov1api.RestoreResourceOrderingDependencyGraph).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("configmaps",
+					builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(ownedBy("ConfigMap", "cm-3", "cm-3")).Result(),
+					builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(builder.WithUID("uid-cm-2")).Result(),
+					builder.ForConfigMap("ns-1", "cm-3").ObjectMeta(builder.WithUID("uid-cm-3"), ownedBy("ConfigMap", "cm-2", "cm-2")).Result(),
+				),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(),
+			},
+			want: []resourceID{
+				{groupResource: "configmaps", nsAndName: "ns-1/cm-2"},
+				{groupResource: "configmaps", nsAndName: "ns-1/cm-3"},
+				{groupResource: "configmaps", nsAndName: "ns-1/cm-1"},
+			},
+		},
+		{
+			name:    "dependency cycles are reported as warnings",
+			restore: defaultRestore().ResourceOrdering(velerov1api.RestoreResourceOrderingDependencyGraph).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("configmaps",
+					builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithUID("uid-cm-1"), ownedBy("Secret", "secret-1", "secret-1")).Result(),
+					builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(builder.WithUID("uid-cm-2"), ownedBy("ConfigMap", "cm-3", "cm-3")).Result(),
+					builder.ForConfigMap("ns-1", "cm-3").ObjectMeta(builder.WithUID("uid-cm-3"), ownedBy("ConfigMap", "cm-2", "cm-2")).Result(),
+				).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithUID("uid-secret-1"), ownedBy("ConfigMap", "cm-1", "cm-1")).Result(),
+				),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(),
+				test.Secrets(),
+			},
+			wantWarnings: results.Result{
+				Velero: []string{"dependency cycle between resources configmaps, secrets, restoring them following the resource priorities"},
+				Namespaces: map[string][]string{
+					"ns-1": {"dependency cycle between configmaps cm-2, cm-3, restoring them in the order of the backup"},
+				},
+			},
+			wantResources: []string{"configmaps", "secrets"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.resourcePriorities = types.Priorities{
+				HighPriorities: []string{"pods", "persistentvolumeclaims", "persistentvolumes", "serviceaccounts", "deployments.apps"},
+			}
+
+			recorder := &createRecorder{t: t}
+			h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
+
+			for _, r := range tc.apiResources {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball.Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			assert.Equal(t, tc.wantWarnings, warnings)
+
+			var created []resourceID
+			var resources []string
+			for _, r := range recorder.resources {
+				// namespaces are created on demand when restoring their first item
+				if r.groupResource == "namespaces" {
+					continue
+				}
+				created = append(created, r)
+				if len(resources) == 0 || resources[len(resources)-1] != r.groupResource {
+					resources = append(resources, r.groupResource)
+				}
+			}
+			if tc.want != nil {
+				assert.Equal(t, tc.want, created)
+			}
+			if tc.wantResources != nil {
+				assert.Equal(t, tc.wantResources, resources)
+			}
+		})
+	}
+}
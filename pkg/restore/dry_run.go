@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// ensureNamespaceExistsAndIsReady ensures the namespace an item is restored into exists, and
+// returns whether it was created. A dry-run restore doesn't create the namespace, it validates
+// its creation with server-side dry run and remembers it, so the items restored into it aren't
+// sent to the API server, which would reject them because the namespace doesn't exist.
+func (ctx *restoreContext) ensureNamespaceExistsAndIsReady(ns *corev1api.Namespace) (bool, error) {
+	if !ctx.dryRun {
+		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout, ctx.resourceDeletionStatusTracker)
+		return nsCreated, err
+	}
+
+	if ctx.isDryRunNamespace(ns.Name) {
+		return false, nil
+	}
+
+	_, err := ctx.namespaceClient.Get(go_context.TODO(), ns.Name, metav1.GetOptions{})
+	if err == nil {
+		return false, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+
+	if _, err := ctx.namespaceClient.Create(go_context.TODO(), ns, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		return false, errors.Wrapf(err, "error creating namespace %s", ns.Name)
+	}
+
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	ctx.dryRunNamespaces.Insert(ns.Name)
+	return true, nil
+}
+
+// isDryRunNamespace returns whether the namespace would be created by the dry-run restore.
+func (ctx *restoreContext) isDryRunNamespace(namespace string) bool {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	return ctx.dryRunNamespaces.Has(namespace)
+}
+
+// setDryRunDiff records the JSON merge patch from the in-cluster version of an item to the
+// version the dry-run restore would restore.
+func (ctx *restoreContext) setDryRunDiff(key itemKey, fromCluster, desired *unstructured.Unstructured) error {
+	patch, err := generatePatch(fromCluster, desired)
+	if err != nil {
+		return errors.Wrapf(err, "error generating the diff of %s", kube.NamespaceAndName(desired))
+	}
+
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	ctx.dryRunDiffs[key] = string(patch)
+	return nil
+}
+
+// dryRunReport returns the report of a dry-run restore, built from the items tracked as
+// restored. The items that failed to restore aren't in the report, their errors are in the
+// results of the restore.
+func (ctx *restoreContext) dryRunReport() []velerov1api.RestoreDryRunItem {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	report := []velerov1api.RestoreDryRunItem{}
+	for key, status := range ctx.restoredItems {
+		diff, differs := ctx.dryRunDiffs[key]
+
+		var result velerov1api.RestoreDryRunItemResult
+		switch {
+		case status.action == ItemRestoreResultCreated:
+			result = velerov1api.RestoreDryRunItemResultWouldCreate
+		case status.action == ItemRestoreResultUpdated:
+			result = velerov1api.RestoreDryRunItemResultWouldUpdate
+		case differs:
+			result = velerov1api.RestoreDryRunItemResultExistsDiffers
+		case status.action == ItemRestoreResultSkipped && status.itemExists:
+			result = velerov1api.RestoreDryRunItemResultExistsUnchanged
+		case status.action == ItemRestoreResultSkipped:
+			result = velerov1api.RestoreDryRunItemResultSkipped
+		default:
+			continue
+		}
+
+		item := velerov1api.RestoreDryRunItem{
+			Resource:  key.resource,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Result:    result,
+		}
+		if result == velerov1api.RestoreDryRunItemResultWouldUpdate || result == velerov1api.RestoreDryRunItemResultExistsDiffers {
+			item.Diff = diff
+		}
+		report = append(report, item)
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Resource != report[j].Resource {
+			return report[i].Resource < report[j].Resource
+		}
+		if report[i].Namespace != report[j].Namespace {
+			return report[i].Namespace < report[j].Namespace
+		}
+		return report[i].Name < report[j].Name
+	})
+
+	return report
+}
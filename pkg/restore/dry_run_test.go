@@ -0,0 +1,156 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestoreDryRun(t *testing.T) {
+	restoreLabels := builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		tarball      io.Reader
+		namespaces   []string
+		apiResources []*test.APIResource
+		want         []velerov1api.RestoreDryRunItem
+		wantResult   velerov1api.RestoreDryRunResult
+	}{
+		{
+			name:    "items in a namespace that doesn't exist would be created in the mapped namespace",
+			restore: defaultRestore().DryRun(true).NamespaceMappings("ns-1", "ns-2").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.ConfigMaps()},
+			want: []velerov1api.RestoreDryRunItem{
+				{Resource: "v1/ConfigMap", Namespace: "ns-2", Name: "cm-1", Result: velerov1api.RestoreDryRunItemResultWouldCreate},
+				{Resource: "v1/Namespace", Name: "ns-2", Result: velerov1api.RestoreDryRunItemResultWouldCreate},
+				{Resource: "v1/Pod", Namespace: "ns-2", Name: "pod-1", Result: velerov1api.RestoreDryRunItemResultWouldCreate},
+			},
+			wantResult: velerov1api.RestoreDryRunResult{WouldCreate: 3},
+		},
+		{
+			name:    "items that exist in the cluster are reported as unchanged or different with their diff",
+			restore: defaultRestore().DryRun(true).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("configmaps",
+					builder.ForConfigMap("ns-1", "cm-1").Data("key", "value").Result(),
+					builder.ForConfigMap("ns-1", "cm-2").Data("key", "value").Result(),
+					builder.ForConfigMap("ns-1", "cm-3").Result(),
+				).
+				Done(),
+			namespaces: []string{"ns-1"},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(restoreLabels).Data("key", "value").Result(),
+					builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(restoreLabels).Data("key", "other").Result(),
+				),
+			},
+			want: []velerov1api.RestoreDryRunItem{
+				{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-1", Result: velerov1api.RestoreDryRunItemResultExistsUnchanged},
+				{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-2", Result: velerov1api.RestoreDryRunItemResultExistsDiffers, Diff: `{"data":{"key":"value"}}`},
+				{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-3", Result: velerov1api.RestoreDryRunItemResultWouldCreate},
+			},
+			wantResult: velerov1api.RestoreDryRunResult{WouldCreate: 1, ExistsUnchanged: 1, ExistsDiffers: 1},
+		},
+		{
+			name:    "items that differ from the cluster would be updated when the existing resource policy is update",
+			restore: defaultRestore().DryRun(true).ExistingResourcePolicy("update").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key", "value").Result()).
+				Done(),
+			namespaces: []string{"ns-1"},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "other").Result()),
+			},
+			want: []velerov1api.RestoreDryRunItem{
+				{Resource: "v1/ConfigMap", Namespace: "ns-1", Name: "cm-1", Result: velerov1api.RestoreDryRunItemResultWouldUpdate, Diff: `{"data":{"key":"value"}}`},
+			},
+			wantResult: velerov1api.RestoreDryRunResult{WouldUpdate: 1},
+		},
+		{
+			name:    "skipped items are reported",
+			restore: defaultRestore().DryRun(true).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations(corev1api.MirrorPodAnnotationKey, "foo")).Result()).
+				Done(),
+			namespaces:   []string{"ns-1"},
+			apiResources: []*test.APIResource{test.Pods()},
+			want: []velerov1api.RestoreDryRunItem{
+				{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-1", Result: velerov1api.RestoreDryRunItemResultSkipped},
+			},
+			wantResult: velerov1api.RestoreDryRunResult{Skipped: 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, ns := range tc.namespaces {
+				_, err := h.KubeClient.CoreV1().Namespaces().Create(t.Context(), builder.ForNamespace(ns).Result(), metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			data := &Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			h.restorer.Restore(data, nil, nil)
+
+			assert.Equal(t, tc.want, data.DryRunReport)
+			assert.Equal(t, &tc.wantResult, data.DryRunResult())
+		})
+	}
+}
+
+func TestRestoreDryRunDoesNotCreateItemsInMissingNamespaces(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	data := &Request{
+		Log:          h.log,
+		Restore:      defaultRestore().DryRun(true).Result(),
+		Backup:       defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).Done(),
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil)
+	assertEmptyResults(t, warnings, errs)
+
+	_, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(t.Context(), "pod-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,95 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// restoreItemWorkerPool restores the items of a restore in parallel. It's started for
+// each restore whose item worker count is greater than one.
+type restoreItemWorkerPool struct {
+	inputChannel chan restoreItemInput
+	wg           *sync.WaitGroup
+	logger       logrus.FieldLogger
+	cancelFunc   context.CancelFunc
+}
+
+type restoreItemInput struct {
+	item          restoreableItem
+	groupResource schema.GroupResource
+	returnChan    chan restoreItemReturn
+}
+
+type restoreItemReturn struct {
+	item     restoreableItem
+	warnings results.Result
+	errs     results.Result
+	// decoded is false if the item couldn't be read from the backup
+	decoded bool
+}
+
+func startRestoreItemWorkerPool(ctx context.Context, restoreCtx *restoreContext, workers int, log logrus.FieldLogger) *restoreItemWorkerPool {
+	// Buffer will hold up to 10 items waiting for processing
+	inputChannel := make(chan restoreItemInput, max(workers, 10))
+
+	ctx, cancelFunc := context.WithCancel(ctx)
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < workers; i++ {
+		logger := log.WithField("worker", i)
+		wg.Add(1)
+		go processRestoreItemWorker(ctx, restoreCtx, inputChannel, logger, wg)
+	}
+
+	return &restoreItemWorkerPool{
+		inputChannel: inputChannel,
+		cancelFunc:   cancelFunc,
+		logger:       log,
+		wg:           wg,
+	}
+}
+
+func (p *restoreItemWorkerPool) Stop() {
+	p.cancelFunc()
+	p.logger.Debug("Restore item workers stopping")
+	p.wg.Wait()
+	p.logger.Debug("Restore item workers stopped")
+}
+
+func processRestoreItemWorker(ctx context.Context,
+	restoreCtx *restoreContext,
+	inputChannel chan restoreItemInput,
+	logger logrus.FieldLogger,
+	wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case m := <-inputChannel:
+			logger.Debugf("restoring %s %s", m.groupResource, m.item.name)
+			m.returnChan <- restoreCtx.restoreSelectedItem(m.item, m.groupResource)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright the Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestoreItemsInParallel(t *testing.T) {
+	var configMaps, secrets []metav1.Object
+	wantItems := map[itemKey]restoredItemStatus{
+		{resource: "v1/Namespace", name: "ns-1"}: {action: ItemRestoreResultCreated, itemExists: true},
+		{resource: "v1/Namespace", name: "ns-2"}: {action: ItemRestoreResultCreated, itemExists: true},
+	}
+	for i := 0; i < 20; i++ {
+		ns := fmt.Sprintf("ns-%d", i%2+1)
+		configMaps = append(configMaps, builder.ForConfigMap(ns, fmt.Sprintf("cm-%d", i)).Result())
+		secrets = append(secrets, builder.ForSecret(ns, fmt.Sprintf("secret-%d", i)).Result())
+		wantItems[itemKey{resource: "v1/ConfigMap", namespace: ns, name: fmt.Sprintf("cm-%d", i)}] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
+		wantItems[itemKey{resource: "v1/Secret", namespace: ns, name: fmt.Sprintf("secret-%d", i)}] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
+	}
+
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		workers int
+	}{
+		{
+			name:    "the item worker count of the restore is used",
+			restore: defaultRestore().ItemWorkerCount(4).Result(),
+			workers: 1,
+		},
+		{
+			name:    "the item worker count of the server is used when the restore doesn't set it",
+			restore: defaultRestore().Result(),
+			workers: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.itemWorkerCount = tc.workers
+
+			recorder := &createRecorder{t: t}
+			h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
+
+			h.DiscoveryClient.WithAPIResource(test.ConfigMaps()).WithAPIResource(test.Secrets())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := &Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).AddItems("configmaps", configMaps...).AddItems("secrets", secrets...).Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // restoreItemActions
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assert.Equal(t, wantItems, data.RestoredItems)
+
+			// all the items of a resource are restored before the next resource
+			var resources []string
+			for _, r := range recorder.resources {
+				if r.groupResource == "namespaces" {
+					continue
+				}
+				if len(resources) == 0 || resources[len(resources)-1] != r.groupResource {
+					resources = append(resources, r.groupResource)
+				}
+			}
+			assert.Equal(t, []string{"configmaps", "secrets"}, resources)
+		})
+	}
+}
+
+func TestRestoreItemsInParallelResults(t *testing.T) {
+	h := newHarness(t)
+
+	h.DiscoveryClient.WithAPIResource(test.ConfigMaps())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	tarball := test.NewTarWriter(t).
+		AddItems("configmaps",
+			builder.ForConfigMap("ns-1", "cm-1").Result(),
+			builder.ForConfigMap("ns-1", "cm-2").Result(),
+		)
+	// an item that can't be decoded is reported as an error of its namespace
+	tarball.Add("resources/configmaps/namespaces/ns-2/cm-3.json", []byte("not json"))
+
+	data := &Request{
+		Log:          h.log,
+		Restore:      defaultRestore().ItemWorkerCount(2).Result(),
+		Backup:       defaultBackup().Result(),
+		BackupReader: tarball.Done(),
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings)
+	assert.Empty(t, errs.Velero)
+	assert.Empty(t, errs.Cluster)
+	assert.Len(t, errs.Namespaces["ns-2"], 1)
+	assert.Contains(t, errs.Namespaces["ns-2"][0], `error decoding "resources/configmaps/namespaces/ns-2/cm-3.json"`)
+	assert.Equal(t, restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}, data.RestoredItems[itemKey{resource: "v1/ConfigMap", namespace: "ns-1", name: "cm-2"}])
+}
+
+func TestRestoreItemsInParallelDependencyGraph(t *testing.T) {
+	ownedBy := func(name string) func(metav1.Object) {
+		return builder.WithOwnerReference([]metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: name, UID: k8stypes.UID("uid-" + name)}})
+	}
+
+	h := newHarness(t)
+
+	recorder := &createRecorder{t: t}
+	h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
+
+	h.DiscoveryClient.WithAPIResource(test.ConfigMaps())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	data := &Request{
+		Log:     h.log,
+		Restore: defaultRestore().ResourceOrdering(velerov1api.RestoreResourceOrderingDependencyGraph).ItemWorkerCount(4).Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("configmaps",
+				builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(ownedBy("cm-3")).Result(),
+				builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(builder.WithUID("uid-cm-2")).Result(),
+				builder.ForConfigMap("ns-1", "cm-3").ObjectMeta(builder.WithUID("uid-cm-3"), ownedBy("cm-2")).Result(),
+			).
+			Done(),
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	// the items that depend on other items are restored after them
+	assert.Equal(t, []resourceID{
+		{groupResource: "configmaps", nsAndName: "ns-1/cm-2"},
+		{groupResource: "configmaps", nsAndName: "ns-1/cm-3"},
+		{groupResource: "configmaps", nsAndName: "ns-1/cm-1"},
+	}, recorder.resources)
+}
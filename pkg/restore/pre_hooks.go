@@ -0,0 +1,271 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/hook"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
+)
+
+const defaultPreHookJobTimeout = 10 * time.Minute
+
+// runPreHooks runs the pre hooks of the restore before any item is restored: the hooks with the
+// Restore scope first, then the hooks with the Namespace scope for each matching namespace. It
+// returns false when a failed hook with the Restore scope prevents the items from being
+// restored, and records the namespaces whose items aren't restored because one of their hooks
+// failed.
+func (ctx *restoreContext) runPreHooks(backupResources map[string]*archive.ResourceItems) (results.Result, bool) {
+	errs := results.Result{}
+
+	for _, spec := range ctx.restore.Spec.Hooks.PreHooks {
+		if pkgrestoreUtil.PreHookScope(spec) != velerov1api.RestorePreHookScopeRestore {
+			continue
+		}
+		if !ctx.runPreHookSpec(spec, "", &errs) {
+			errs.AddVeleroError(errors.Errorf("the items of the restore aren't restored because its pre hook %s failed", spec.Name))
+			return errs, false
+		}
+	}
+
+	var namespaces []string
+	for _, spec := range ctx.restore.Spec.Hooks.PreHooks {
+		if pkgrestoreUtil.PreHookScope(spec) != velerov1api.RestorePreHookScopeNamespace {
+			continue
+		}
+		if namespaces == nil {
+			namespaces = ctx.preHookNamespaces(backupResources)
+		}
+
+		includesExcludes := collections.NewIncludesExcludes().Includes(spec.IncludedNamespaces...).Excludes(spec.ExcludedNamespaces...)
+		for _, namespace := range namespaces {
+			if !includesExcludes.ShouldInclude(namespace) || ctx.preHookFailedNamespaces.Has(namespace) {
+				continue
+			}
+			if !ctx.runPreHookSpec(spec, namespace, &errs) {
+				ctx.preHookFailedNamespaces.Insert(namespace)
+				errs.Add(namespace, errors.Errorf("the items of namespace %s aren't restored because its pre hook %s failed", namespace, spec.Name))
+			}
+		}
+	}
+	return errs, true
+}
+
+// preHookNamespaces returns the sorted target namespaces of the restored items that exist in the
+// cluster, the namespaces the hooks with the Namespace scope run for.
+func (ctx *restoreContext) preHookNamespaces(backupResources map[string]*archive.ResourceItems) []string {
+	namespaces := sets.New[string]()
+	for _, resource := range backupResources {
+		for namespace := range resource.ItemsByNamespace {
+			if namespace == "" || !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+				continue
+			}
+			target, _ := pkgrestoreUtil.MapNamespace(ctx.restore, namespace)
+			namespaces.Insert(target)
+		}
+	}
+
+	var existing []string
+	for _, namespace := range sets.List(namespaces) {
+		if _, err := ctx.namespaceClient.Get(go_context.TODO(), namespace, metav1.GetOptions{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				ctx.log.WithError(err).Warnf("Error getting namespace %s, its pre hooks don't run", namespace)
+			}
+			continue
+		}
+		existing = append(existing, namespace)
+	}
+	return existing
+}
+
+// runPreHookSpec runs the hooks of the spec in order, for the namespace with the Namespace scope.
+// It returns false if a hook whose OnError is Fail failed, which stops the remaining hooks.
+func (ctx *restoreContext) runPreHookSpec(spec velerov1api.RestorePreHookSpec, namespace string, errs *results.Result) bool {
+	hookName := spec.Name
+	if namespace != "" {
+		hookName = fmt.Sprintf("%s/%s", spec.Name, namespace)
+	}
+	log := ctx.log.WithField("hookName", hookName)
+
+	for i, preHook := range spec.Hooks {
+		var (
+			onError   velerov1api.HookErrorMode
+			podNs     string
+			podName   string
+			container string
+			err       error
+		)
+		switch {
+		case preHook.Exec != nil:
+			onError = preHook.Exec.OnError
+			container = preHook.Exec.Container
+			podNs, podName, err = ctx.runPreHookExec(log, hookName, preHook.Exec, namespace)
+		case preHook.Job != nil:
+			onError = preHook.Job.OnError
+			podNs, podName, err = ctx.runPreHookJob(log, spec.Name, preHook.Job, namespace)
+		default:
+			continue
+		}
+		if podNs == "" {
+			podNs = namespace
+		}
+
+		if ctx.multiHookTracker != nil {
+			ctx.multiHookTracker.Add(ctx.restore.Name, podNs, podName, container, hook.HookSourceSpec, hookName, hook.PhasePre, i)
+			if trackErr := ctx.multiHookTracker.Record(ctx.restore.Name, podNs, podName, container, hook.HookSourceSpec, hookName, hook.PhasePre, i, err != nil, err); trackErr != nil {
+				log.WithError(trackErr).Warn("Error recording the execution of the pre hook")
+			}
+		} else if err != nil {
+			errs.Add(podNs, err)
+		}
+
+		if err == nil {
+			log.Infof("Pre hook %d ran successfully", i)
+			continue
+		}
+		log.WithError(err).Errorf("Error running pre hook %d", i)
+		if onError != velerov1api.HookErrorModeContinue {
+			return false
+		}
+	}
+	return true
+}
+
+// runPreHookExec executes the command of the hook in its pod, and returns the namespace and name
+// of the pod.
+func (ctx *restoreContext) runPreHookExec(log logrus.FieldLogger, hookName string, exec *velerov1api.ExecRestorePreHook, namespace string) (string, string, error) {
+	if exec.Namespace != "" {
+		namespace = exec.Namespace
+	}
+
+	pod, err := ctx.preHookPod(exec, namespace)
+	if err != nil {
+		return namespace, exec.Pod, err
+	}
+
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return namespace, pod.Name, errors.WithStack(err)
+	}
+
+	err = ctx.podCommandExecutor.ExecutePodCommand(log, item, pod.Namespace, pod.Name, hookName, &velerov1api.ExecHook{
+		Container: exec.Container,
+		Command:   exec.Command,
+		OnError:   exec.OnError,
+		Timeout:   exec.Timeout,
+	})
+	return namespace, pod.Name, err
+}
+
+// preHookPod returns the pod the command of the exec hook is executed in: the pod named by the
+// hook, or the first running pod, sorted by name, its selector matches.
+func (ctx *restoreContext) preHookPod(exec *velerov1api.ExecRestorePreHook, namespace string) (*corev1api.Pod, error) {
+	if exec.Pod != "" {
+		pod := new(corev1api.Pod)
+		if err := ctx.kbClient.Get(go_context.TODO(), crclient.ObjectKey{Namespace: namespace, Name: exec.Pod}, pod); err != nil {
+			return nil, errors.Wrapf(err, "error getting pod %s/%s", namespace, exec.Pod)
+		}
+		return pod, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(exec.PodSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing the pod selector")
+	}
+	pods := new(corev1api.PodList)
+	if err := ctx.kbClient.List(go_context.TODO(), pods, crclient.InNamespace(namespace), crclient.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrapf(err, "error listing the pods of namespace %s", namespace)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1api.PodRunning {
+			return &pods.Items[i], nil
+		}
+	}
+	return nil, errors.Errorf("no running pod of namespace %s matches the selector %s", namespace, selector)
+}
+
+// runPreHookJob creates the Job of the hook and waits for it to complete, and returns the
+// namespace and name of the Job. The Job isn't deleted so that its logs can be inspected.
+func (ctx *restoreContext) runPreHookJob(log logrus.FieldLogger, specName string, jobHook *velerov1api.JobRestorePreHook, namespace string) (string, string, error) {
+	job, err := pkgrestoreUtil.DecodePreHookJob(jobHook)
+	if err != nil {
+		return namespace, "", err
+	}
+	switch {
+	case jobHook.Namespace != "":
+		job.Namespace = jobHook.Namespace
+	case namespace != "":
+		job.Namespace = namespace
+	}
+	if job.Name == "" && job.GenerateName == "" {
+		job.GenerateName = label.GetValidName(specName) + "-"
+	}
+	job.ResourceVersion = ""
+	if job.Labels == nil {
+		job.Labels = make(map[string]string)
+	}
+	job.Labels[velerov1api.RestoreNameLabel] = label.GetValidName(ctx.restore.Name)
+
+	if err := ctx.kbClient.Create(go_context.TODO(), job); err != nil {
+		return job.Namespace, job.Name, errors.Wrapf(err, "error creating the Job of the pre hook in namespace %s", job.Namespace)
+	}
+	log.Infof("Waiting for the Job %s/%s of the pre hook to complete", job.Namespace, job.Name)
+
+	timeout := jobHook.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultPreHookJobTimeout
+	}
+	reason := ""
+	err = wait.PollUntilContextTimeout(go_context.Background(), time.Second, timeout, true, func(c go_context.Context) (bool, error) {
+		current := new(batchv1api.Job)
+		if err := ctx.kbClient.Get(c, crclient.ObjectKeyFromObject(job), current); err != nil {
+			return false, errors.Wrapf(err, "error getting Job %s/%s", job.Namespace, job.Name)
+		}
+		complete, failed, why := kube.IsJobComplete(current)
+		if failed {
+			return false, errors.Errorf("Job %s/%s of the pre hook failed: %s", job.Namespace, job.Name, why)
+		}
+		reason = why
+		return complete, nil
+	})
+	if wait.Interrupted(err) {
+		err = errors.Errorf("timed out after %v waiting for Job %s/%s of the pre hook to complete: %s", timeout, job.Namespace, job.Name, reason)
+	}
+	return job.Namespace, job.Name, err
+}
@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	k8sfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/vmware-tanzu/velero/internal/hook"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestorePreHooks(t *testing.T) {
+	execHook := func(namespace, pod string, onError velerov1api.HookErrorMode) velerov1api.RestorePreHook {
+		return velerov1api.RestorePreHook{Exec: &velerov1api.ExecRestorePreHook{
+			Namespace: namespace,
+			Pod:       pod,
+			Command:   []string{"maintenance", "on"},
+			OnError:   onError,
+		}}
+	}
+	jobHook := func(namespace, result string) velerov1api.RestorePreHook {
+		return velerov1api.RestorePreHook{Job: &velerov1api.JobRestorePreHook{
+			Namespace: namespace,
+			Manifest:  runtime.RawExtension{Raw: []byte(`{"metadata":{"labels":{"result":"` + result + `"}}}`)},
+		}}
+	}
+
+	consumer := builder.ForPod("ns-1", "consumer-0").ObjectMeta(builder.WithLabels("app", "consumer")).Phase(corev1api.PodRunning).Result()
+
+	tests := []struct {
+		name              string
+		preHooks          []velerov1api.RestorePreHookSpec
+		execErrs          map[string]error
+		wantExecs         []string
+		wantRestored      []string
+		wantNotRestored   []string
+		wantVeleroErrs    []string
+		wantNamespaceErrs map[string][]string
+		wantHooksFailed   int
+	}{
+		{
+			name: "hooks run before the items are restored",
+			preHooks: []velerov1api.RestorePreHookSpec{
+				{Name: "maintenance", Hooks: []velerov1api.RestorePreHook{execHook("ops", "ops-0", ""), jobHook("ops", "complete")}},
+				{
+					Name:               "scale-down",
+					Scope:              velerov1api.RestorePreHookScopeNamespace,
+					IncludedNamespaces: []string{"ns-1"},
+					Hooks: []velerov1api.RestorePreHook{{Exec: &velerov1api.ExecRestorePreHook{
+						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "consumer"}},
+						Command:     []string{"scale", "down"},
+					}}},
+				},
+			},
+			wantExecs:    []string{"ops.ops-0.maintenance.maintenance,on", "ns-1.consumer-0.scale-down/ns-1.scale,down"},
+			wantRestored: []string{"ns-1/pod-1", "ns-2/pod-2"},
+		},
+		{
+			name: "a failed hook of the restore whose OnError is Fail prevents the items from being restored",
+			preHooks: []velerov1api.RestorePreHookSpec{
+				{Name: "maintenance", Hooks: []velerov1api.RestorePreHook{jobHook("ops", "failed"), execHook("ops", "ops-0", "")}},
+			},
+			wantNotRestored: []string{"ns-1/pod-1", "ns-2/pod-2"},
+			wantVeleroErrs:  []string{"the items of the restore aren't restored because its pre hook maintenance failed"},
+			wantHooksFailed: 1,
+		},
+		{
+			name: "a failed hook whose OnError is Continue doesn't prevent the items from being restored",
+			preHooks: []velerov1api.RestorePreHookSpec{
+				{Name: "maintenance", Hooks: []velerov1api.RestorePreHook{execHook("ops", "ops-0", velerov1api.HookErrorModeContinue), jobHook("ops", "complete")}},
+			},
+			execErrs:        map[string]error{"ops/ops-0": errors.New("command failed")},
+			wantExecs:       []string{"ops.ops-0.maintenance.maintenance,on"},
+			wantRestored:    []string{"ns-1/pod-1", "ns-2/pod-2"},
+			wantHooksFailed: 1,
+		},
+		{
+			name: "a failed hook of a namespace whose OnError is Fail prevents the items of the namespace from being restored",
+			preHooks: []velerov1api.RestorePreHookSpec{
+				{
+					Name:               "scale-down",
+					Scope:              velerov1api.RestorePreHookScopeNamespace,
+					ExcludedNamespaces: []string{"ns-2"},
+					Hooks:              []velerov1api.RestorePreHook{execHook("", "consumer-0", "")},
+				},
+			},
+			execErrs:          map[string]error{"ns-1/consumer-0": errors.New("command failed")},
+			wantExecs:         []string{"ns-1.consumer-0.scale-down/ns-1.maintenance,on"},
+			wantRestored:      []string{"ns-2/pod-2"},
+			wantNotRestored:   []string{"ns-1/pod-1"},
+			wantNamespaceErrs: map[string][]string{"ns-1": {"the items of namespace ns-1 aren't restored because its pre hook scale-down failed"}},
+			wantHooksFailed:   1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+			for _, namespace := range []string{"ns-1", "ns-2"} {
+				_, err := h.KubeClient.CoreV1().Namespaces().Create(t.Context(), builder.ForNamespace(namespace).Result(), metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			h.restorer.kbClient = newPreHookJobClient(t, builder.ForPod("ops", "ops-0").Phase(corev1api.PodRunning).Result(), consumer)
+			h.restorer.multiHookTracker = hook.NewMultiHookTracker()
+			podCommandExecutor := &test.MockPodCommandExecutor{}
+			for key, err := range tc.execErrs {
+				namespace, name, _ := strings.Cut(key, "/")
+				podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, namespace, name, mock.Anything, mock.Anything).Return(err)
+			}
+			podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			h.restorer.podCommandExecutor = podCommandExecutor
+
+			restore := defaultRestore().PreHooks(tc.preHooks...).Result()
+			data := &Request{
+				Log:     h.log,
+				Restore: restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-2", "pod-2").Result()).
+					Done(),
+			}
+			_, errs := h.restorer.Restore(data, nil, nil)
+
+			var execs []string
+			for _, entry := range podCommandExecutor.HookExecutionLog {
+				execs = append(execs, entry.String())
+			}
+			assert.Equal(t, tc.wantExecs, execs)
+			assert.Equal(t, tc.wantVeleroErrs, errs.Velero)
+			for namespace, want := range tc.wantNamespaceErrs {
+				assert.Equal(t, want, errs.Namespaces[namespace])
+			}
+			_, hooksFailed := h.restorer.multiHookTracker.Stat(restore.Name)
+			assert.Equal(t, tc.wantHooksFailed, hooksFailed)
+
+			for _, key := range tc.wantRestored {
+				namespace, name, _ := strings.Cut(key, "/")
+				_, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace(namespace).Get(t.Context(), name, metav1.GetOptions{})
+				assert.NoError(t, err, key)
+			}
+			for _, key := range tc.wantNotRestored {
+				namespace, name, _ := strings.Cut(key, "/")
+				_, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace(namespace).Get(t.Context(), name, metav1.GetOptions{})
+				assert.True(t, apierrors.IsNotFound(err), key)
+			}
+		})
+	}
+}
+
+// newPreHookJobClient returns a fake client whose Jobs are created complete, or failed when
+// their "result" label is "failed".
+func newPreHookJobClient(t *testing.T, objs ...crclient.Object) crclient.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1api.AddToScheme(scheme))
+	require.NoError(t, batchv1api.AddToScheme(scheme))
+	require.NoError(t, velerov1api.AddToScheme(scheme))
+
+	return k8sfake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, client crclient.WithWatch, obj crclient.Object, opts ...crclient.CreateOption) error {
+			if job, ok := obj.(*batchv1api.Job); ok {
+				condition := batchv1api.JobComplete
+				if job.Labels["result"] == "failed" {
+					condition = batchv1api.JobFailed
+				}
+				job.Status.Conditions = []batchv1api.JobCondition{{Type: condition, Status: corev1api.ConditionTrue, Message: "BackoffLimitExceeded"}}
+			}
+			return client.Create(ctx, obj, opts...)
+		},
+	}).Build()
+}
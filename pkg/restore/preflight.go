@@ -0,0 +1,398 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+)
+
+// podTemplateResources are the resources whose items have a pod template, checked by the
+// preflight check of the nodes.
+var podTemplateResources = map[string]bool{
+	"deployments.apps":  true,
+	"statefulsets.apps": true,
+	"daemonsets.apps":   true,
+	"replicasets.apps":  true,
+	"jobs.batch":        true,
+}
+
+// preflightReport collects the failed preflight checks of a restore.
+type preflightReport struct {
+	enforce  bool
+	failures []string
+	warnings results.Result
+}
+
+// fail reports a failed check that makes the restore fail its validation when the preflight
+// policy is Enforce, and that is reported as a warning otherwise.
+func (r *preflightReport) fail(namespace string, err error) {
+	if r.enforce {
+		r.failures = append(r.failures, err.Error())
+		return
+	}
+	r.warnings.Add(namespace, err)
+}
+
+// warn reports a failed check as a warning of the restore.
+func (r *preflightReport) warn(namespace string, err error) {
+	r.warnings.Add(namespace, err)
+}
+
+// preflight checks the target cluster against the items selected for the restore, before any
+// item is restored. It returns the failed checks reported as warnings, and the failed checks
+// that make the restore fail its validation when the preflight policy is Enforce.
+func (ctx *restoreContext) preflight(backupResources map[string]*archive.ResourceItems) (results.Result, []string) {
+	report := &preflightReport{enforce: ctx.restore.Spec.PreflightPolicy == velerov1api.RestorePreflightPolicyEnforce}
+
+	ctx.log.Infof("Running the preflight checks of the restore with the %s policy", ctx.restore.Spec.PreflightPolicy)
+
+	// the warnings and errors of the selection of the items are reported when they are restored
+	collection, _, _, _ := ctx.getOrderedResourceCollection(
+		backupResources,
+		make([]restoreableResource, 0),
+		sets.New[string](),
+		ctx.resourcePriorities,
+		true,
+	)
+
+	ctx.preflightAPIs(collection, report)
+	ctx.preflightStorageClasses(collection, report)
+	ctx.preflightResourceQuotas(collection, report)
+	ctx.preflightNodes(collection, report)
+
+	sort.Strings(report.failures)
+	for _, failure := range report.failures {
+		ctx.log.Errorf("Preflight check failed: %s", failure)
+	}
+	ctx.log.Infof("Preflight checks of the restore done, %d failed", len(report.failures))
+	return report.warnings, report.failures
+}
+
+// preflightItems calls the function with the items of the resource selected for the restore,
+// in the order of their target namespaces.
+func (ctx *restoreContext) preflightItems(selected restoreableResource, fn func(item restoreableItem, obj *unstructured.Unstructured)) {
+	namespaces := make([]string, 0, len(selected.selectedItemsByNamespace))
+	for namespace := range selected.selectedItemsByNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		for _, item := range selected.selectedItemsByNamespace[namespace] {
+			obj, err := archive.Unmarshal(ctx.fileSystem, item.path)
+			if err != nil {
+				ctx.log.WithError(err).Warnf("Skipping the preflight checks of %s %s", selected.resource, item.name)
+				continue
+			}
+			fn(item, obj)
+		}
+	}
+}
+
+// preflightAPIs checks that the API of each resource of the restore is served by the cluster,
+// or defined by a CustomResourceDefinition restored by the restore, in the versions of the items.
+func (ctx *restoreContext) preflightAPIs(collection []restoreableResource, report *preflightReport) {
+	// served versions of the resources defined by the restored CRDs
+	crdVersions := map[string]sets.Set[string]{}
+	for _, selected := range collection {
+		if selected.resource != kuberesource.CustomResourceDefinitions.String() {
+			continue
+		}
+		ctx.preflightItems(selected, func(_ restoreableItem, obj *unstructured.Unstructured) {
+			group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+			plural, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "plural")
+			versions := sets.New[string]()
+			if version, found, _ := unstructured.NestedString(obj.Object, "spec", "version"); found {
+				versions.Insert(version)
+			}
+			crdVersionList, _, _ := unstructured.NestedSlice(obj.Object, "spec", "versions")
+			for _, v := range crdVersionList {
+				if m, ok := v.(map[string]any); ok && m["served"] != false {
+					if name, ok := m["name"].(string); ok {
+						versions.Insert(name)
+					}
+				}
+			}
+			crdVersions[schema.GroupResource{Group: group, Resource: plural}.String()] = versions
+		})
+	}
+
+	for _, selected := range collection {
+		if selected.totalItems == 0 {
+			continue
+		}
+
+		versions := sets.New[string]()
+		for _, items := range selected.selectedItemsByNamespace {
+			for _, item := range items {
+				if item.version != "" {
+					versions.Insert(item.version)
+				}
+			}
+		}
+
+		groupResource := schema.ParseGroupResource(selected.resource)
+		if _, _, err := ctx.discoveryHelper.ResourceFor(groupResource.WithVersion("")); err == nil {
+			for _, version := range sets.List(versions) {
+				if _, _, err := ctx.discoveryHelper.ResourceFor(groupResource.WithVersion(version)); err != nil {
+					report.warn("", errors.Errorf("version %s of %s isn't served by the cluster", version, selected.resource))
+				}
+			}
+			continue
+		}
+
+		crdServed, found := crdVersions[selected.resource]
+		if !found {
+			report.fail("", errors.Errorf("%s isn't served by the cluster and its CustomResourceDefinition isn't in the restore", selected.resource))
+			continue
+		}
+		for _, version := range sets.List(versions.Difference(crdServed)) {
+			report.fail("", errors.Errorf("version %s of %s isn't served by its CustomResourceDefinition in the restore", version, selected.resource))
+		}
+	}
+}
+
+// preflightStorageClasses checks that the storage classes of the restored PVCs exist, after
+// they are changed by the storage class mapping of the change-storage-class restore item action.
+func (ctx *restoreContext) preflightStorageClasses(collection []restoreableResource, report *preflightReport) {
+	mapping := ctx.storageClassMapping()
+	exists := map[string]bool{}
+
+	for _, selected := range collection {
+		if selected.resource != kuberesource.PersistentVolumeClaims.String() {
+			continue
+		}
+		ctx.preflightItems(selected, func(item restoreableItem, obj *unstructured.Unstructured) {
+			storageClass, _, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+			if storageClass == "" {
+				return
+			}
+			if mapped, ok := mapping[storageClass]; ok {
+				storageClass = mapped
+			}
+
+			if _, checked := exists[storageClass]; !checked {
+				err := ctx.kbClient.Get(go_context.TODO(), crclient.ObjectKey{Name: storageClass}, &storagev1api.StorageClass{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					ctx.log.WithError(err).Warnf("Unable to get the storage class %s", storageClass)
+				}
+				exists[storageClass] = err == nil
+			}
+			if !exists[storageClass] {
+				report.fail(item.targetNamespace, errors.Errorf("storage class %s of PersistentVolumeClaim %s/%s doesn't exist", storageClass, item.targetNamespace, item.name))
+			}
+		})
+	}
+}
+
+// storageClassMapping returns the storage class mapping of the change-storage-class restore
+// item action, empty if it isn't configured.
+func (ctx *restoreContext) storageClassMapping() map[string]string {
+	selector, err := labels.Parse(common.PluginConfigLabelSelector(common.PluginKindRestoreItemAction, "velero.io/change-storage-class"))
+	if err != nil {
+		return nil
+	}
+
+	list := &corev1api.ConfigMapList{}
+	if err := ctx.kbClient.List(go_context.TODO(), list, crclient.InNamespace(ctx.restore.Namespace), crclient.MatchingLabelsSelector{Selector: selector}); err != nil {
+		ctx.log.WithError(err).Warn("Unable to list the storage class mapping ConfigMaps")
+		return nil
+	}
+	if len(list.Items) != 1 {
+		return nil
+	}
+	return list.Items[0].Data
+}
+
+// preflightResourceQuotas checks that the resource quotas of the target namespaces have room
+// for the restored pods and PVCs.
+func (ctx *restoreContext) preflightResourceQuotas(collection []restoreableResource, report *preflightReport) {
+	required := map[string]corev1api.ResourceList{}
+	add := func(namespace string, name corev1api.ResourceName, quantity resource.Quantity) {
+		if required[namespace] == nil {
+			required[namespace] = corev1api.ResourceList{}
+		}
+		total := required[namespace][name]
+		total.Add(quantity)
+		required[namespace][name] = total
+	}
+
+	for _, selected := range collection {
+		switch selected.resource {
+		case kuberesource.Pods.String():
+			ctx.preflightItems(selected, func(item restoreableItem, obj *unstructured.Unstructured) {
+				pod := new(corev1api.Pod)
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pod); err != nil {
+					return
+				}
+				add(item.targetNamespace, corev1api.ResourcePods, resource.MustParse("1"))
+				for _, container := range pod.Spec.Containers {
+					for name, quantity := range container.Resources.Requests {
+						add(item.targetNamespace, corev1api.ResourceName("requests."+string(name)), quantity)
+					}
+					for name, quantity := range container.Resources.Limits {
+						add(item.targetNamespace, corev1api.ResourceName("limits."+string(name)), quantity)
+					}
+				}
+			})
+		case kuberesource.PersistentVolumeClaims.String():
+			ctx.preflightItems(selected, func(item restoreableItem, obj *unstructured.Unstructured) {
+				pvc := new(corev1api.PersistentVolumeClaim)
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pvc); err != nil {
+					return
+				}
+				add(item.targetNamespace, corev1api.ResourcePersistentVolumeClaims, resource.MustParse("1"))
+				if storage, ok := pvc.Spec.Resources.Requests[corev1api.ResourceStorage]; ok {
+					add(item.targetNamespace, corev1api.ResourceRequestsStorage, storage)
+				}
+			})
+		}
+	}
+
+	namespaces := make([]string, 0, len(required))
+	for namespace := range required {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	for _, namespace := range namespaces {
+		quotas := &corev1api.ResourceQuotaList{}
+		if err := ctx.kbClient.List(go_context.TODO(), quotas, crclient.InNamespace(namespace)); err != nil {
+			ctx.log.WithError(err).Warnf("Unable to list the resource quotas of namespace %s", namespace)
+			continue
+		}
+
+		for _, quota := range quotas.Items {
+			names := make([]string, 0, len(quota.Status.Hard))
+			for name := range quota.Status.Hard {
+				names = append(names, string(name))
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				hard := quota.Status.Hard[corev1api.ResourceName(name)]
+				// the quotas of cpu and memory are quotas of their requests
+				requiredName := corev1api.ResourceName(name)
+				if requiredName == corev1api.ResourceCPU || requiredName == corev1api.ResourceMemory {
+					requiredName = corev1api.ResourceName("requests." + name)
+				}
+				quantity, ok := required[namespace][requiredName]
+				if !ok {
+					continue
+				}
+
+				available := hard.DeepCopy()
+				available.Sub(quota.Status.Used[corev1api.ResourceName(name)])
+				if quantity.Cmp(available) > 0 {
+					report.warn(namespace, errors.Errorf("resource quota %s/%s doesn't have room for the restored items: they require %s of %s, %s is available",
+						namespace, quota.Name, quantity.String(), name, available.String()))
+				}
+			}
+		}
+	}
+}
+
+// preflightNodes checks that the cluster has nodes with the operating system and the
+// architecture required by the restored pods and pod templates, from their spec.os.name and
+// their kubernetes.io/os and kubernetes.io/arch node selectors.
+func (ctx *restoreContext) preflightNodes(collection []restoreableResource, report *preflightReport) {
+	var platforms []nodePlatform
+
+	for _, selected := range collection {
+		podSpecPath := []string{"spec"}
+		if podTemplateResources[selected.resource] {
+			podSpecPath = []string{"spec", "template", "spec"}
+		} else if selected.resource != kuberesource.Pods.String() {
+			continue
+		}
+
+		ctx.preflightItems(selected, func(item restoreableItem, obj *unstructured.Unstructured) {
+			nodeSelector, _, _ := unstructured.NestedStringMap(obj.Object, append(podSpecPath, "nodeSelector")...)
+			required := nodePlatform{arch: nodeSelector[corev1api.LabelArchStable]}
+			required.os, _, _ = unstructured.NestedString(obj.Object, append(podSpecPath, "os", "name")...)
+			if required.os == "" {
+				required.os = nodeSelector[corev1api.LabelOSStable]
+			}
+			if required.os == "" && required.arch == "" {
+				return
+			}
+
+			if platforms == nil {
+				platforms = ctx.nodePlatforms()
+			}
+			for _, platform := range platforms {
+				if (required.os == "" || required.os == platform.os) && (required.arch == "" || required.arch == platform.arch) {
+					return
+				}
+			}
+			report.warn(item.targetNamespace, errors.Errorf("no node of the cluster has the platform %s required by %s %s/%s",
+				required, selected.resource, item.targetNamespace, item.name))
+		})
+	}
+}
+
+// nodePlatform is the operating system and the architecture of a node.
+type nodePlatform struct {
+	os   string
+	arch string
+}
+
+func (p nodePlatform) String() string {
+	os, arch := p.os, p.arch
+	if os == "" {
+		os = "*"
+	}
+	if arch == "" {
+		arch = "*"
+	}
+	return fmt.Sprintf("%s/%s", os, arch)
+}
+
+// nodePlatforms returns the platforms of the nodes of the cluster.
+func (ctx *restoreContext) nodePlatforms() []nodePlatform {
+	platforms := []nodePlatform{}
+
+	nodes := &corev1api.NodeList{}
+	if err := ctx.kbClient.List(go_context.TODO(), nodes); err != nil {
+		ctx.log.WithError(err).Warn("Unable to list the nodes of the cluster")
+		return platforms
+	}
+	for _, node := range nodes.Items {
+		platforms = append(platforms, nodePlatform{os: node.Labels[corev1api.LabelOSStable], arch: node.Labels[corev1api.LabelArchStable]})
+	}
+	return platforms
+}
@@ -0,0 +1,165 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRestorePreflight(t *testing.T) {
+	widget := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]any{"namespace": "ns-1", "name": "widget-1"},
+	}}
+	widgetCRD := builder.ForV1CustomResourceDefinition("widgets.example.com").
+		Version(builder.ForV1CustomResourceDefinitionVersion("v1").Served(true).Storage(true).Result()).
+		Result()
+	widgetCRD.Spec.Group = "example.com"
+	widgetCRD.Spec.Names.Plural = "widgets"
+
+	armPod := builder.ForPod("ns-1", "pod-1").Result()
+	armPod.Spec.NodeSelector = map[string]string{corev1api.LabelArchStable: "arm64"}
+	armPod.Spec.Containers = []corev1api.Container{{
+		Name:      "container-1",
+		Resources: corev1api.ResourceRequirements{Requests: corev1api.ResourceList{corev1api.ResourceCPU: resource.MustParse("1")}},
+	}}
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		tarball      io.Reader
+		apiResources []*test.APIResource
+		objects      []crclient.Object
+		wantFailures []string
+		wantWarnings map[string][]string
+	}{
+		{
+			name:    "failed checks fail the restore when the policy is Enforce",
+			restore: defaultRestore().PreflightPolicy(velerov1api.RestorePreflightPolicyEnforce).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("gold").Result()).
+				AddItems("widgets.example.com", widget).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.PVCs()},
+			wantFailures: []string{
+				"storage class gold of PersistentVolumeClaim ns-1/pvc-1 doesn't exist",
+				"widgets.example.com isn't served by the cluster and its CustomResourceDefinition isn't in the restore",
+			},
+		},
+		{
+			name:    "failed checks are warnings when the policy is Warn",
+			restore: defaultRestore().PreflightPolicy(velerov1api.RestorePreflightPolicyWarn).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("gold").Result()).
+				Done(),
+			apiResources: []*test.APIResource{test.PVCs()},
+			wantWarnings: map[string][]string{
+				"ns-1": {"storage class gold of PersistentVolumeClaim ns-1/pvc-1 doesn't exist"},
+			},
+		},
+		{
+			name:    "storage classes are checked after the storage class mapping, and CRDs of the backup define their resources",
+			restore: defaultRestore().PreflightPolicy(velerov1api.RestorePreflightPolicyEnforce).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("customresourcedefinitions.apiextensions.k8s.io", widgetCRD).
+				AddItems("persistentvolumeclaims", builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("gold").Result()).
+				AddItems("widgets.example.com", widget).
+				Done(),
+			apiResources: []*test.APIResource{test.CRDs(), test.PVCs()},
+			objects: []crclient.Object{
+				builder.ForConfigMap(velerov1api.DefaultNamespace, "change-storage-class").
+					ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", "velero.io/change-storage-class", "RestoreItemAction")).
+					Data("gold", "silver").
+					Result(),
+				&storagev1api.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "silver"}},
+			},
+		},
+		{
+			name:    "resource quotas without room and missing node platforms are warnings",
+			restore: defaultRestore().PreflightPolicy(velerov1api.RestorePreflightPolicyEnforce).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", armPod).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods()},
+			objects: []crclient.Object{
+				&corev1api.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "quota-1"},
+					Status: corev1api.ResourceQuotaStatus{
+						Hard: corev1api.ResourceList{corev1api.ResourcePods: resource.MustParse("2"), corev1api.ResourceRequestsCPU: resource.MustParse("2")},
+						Used: corev1api.ResourceList{corev1api.ResourcePods: resource.MustParse("2")},
+					},
+				},
+				builder.ForNode("node-1").Labels(map[string]string{corev1api.LabelOSStable: "linux", corev1api.LabelArchStable: "amd64"}).Result(),
+			},
+			wantWarnings: map[string][]string{
+				"ns-1": {
+					"resource quota ns-1/quota-1 doesn't have room for the restored items: they require 1 of pods, 0 is available",
+					"no node of the cluster has the platform */arm64 required by pods ns-1/pod-1",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+			for _, obj := range tc.objects {
+				require.NoError(t, h.restorer.kbClient.Create(t.Context(), obj))
+			}
+
+			data := &Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			warnings, _ := h.restorer.Restore(data, nil, nil)
+
+			assert.Equal(t, tc.wantFailures, data.PreflightFailures)
+			for namespace, want := range tc.wantWarnings {
+				for _, warning := range want {
+					assert.Contains(t, warnings.Namespaces[namespace], warning)
+				}
+			}
+			if len(tc.wantFailures) > 0 {
+				assert.Empty(t, data.RestoredItems)
+				_, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(t.Context(), "pod-1", metav1.GetOptions{})
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
@@ -66,6 +67,14 @@ type Request struct {
 	BackupVolumeInfoMap           map[string]volume.BackupVolumeInfo
 	RestoreVolumeInfoTracker      *volume.RestoreVolumeInfoTracker
 	ResourceDeletionStatusTracker kube.ResourceDeletionStatusTracker
+	// DryRunReport is the report of the items of a dry-run restore, it's set by the restorer.
+	DryRunReport []velerov1api.RestoreDryRunItem
+	// OriginalItems is the in-cluster version of the items updated by the restore, as they were
+	// before the restore updated them, it's set by the restorer.
+	OriginalItems []*unstructured.Unstructured
+	// PreflightFailures are the failed preflight checks of a restore whose preflight policy is
+	// Enforce, which restored nothing, it's set by the restorer.
+	PreflightFailures []string
 }
 
 type restoredItemStatus struct {
@@ -102,3 +111,23 @@ func (r *Request) RestoredResourceList() map[string][]string {
 
 	return resources
 }
+
+// DryRunResult returns the number of items of the dry-run report by result.
+func (r *Request) DryRunResult() *velerov1api.RestoreDryRunResult {
+	result := &velerov1api.RestoreDryRunResult{}
+	for _, item := range r.DryRunReport {
+		switch item.Result {
+		case velerov1api.RestoreDryRunItemResultWouldCreate:
+			result.WouldCreate++
+		case velerov1api.RestoreDryRunItemResultWouldUpdate:
+			result.WouldUpdate++
+		case velerov1api.RestoreDryRunItemResultExistsUnchanged:
+			result.ExistsUnchanged++
+		case velerov1api.RestoreDryRunItemResultExistsDiffers:
+			result.ExistsDiffers++
+		case velerov1api.RestoreDryRunItemResultSkipped:
+			result.Skipped++
+		}
+	}
+	return result
+}
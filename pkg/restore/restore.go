@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -34,6 +35,7 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	appsv1api "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -77,6 +79,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
+	pkgrestoreUtil "github.com/vmware-tanzu/velero/pkg/util/velero/restore"
 )
 
 const ObjectStatusRestoreAnnotationKey = "velero.io/restore-status"
@@ -124,6 +127,7 @@ type kubernetesRestorer struct {
 	kbClient                      crclient.Client
 	multiHookTracker              *hook.MultiHookTracker
 	resourceDeletionStatusTracker kube.ResourceDeletionStatusTracker
+	itemWorkerCount               int
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -136,6 +140,7 @@ func NewKubernetesRestorer(
 	podVolumeTimeout time.Duration,
 	resourceTerminatingTimeout time.Duration,
 	resourceTimeout time.Duration,
+	itemWorkerCount int,
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
 	podGetter cache.Getter,
@@ -151,6 +156,7 @@ func NewKubernetesRestorer(
 		podVolumeTimeout:           podVolumeTimeout,
 		resourceTerminatingTimeout: resourceTerminatingTimeout,
 		resourceTimeout:            resourceTimeout,
+		itemWorkerCount:            itemWorkerCount,
 		resourcePriorities:         resourcePriorities,
 		logger:                     logger,
 		pvRenamer: func(string) (string, error) {
@@ -230,6 +236,12 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		)
 	}
 
+	// Get the resources recreated by the recreate existing resource policy.
+	var recreateResources *collections.IncludesExcludes
+	if req.Restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeRecreate && len(req.Restore.Spec.RecreateResources) > 0 {
+		recreateResources = collections.GetResourceIncludesExcludes(kr.discoveryHelper, req.Restore.Spec.RecreateResources, nil)
+	}
+
 	// Get namespace includes-excludes.
 	namespaceIncludesExcludes := collections.NewIncludesExcludes().
 		Includes(req.Restore.Spec.IncludedNamespaces...).
@@ -257,8 +269,16 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	kr.podVolumeContext, podVolumeCancelFunc = go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
 	defer podVolumeCancelFunc()
 
+	// a dry-run restore sends the creates and patches of the items with server-side dry run,
+	// and doesn't restore the pod volumes
+	dryRun := boolptr.IsSetToTrue(req.Restore.Spec.DryRun)
+	dynamicFactory := kr.dynamicFactory
+	if dryRun {
+		dynamicFactory = dynamicFactory.DryRun()
+	}
+
 	var podVolumeRestorer podvolume.Restorer
-	if kr.podVolumeRestorerFactory != nil {
+	if kr.podVolumeRestorerFactory != nil && !dryRun {
 		podVolumeRestorer, err = kr.podVolumeRestorerFactory.NewRestorer(kr.podVolumeContext, req.Restore)
 		if err != nil {
 			return results.Result{}, results.Result{Velero: []string{err.Error()}}
@@ -290,6 +310,11 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 
 	req.RestoredItems = make(map[itemKey]restoredItemStatus)
 
+	itemWorkerCount := kr.itemWorkerCount
+	if req.Restore.Spec.ItemWorkerCount > 0 {
+		itemWorkerCount = req.Restore.Spec.ItemWorkerCount
+	}
+
 	restoreCtx := &restoreContext{
 		backup:                         req.Backup,
 		backupReader:                   req.BackupReader,
@@ -297,12 +322,13 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		resourceIncludesExcludes:       resourceIncludesExcludes,
 		resourceStatusIncludesExcludes: restoreStatusIncludesExcludes,
 		namespaceIncludesExcludes:      namespaceIncludesExcludes,
+		recreateResources:              recreateResources,
 		resourceMustHave:               sets.New[string](resourceMustHave...),
 		chosenGrpVersToRestore:         make(map[string]ChosenGroupVersion),
 		selector:                       selector,
 		OrSelectors:                    OrSelectors,
 		log:                            req.Log,
-		dynamicFactory:                 kr.dynamicFactory,
+		dynamicFactory:                 dynamicFactory,
 		fileSystem:                     kr.fileSystem,
 		namespaceClient:                kr.namespaceClient,
 		restoreItemActions:             resolvedActions,
@@ -331,9 +357,22 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		restoreVolumeInfoTracker:       req.RestoreVolumeInfoTracker,
 		hooksWaitExecutor:              hooksWaitExecutor,
 		resourceDeletionStatusTracker:  req.ResourceDeletionStatusTracker,
+		itemWorkerCount:                itemWorkerCount,
+		dryRun:                         dryRun,
+		dryRunNamespaces:               sets.New[string](),
+		dryRunDiffs:                    make(map[itemKey]string),
+		originalItems:                  make(map[itemKey]*unstructured.Unstructured),
+		podCommandExecutor:             kr.podCommandExecutor,
+		preHookFailedNamespaces:        sets.New[string](),
 	}
 
-	return restoreCtx.execute()
+	warnings, errs := restoreCtx.execute()
+	if dryRun {
+		req.DryRunReport = restoreCtx.dryRunReport()
+	}
+	req.OriginalItems = restoreCtx.originalItemList()
+	req.PreflightFailures = restoreCtx.preflightFailures
+	return warnings, errs
 }
 
 type restoreContext struct {
@@ -344,6 +383,7 @@ type restoreContext struct {
 	resourceIncludesExcludes       *collections.IncludesExcludes
 	resourceStatusIncludesExcludes *collections.IncludesExcludes
 	namespaceIncludesExcludes      *collections.IncludesExcludes
+	recreateResources              *collections.IncludesExcludes
 	resourceMustHave               sets.Set[string]
 	chosenGrpVersToRestore         map[string]ChosenGroupVersion
 	selector                       labels.Selector
@@ -380,6 +420,20 @@ type restoreContext struct {
 	restoreVolumeInfoTracker       *volume.RestoreVolumeInfoTracker
 	hooksWaitExecutor              *hooksWaitExecutor
 	resourceDeletionStatusTracker  kube.ResourceDeletionStatusTracker
+	dependencyGraph                *dependencyGraph
+	itemWorkerCount                int
+	itemWorkerPool                 *restoreItemWorkerPool
+	dryRun                         bool
+	dryRunNamespaces               sets.Set[string]
+	dryRunDiffs                    map[itemKey]string
+	originalItems                  map[itemKey]*unstructured.Unstructured
+	preflightFailures              []string
+	podCommandExecutor             podexec.PodCommandExecutor
+	preHookFailedNamespaces        sets.Set[string]
+	// lock guards the state shared by the item workers: restoredItems, resourceClients,
+	// pvsToProvision, renamedPVs, itemOperationsList, dryRunNamespaces, dryRunDiffs and
+	// originalItems.
+	lock sync.Mutex
 }
 
 type resourceClientKey struct {
@@ -484,6 +538,47 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		}
 	}
 
+	// the claims of the renamed statefulsets are renamed after them before any item is renamed
+	if pkgrestoreUtil.HasNameMapping(ctx.restore) {
+		ctx.mapStatefulSetClaims(backupResources)
+	}
+
+	// the preflight checks run before any item is restored, a restore whose enforced checks
+	// failed restores nothing
+	if ctx.restore.Spec.PreflightPolicy != "" {
+		w, failures := ctx.preflight(backupResources)
+		warnings.Merge(&w)
+		if len(failures) > 0 {
+			ctx.preflightFailures = failures
+			return warnings, errs
+		}
+	}
+
+	// the pre hooks run before any item is restored, a failed hook whose OnError is Fail
+	// prevents the items of the restore, or of the namespace it ran for, from being restored
+	if len(ctx.restore.Spec.Hooks.PreHooks) > 0 {
+		if ctx.dryRun {
+			ctx.log.Info("Skipping the pre hooks of the dry-run restore")
+		} else {
+			e, restoreItems := ctx.runPreHooks(backupResources)
+			errs.Merge(&e)
+			if !restoreItems {
+				return warnings, errs
+			}
+		}
+	}
+
+	if ctx.restore.Spec.ResourceOrdering == velerov1api.RestoreResourceOrderingDependencyGraph {
+		ctx.log.Info("Computing the restore order from the dependency graph of the backup")
+		ctx.dependencyGraph = ctx.newDependencyGraph(backupResources)
+	}
+
+	if ctx.itemWorkerCount > 1 {
+		ctx.log.Infof("Restoring up to %d items of a resource in parallel", ctx.itemWorkerCount)
+		ctx.itemWorkerPool = startRestoreItemWorkerPool(go_context.Background(), ctx, ctx.itemWorkerCount, ctx.log)
+		defer ctx.itemWorkerPool.Stop()
+	}
+
 	update := make(chan progressUpdate)
 
 	quit := make(chan struct{})
@@ -688,7 +783,8 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
-// in the expected total restore count.
+// in the expected total restore count. When the item worker pool is started, the items are
+// restored in parallel, and all of them are restored when this function returns.
 func (ctx *restoreContext) processSelectedResource(
 	selectedResource restoreableResource,
 	totalItems int,
@@ -699,17 +795,52 @@ func (ctx *restoreContext) processSelectedResource(
 	warnings, errs := results.Result{}, results.Result{}
 	groupResource := schema.ParseGroupResource(selectedResource.resource)
 
+	// collect merges the results of a restored item and updates the restore progress
+	collect := func(ret restoreItemReturn) {
+		warnings.Merge(&ret.warnings)
+		errs.Merge(&ret.errs)
+		if !ret.decoded {
+			return
+		}
+		processedItems++
+
+		// totalItems keeps the count of items previously known. There
+		// may be additional items restored by plugins. We want to include
+		// the additional items by looking at restoredItems at the same
+		// time, we don't want previously known items counted twice as
+		// they are present in both restoredItems and totalItems.
+		itemsRestored := ctx.restoredItemsCount()
+		actualTotalItems := itemsRestored + (totalItems - processedItems)
+		if update != nil {
+			update <- progressUpdate{
+				totalItems:    actualTotalItems,
+				itemsRestored: itemsRestored,
+			}
+		}
+		ctx.log.WithFields(map[string]any{
+			"progress":  "",
+			"resource":  groupResource.String(),
+			"namespace": ret.item.targetNamespace,
+			"name":      ret.item.name,
+		}).Infof("Restored %d items out of an estimated total of %d (estimate will change throughout the restore)", itemsRestored, actualTotalItems)
+	}
+
+	// the items submitted to the worker pool whose results haven't been collected yet
+	pending := 0
+	returnChan := make(chan restoreItemReturn)
+	wait := func() {
+		for ; pending > 0; pending-- {
+			collect(<-returnChan)
+		}
+	}
+
 	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
 		for _, selectedItem := range selectedItems {
 			targetNS := selectedItem.targetNamespace
 			if groupResource == kuberesource.Namespaces {
 				// namespace is a cluster-scoped resource and doesn't have "targetNamespace" attribute in the restoreableItem instance
 				namespace = selectedItem.name
-				if n, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
-					targetNS = n
-				} else {
-					targetNS = namespace
-				}
+				targetNS, _ = pkgrestoreUtil.MapNamespace(ctx.restore, namespace)
 			}
 			// If we don't know whether this namespace exists yet, attempt to create
 			// it in order to ensure it exists. Try to get it from the backup tarball
@@ -723,12 +854,7 @@ func (ctx *restoreContext) processSelectedResource(
 					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
 					targetNS,
 				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-					ctx.resourceDeletionStatusTracker,
-				)
+				nsCreated, err := ctx.ensureNamespaceExistsAndIsReady(ns)
 				if err != nil {
 					errs.AddVeleroError(err)
 					continue
@@ -741,7 +867,7 @@ func (ctx *restoreContext) processSelectedResource(
 						namespace: ns.Namespace,
 						name:      ns.Name,
 					}
-					ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
+					ctx.setRestoredItem(itemKey, restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true})
 				}
 
 				// Keep track of namespaces that we know exist so we don't
@@ -753,48 +879,154 @@ func (ctx *restoreContext) processSelectedResource(
 				continue
 			}
 
-			obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
-			if err != nil {
-				errs.Add(
-					selectedItem.targetNamespace,
-					fmt.Errorf(
-						"error decoding %q: %v",
-						strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
-						err,
-					),
-				)
+			if ctx.itemWorkerPool == nil {
+				collect(ctx.restoreSelectedItem(selectedItem, groupResource))
 				continue
 			}
 
-			w, e, _ := ctx.restoreItem(obj, groupResource, targetNS)
-			warnings.Merge(&w)
-			errs.Merge(&e)
-			processedItems++
-
-			// totalItems keeps the count of items previously known. There
-			// may be additional items restored by plugins. We want to include
-			// the additional items by looking at restoredItems at the same
-			// time, we don't want previously known items counted twice as
-			// they are present in both restoredItems and totalItems.
-			actualTotalItems := len(ctx.restoredItems) + (totalItems - processedItems)
-			if update != nil {
-				update <- progressUpdate{
-					totalItems:    actualTotalItems,
-					itemsRestored: len(ctx.restoredItems),
+			// the items of the resource this item depends on come before it, wait for them
+			// to be restored before restoring it
+			if ctx.dependencyGraph != nil && ctx.dependencyGraph.hasItemDependencies(selectedResource.resource, namespace, selectedItem.name) {
+				wait()
+			}
+
+			input := restoreItemInput{
+				item:          selectedItem,
+				groupResource: groupResource,
+				returnChan:    returnChan,
+			}
+			// keep collecting the results while waiting for a free worker
+			for submitted := false; !submitted; {
+				select {
+				case ctx.itemWorkerPool.inputChannel <- input:
+					pending++
+					submitted = true
+				case ret := <-returnChan:
+					pending--
+					collect(ret)
 				}
 			}
-			ctx.log.WithFields(map[string]any{
-				"progress":  "",
-				"resource":  groupResource.String(),
-				"namespace": selectedItem.targetNamespace,
-				"name":      selectedItem.name,
-			}).Infof("Restored %d items out of an estimated total of %d (estimate will change throughout the restore)", len(ctx.restoredItems), actualTotalItems)
 		}
 	}
+	wait()
 
 	return processedItems, warnings, errs
 }
 
+// restoreSelectedItem decodes a selected item from the backup and restores it.
+func (ctx *restoreContext) restoreSelectedItem(selectedItem restoreableItem, groupResource schema.GroupResource) restoreItemReturn {
+	ret := restoreItemReturn{item: selectedItem}
+
+	obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
+	if err != nil {
+		ret.errs.Add(
+			selectedItem.targetNamespace,
+			fmt.Errorf(
+				"error decoding %q: %v",
+				strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
+				err,
+			),
+		)
+		return ret
+	}
+
+	ret.warnings, ret.errs, _ = ctx.restoreItem(obj, groupResource, selectedItem.targetNamespace)
+	ret.decoded = true
+	return ret
+}
+
+// getRestoredItem returns the status of an item tracked as restored.
+func (ctx *restoreContext) getRestoredItem(key itemKey) (restoredItemStatus, bool) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	status, ok := ctx.restoredItems[key]
+	return status, ok
+}
+
+// setRestoredItem sets the status of an item tracked as restored.
+func (ctx *restoreContext) setRestoredItem(key itemKey, status restoredItemStatus) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	ctx.restoredItems[key] = status
+}
+
+// setUpdatedItem tracks an item as updated by the restore, along with its in-cluster version
+// before it was updated, so the update can be reverted by a rollback of the restore.
+func (ctx *restoreContext) setUpdatedItem(key itemKey, status restoredItemStatus, original *unstructured.Unstructured) {
+	status.action = ItemRestoreResultUpdated
+
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	ctx.restoredItems[key] = status
+	ctx.originalItems[key] = original
+}
+
+// originalItemList returns the in-cluster version of the items updated by the restore, sorted
+// by resource, namespace and name.
+func (ctx *restoreContext) originalItemList() []*unstructured.Unstructured {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	keys := make([]itemKey, 0, len(ctx.originalItems))
+	for key := range ctx.originalItems {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].resource != keys[j].resource {
+			return keys[i].resource < keys[j].resource
+		}
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].name < keys[j].name
+	})
+
+	var items []*unstructured.Unstructured
+	for _, key := range keys {
+		items = append(items, ctx.originalItems[key])
+	}
+	return items
+}
+
+// trackRestoredItem tracks an item as restored with the status, unless it's already tracked,
+// in which case its current status is returned along with true.
+func (ctx *restoreContext) trackRestoredItem(key itemKey, status restoredItemStatus) (restoredItemStatus, bool) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	if prev, ok := ctx.restoredItems[key]; ok {
+		return prev, true
+	}
+	ctx.restoredItems[key] = status
+	return status, false
+}
+
+func (ctx *restoreContext) restoredItemsCount() int {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	return len(ctx.restoredItems)
+}
+
+// trackPVToProvision records a PV that is dynamically re-provisioned instead of restored.
+func (ctx *restoreContext) trackPVToProvision(name string) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	ctx.pvsToProvision.Insert(name)
+}
+
+func (ctx *restoreContext) isPVToProvision(name string) bool {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	return ctx.pvsToProvision.Has(name)
+}
+
+// renamedPV returns the new name of a PV renamed during the restore.
+func (ctx *restoreContext) renamedPV(name string) (string, bool) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	newName, ok := ctx.renamedPVs[name]
+	return newName, ok
+}
+
 // getNamespace returns a namespace API object that we should attempt to
 // create before restoring anything into it. It will come from the backup
 // tarball if it exists, else will be a new one. If from the tarball, it
@@ -1025,6 +1257,9 @@ func getResourceClientKey(groupResource schema.GroupResource, version, namespace
 func (ctx *restoreContext) getResourceClient(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace string) (client.Dynamic, error) {
 	key := getResourceClientKey(groupResource, obj.GroupVersionKind().Version, namespace)
 
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
 	if client, ok := ctx.resourceClients[key]; ok {
 		return client, nil
 	}
@@ -1099,6 +1334,8 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	resourceID := getResourceID(groupResource, namespace, obj.GetName())
 	resourceKind := obj.GetKind()
 	backupResourceName := obj.GetName()
+	// the name the item is restored with, which is different if the restore renames it
+	restoreResourceName, _ := pkgrestoreUtil.MapName(ctx.restore, groupResource, obj.GetNamespace(), backupResourceName)
 
 	restoreLogger := ctx.log.WithFields(logrus.Fields{
 		"namespace":     obj.GetNamespace(),
@@ -1130,7 +1367,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(restoreLogger, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout, ctx.resourceDeletionStatusTracker)
+		nsCreated, err := ctx.ensureNamespaceExistsAndIsReady(nsToEnsure)
 		if err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs, itemExists
@@ -1142,7 +1379,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				namespace: nsToEnsure.Namespace,
 				name:      nsToEnsure.Name,
 			}
-			ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true}
+			ctx.setRestoredItem(itemKey, restoredItemStatus{action: ItemRestoreResultCreated, itemExists: true})
 		}
 	} else {
 		if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
@@ -1169,16 +1406,15 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	itemKey := itemKey{
 		resource:  resourceKey(obj),
 		namespace: namespace,
-		name:      backupResourceName,
+		name:      restoreResourceName,
 	}
-	if prevRestoredItemStatus, exists := ctx.restoredItems[itemKey]; exists {
+	if prevRestoredItemStatus, exists := ctx.trackRestoredItem(itemKey, restoredItemStatus{itemExists: itemExists}); exists {
 		restoreLogger.Infof("Skipping %s because it's already been restored.", resourceID)
 		itemExists = prevRestoredItemStatus.itemExists
 		return warnings, errs, itemExists
 	}
-	ctx.restoredItems[itemKey] = restoredItemStatus{itemExists: itemExists}
 	defer func() {
-		itemStatus := ctx.restoredItems[itemKey]
+		itemStatus, _ := ctx.getRestoredItem(itemKey)
 		// the action field is set explicitly
 		if len(itemStatus.action) > 0 {
 			return
@@ -1186,12 +1422,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// no action specified, and no warnings and errors
 		if errs.IsEmpty() && warnings.IsEmpty() {
 			itemStatus.action = ItemRestoreResultSkipped
-			ctx.restoredItems[itemKey] = itemStatus
+			ctx.setRestoredItem(itemKey, itemStatus)
 			return
 		}
 		// others are all failed
 		itemStatus.action = ItemRestoreResultFailed
-		ctx.restoredItems[itemKey] = itemStatus
+		ctx.setRestoredItem(itemKey, itemStatus)
 	}()
 
 	// TODO: move to restore item action if/when we add a ShouldRestore() method
@@ -1221,7 +1457,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case volume.PodVolumeBackup:
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
-				ctx.pvsToProvision.Insert(backupResourceName)
+				ctx.trackPVToProvision(backupResourceName)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1229,7 +1465,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case volume.CSISnapshot:
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a CSI VolumeSnapshot or a related snapshot DataUpload.")
-				ctx.pvsToProvision.Insert(backupResourceName)
+				ctx.trackPVToProvision(backupResourceName)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1240,7 +1476,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			default:
 				if hasDeleteReclaimPolicy(obj.Object) {
 					restoreLogger.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
-					ctx.pvsToProvision.Insert(backupResourceName)
+					ctx.trackPVToProvision(backupResourceName)
 
 					// Return early because we don't want to restore the PV itself, we
 					// want to dynamically re-provision it.
@@ -1268,7 +1504,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case hasPodVolumeBackup(obj, ctx):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
-				ctx.pvsToProvision.Insert(backupResourceName)
+				ctx.trackPVToProvision(backupResourceName)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1278,7 +1514,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				fallthrough
 			case hasSnapshotDataUpload(ctx, obj):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a CSI VolumeSnapshot or a related snapshot DataUpload.")
-				ctx.pvsToProvision.Insert(backupResourceName)
+				ctx.trackPVToProvision(backupResourceName)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1286,7 +1522,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case hasDeleteReclaimPolicy(obj.Object):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
-				ctx.pvsToProvision.Insert(backupResourceName)
+				ctx.trackPVToProvision(backupResourceName)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1334,8 +1570,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs, itemExists
 		}
 
-		// If async plugin started async operation, add it to the ItemOperations list
-		if executeOutput.OperationID != "" {
+		// If async plugin started async operation, add it to the ItemOperations list.
+		// A dry-run restore doesn't track operations, the plugins shouldn't start any.
+		if executeOutput.OperationID != "" && ctx.dryRun {
+			restoreLogger.Warnf("Ignoring operation %s started by %s in a dry-run restore", executeOutput.OperationID, action.RestoreItemAction.Name())
+		} else if executeOutput.OperationID != "" {
 			resourceIdentifier := velero.ResourceIdentifier{
 				GroupResource: groupResource,
 				Namespace:     namespace,
@@ -1355,8 +1594,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					Created: &now,
 				},
 			}
+			ctx.lock.Lock()
 			itemOperList := ctx.itemOperationsList
 			*itemOperList = append(*itemOperList, &newOperation)
+			ctx.lock.Unlock()
 		}
 		if executeOutput.SkipRestore {
 			restoreLogger.Infof("Skipping restore because a registered plugin discarded it")
@@ -1393,9 +1634,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			additionalItemNamespace := additionalItem.Namespace
 			if additionalItemNamespace != "" {
-				if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
-					additionalItemNamespace = remapped
-				}
+				additionalItemNamespace, _ = pkgrestoreUtil.MapNamespace(ctx.restore, additionalItemNamespace)
 			}
 
 			w, e, additionalItemExists := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
@@ -1407,6 +1646,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			errs.Merge(&e)
 		}
 		executeOutput.AdditionalItems = filteredAdditionalItems
+		// the additional items of a dry-run restore are never created, don't wait for them
+		if ctx.dryRun {
+			continue
+		}
 		available, err := ctx.itemsAvailable(action, executeOutput)
 		if err != nil {
 			errs.Add(namespace, errors.Wrapf(err, "error verifying additional items are ready to use"))
@@ -1436,13 +1679,17 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			// This is the case for PVB volumes, where we need to actually have an empty volume created instead of restoring one.
 			// The assumption is that any PV in pvsToProvision doesn't have an associated snapshot.
-			if ctx.pvsToProvision.Has(pvc.Spec.VolumeName) {
+			if ctx.isPVToProvision(pvc.Spec.VolumeName) {
 				restoreLogger.Infof("Resetting PersistentVolumeClaim for dynamic provisioning")
 				unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
 			}
 		}
 
-		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
+		newName, ok := ctx.renamedPV(pvc.Spec.VolumeName)
+		if !ok {
+			newName, ok = pkgrestoreUtil.MapName(ctx.restore, kuberesource.PersistentVolumes, "", pvc.Spec.VolumeName)
+		}
+		if ok {
 			restoreLogger.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, obj.GetName(), pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
 				errs.Add(namespace, err)
@@ -1466,6 +1713,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	// Rename the item if the restore renames items. The references to the renamed items
+	// specific to a resource are rewritten by the restore item actions.
+	if pkgrestoreUtil.HasNameMapping(ctx.restore) {
+		ctx.renameItem(obj, backupResourceName, restoreResourceName, originalNamespace)
+	}
+
 	// Label the resource with the restore's name and the restored backup's name
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from.
@@ -1511,10 +1764,16 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	if err != nil || fromCluster == nil {
 		// couldn't find the resource, attempt to create
 		restoreLogger.Debugf("Creating %s", obj.GetName())
-		createdObj, restoreErr = resourceClient.Create(obj)
+		if ctx.dryRun && ctx.isDryRunNamespace(namespace) {
+			// the namespace of the item doesn't exist, so neither does the item, and the
+			// API server would reject its creation, even with server-side dry run
+			createdObj = obj
+		} else {
+			createdObj, restoreErr = resourceClient.Create(obj)
+		}
 		if restoreErr == nil {
 			itemExists = true
-			ctx.restoredItems[itemKey] = restoredItemStatus{action: ItemRestoreResultCreated, itemExists: itemExists}
+			ctx.setRestoredItem(itemKey, restoredItemStatus{action: ItemRestoreResultCreated, itemExists: itemExists})
 		}
 	}
 
@@ -1540,11 +1799,16 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	var itemStatus restoredItemStatus
+	var original, fromClusterWithLabels *unstructured.Unstructured
+	var recreated bool
 	if fromCluster != nil {
 		itemExists = true
-		itemStatus := ctx.restoredItems[itemKey]
+		itemStatus, _ = ctx.getRestoredItem(itemKey)
 		itemStatus.itemExists = itemExists
-		ctx.restoredItems[itemKey] = itemStatus
+		ctx.setRestoredItem(itemKey, itemStatus)
+		// saving the in-cluster object as it is so that the restore can be rolled back if it's updated
+		original = fromCluster.DeepCopy()
 		// Remove insubstantial metadata.
 		fromCluster, err = resetMetadataAndStatus(fromCluster)
 		if err != nil {
@@ -1557,8 +1821,30 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// labels, so copy them from the object we attempted to restore.
 		labels := obj.GetLabels()
 		addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
-		fromClusterWithLabels := fromCluster.DeepCopy() // saving the in-cluster object so that we can create label patch if overall patch fails
+		fromClusterWithLabels = fromCluster.DeepCopy() // saving the in-cluster object so that we can create label patch if overall patch fails
+
+		// the changed resources of the recreate existing resource policy are deleted and created
+		// from the backup, and the restore of the item goes on as if it didn't exist in the cluster
+		if ctx.shouldRecreate(newGR) && !equality.Semantic.DeepEqual(fromCluster, obj) {
+			if ctx.dryRun {
+				if err := ctx.setDryRunDiff(itemKey, fromCluster, obj); err != nil {
+					warnings.Add(namespace, err)
+				}
+			}
 
+			createdObj, err = ctx.processRecreateResourcePolicy(obj, resourceClient)
+			if err != nil {
+				restoreLogger.Errorf("error recreating %s: %s", kube.NamespaceAndName(obj), err.Error())
+				errs.Add(namespace, err)
+				return warnings, errs, itemExists
+			}
+			restoreErr = nil
+			recreated = true
+			ctx.setUpdatedItem(itemKey, itemStatus, original)
+		}
+	}
+
+	if fromCluster != nil && !recreated {
 		if !equality.Semantic.DeepEqual(fromCluster, obj) {
 			switch newGR {
 			case kuberesource.ServiceAccounts:
@@ -1582,6 +1868,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					return warnings, errs, itemExists
 				}
 
+				if ctx.dryRun {
+					if err := ctx.setDryRunDiff(itemKey, fromCluster, desired); err != nil {
+						warnings.Add(namespace, err)
+					}
+				}
+
 				_, err = resourceClient.Patch(obj.GetName(), patchBytes)
 				if err != nil {
 					warnings.Add(namespace, err)
@@ -1595,18 +1887,24 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 						errs.Merge(&errsFromUpdate)
 					}
 				} else {
-					itemStatus.action = ItemRestoreResultUpdated
-					ctx.restoredItems[itemKey] = itemStatus
+					ctx.setUpdatedItem(itemKey, itemStatus, original)
 					restoreLogger.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
 				}
 			default:
+				if ctx.dryRun {
+					if err := ctx.setDryRunDiff(itemKey, fromCluster, obj); err != nil {
+						warnings.Add(namespace, err)
+					}
+				}
+
 				// check for the presence of existingResourcePolicy
 				if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 {
 					resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
 					restoreLogger.Infof("restore API has resource policy defined %s, executing restore workflow accordingly for changed resource %s %s", resourcePolicy, fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 
-					// existingResourcePolicy is set as none, add warning
-					if resourcePolicy == velerov1api.PolicyTypeNone {
+					// existingResourcePolicy is set as none, or as recreate for a resource that
+					// isn't recreated, add warning
+					if resourcePolicy == velerov1api.PolicyTypeNone || resourcePolicy == velerov1api.PolicyTypeRecreate {
 						e := errors.Errorf("could not restore, %s %q already exists. Warning: the in-cluster version is different than the backed-up version",
 							obj.GetKind(), obj.GetName())
 						warnings.Add(namespace, e)
@@ -1615,8 +1913,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 						// processing update as existingResourcePolicy
 						warningsFromUpdateRP, errsFromUpdateRP := ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj, namespace, resourceClient)
 						if warningsFromUpdateRP.IsEmpty() && errsFromUpdateRP.IsEmpty() {
-							itemStatus.action = ItemRestoreResultUpdated
-							ctx.restoredItems[itemKey] = itemStatus
+							ctx.setUpdatedItem(itemKey, itemStatus, original)
 						}
 						warnings.Merge(&warningsFromUpdateRP)
 						errs.Merge(&errsFromUpdateRP)
@@ -1654,6 +1951,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 
+	// The item of a dry-run restore isn't created, there's no status or managed fields to
+	// restore, no pod volume to restore and no hook to run.
+	if ctx.dryRun {
+		return warnings, errs, itemExists
+	}
+
 	// determine whether to restore status according to original GR
 	shouldRestoreStatus := determineRestoreStatus(obj, ctx.resourceStatusIncludesExcludes, groupResource.String(), restoreLogger)
 
@@ -1724,11 +2027,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs, itemExists
 		}
 
+		// the pod volume backups are matched with the name of the pod in the backup
+		pod.Name = backupResourceName
+
 		// Do not create podvolumerestore when current restore excludes pv/pvc
 		if ctx.resourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumeClaims.String()) &&
 			ctx.resourceIncludesExcludes.ShouldInclude(kuberesource.PersistentVolumes.String()) &&
 			len(podvolume.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, originalNamespace)) > 0 {
-			restorePodVolumeBackups(ctx, createdObj, originalNamespace)
+			restorePodVolumeBackups(ctx, createdObj, originalNamespace, backupResourceName)
 		}
 	}
 
@@ -1811,8 +2117,8 @@ func isAlreadyExistsError(ctx *restoreContext, obj *unstructured.Unstructured, e
 // original name already exists in-cluster, and (b) in the backup, the PV is claimed
 // by a PVC in a namespace that's being remapped during the restore.
 func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client client.Dynamic) (bool, error) {
-	if len(ctx.restore.Spec.NamespaceMapping) == 0 {
-		ctx.log.Debugf("Persistent volume does not need to be renamed because restore is not remapping any namespaces")
+	if !pkgrestoreUtil.HasNamespaceMapping(ctx.restore) && !pkgrestoreUtil.HasNameMapping(ctx.restore) {
+		ctx.log.Debugf("Persistent volume does not need to be renamed because restore is not remapping any namespaces or renaming any items")
 		return false, nil
 	}
 
@@ -1826,8 +2132,10 @@ func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client
 		return false, nil
 	}
 
-	if _, ok := ctx.restore.Spec.NamespaceMapping[pv.Spec.ClaimRef.Namespace]; !ok {
-		ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed by a PVC in a namespace that's being remapped")
+	_, namespaceRemapped := pkgrestoreUtil.MapNamespace(ctx.restore, pv.Spec.ClaimRef.Namespace)
+	_, claimRenamed := pkgrestoreUtil.MapName(ctx.restore, kuberesource.PersistentVolumeClaims, pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+	if !namespaceRemapped && !claimRenamed {
+		ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed by a PVC in a namespace that's being remapped or by a PVC that's being renamed")
 		return false, nil
 	}
 
@@ -1845,10 +2153,10 @@ func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client
 }
 
 // remapClaimRefNS remaps a PersistentVolume's claimRef.Namespace based on a
-// restore's NamespaceMappings, if necessary. Returns true if the namespace was
+// restore's NamespaceMapping and NamespaceMappingRules, if necessary. Returns true if the namespace was
 // remapped, false if it was not required.
 func remapClaimRefNS(ctx *restoreContext, obj *unstructured.Unstructured) (bool, error) { //nolint:unparam // ignore the result 0 (bool) is never used warning.
-	if len(ctx.restore.Spec.NamespaceMapping) == 0 {
+	if !pkgrestoreUtil.HasNamespaceMapping(ctx.restore) {
 		ctx.log.Debug("Persistent volume does not need to have the claimRef.namespace remapped because restore is not remapping any namespaces")
 		return false, nil
 	}
@@ -1865,7 +2173,7 @@ func remapClaimRefNS(ctx *restoreContext, obj *unstructured.Unstructured) (bool,
 		return false, nil
 	}
 
-	targetNS, ok := ctx.restore.Spec.NamespaceMapping[pv.Spec.ClaimRef.Namespace]
+	targetNS, ok := pkgrestoreUtil.MapNamespace(ctx.restore, pv.Spec.ClaimRef.Namespace)
 
 	if !ok {
 		ctx.log.Debugf("Persistent volume does not need to have the claimRef.namespace remapped because it's not claimed by a PVC in a namespace that's being remapped")
@@ -1880,8 +2188,139 @@ func remapClaimRefNS(ctx *restoreContext, obj *unstructured.Unstructured) (bool,
 	return true, nil
 }
 
+// remapClaimRefName renames a PersistentVolume's claimRef.Name based on a restore's
+// NameMapping, if the PVC it's claimed by is renamed.
+func remapClaimRefName(ctx *restoreContext, obj *unstructured.Unstructured) error {
+	if !pkgrestoreUtil.HasNameMapping(ctx.restore) {
+		return nil
+	}
+
+	namespace, _, err := unstructured.NestedString(obj.Object, "spec", "claimRef", "namespace")
+	if err != nil {
+		return errors.Wrapf(err, "error getting persistent volume's claimRef.namespace")
+	}
+	name, _, err := unstructured.NestedString(obj.Object, "spec", "claimRef", "name")
+	if err != nil {
+		return errors.Wrapf(err, "error getting persistent volume's claimRef.name")
+	}
+
+	targetName, ok := pkgrestoreUtil.MapName(ctx.restore, kuberesource.PersistentVolumeClaims, namespace, name)
+	if !ok {
+		return nil
+	}
+	if err := unstructured.SetNestedField(obj.Object, targetName, "spec", "claimRef", "name"); err != nil {
+		return err
+	}
+	ctx.log.Debug("Persistent volume's claimRef.name was updated")
+	return nil
+}
+
+// renameItem renames an item of the restore, and rewrites the names of its owners and the
+// values of the labels of the name mapping in its labels, pod template labels and selector.
+func (ctx *restoreContext) renameItem(obj *unstructured.Unstructured, backupName, name, originalNamespace string) {
+	// a PV may have been renamed because the original PV exists in-cluster
+	if obj.GetName() == backupName {
+		obj.SetName(name)
+	}
+
+	if ownerRefs := obj.GetOwnerReferences(); len(ownerRefs) > 0 {
+		for i, ref := range ownerRefs {
+			gv, err := schema.ParseGroupVersion(ref.APIVersion)
+			if err != nil {
+				ctx.log.WithError(err).Warnf("Unable to rename owner %s %s of %s", ref.Kind, ref.Name, kube.NamespaceAndName(obj))
+				continue
+			}
+			gvr, _, err := ctx.discoveryHelper.KindFor(gv.WithKind(ref.Kind))
+			if err != nil {
+				ctx.log.WithError(err).Warnf("Unable to rename owner %s %s of %s", ref.Kind, ref.Name, kube.NamespaceAndName(obj))
+				continue
+			}
+			ownerRefs[i].Name, _ = pkgrestoreUtil.MapName(ctx.restore, gvr.GroupResource(), originalNamespace, ref.Name)
+		}
+		obj.SetOwnerReferences(ownerRefs)
+	}
+
+	if labels := obj.GetLabels(); pkgrestoreUtil.MapLabels(ctx.restore, labels) {
+		obj.SetLabels(labels)
+	}
+	for _, path := range [][]string{{"spec", "template", "metadata", "labels"}, {"spec", "selector", "matchLabels"}} {
+		labels, found, err := unstructured.NestedStringMap(obj.Object, path...)
+		if err != nil || !found {
+			continue
+		}
+		if pkgrestoreUtil.MapLabels(ctx.restore, labels) {
+			// the labels were read from the same path, setting them back can't fail
+			_ = unstructured.SetNestedStringMap(obj.Object, labels, path...)
+		}
+	}
+}
+
+// mapStatefulSetClaims renames the persistent volume claims created from the volume claim
+// templates of the statefulsets the restore renames, <template>-<statefulset>-<ordinal>, after the
+// renamed statefulsets, so that the restored statefulsets use the restored claims. The claims
+// are added to the names of the name mapping of the restore the items are restored with, the
+// restore object is left as is, unless they're already mapped explicitly.
+func (ctx *restoreContext) mapStatefulSetClaims(backupResources map[string]*archive.ResourceItems) {
+	statefulSets := backupResources[kuberesource.StatefulSets.String()]
+	claims := backupResources[kuberesource.PersistentVolumeClaims.String()]
+	if statefulSets == nil || claims == nil {
+		return
+	}
+
+	names := map[string]string{}
+	for namespace, statefulSetNames := range statefulSets.ItemsByNamespace {
+		for _, name := range statefulSetNames {
+			target, renamed := pkgrestoreUtil.MapName(ctx.restore, kuberesource.StatefulSets, namespace, name)
+			if !renamed {
+				continue
+			}
+
+			itemPath := archive.GetItemFilePath(ctx.restoreDir, ctx.resourcePathInBackup(kuberesource.StatefulSets.String()), namespace, name)
+			obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
+			if err != nil {
+				ctx.log.WithError(err).Warnf("Unable to rename the claims of statefulset %s/%s", namespace, name)
+				continue
+			}
+			statefulSet := new(appsv1api.StatefulSet)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), statefulSet); err != nil {
+				ctx.log.WithError(err).Warnf("Unable to rename the claims of statefulset %s/%s", namespace, name)
+				continue
+			}
+
+			for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+				prefix := template.Name + "-" + name + "-"
+				for _, claim := range claims.ItemsByNamespace[namespace] {
+					ordinal, found := strings.CutPrefix(claim, prefix)
+					if !found {
+						continue
+					}
+					if _, err := strconv.ParseUint(ordinal, 10, 32); err != nil {
+						continue
+					}
+					if _, mapped := ctx.restore.Spec.NameMapping.Names[claim]; mapped {
+						continue
+					}
+					names[claim] = template.Name + "-" + target + "-" + ordinal
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	ctx.restore = ctx.restore.DeepCopy()
+	if ctx.restore.Spec.NameMapping.Names == nil {
+		ctx.restore.Spec.NameMapping.Names = map[string]string{}
+	}
+	for claim, target := range names {
+		ctx.log.Infof("Renaming the claim %s of a renamed statefulset to %s", claim, target)
+		ctx.restore.Spec.NameMapping.Names[claim] = target
+	}
+}
+
 // restorePodVolumeBackups restores the PodVolumeBackups for the given restored pod
-func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstructured, originalNamespace string) {
+func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstructured, originalNamespace, originalName string) {
 	if ctx.podVolumeRestorer == nil {
 		ctx.log.Warn("No pod volume restorer, not restoring pod's volumes")
 	} else {
@@ -1903,6 +2342,7 @@ func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstr
 				Pod:              pod,
 				PodVolumeBackups: ctx.podVolumeBackups,
 				SourceNamespace:  originalNamespace,
+				SourceName:       originalName,
 				BackupLocation:   ctx.backup.Spec.StorageLocation,
 			}
 			if errs := ctx.podVolumeRestorer.RestorePodVolumes(data, ctx.restoreVolumeInfoTracker); errs != nil {
@@ -2209,6 +2649,11 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 	var resourceList []string
 	if includeAllResources {
 		resourceList = getOrderedResources(resourcePriorities, backupResources)
+		if ctx.dependencyGraph != nil {
+			var w results.Result
+			resourceList, w = ctx.dependencyGraph.orderResources(resourceList)
+			warnings.Merge(&w)
+		}
 	} else {
 		resourceList = resourcePriorities.HighPriorities
 	}
@@ -2281,7 +2726,7 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 // getSelectedRestoreableItems applies Kubernetes selectors on individual items
 // of each resource type to create a list of items which will be actually
 // restored.
-func (ctx *restoreContext) getSelectedRestoreableItems(resource string, originalNamespace string, items []string) (restoreableResource, results.Result, results.Result) { //nolint:unparam // Ignore the warnings is always nil warning.
+func (ctx *restoreContext) getSelectedRestoreableItems(resource string, originalNamespace string, items []string) (restoreableResource, results.Result, results.Result) {
 	warnings, errs := results.Result{}, results.Result{}
 
 	restorable := restoreableResource{
@@ -2292,9 +2737,11 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 		restorable.selectedItemsByNamespace = make(map[string][]restoreableItem)
 	}
 
-	targetNamespace := originalNamespace
-	if target, ok := ctx.restore.Spec.NamespaceMapping[originalNamespace]; ok {
-		targetNamespace = target
+	targetNamespace, _ := pkgrestoreUtil.MapNamespace(ctx.restore, originalNamespace)
+
+	if ctx.preHookFailedNamespaces.Has(targetNamespace) {
+		ctx.log.Infof("Resource '%s' won't be restored into namespace '%s' because a pre hook of the namespace failed", resource, targetNamespace)
+		return restorable, warnings, errs
 	}
 
 	if targetNamespace != "" {
@@ -2303,20 +2750,7 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 		ctx.log.Infof("Resource '%s' will be restored at cluster scope", resource)
 	}
 
-	resourceForPath := resource
-	// If the APIGroupVersionsFeatureFlag is enabled, the item path will be
-	// updated to include the API group version that was chosen for restore. For
-	// example, for "horizontalpodautoscalers.autoscaling", if v2beta1 is chosen
-	// to be restored, then "horizontalpodautoscalers.autoscaling/v2beta1" will
-	// be part of item path. Different versions would only have been stored
-	// if the APIGroupVersionsFeatureFlag was enabled during backup. The
-	// chosenGrpVersToRestore map would only be populated if
-	// APIGroupVersionsFeatureFlag was enabled for restore and the minimum
-	// required backup format version has been met.
-	cgv, ok := ctx.chosenGrpVersToRestore[resource]
-	if ok {
-		resourceForPath = filepath.Join(resource, cgv.Dir)
-	}
+	resourceForPath := ctx.resourcePathInBackup(resource)
 
 	for _, item := range items {
 		itemPath := archive.GetItemFilePath(ctx.restoreDir, resourceForPath, originalNamespace, item)
@@ -2371,9 +2805,39 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 			append(restorable.selectedItemsByNamespace[originalNamespace], selectedItem)
 		restorable.totalItems++
 	}
+
+	if ctx.dependencyGraph != nil {
+		var cycles [][]string
+		restorable.selectedItemsByNamespace[originalNamespace], cycles = ctx.dependencyGraph.orderItems(
+			resource,
+			originalNamespace,
+			restorable.selectedItemsByNamespace[originalNamespace],
+		)
+		for _, cycle := range cycles {
+			warnings.Add(targetNamespace, errors.Errorf("dependency cycle between %s %s, restoring them in the order of the backup", resource, strings.Join(cycle, ", ")))
+		}
+	}
 	return restorable, warnings, errs
 }
 
+// resourcePathInBackup returns the directory of the resource's items in the
+// extracted backup, relative to the resources directory.
+func (ctx *restoreContext) resourcePathInBackup(resource string) string {
+	// If the APIGroupVersionsFeatureFlag is enabled, the item path will be
+	// updated to include the API group version that was chosen for restore. For
+	// example, for "horizontalpodautoscalers.autoscaling", if v2beta1 is chosen
+	// to be restored, then "horizontalpodautoscalers.autoscaling/v2beta1" will
+	// be part of item path. Different versions would only have been stored
+	// if the APIGroupVersionsFeatureFlag was enabled during backup. The
+	// chosenGrpVersToRestore map would only be populated if
+	// APIGroupVersionsFeatureFlag was enabled for restore and the minimum
+	// required backup format version has been met.
+	if cgv, ok := ctx.chosenGrpVersToRestore[resource]; ok {
+		return filepath.Join(resource, cgv.Dir)
+	}
+	return resource
+}
+
 // removeRestoreLabels removes the restore name and the
 // restored backup's name.
 func removeRestoreLabels(obj metav1.Object) {
@@ -2452,6 +2916,55 @@ func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterW
 	return warnings, errs
 }
 
+// shouldRecreate returns whether the changed resources of the group resource are recreated by
+// the recreate existing resource policy. Namespaces are never recreated, deleting them would
+// delete the items restored into them.
+func (ctx *restoreContext) shouldRecreate(groupResource schema.GroupResource) bool {
+	if ctx.recreateResources == nil || groupResource == kuberesource.Namespaces {
+		return false
+	}
+	return ctx.recreateResources.ShouldInclude(groupResource.String())
+}
+
+// processRecreateResourcePolicy deletes the in-cluster version of the item, waits up to the
+// resource terminating timeout for it to be gone, its finalizers and dependents included, and
+// creates the item from the backup.
+func (ctx *restoreContext) processRecreateResourcePolicy(obj *unstructured.Unstructured, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
+	ctx.log.Infof("restore API has existingResourcePolicy defined as recreate, deleting and recreating changed resource %s %s", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+
+	propagation := metav1.DeletePropagationForeground
+	if err := resourceClient.Delete(obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "error deleting %s %s", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	// the delete of a dry-run restore isn't persisted, so the item would still exist
+	if ctx.dryRun {
+		return obj, nil
+	}
+
+	err := wait.PollUntilContextTimeout(go_context.Background(), time.Second, ctx.resourceTerminatingTimeout, true, func(go_context.Context) (bool, error) {
+		_, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "error getting %s %s", obj.GetKind(), kube.NamespaceAndName(obj))
+		}
+		ctx.log.Debugf("%s %s is still terminating, waiting", obj.GetKind(), kube.NamespaceAndName(obj))
+		return false, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error waiting for %s %s to be deleted", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	createdObj, err := resourceClient.Create(obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error recreating %s %s", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+	ctx.log.Infof("%s %s successfully recreated", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+	return createdObj, nil
+}
+
 func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructured, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
 	retObj := obj.DeepCopy()
 	oldName := obj.GetName()
@@ -2466,6 +2979,9 @@ func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructu
 	if err != nil {
 		return nil, err
 	}
+	if err := remapClaimRefName(ctx, retObj); err != nil {
+		return nil, err
+	}
 
 	var shouldRestoreSnapshot bool
 	if !shouldRenamePV {
@@ -2489,10 +3005,14 @@ func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructu
 
 		// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 		// uses the original name to look up metadata about the snapshot.
-		ctx.log.Infof("Restoring persistent volume from snapshot.")
-		retObj, err = ctx.pvRestorer.executePVAction(retObj)
-		if err != nil {
-			return nil, fmt.Errorf("error executing PVAction for %s: %v", getResourceID(kuberesource.PersistentVolumes, "", oldName), err)
+		if ctx.dryRun {
+			ctx.log.Infof("Not restoring persistent volume from snapshot in a dry-run restore.")
+		} else {
+			ctx.log.Infof("Restoring persistent volume from snapshot.")
+			retObj, err = ctx.pvRestorer.executePVAction(retObj)
+			if err != nil {
+				return nil, fmt.Errorf("error executing PVAction for %s: %v", getResourceID(kuberesource.PersistentVolumes, "", oldName), err)
+			}
 		}
 
 		// VolumeSnapshotter has modified the PV name, we should rename the PV.
@@ -2515,7 +3035,9 @@ func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructu
 			pvName = retObj.GetName()
 		}
 
+		ctx.lock.Lock()
 		ctx.renamedPVs[oldName] = pvName
+		ctx.lock.Unlock()
 		retObj.SetName(pvName)
 		ctx.restoreVolumeInfoTracker.RenamePVForNativeSnapshot(oldName, pvName)
 		// Add the original PV name as an annotation.
@@ -2540,6 +3062,9 @@ func (ctx *restoreContext) handleSkippedPVHasRetainPolicy(
 	if _, err := remapClaimRefNS(ctx, obj); err != nil {
 		return nil, err
 	}
+	if err := remapClaimRefName(ctx, obj); err != nil {
+		return nil, err
+	}
 
 	obj = resetVolumeBindingInfo(obj)
 	return obj, nil
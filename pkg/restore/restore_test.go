@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -833,6 +834,29 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				test.Pods(): {"mapped-ns-1/pod-1", "mapped-ns-2/pod-2"},
 			},
 		},
+		{
+			name: "namespace mapping rules are applied after namespace mappings",
+			restore: defaultRestore().NamespaceMappings("team-1", "mapped-team-1").
+				NamespaceMappingRules(
+					velerov1api.NamespaceMappingRule{Source: "team-*", Target: "team-*-dr"},
+					velerov1api.NamespaceMappingRule{Source: "^(.*)-prod$", Target: "$1-restored"},
+				).Result(),
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("team-1", "pod-1").Result(),
+					builder.ForPod("team-2", "pod-2").Result(),
+					builder.ForPod("web-prod", "pod-3").Result(),
+					builder.ForPod("ns-4", "pod-4").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"mapped-team-1/pod-1", "team-2-dr/pod-2", "web-restored/pod-3", "ns-4/pod-4"},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -864,6 +888,157 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 	}
 }
 
+// TestRestoreNameMapping runs restores with a name mapping and verifies that the items, their owner
+// references and the labels in the label keys of the name mapping are renamed.
+func TestRestoreNameMapping(t *testing.T) {
+	deployment := func(name, app string) *appsv1api.Deployment {
+		d := builder.ForDeployment("ns-1", name).ObjectMeta(builder.WithLabels("app", app)).Result()
+		d.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}}
+		d.Spec.Template.Labels = map[string]string{"app": app}
+		return d
+	}
+	pod := func(name, app, owner string, opts ...builder.ObjectMetaOpt) *corev1api.Pod {
+		opts = append(opts,
+			builder.WithOwnerReference([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: owner}}))
+		return builder.ForPod("ns-1", name).ObjectMeta(opts...).Result()
+	}
+	restoreLabels := func(app string) builder.ObjectMetaOpt {
+		return builder.WithLabels("app", app, "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")
+	}
+	// the owner references are removed from the restored items, unless a restore item action
+	// keeps them
+	keepOwnersAction := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			obj := input.Item.(*unstructured.Unstructured).DeepCopy()
+			obj.SetOwnerReferences(input.ItemFromBackup.(*unstructured.Unstructured).GetOwnerReferences())
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		restore              *velerov1api.Restore
+		want                 []*test.APIResource
+		expectedRestoreItems map[itemKey]restoredItemStatus
+	}{
+		{
+			name:    "items, owner references and labels are renamed",
+			restore: defaultRestore().NameMapping(&velerov1api.RestoreNameMapping{Suffix: "-clone", LabelKeys: []string{"app"}}).Result(),
+			want: []*test.APIResource{
+				test.Pods(pod("pod-1-clone", "web-clone", "deploy-1-clone", restoreLabels("web-clone"))),
+				test.Deployments(func() *appsv1api.Deployment {
+					d := deployment("deploy-1-clone", "web-clone")
+					d.Labels = map[string]string{"app": "web-clone", "velero.io/backup-name": "backup-1", "velero.io/restore-name": "restore-1"}
+					return d
+				}()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:                     {action: "created", itemExists: true},
+				{resource: "v1/Pod", namespace: "ns-1", name: "pod-1-clone"}:                {action: "created", itemExists: true},
+				{resource: "apps/v1/Deployment", namespace: "ns-1", name: "deploy-1-clone"}: {action: "created", itemExists: true},
+			},
+		},
+		{
+			name:    "only the items of the included resources are renamed",
+			restore: defaultRestore().NameMapping(&velerov1api.RestoreNameMapping{IncludedResources: []string{"pods"}, Names: map[string]string{"pod-1": "pod-2"}}).Result(),
+			want: []*test.APIResource{
+				test.Pods(pod("pod-2", "web", "deploy-1", restoreLabels("web"))),
+				test.Deployments(func() *appsv1api.Deployment {
+					d := deployment("deploy-1", "web")
+					d.Labels = map[string]string{"app": "web", "velero.io/backup-name": "backup-1", "velero.io/restore-name": "restore-1"}
+					return d
+				}()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:               {action: "created", itemExists: true},
+				{resource: "v1/Pod", namespace: "ns-1", name: "pod-2"}:                {action: "created", itemExists: true},
+				{resource: "apps/v1/Deployment", namespace: "ns-1", name: "deploy-1"}: {action: "created", itemExists: true},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+			h.AddItems(t, test.Deployments())
+
+			data := &Request{
+				Log:     h.log,
+				Restore: tc.restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("pods", pod("pod-1", "web", "deploy-1", builder.WithLabels("app", "web"))).
+					AddItems("deployments.apps", deployment("deploy-1", "web")).
+					Done(),
+				RestoredItems: map[itemKey]restoredItemStatus{},
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				[]riav2.RestoreItemAction{keepOwnersAction},
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertRestoredItems(t, h, tc.want)
+			assert.Equal(t, tc.expectedRestoreItems, data.RestoredItems)
+		})
+	}
+}
+
+// TestRestoreNameMappingStatefulSetClaims runs a restore that renames a statefulset and its claims,
+// and verifies that the claims are renamed after the statefulset, so that the restored
+// statefulset uses them.
+func TestRestoreNameMappingStatefulSetClaims(t *testing.T) {
+	statefulSet := func(name string, opts ...builder.ObjectMetaOpt) *appsv1api.StatefulSet {
+		sts := builder.ForStatefulSet("ns-1", name).Result()
+		for _, opt := range opts {
+			opt(sts)
+		}
+		sts.Spec.VolumeClaimTemplates = []corev1api.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}}
+		return sts
+	}
+	restoreLabels := builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")
+
+	h := newHarness(t)
+	h.AddItems(t, test.PVCs())
+	h.AddItems(t, test.StatefulSets())
+
+	restore := defaultRestore().NameMapping(&velerov1api.RestoreNameMapping{
+		Names:  map[string]string{"data-web-1": "data-web-1-kept"},
+		Suffix: "-clone",
+	}).Result()
+	data := &Request{
+		Log:     h.log,
+		Restore: restore,
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("persistentvolumeclaims",
+				builder.ForPersistentVolumeClaim("ns-1", "data-web-0").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "data-web-1").Result(),
+				builder.ForPersistentVolumeClaim("ns-1", "data-other").Result(),
+			).
+			AddItems("statefulsets.apps", statefulSet("web")).
+			Done(),
+		RestoredItems: map[itemKey]restoredItemStatus{},
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil)
+
+	assertEmptyResults(t, warnings, errs)
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.PVCs(
+			// the claim of the statefulset is renamed after it, unless it's mapped explicitly
+			builder.ForPersistentVolumeClaim("ns-1", "data-web-clone-0").ObjectMeta(restoreLabels).Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "data-web-1-kept").ObjectMeta(restoreLabels).Result(),
+			builder.ForPersistentVolumeClaim("ns-1", "data-other-clone").ObjectMeta(restoreLabels).Result(),
+		),
+		test.StatefulSets(statefulSet("web-clone", restoreLabels)),
+	})
+	// the names of the claims aren't added to the restore object
+	assert.Equal(t, map[string]string{"data-web-1": "data-web-1-kept"}, restore.Spec.NameMapping.Names)
+}
+
 // TestRestoreResourcePriorities runs restores with resource priorities specified,
 // and verifies that the set of items created in the API are created in the expected
 // order. Validation is done by adding a Reactor to the fake dynamic client that records
@@ -1417,6 +1592,134 @@ func TestRestoreItems(t *testing.T) {
 	}
 }
 
+// TestRestoreRecreateResourcePolicy runs restores with the recreate existing resource policy and
+// verifies that the changed items of the recreated resources are deleted and created from the
+// backup, and that the other items are left as is.
+func TestRestoreRecreateResourcePolicy(t *testing.T) {
+	restoreLabels := builder.WithLabels("app", "v1", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")
+
+	tests := []struct {
+		name              string
+		restore           *velerov1api.Restore
+		tarball           io.Reader
+		apiResources      []*test.APIResource
+		blockDeletion     bool
+		want              []*test.APIResource
+		wantDeleted       []string
+		wantRestoredItems map[itemKey]restoredItemStatus
+		wantWarnings      Result
+		wantErrs          Result
+	}{
+		{
+			name:    "changed items of the recreated resources are deleted and created from the backup",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").RecreateResources("deployments").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v1")).Result(),
+					builder.ForDeployment("ns-1", "deploy-2").ObjectMeta(builder.WithLabels("app", "v1")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Deployments(
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v2")).Result(),
+					builder.ForDeployment("ns-1", "deploy-2").ObjectMeta(restoreLabels).Result(),
+				),
+			},
+			want: []*test.APIResource{
+				test.Deployments(
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(restoreLabels).Result(),
+					builder.ForDeployment("ns-1", "deploy-2").ObjectMeta(restoreLabels).Result(),
+				),
+			},
+			wantDeleted: []string{"deploy-1"},
+			wantRestoredItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", name: "ns-1"}:                              {action: "created", itemExists: true},
+				{resource: "apps/v1/Deployment", namespace: "ns-1", name: "deploy-1"}: {action: "updated", itemExists: true},
+				{resource: "apps/v1/Deployment", namespace: "ns-1", name: "deploy-2"}: {action: "skipped", itemExists: true},
+			},
+		},
+		{
+			name:    "changed items of the resources that aren't recreated are left as is",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").RecreateResources("statefulsets.apps").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v1")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v2")).Result()),
+			},
+			want: []*test.APIResource{
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v2")).Result()),
+			},
+			wantWarnings: Result{
+				Namespaces: map[string][]string{"ns-1": {`could not restore, Deployment "deploy-1" already exists`}},
+			},
+		},
+		{
+			name:    "items that are still terminating after the timeout aren't recreated",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").RecreateResources("deployments").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v1")).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v2"), builder.WithFinalizers("finalizer-1")).Result()),
+			},
+			blockDeletion: true,
+			want: []*test.APIResource{
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "v2"), builder.WithFinalizers("finalizer-1")).Result()),
+			},
+			wantDeleted: []string{"deploy-1"},
+			wantErrs: Result{
+				Namespaces: map[string][]string{"ns-1": {"error waiting for Deployment ns-1/deploy-1 to be deleted"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.resourceTerminatingTimeout = time.Millisecond
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+			if tc.blockDeletion {
+				// the fake client deletes the items right away, mimic the finalizers keeping them
+				h.DynamicClient.PrependReactor("delete", "deployments", func(kubetesting.Action) (bool, runtime.Object, error) {
+					return true, nil, nil
+				})
+			}
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              tc.restore,
+				Backup:               defaultBackup().Result(),
+				BackupReader:         tc.tarball,
+				RestoredItems:        map[itemKey]restoredItemStatus{},
+				DisableInformerCache: true,
+			}
+			warnings, errs := h.restorer.Restore(data, nil, nil)
+
+			assertWantErrsOrWarnings(t, tc.wantWarnings, warnings)
+			assertWantErrsOrWarnings(t, tc.wantErrs, errs)
+			if tc.wantWarnings.IsEmpty() && tc.wantErrs.IsEmpty() {
+				assertEmptyResults(t, warnings, errs)
+			}
+			assertRestoredItems(t, h, tc.want)
+
+			var deleted []string
+			for _, action := range h.DynamicClient.Actions() {
+				if action.Matches("delete", "deployments") {
+					deleted = append(deleted, action.(kubetesting.DeleteAction).GetName())
+				}
+			}
+			assert.Equal(t, tc.wantDeleted, deleted)
+			if len(tc.wantRestoredItems) > 0 {
+				assert.Equal(t, tc.wantRestoredItems, data.RestoredItems)
+			}
+		})
+	}
+}
+
 // recordResourcesAction is a restore item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
@@ -2936,6 +3239,47 @@ func TestRestorePersistentVolumes(t *testing.T) {
 				),
 			},
 		},
+		{
+			name:    "when a PV without a snapshot is used by a PVC in a namespace that's being remapped by a namespace mapping rule, the PV's claimRef is remapped",
+			restore: defaultRestore().NamespaceMappingRules(velerov1api.NamespaceMappingRule{Source: "source-*", Target: "target-*"}).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems(
+					"persistentvolumes",
+					builder.ForPersistentVolume("source-pv").
+						AWSEBSVolumeID("source-volume").
+						ClaimRef("source-ns", "pvc-1").
+						Result(),
+				).
+				AddItems(
+					"persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("source-ns", "pvc-1").VolumeName("source-pv").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.PVs(),
+				test.PVCs(),
+			},
+			want: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("source-pv").
+						ObjectMeta(
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						ClaimRef("target-ns", "pvc-1").
+						AWSEBSVolumeID("source-volume").
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("target-ns", "pvc-1").
+						ObjectMeta(
+							builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1"),
+						).
+						VolumeName("source-pv").
+						Result(),
+				),
+			},
+		},
 		{
 			name:    "when a PV is renamed and the original PV does not exist in-cluster, the PV should be renamed",
 			restore: defaultRestore().NamespaceMappings("source-ns", "target-ns").Result(),
@@ -3357,6 +3701,7 @@ func TestRestoreWithPodVolume(t *testing.T) {
 					Pod:              pod,
 					PodVolumeBackups: tc.podVolumeBackups,
 					SourceNamespace:  pod.Namespace,
+					SourceName:       pod.Name,
 					BackupLocation:   "",
 				}
 				restorer.
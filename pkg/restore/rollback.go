@@ -0,0 +1,395 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/manifest"
+	"github.com/vmware-tanzu/velero/pkg/types"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// Rollbacker undoes a restore: it deletes the items the restore created, and reverts the items
+// the restore updated to their in-cluster version before the restore.
+type Rollbacker interface {
+	// Rollback rolls back the restore, given the list of the items restored by the restore and
+	// the in-cluster version of the items it updated, as they were before the restore.
+	Rollback(log logrus.FieldLogger, restore *velerov1api.Restore, restoredResources map[string][]string, originalItems []*unstructured.Unstructured) RollbackResult
+}
+
+// RollbackResult is the result of the rollback of a restore.
+type RollbackResult struct {
+	ItemsDeleted  int
+	ItemsReverted int
+	ItemsSkipped  int
+	Errors        []string
+}
+
+type kubernetesRollbacker struct {
+	discoveryHelper            discovery.Helper
+	dynamicFactory             client.DynamicFactory
+	resourcePriorities         types.Priorities
+	resourceTerminatingTimeout time.Duration
+}
+
+// NewKubernetesRollbacker returns a Rollbacker that deletes the created items in the reverse order
+// they were restored in, following the resource priorities or the dependency graph. The items recreated by the restore are
+// reverted by deleting them and creating their version before the restore, waiting up to the
+// resource terminating timeout for them to be deleted.
+func NewKubernetesRollbacker(discoveryHelper discovery.Helper, dynamicFactory client.DynamicFactory, resourcePriorities types.Priorities, resourceTerminatingTimeout time.Duration) Rollbacker {
+	return &kubernetesRollbacker{
+		discoveryHelper:            discoveryHelper,
+		dynamicFactory:             dynamicFactory,
+		resourcePriorities:         resourcePriorities,
+		resourceTerminatingTimeout: resourceTerminatingTimeout,
+	}
+}
+
+type rollbackItem struct {
+	gvr       schema.GroupVersionResource
+	resource  metav1.APIResource
+	namespace string
+	name      string
+}
+
+func (i rollbackItem) String() string {
+	if i.namespace == "" {
+		return fmt.Sprintf("%s %s", i.gvr.GroupResource(), i.name)
+	}
+	return fmt.Sprintf("%s %s/%s", i.gvr.GroupResource(), i.namespace, i.name)
+}
+
+// Rollback deletes the created items resource by resource, in the reverse order of the resource
+// priorities, which put the persistent volume claims after the persistent volumes and the
+// controllers after the items they manage. The items of a restore that used the DependencyGraph
+// resource ordering are deleted in the reverse order of the references between them instead, the
+// way the restore ordered them. The items owned by a deleted item are garbage-collected with it.
+func (r *kubernetesRollbacker) Rollback(log logrus.FieldLogger, restore *velerov1api.Restore, restoredResources map[string][]string, originalItems []*unstructured.Unstructured) RollbackResult {
+	result := RollbackResult{}
+
+	// the items created by the restore, grouped by resource
+	created := map[string][]rollbackItem{}
+	for apiVersionKind, entries := range restoredResources {
+		gvk, err := parseAPIVersionKind(apiVersionKind)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			continue
+		}
+
+		var gvr schema.GroupVersionResource
+		var resource metav1.APIResource
+		for _, entry := range entries {
+			namespace, name, action := parseRestoredResourceEntry(entry)
+			if action != ItemRestoreResultCreated {
+				continue
+			}
+			if gvr.Empty() {
+				if gvr, resource, err = r.discoveryHelper.KindFor(gvk); err != nil {
+					result.Errors = append(result.Errors, fmt.Sprintf("error getting the resource of %s: %v", apiVersionKind, err))
+					break
+				}
+			}
+			created[gvr.GroupResource().String()] = append(created[gvr.GroupResource().String()], rollbackItem{
+				gvr:       gvr,
+				resource:  resource,
+				namespace: namespace,
+				name:      name,
+			})
+		}
+	}
+
+	// the items are deleted in the reverse order they were restored in, so that they are
+	// deleted before the items they depend on, and the namespaces are deleted last
+	resources := map[string]*archive.ResourceItems{}
+	for resource := range created {
+		resources[resource] = nil
+	}
+	ordered := getOrderedResources(r.resourcePriorities, resources)
+	var toDelete []rollbackItem
+	for i := len(ordered) - 1; i >= 0; i-- {
+		toDelete = append(toDelete, created[ordered[i]]...)
+		// a resource may be in the resource priorities more than once
+		delete(created, ordered[i])
+	}
+	if restore.Spec.ResourceOrdering == velerov1api.RestoreResourceOrderingDependencyGraph {
+		toDelete = r.orderByDependencies(log, restore, toDelete)
+	}
+	for _, item := range toDelete {
+		r.deleteItem(log, restore, item, &result)
+	}
+
+	for _, original := range originalItems {
+		r.revertItem(log, restore, original, &result)
+	}
+
+	return result
+}
+
+// orderByDependencies reorders the items to delete, given in the reverse order of the resource
+// priorities, from the dependency graph of their in-cluster version: every item is deleted before
+// the items it depends on. The items that can't be read, or weren't created by the restore, are
+// left at the end for deleteItem to skip or report them.
+func (r *kubernetesRollbacker) orderByDependencies(log logrus.FieldLogger, restore *velerov1api.Restore, items []rollbackItem) []rollbackItem {
+	graph := &dependencyGraph{items: manifest.New()}
+	var restoreOrder []*manifest.Item
+	rollbackItems := map[*manifest.Item]rollbackItem{}
+	var unordered []rollbackItem
+	for i := len(items) - 1; i >= 0; i-- {
+		item := items[i]
+		resourceClient, err := r.dynamicFactory.ClientForGroupVersionResource(item.gvr.GroupVersion(), item.resource, item.namespace)
+		if err != nil {
+			unordered = append([]rollbackItem{item}, unordered...)
+			continue
+		}
+		obj, err := resourceClient.Get(item.name, metav1.GetOptions{})
+		if err != nil || !createdByRestore(obj, item.gvr.GroupResource(), restore) {
+			unordered = append([]rollbackItem{item}, unordered...)
+			continue
+		}
+
+		graphItem, err := manifest.NewItem(obj, item.gvr.GroupResource(), nil)
+		if err == nil {
+			_, err = graph.items.Add(graphItem)
+		}
+		if err != nil {
+			log.WithError(err).Debugf("Skipping %s in the dependency graph", item)
+			unordered = append([]rollbackItem{item}, unordered...)
+			continue
+		}
+		restoreOrder = append(restoreOrder, graphItem)
+		rollbackItems[graphItem] = item
+	}
+
+	deletionOrder, cycles := graph.deletionOrder(restoreOrder)
+	for _, cycle := range cycles {
+		log.Warnf("Dependency cycle between items %s, deleting them in the reverse order of the resource priorities", strings.Join(cycle, ", "))
+	}
+
+	ordered := make([]rollbackItem, 0, len(items))
+	for _, graphItem := range deletionOrder {
+		ordered = append(ordered, rollbackItems[graphItem])
+	}
+	return append(ordered, unordered...)
+}
+
+// deleteItem deletes an item created by the restore, unless it was replaced since the restore.
+func (r *kubernetesRollbacker) deleteItem(log logrus.FieldLogger, restore *velerov1api.Restore, item rollbackItem, result *RollbackResult) {
+	log = log.WithField("item", item.String())
+
+	resourceClient, err := r.dynamicFactory.ClientForGroupVersionResource(item.gvr.GroupVersion(), item.resource, item.namespace)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting the client of %s: %v", item, err))
+		return
+	}
+
+	obj, err := resourceClient.Get(item.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Info("Item created by the restore no longer exists, skipping")
+		result.ItemsSkipped++
+		return
+	}
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting %s: %v", item, err))
+		return
+	}
+
+	if !createdByRestore(obj, item.gvr.GroupResource(), restore) {
+		log.Info("Item created by the restore was replaced since the restore, skipping")
+		result.ItemsSkipped++
+		return
+	}
+
+	if err := resourceClient.Delete(item.name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		result.Errors = append(result.Errors, fmt.Sprintf("error deleting %s: %v", item, err))
+		return
+	}
+
+	log.Info("Deleted item created by the restore")
+	result.ItemsDeleted++
+}
+
+// revertItem patches an item updated by the restore back to its in-cluster version before the
+// restore. An item recreated by the restore, which has another UID, is deleted and created
+// from its version before the restore instead, as its immutable fields may have changed.
+func (r *kubernetesRollbacker) revertItem(log logrus.FieldLogger, restore *velerov1api.Restore, original *unstructured.Unstructured, result *RollbackResult) {
+	log = log.WithField("item", fmt.Sprintf("%s %s", original.GroupVersionKind(), kube.NamespaceAndName(original)))
+
+	gvr, resource, err := r.discoveryHelper.KindFor(original.GroupVersionKind())
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting the resource of %s: %v", original.GroupVersionKind(), err))
+		return
+	}
+	item := rollbackItem{gvr: gvr, resource: resource, namespace: original.GetNamespace(), name: original.GetName()}
+
+	resourceClient, err := r.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, original.GetNamespace())
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting the client of %s: %v", item, err))
+		return
+	}
+
+	current, err := resourceClient.Get(original.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.Info("Item updated by the restore no longer exists, skipping")
+		result.ItemsSkipped++
+		return
+	}
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error getting %s: %v", item, err))
+		return
+	}
+
+	desired, err := resetForRevert(original)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error reverting %s: %v", item, err))
+		return
+	}
+
+	if current.GetUID() != original.GetUID() {
+		if !createdByRestore(current, gvr.GroupResource(), restore) {
+			log.Info("Item recreated by the restore was replaced since the restore, skipping")
+			result.ItemsSkipped++
+			return
+		}
+		// the ownerReferences removed by the reset still refer to the owners of the item
+		desired.SetOwnerReferences(original.GetOwnerReferences())
+		if err := r.recreateItem(resourceClient, item, desired); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("error reverting %s: %v", item, err))
+			return
+		}
+		log.Info("Reverted item recreated by the restore")
+		result.ItemsReverted++
+		return
+	}
+
+	live, err := resetForRevert(current)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error reverting %s: %v", item, err))
+		return
+	}
+
+	patch, err := generatePatch(live, desired)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("error reverting %s: %v", item, err))
+		return
+	}
+	if patch != nil {
+		if _, err := resourceClient.Patch(original.GetName(), patch); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("error reverting %s: %v", item, err))
+			return
+		}
+	}
+
+	log.Info("Reverted item updated by the restore")
+	result.ItemsReverted++
+}
+
+// recreateItem deletes the in-cluster version of the item, waits up to the resource terminating
+// timeout for it to be gone, and creates the desired version of the item.
+func (r *kubernetesRollbacker) recreateItem(resourceClient client.Dynamic, item rollbackItem, desired *unstructured.Unstructured) error {
+	propagation := metav1.DeletePropagationForeground
+	if err := resourceClient.Delete(item.name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "error deleting the item recreated by the restore")
+	}
+
+	err := wait.PollUntilContextTimeout(go_context.Background(), time.Second, r.resourceTerminatingTimeout, true, func(go_context.Context) (bool, error) {
+		_, err := resourceClient.Get(item.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "error getting the item recreated by the restore")
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "error waiting for the item recreated by the restore to be deleted")
+	}
+
+	if _, err := resourceClient.Create(desired); err != nil {
+		return errors.Wrap(err, "error creating the item from its version before the restore")
+	}
+	return nil
+}
+
+// createdByRestore returns whether the in-cluster item is the one created by the restore. The
+// items are labeled with the name of the restore that created them, except the namespaces,
+// which must have been created after the restore started.
+func createdByRestore(obj *unstructured.Unstructured, groupResource schema.GroupResource, restore *velerov1api.Restore) bool {
+	if groupResource == kuberesource.Namespaces {
+		if restore.Status.StartTimestamp == nil {
+			return true
+		}
+		return !obj.GetCreationTimestamp().Time.Before(restore.Status.StartTimestamp.Time.Truncate(time.Second))
+	}
+	return obj.GetLabels()[velerov1api.RestoreNameLabel] == label.GetValidName(restore.Name)
+}
+
+// resetForRevert returns a copy of the item without the metadata and status that aren't
+// reverted.
+func resetForRevert(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	res, err := resetMetadataAndStatus(obj.DeepCopy())
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(res.Object, "metadata", "managedFields")
+	return res, nil
+}
+
+// parseAPIVersionKind parses the API version and kind of the restored resource list, such as
+// apps/v1/Deployment.
+func parseAPIVersionKind(apiVersionKind string) (schema.GroupVersionKind, error) {
+	i := strings.LastIndex(apiVersionKind, "/")
+	if i < 0 {
+		return schema.GroupVersionKind{}, errors.Errorf("invalid API version and kind %s", apiVersionKind)
+	}
+	gv, err := schema.ParseGroupVersion(apiVersionKind[:i])
+	if err != nil {
+		return schema.GroupVersionKind{}, errors.Wrapf(err, "invalid API version and kind %s", apiVersionKind)
+	}
+	return gv.WithKind(apiVersionKind[i+1:]), nil
+}
+
+// parseRestoredResourceEntry parses an entry of the restored resource list, such as
+// namespace/name(created).
+func parseRestoredResourceEntry(entry string) (namespace, name, action string) {
+	if i := strings.LastIndex(entry, "("); i >= 0 && strings.HasSuffix(entry, ")") {
+		action = entry[i+1 : len(entry)-1]
+		entry = entry[:i]
+	}
+	if i := strings.Index(entry, "/"); i >= 0 {
+		return entry[:i], entry[i+1:], action
+	}
+	return "", entry, action
+}
@@ -0,0 +1,283 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/types"
+)
+
+func toUnstructuredOrFail(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: res}
+}
+
+func TestRollback(t *testing.T) {
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	restoreLabels := builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")
+
+	tests := []struct {
+		name              string
+		restoredResources map[string][]string
+		resourceOrdering  velerov1api.RestoreResourceOrdering
+		originalItems     func(t *testing.T) []*unstructured.Unstructured
+		namespaces        map[string]time.Time
+		apiResources      []*test.APIResource
+		wantDeleted       []string
+		wantResult        RollbackResult
+		want              []*test.APIResource
+	}{
+		{
+			name: "created items are deleted in the reverse order of the resource priorities",
+			restoredResources: map[string][]string{
+				"v1/Namespace":       {"ns-1(created)"},
+				"v1/ConfigMap":       {"ns-1/cm-1(created)"},
+				"v1/Pod":             {"ns-1/pod-1(created)"},
+				"apps/v1/Deployment": {"ns-1/deploy-1(created)"},
+			},
+			namespaces: map[string]time.Time{"ns-1": started.Add(time.Second)},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(restoreLabels).Result()),
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(restoreLabels).Result()),
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(restoreLabels).Result()),
+			},
+			wantDeleted: []string{"deployments/deploy-1", "pods/pod-1", "configmaps/cm-1", "namespaces/ns-1"},
+			wantResult:  RollbackResult{ItemsDeleted: 4},
+		},
+		{
+			name: "created items are deleted in the reverse order of the dependency graph",
+			restoredResources: map[string][]string{
+				"v1/Namespace":       {"ns-1(created)"},
+				"v1/ConfigMap":       {"ns-1/cm-1(created)"},
+				"v1/Pod":             {"ns-1/pod-1(created)"},
+				"apps/v1/Deployment": {"ns-1/deploy-1(created)"},
+			},
+			resourceOrdering: velerov1api.RestoreResourceOrderingDependencyGraph,
+			namespaces:       map[string]time.Time{"ns-1": started.Add(time.Second)},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(restoreLabels).Result()),
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(
+					restoreLabels,
+					builder.WithOwnerReference([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-1", UID: "deploy-uid"}}),
+				).Volumes(&corev1api.Volume{
+					Name:         "config",
+					VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{LocalObjectReference: corev1api.LocalObjectReference{Name: "cm-1"}}},
+				}).Result()),
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(restoreLabels, builder.WithUID("deploy-uid")).Result()),
+			},
+			wantDeleted: []string{"pods/pod-1", "deployments/deploy-1", "configmaps/cm-1", "namespaces/ns-1"},
+			wantResult:  RollbackResult{ItemsDeleted: 4},
+		},
+		{
+			name: "items that weren't created by the restore are left as is",
+			restoredResources: map[string][]string{
+				"v1/Namespace": {"ns-1(skipped)"},
+				"v1/ConfigMap": {"ns-1/cm-1(skipped)", "ns-1/cm-2(updated)", "ns-1/cm-3(failed)"},
+			},
+			namespaces: map[string]time.Time{"ns-1": started.Add(-time.Hour)},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(restoreLabels).Result(),
+					builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(restoreLabels).Result(),
+					builder.ForConfigMap("ns-1", "cm-3").ObjectMeta(restoreLabels).Result(),
+				),
+			},
+			wantResult: RollbackResult{},
+		},
+		{
+			name: "created items that no longer exist or were replaced since the restore are skipped",
+			restoredResources: map[string][]string{
+				"v1/Namespace": {"ns-1(created)"},
+				"v1/ConfigMap": {"ns-1/cm-1(created)", "ns-1/cm-2(created)"},
+			},
+			namespaces: map[string]time.Time{"ns-1": started.Add(-time.Hour)},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("velero.io/restore-name", "restore-2")).Result()),
+			},
+			wantResult: RollbackResult{ItemsSkipped: 3},
+		},
+		{
+			name: "updated items are reverted to their state before the restore",
+			restoredResources: map[string][]string{
+				"v1/ConfigMap": {"ns-1/cm-1(updated)", "ns-1/cm-2(updated)"},
+			},
+			originalItems: func(t *testing.T) []*unstructured.Unstructured {
+				t.Helper()
+				return []*unstructured.Unstructured{
+					toUnstructuredOrFail(t, builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v1")).Data("key", "v1").Result()),
+					toUnstructuredOrFail(t, builder.ForConfigMap("ns-1", "cm-2").Data("key", "v1").Result()),
+				}
+			},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v2", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data("key", "v2").Result()),
+			},
+			wantResult: RollbackResult{ItemsReverted: 1, ItemsSkipped: 1},
+			want: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v1")).Data("key", "v1").Result()),
+			},
+		},
+		{
+			name: "recreated items are deleted and created from their state before the restore",
+			restoredResources: map[string][]string{
+				"v1/ConfigMap": {"ns-1/cm-1(updated)", "ns-1/cm-2(updated)"},
+			},
+			originalItems: func(t *testing.T) []*unstructured.Unstructured {
+				t.Helper()
+				return []*unstructured.Unstructured{
+					toUnstructuredOrFail(t, builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithUID("uid-1"), builder.WithLabels("app", "v1")).Data("key", "v1").Result()),
+					toUnstructuredOrFail(t, builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(builder.WithUID("uid-3")).Data("key", "v1").Result()),
+				}
+			},
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithUID("uid-2"), builder.WithLabels("app", "v2", "velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data("key", "v2").Result(),
+					// replaced since the restore
+					builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(builder.WithUID("uid-4")).Data("key", "v3").Result(),
+				),
+			},
+			wantDeleted: []string{"configmaps/cm-1"},
+			wantResult:  RollbackResult{ItemsReverted: 1, ItemsSkipped: 1},
+			want: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v1")).Data("key", "v1").Result(),
+					builder.ForConfigMap("ns-1", "cm-2").ObjectMeta(builder.WithUID("uid-4")).Data("key", "v3").Result(),
+				),
+			},
+		},
+		{
+			name: "items of unknown resources are reported as errors",
+			restoredResources: map[string][]string{
+				"example.io/v1/Unknown": {"ns-1/unknown-1(created)"},
+			},
+			wantResult: RollbackResult{Errors: []string{`error getting the resource of example.io/v1/Unknown: no matches for kind "Unknown" in version "example.io/v1"`}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Namespaces())
+			for name, created := range tc.namespaces {
+				// AddItems removes the creation timestamps the namespaces are checked against
+				ns := toUnstructuredOrFail(t, builder.ForNamespace(name).ObjectMeta(builder.WithCreationTimestamp(created)).Result())
+				_, err := h.DynamicClient.Resource(test.Namespaces().GVR()).Create(t.Context(), ns, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			var originalItems []*unstructured.Unstructured
+			if tc.originalItems != nil {
+				originalItems = tc.originalItems(t)
+			}
+
+			rollbacker := NewKubernetesRollbacker(
+				h.restorer.discoveryHelper,
+				client.NewDynamicFactory(h.DynamicClient),
+				types.Priorities{HighPriorities: []string{"namespaces", "configmaps", "pods"}},
+				time.Minute,
+			)
+			restore := defaultRestore().StartTimestamp(started).ResourceOrdering(tc.resourceOrdering).Result()
+
+			result := rollbacker.Rollback(h.log, restore, tc.restoredResources, originalItems)
+			assert.Equal(t, tc.wantResult, result)
+
+			var deleted []string
+			for _, action := range h.DynamicClient.Actions() {
+				if action.GetVerb() == "delete" {
+					deleted = append(deleted, action.GetResource().Resource+"/"+action.(kubetesting.DeleteAction).GetName())
+				}
+			}
+			assert.Equal(t, tc.wantDeleted, deleted)
+			assertRestoredItems(t, h, tc.want)
+		})
+	}
+}
+
+func TestRollbackAfterRestore(t *testing.T) {
+	h := newHarness(t)
+	_, err := h.KubeClient.CoreV1().Namespaces().Create(t.Context(), builder.ForNamespace("ns-1").Result(), metav1.CreateOptions{})
+	require.NoError(t, err)
+	h.AddItems(t, test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v2")).Data("key", "v2").Result()))
+	h.AddItems(t, test.Pods())
+
+	restore := defaultRestore().ExistingResourcePolicy("update").StartTimestamp(time.Now()).Result()
+	data := &Request{
+		Log:     h.log,
+		Restore: restore,
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v1")).Data("key", "v1").Result()).
+			AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+			Done(),
+		RestoredItems: map[itemKey]restoredItemStatus{},
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil)
+	assertEmptyResults(t, warnings, errs)
+	require.Len(t, data.OriginalItems, 1)
+
+	rollbacker := NewKubernetesRollbacker(h.restorer.discoveryHelper, client.NewDynamicFactory(h.DynamicClient), types.Priorities{}, time.Minute)
+	result := rollbacker.Rollback(h.log, restore, data.RestoredResourceList(), data.OriginalItems)
+	assert.Equal(t, RollbackResult{ItemsDeleted: 1, ItemsReverted: 1}, result)
+
+	_, err = h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(t.Context(), "pod-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").ObjectMeta(builder.WithLabels("app", "v2")).Data("key", "v2").Result()),
+	})
+}
+
+func TestParseRestoredResourceEntry(t *testing.T) {
+	tests := []struct {
+		entry         string
+		wantNamespace string
+		wantName      string
+		wantAction    string
+	}{
+		{entry: "ns-1/pod-1(created)", wantNamespace: "ns-1", wantName: "pod-1", wantAction: "created"},
+		{entry: "ns-1(created)", wantName: "ns-1", wantAction: "created"},
+		{entry: "ns-1/pod-1", wantNamespace: "ns-1", wantName: "pod-1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.entry, func(t *testing.T) {
+			namespace, name, action := parseRestoredResourceEntry(tc.entry)
+			assert.Equal(t, tc.wantNamespace, namespace)
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantAction, action)
+		})
+	}
+}
@@ -49,7 +49,9 @@ func NewAPIServer(t *testing.T) *APIServer {
 				{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}:                             "PVCList",
 				{Group: "", Version: "v1", Resource: "secrets"}:                                            "SecretsList",
 				{Group: "", Version: "v1", Resource: "serviceaccounts"}:                                    "ServiceAccountsList",
+				{Group: "", Version: "v1", Resource: "configmaps"}:                                         "ConfigMapsList",
 				{Group: "apps", Version: "v1", Resource: "deployments"}:                                    "DeploymentsList",
+				{Group: "apps", Version: "v1", Resource: "statefulsets"}:                                   "StatefulSetsList",
 				{Group: "apiextensions.k8s.io", Version: "v1beta1", Resource: "customresourcedefinitions"}: "CRDList",
 				{Group: "velero.io", Version: "v1", Resource: "volumesnapshotlocations"}:                   "VSLList",
 				{Group: "velero.io", Version: "v1", Resource: "backups"}:                                   "BackupList",
@@ -43,6 +43,11 @@ func (df *FakeDynamicFactory) DynamicSharedInformerFactory() dynamicinformer.Dyn
 	return args.Get(0).(dynamicinformer.DynamicSharedInformerFactory)
 }
 
+func (df *FakeDynamicFactory) DryRun() client.DynamicFactory {
+	args := df.Called()
+	return args.Get(0).(client.DynamicFactory)
+}
+
 type FakeDynamicClient struct {
 	mock.Mock
 }
@@ -103,6 +103,18 @@ func Deployments(items ...metav1.Object) *APIResource {
 	}
 }
 
+func StatefulSets(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "apps",
+		Version:    "v1",
+		Name:       "statefulsets",
+		ShortName:  "sts",
+		Kind:       "StatefulSet",
+		Namespaced: true,
+		Items:      items,
+	}
+}
+
 func ExtensionsDeployments(items ...metav1.Object) *APIResource {
 	return &APIResource{
 		Group:      "extensions",
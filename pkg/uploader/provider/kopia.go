@@ -66,7 +66,7 @@ func NewKopiaUploaderProvider(
 	//repoUID which is used to generate kopia repository config with unique directory path
 	repoUID := string(backupRepo.GetUID())
 	repoOpt, err := udmrepo.NewRepoOptions(
-		udmrepo.WithPassword(kp, ""),
+		udmrepo.WithPassword(kp, backupRepo),
 		udmrepo.WithConfigFile("", repoUID),
 		udmrepo.WithDescription("Initial kopia uploader provider"),
 	)
@@ -196,7 +196,8 @@ func (kp *kopiaProvider) GetPassword(param any) (string, error) {
 	if kp.credGetter.FromSecret == nil {
 		return "", errors.New("invalid credentials interface")
 	}
-	rawPass, err := kp.credGetter.FromSecret.Get(repokeys.RepoKeySelector())
+	backupRepo, _ := param.(*velerov1api.BackupRepository)
+	rawPass, err := kp.credGetter.FromSecret.Get(repokeys.RepoKeySelector(backupRepo))
 	if err != nil {
 		return "", errors.Wrap(err, "error to get password")
 	}
@@ -236,6 +236,7 @@ func TestGetPassword(t *testing.T) {
 	testCases := []struct {
 		name           string
 		empytSecret    bool
+		repoKey        *corev1api.SecretKeySelector
 		credGetterFunc func(*mocks.SecretStore, *corev1api.SecretKeySelector)
 		expectError    bool
 		expectedPass   string
@@ -248,6 +249,15 @@ func TestGetPassword(t *testing.T) {
 			expectError:  false,
 			expectedPass: "test",
 		},
+		{
+			name:    "repository with its own key",
+			repoKey: &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "velero-repo-key-repo"}, Key: "repository-password-abcde"},
+			credGetterFunc: func(ss *mocks.SecretStore, selector *corev1api.SecretKeySelector) {
+				ss.On("Get", selector).Return("repo-test", nil)
+			},
+			expectError:  false,
+			expectedPass: "repo-test",
+		},
 		{
 			name:         "empty from secret",
 			empytSecret:  true,
@@ -273,6 +283,11 @@ func TestGetPassword(t *testing.T) {
 				credGetter.FromSecret = mockCredGetter
 			}
 			repoKeySelector := &corev1api.SecretKeySelector{LocalObjectReference: corev1api.LocalObjectReference{Name: "velero-repo-credentials"}, Key: "repository-password"}
+			var backupRepo *velerov1api.BackupRepository
+			if tc.repoKey != nil {
+				repoKeySelector = tc.repoKey
+				backupRepo = &velerov1api.BackupRepository{Spec: velerov1api.BackupRepositorySpec{RepositoryKeySecret: tc.repoKey}}
+			}
 
 			if tc.credGetterFunc != nil {
 				tc.credGetterFunc(mockCredGetter, repoKeySelector)
@@ -282,7 +297,7 @@ func TestGetPassword(t *testing.T) {
 				credGetter: credGetter,
 			}
 
-			password, err := kp.GetPassword(nil)
+			password, err := kp.GetPassword(backupRepo)
 			if tc.expectError {
 				require.Error(t, err, "Expected an error")
 			} else {
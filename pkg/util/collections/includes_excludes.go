@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
@@ -259,6 +260,46 @@ func (ie *ScopeIncludesExcludes) ShouldExclude(typeName string) bool {
 	return false
 }
 
+// CombineWithPolicy adds the resources of the include/exclude policy of the resource policies to
+// the filters. The filters of the backup spec take precedence over the policy, so a resource is only
+// added when the filters neither include nor exclude it yet.
+func (ie *ScopeIncludesExcludes) CombineWithPolicy(policy *resourcepolicies.IncludeExcludePolicy) {
+	if policy == nil {
+		return
+	}
+
+	mapFunc := scopeResourceMapFunc(ie.helper)
+	combineFilterWithPolicy(&ie.namespaceScopedResourceFilter, policy.IncludedNamespaceScopedResources, policy.ExcludedNamespaceScopedResources, mapFunc, true)
+	combineFilterWithPolicy(&ie.clusterScopedResourceFilter, policy.IncludedClusterScopedResources, policy.ExcludedClusterScopedResources, mapFunc, false)
+
+	ie.logger.Infof("Scoped resource includes/excludes after combining with the include/exclude policy")
+	ie.logger.Infof("Including namespace-scoped resources: %s", ie.namespaceScopedResourceFilter.IncludesString())
+	ie.logger.Infof("Excluding namespace-scoped resources: %s", ie.namespaceScopedResourceFilter.ExcludesString())
+	ie.logger.Infof("Including cluster-scoped resources: %s", ie.clusterScopedResourceFilter.GetIncludes())
+	ie.logger.Infof("Excluding cluster-scoped resources: %s", ie.clusterScopedResourceFilter.ExcludesString())
+}
+
+func combineFilterWithPolicy(filter *IncludesExcludes, includes, excludes []string, mapFunc func(string, bool) string, namespaced bool) {
+	for _, item := range excludes {
+		key := mapFunc(item, namespaced)
+		if key == "" {
+			continue
+		}
+		if !filter.includes.match(key) && !filter.excludes.match(key) {
+			filter.Excludes(key)
+		}
+	}
+	for _, item := range includes {
+		key := mapFunc(item, namespaced)
+		if key == "" {
+			continue
+		}
+		if !filter.includes.match(key) && !filter.excludes.match(key) {
+			filter.Includes(key)
+		}
+	}
+}
+
 // IncludesString returns a string containing all of the includes, separated by commas, or * if the
 // list is empty.
 func (ie *IncludesExcludes) IncludesString() string {
@@ -516,18 +557,7 @@ func GetScopeResourceIncludesExcludes(helper discovery.Helper, logger logrus.Fie
 		namespaceExcludes,
 		clusterIncludes,
 		clusterExcludes,
-		func(item string, namespaced bool) string {
-			gvr, resource, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
-			if err != nil {
-				return item
-			}
-			if resource.Namespaced != namespaced {
-				return ""
-			}
-
-			gr := gvr.GroupResource()
-			return gr.String()
-		},
+		scopeResourceMapFunc(helper),
 		nsIncludesExcludes,
 		helper,
 		logger,
@@ -540,6 +570,23 @@ func GetScopeResourceIncludesExcludes(helper discovery.Helper, logger logrus.Fie
 	return ret
 }
 
+// scopeResourceMapFunc returns the function resolving the resources of the scoped filters to
+// fully-qualified group-resource names. Resources of the wrong scope are resolved to "".
+func scopeResourceMapFunc(helper discovery.Helper) func(string, bool) string {
+	return func(item string, namespaced bool) string {
+		gvr, resource, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
+		if err != nil {
+			return item
+		}
+		if resource.Namespaced != namespaced {
+			return ""
+		}
+
+		gr := gvr.GroupResource()
+		return gr.String()
+	}
+}
+
 // UseOldResourceFilters checks whether to use old resource filters (IncludeClusterResources,
 // IncludedResources and ExcludedResources), depending the backup's filters setting.
 // New filters are IncludedClusterScopedResources, ExcludedClusterScopedResources,
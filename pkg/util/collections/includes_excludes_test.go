@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -741,6 +742,90 @@ func TestGetScopedResourceIncludesExcludes(t *testing.T) {
 	}
 }
 
+func TestCombineWithPolicy(t *testing.T) {
+	tests := []struct {
+		name                            string
+		namespaceScopedIncludes         []string
+		namespaceScopedExcludes         []string
+		clusterScopedIncludes           []string
+		clusterScopedExcludes           []string
+		policy                          *resourcepolicies.IncludeExcludePolicy
+		expectedNamespaceScopedIncludes []string
+		expectedNamespaceScopedExcludes []string
+		expectedClusterScopedIncludes   []string
+		expectedClusterScopedExcludes   []string
+	}{
+		{
+			name:                            "nil policy",
+			namespaceScopedIncludes:         []string{"pods"},
+			expectedNamespaceScopedIncludes: []string{"pods"},
+			expectedNamespaceScopedExcludes: []string{},
+			expectedClusterScopedIncludes:   []string{},
+			expectedClusterScopedExcludes:   []string{},
+		},
+		{
+			name: "policy is used when the backup spec has no filters",
+			policy: &resourcepolicies.IncludeExcludePolicy{
+				IncludedNamespaceScopedResources: []string{"deployments.apps", "pods"},
+				ExcludedNamespaceScopedResources: []string{"secrets"},
+				IncludedClusterScopedResources:   []string{"persistentvolumes"},
+				ExcludedClusterScopedResources:   []string{"namespaces"},
+			},
+			expectedNamespaceScopedIncludes: []string{"deployments.apps", "pods"},
+			expectedNamespaceScopedExcludes: []string{"secrets"},
+			expectedClusterScopedIncludes:   []string{"persistentvolumes"},
+			expectedClusterScopedExcludes:   []string{"namespaces"},
+		},
+		{
+			name:                    "backup spec filters take precedence over the policy",
+			namespaceScopedIncludes: []string{"secrets"},
+			namespaceScopedExcludes: []string{"pods"},
+			clusterScopedExcludes:   []string{"persistentvolumes"},
+			policy: &resourcepolicies.IncludeExcludePolicy{
+				IncludedNamespaceScopedResources: []string{"pods", "deployments.apps"},
+				ExcludedNamespaceScopedResources: []string{"secrets", "configmaps"},
+				IncludedClusterScopedResources:   []string{"persistentvolumes"},
+			},
+			expectedNamespaceScopedIncludes: []string{"deployments.apps", "secrets"},
+			expectedNamespaceScopedExcludes: []string{"configmaps", "pods"},
+			expectedClusterScopedIncludes:   []string{},
+			expectedClusterScopedExcludes:   []string{"persistentvolumes"},
+		},
+		{
+			name: "resources of the wrong scope are ignored",
+			policy: &resourcepolicies.IncludeExcludePolicy{
+				IncludedNamespaceScopedResources: []string{"persistentvolumes"},
+				ExcludedClusterScopedResources:   []string{"pods"},
+			},
+			expectedNamespaceScopedIncludes: []string{},
+			expectedNamespaceScopedExcludes: []string{},
+			expectedClusterScopedIncludes:   []string{},
+			expectedClusterScopedExcludes:   []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := logrus.StandardLogger()
+			helper := setupDiscoveryClientWithResources([]*test.APIResource{
+				test.Deployments(),
+				test.Pods(),
+				test.Secrets(),
+				test.ConfigMaps(),
+				test.PVs(),
+				test.Namespaces(),
+			})
+			resources := GetScopeResourceIncludesExcludes(helper, logger, tc.namespaceScopedIncludes, tc.namespaceScopedExcludes, tc.clusterScopedIncludes, tc.clusterScopedExcludes, *NewIncludesExcludes())
+			resources.CombineWithPolicy(tc.policy)
+
+			assert.Equal(t, tc.expectedNamespaceScopedIncludes, resources.namespaceScopedResourceFilter.includes.List())
+			assert.Equal(t, tc.expectedNamespaceScopedExcludes, resources.namespaceScopedResourceFilter.excludes.List())
+			assert.Equal(t, tc.expectedClusterScopedIncludes, resources.clusterScopedResourceFilter.includes.List())
+			assert.Equal(t, tc.expectedClusterScopedExcludes, resources.clusterScopedResourceFilter.excludes.List())
+		})
+	}
+}
+
 func TestUseOldResourceFilters(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -0,0 +1,272 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the client-side encryption of the objects Velero stores in
+// backup storage locations.
+//
+// Objects are encrypted with AES-256-GCM in chunks, so they can be encrypted and decrypted
+// while they're streamed. An encrypted object starts with a header made of a magic string, the
+// ID of the key it's encrypted with and a random nonce, followed by the sealed chunks. Every
+// chunk but the last one holds chunkSize bytes of plaintext, and the last chunk is flagged in
+// its additional data, so reordered, truncated or extended objects fail to decrypt.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// KeySize is the size of the encryption keys, in bytes.
+	KeySize = 32
+
+	keyIDSize = 8
+	chunkSize = 64 * 1024
+)
+
+var magic = []byte("VELEROE1")
+
+var headerSize = len(magic) + keyIDSize + 12
+
+// ParseKey returns the key held by the data of a Secret, either the raw key or its base64
+// encoding.
+func ParseKey(data []byte) ([]byte, error) {
+	if len(data) == KeySize {
+		return data, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(key) != KeySize {
+		return nil, errors.Errorf("encryption key must be %d bytes, raw or base64-encoded", KeySize)
+	}
+	return key, nil
+}
+
+// Keyring holds the key objects are encrypted with, and the keys they can be decrypted with.
+type Keyring struct {
+	activeID string
+	aeads    map[string]cipher.AEAD
+}
+
+// NewKeyring returns a Keyring encrypting objects with the active key, and decrypting the
+// objects encrypted with the active key or any of the previous keys. A keyring without active
+// key can only decrypt.
+func NewKeyring(active []byte, previous ...[]byte) (*Keyring, error) {
+	k := &Keyring{aeads: map[string]cipher.AEAD{}}
+	for i, key := range append([][]byte{active}, previous...) {
+		if key == nil && i == 0 {
+			continue
+		}
+
+		id, aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.aeads[id] = aead
+		if i == 0 {
+			k.activeID = id
+		}
+	}
+	return k, nil
+}
+
+func newAEAD(key []byte) (string, cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return "", nil, errors.Errorf("encryption key must be %d bytes", KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", nil, errors.WithStack(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", nil, errors.WithStack(err)
+	}
+
+	sum := sha256.Sum256(key)
+	return string(sum[:keyIDSize]), aead, nil
+}
+
+// Encrypt returns a reader of the encryption of the data read from r with the active key.
+func (k *Keyring) Encrypt(r io.Reader) (io.Reader, error) {
+	if k.activeID == "" {
+		return nil, errors.New("keyring has no encryption key")
+	}
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, magic...)
+	header = append(header, k.activeID...)
+	nonce := make([]byte, 12)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+	header = append(header, nonce...)
+
+	return &encryptingReader{
+		src:   r,
+		aead:  k.aeads[k.activeID],
+		nonce: nonce,
+		out:   header,
+		plain: make([]byte, chunkSize),
+	}, nil
+}
+
+// ErrNotEncrypted is returned when decrypting data that isn't encrypted.
+var ErrNotEncrypted = errors.New("object isn't encrypted")
+
+// Decrypt returns a reader of the decryption of the data read from r. It returns ErrNotEncrypted
+// if the data isn't encrypted, since accepting it would let anyone able to replace the data bypass
+// the authentication of the cipher.
+func (k *Keyring) Decrypt(r io.Reader) (io.Reader, error) {
+	encrypted, r, err := IsEncrypted(r)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return nil, errors.WithStack(ErrNotEncrypted)
+	}
+
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "error reading encryption header")
+	}
+	aead, ok := k.aeads[string(header[len(magic):len(magic)+keyIDSize])]
+	if !ok {
+		return nil, errors.Errorf("object is encrypted with unknown key %x", header[len(magic):len(magic)+keyIDSize])
+	}
+
+	return &decryptingReader{
+		src:    r,
+		aead:   aead,
+		nonce:  header[len(magic)+keyIDSize:],
+		sealed: make([]byte, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+// IsEncrypted returns whether the data read from r is encrypted, and a reader of the data.
+func IsEncrypted(r io.Reader) (bool, io.Reader, error) {
+	br := bufio.NewReader(r)
+	prefix, err := br.Peek(len(magic))
+	if err != nil && err != io.EOF {
+		return false, nil, errors.WithStack(err)
+	}
+	return bytes.Equal(prefix, magic), br, nil
+}
+
+// chunkNonce returns the nonce of a chunk, the nonce of the object xor-ed with the index of
+// the chunk.
+func chunkNonce(nonce []byte, index uint64) []byte {
+	n := make([]byte, len(nonce))
+	copy(n, nonce)
+	counter := binary.BigEndian.Uint64(n[len(n)-8:])
+	binary.BigEndian.PutUint64(n[len(n)-8:], counter^index)
+	return n
+}
+
+func additionalData(final bool) []byte {
+	if final {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+type encryptingReader struct {
+	src   io.Reader
+	aead  cipher.AEAD
+	nonce []byte
+	index uint64
+	plain []byte
+	out   []byte
+	done  bool
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(r.src, r.plain)
+		final := false
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			final = true
+		default:
+			return 0, err
+		}
+
+		r.out = r.aead.Seal(r.out[:0], chunkNonce(r.nonce, r.index), r.plain[:n], additionalData(final))
+		r.index++
+		r.done = final
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+type decryptingReader struct {
+	src    io.Reader
+	aead   cipher.AEAD
+	nonce  []byte
+	index  uint64
+	sealed []byte
+	out    []byte
+	done   bool
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(r.src, r.sealed)
+		final := false
+		switch err {
+		case nil:
+		case io.ErrUnexpectedEOF:
+			final = true
+		case io.EOF:
+			return 0, errors.New("encrypted object is truncated")
+		default:
+			return 0, err
+		}
+
+		plain, err := r.aead.Open(r.out[:0], chunkNonce(r.nonce, r.index), r.sealed[:n], additionalData(final))
+		if err != nil {
+			return 0, errors.New("encrypted object is corrupted or was modified")
+		}
+		r.out = plain
+		r.index++
+		r.done = final
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
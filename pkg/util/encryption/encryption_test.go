@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encrypt(t *testing.T, k *Keyring, plain []byte) []byte {
+	t.Helper()
+	r, err := k.Encrypt(bytes.NewReader(plain))
+	require.NoError(t, err)
+	sealed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return sealed
+}
+
+func decrypt(k *Keyring, sealed []byte) ([]byte, error) {
+	r, err := k.Decrypt(bytes.NewReader(sealed))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, KeySize)
+	keyring, err := NewKeyring(key)
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 17} {
+		plain := bytes.Repeat([]byte("velero"), size/6+1)[:size]
+
+		sealed := encrypt(t, keyring, plain)
+		assert.NotContains(t, string(sealed), "velerovelero")
+		encrypted, _, err := IsEncrypted(bytes.NewReader(sealed))
+		require.NoError(t, err)
+		assert.True(t, encrypted)
+
+		got, err := decrypt(keyring, sealed)
+		require.NoError(t, err)
+		assert.Equal(t, plain, got, "size %d", size)
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	oldKey := bytes.Repeat([]byte{1}, KeySize)
+	newKey := bytes.Repeat([]byte{2}, KeySize)
+	oldKeyring, err := NewKeyring(oldKey)
+	require.NoError(t, err)
+	rotatedKeyring, err := NewKeyring(newKey, oldKey)
+	require.NoError(t, err)
+	newKeyring, err := NewKeyring(newKey)
+	require.NoError(t, err)
+
+	plain := bytes.Repeat([]byte("velero"), chunkSize/2)
+	sealed := encrypt(t, oldKeyring, plain)
+
+	// objects encrypted with a previous key are readable after the key is rotated
+	got, err := decrypt(rotatedKeyring, sealed)
+	require.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	_, err = decrypt(newKeyring, sealed)
+	require.ErrorContains(t, err, "object is encrypted with unknown key")
+
+	modified := bytes.Clone(sealed)
+	modified[len(modified)-20] ^= 1
+	_, err = decrypt(oldKeyring, modified)
+	require.EqualError(t, err, "encrypted object is corrupted or was modified")
+
+	_, err = decrypt(oldKeyring, sealed[:headerSize+chunkSize+16+100])
+	require.EqualError(t, err, "encrypted object is corrupted or was modified")
+
+	_, err = decrypt(oldKeyring, sealed[:headerSize])
+	require.EqualError(t, err, "encrypted object is truncated")
+
+	// unencrypted objects are rejected
+	_, err = decrypt(newKeyring, []byte("plain"))
+	require.ErrorIs(t, err, ErrNotEncrypted)
+
+	decryptOnly, err := NewKeyring(nil, oldKey)
+	require.NoError(t, err)
+	_, err = decryptOnly.Encrypt(bytes.NewReader(plain))
+	require.EqualError(t, err, "keyring has no encryption key")
+}
+
+func TestParseKey(t *testing.T) {
+	key := bytes.Repeat([]byte{3}, KeySize)
+
+	got, err := ParseKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	got, err = ParseKey([]byte(base64.StdEncoding.EncodeToString(key) + "\n"))
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	_, err = ParseKey([]byte("too-short"))
+	require.EqualError(t, err, "encryption key must be 32 bytes, raw or base64-encoded")
+}
@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"fmt"
+
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+)
+
+// IsDeploymentReady returns whether all the replicas of the deployment are updated and
+// available, and why it's not ready.
+func IsDeploymentReady(deployment *appsv1api.Deployment) (bool, string) {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, "the latest spec is not observed yet"
+	}
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1api.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return false, fmt.Sprintf("the deployment exceeded its progress deadline: %s", cond.Message)
+		}
+	}
+	replicas := replicasOrDefault(deployment.Spec.Replicas)
+	if deployment.Status.UpdatedReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d replicas are updated", deployment.Status.UpdatedReplicas, replicas)
+	}
+	if deployment.Status.AvailableReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d replicas are available", deployment.Status.AvailableReplicas, replicas)
+	}
+	return true, ""
+}
+
+// IsStatefulSetReady returns whether all the replicas of the stateful set are ready, and why
+// it's not ready.
+func IsStatefulSetReady(statefulSet *appsv1api.StatefulSet) (bool, string) {
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return false, "the latest spec is not observed yet"
+	}
+	replicas := replicasOrDefault(statefulSet.Spec.Replicas)
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d replicas are ready", statefulSet.Status.ReadyReplicas, replicas)
+	}
+	return true, ""
+}
+
+// IsDaemonSetReady returns whether the pods of the daemon set are available on all the nodes
+// they're scheduled on, and why it's not ready.
+func IsDaemonSetReady(daemonSet *appsv1api.DaemonSet) (bool, string) {
+	if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+		return false, "the latest spec is not observed yet"
+	}
+	if daemonSet.Status.NumberAvailable < daemonSet.Status.DesiredNumberScheduled {
+		return false, fmt.Sprintf("%d of %d pods are available", daemonSet.Status.NumberAvailable, daemonSet.Status.DesiredNumberScheduled)
+	}
+	return true, ""
+}
+
+// IsJobComplete returns whether the job completed, whether it failed, and why it's not complete.
+func IsJobComplete(job *batchv1api.Job) (complete bool, failed bool, reason string) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1api.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1api.JobComplete:
+			return true, false, ""
+		case batchv1api.JobFailed:
+			return false, true, fmt.Sprintf("the job failed: %s", cond.Message)
+		}
+	}
+	return false, false, fmt.Sprintf("%d pods succeeded, %d pods failed", job.Status.Succeeded, job.Status.Failed)
+}
+
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsDeploymentReady(t *testing.T) {
+	replicas := int32(2)
+	tests := []struct {
+		name        string
+		deployment  *appsv1api.Deployment
+		wantReady   bool
+		wantMessage string
+	}{
+		{
+			name: "all replicas are updated and available",
+			deployment: &appsv1api.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       appsv1api.DeploymentSpec{Replicas: &replicas},
+				Status:     appsv1api.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			wantReady: true,
+		},
+		{
+			name: "spec is not observed",
+			deployment: &appsv1api.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     appsv1api.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+			},
+			wantMessage: "the latest spec is not observed yet",
+		},
+		{
+			name: "replicas are not available",
+			deployment: &appsv1api.Deployment{
+				Spec:   appsv1api.DeploymentSpec{Replicas: &replicas},
+				Status: appsv1api.DeploymentStatus{UpdatedReplicas: 2, AvailableReplicas: 1},
+			},
+			wantMessage: "1 of 2 replicas are available",
+		},
+		{
+			name: "progress deadline is exceeded",
+			deployment: &appsv1api.Deployment{
+				Status: appsv1api.DeploymentStatus{Conditions: []appsv1api.DeploymentCondition{
+					{Type: appsv1api.DeploymentProgressing, Status: corev1api.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: "ReplicaSet has timed out progressing."},
+				}},
+			},
+			wantMessage: "the deployment exceeded its progress deadline: ReplicaSet has timed out progressing.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ready, message := IsDeploymentReady(tc.deployment)
+			assert.Equal(t, tc.wantReady, ready)
+			assert.Equal(t, tc.wantMessage, message)
+		})
+	}
+}
+
+func TestIsStatefulSetReady(t *testing.T) {
+	ready, message := IsStatefulSetReady(&appsv1api.StatefulSet{Status: appsv1api.StatefulSetStatus{ReadyReplicas: 1}})
+	assert.True(t, ready)
+	assert.Empty(t, message)
+
+	ready, message = IsStatefulSetReady(&appsv1api.StatefulSet{})
+	assert.False(t, ready)
+	assert.Equal(t, "0 of 1 replicas are ready", message)
+}
+
+func TestIsDaemonSetReady(t *testing.T) {
+	ready, message := IsDaemonSetReady(&appsv1api.DaemonSet{Status: appsv1api.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 3}})
+	assert.True(t, ready)
+	assert.Empty(t, message)
+
+	ready, message = IsDaemonSetReady(&appsv1api.DaemonSet{Status: appsv1api.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 2}})
+	assert.False(t, ready)
+	assert.Equal(t, "2 of 3 pods are available", message)
+}
+
+func TestIsJobComplete(t *testing.T) {
+	complete, failed, reason := IsJobComplete(&batchv1api.Job{Status: batchv1api.JobStatus{Conditions: []batchv1api.JobCondition{
+		{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue},
+	}}})
+	assert.True(t, complete)
+	assert.False(t, failed)
+	assert.Empty(t, reason)
+
+	complete, failed, reason = IsJobComplete(&batchv1api.Job{Status: batchv1api.JobStatus{Conditions: []batchv1api.JobCondition{
+		{Type: batchv1api.JobFailed, Status: corev1api.ConditionTrue, Message: "Job has reached the specified backoff limit"},
+	}}})
+	assert.False(t, complete)
+	assert.True(t, failed)
+	assert.Equal(t, "the job failed: Job has reached the specified backoff limit", reason)
+
+	complete, failed, reason = IsJobComplete(&batchv1api.Job{Status: batchv1api.JobStatus{Failed: 1}})
+	assert.False(t, complete)
+	assert.False(t, failed)
+	assert.Equal(t, "0 pods succeeded, 1 pods failed", reason)
+}
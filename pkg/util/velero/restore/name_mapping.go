@@ -0,0 +1,113 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// HasNameMapping returns whether the restore renames any items.
+func HasNameMapping(restore *api.Restore) bool {
+	if restore == nil {
+		return false
+	}
+	m := restore.Spec.NameMapping
+	return m != nil && (len(m.Names) > 0 || m.Prefix != "" || m.Suffix != "")
+}
+
+// MapName returns the name the item of the resource is restored with, and whether it's renamed.
+// The namespace is the namespace of the item in the backup, empty for the cluster-scoped items,
+// which are only renamed when their resource is included explicitly. The restore item actions
+// use it to rewrite the references to the renamed items.
+func MapName(restore *api.Restore, groupResource schema.GroupResource, namespace, name string) (string, bool) {
+	if name == "" || !HasNameMapping(restore) || groupResource == kuberesource.Namespaces {
+		return name, false
+	}
+
+	m := restore.Spec.NameMapping
+	included := namespace != "" && len(m.IncludedResources) == 0
+	for _, resource := range m.IncludedResources {
+		if resource == "*" || resource == groupResource.Resource || resource == groupResource.String() {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return name, false
+	}
+
+	return mapName(m, name)
+}
+
+// ValidateNameMapping returns the errors of an invalid name mapping.
+func ValidateNameMapping(m *api.RestoreNameMapping) []error {
+	if m == nil {
+		return nil
+	}
+
+	var errs []error
+	if len(m.Names) == 0 && m.Prefix == "" && m.Suffix == "" {
+		errs = append(errs, errors.New("name mapping must have names, a prefix or a suffix"))
+	}
+	for source, target := range m.Names {
+		if target == "" {
+			errs = append(errs, errors.Errorf("name mapping of %q has no target", source))
+		}
+	}
+	for _, resource := range m.IncludedResources {
+		if resource == kuberesource.Namespaces.Resource {
+			errs = append(errs, errors.New("namespaces can't be renamed by the name mapping, use the namespace mapping instead"))
+		}
+	}
+	return errs
+}
+
+// MapLabels renames the values of the labels whose keys are in the LabelKeys of the restore's
+// name mapping, and returns whether any label was renamed.
+func MapLabels(restore *api.Restore, labels map[string]string) bool {
+	if !HasNameMapping(restore) {
+		return false
+	}
+
+	renamed := false
+	for key, value := range labels {
+		if !slices.Contains(restore.Spec.NameMapping.LabelKeys, key) {
+			continue
+		}
+		if target, ok := mapName(restore.Spec.NameMapping, value); ok {
+			labels[key] = target
+			renamed = true
+		}
+	}
+	return renamed
+}
+
+func mapName(m *api.RestoreNameMapping, name string) (string, bool) {
+	if target, ok := m.Names[name]; ok {
+		return target, target != name
+	}
+	if m.Prefix == "" && m.Suffix == "" {
+		return name, false
+	}
+	return m.Prefix + name + m.Suffix, true
+}
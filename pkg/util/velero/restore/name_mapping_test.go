@@ -0,0 +1,145 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestMapName(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	tests := []struct {
+		name          string
+		mapping       *velerov1api.RestoreNameMapping
+		groupResource schema.GroupResource
+		namespace     string
+		itemName      string
+		wantName      string
+		wantRenamed   bool
+	}{
+		{
+			name:          "no name mapping",
+			groupResource: kuberesource.Pods,
+			namespace:     "ns-1",
+			itemName:      "pod-1",
+			wantName:      "pod-1",
+		},
+		{
+			name:          "suffix is added to namespaced items",
+			mapping:       &velerov1api.RestoreNameMapping{Suffix: "-clone"},
+			groupResource: kuberesource.Pods,
+			namespace:     "ns-1",
+			itemName:      "pod-1",
+			wantName:      "pod-1-clone",
+			wantRenamed:   true,
+		},
+		{
+			name:          "explicit name takes precedence over prefix and suffix",
+			mapping:       &velerov1api.RestoreNameMapping{Names: map[string]string{"pod-1": "pod-2"}, Prefix: "copy-", Suffix: "-clone"},
+			groupResource: kuberesource.Pods,
+			namespace:     "ns-1",
+			itemName:      "pod-1",
+			wantName:      "pod-2",
+			wantRenamed:   true,
+		},
+		{
+			name:          "cluster-scoped items aren't renamed by default",
+			mapping:       &velerov1api.RestoreNameMapping{Prefix: "copy-"},
+			groupResource: kuberesource.ClusterRoles,
+			itemName:      "role-1",
+			wantName:      "role-1",
+		},
+		{
+			name:          "cluster-scoped items are renamed when their resource is included",
+			mapping:       &velerov1api.RestoreNameMapping{IncludedResources: []string{"clusterroles.rbac.authorization.k8s.io"}, Prefix: "copy-"},
+			groupResource: kuberesource.ClusterRoles,
+			itemName:      "role-1",
+			wantName:      "copy-role-1",
+			wantRenamed:   true,
+		},
+		{
+			name:          "items of resources that aren't included aren't renamed",
+			mapping:       &velerov1api.RestoreNameMapping{IncludedResources: []string{"deployments"}, Suffix: "-clone"},
+			groupResource: kuberesource.Pods,
+			namespace:     "ns-1",
+			itemName:      "pod-1",
+			wantName:      "pod-1",
+		},
+		{
+			name:          "items of included resources are renamed",
+			mapping:       &velerov1api.RestoreNameMapping{IncludedResources: []string{"deployments"}, Suffix: "-clone"},
+			groupResource: deployments,
+			namespace:     "ns-1",
+			itemName:      "deploy-1",
+			wantName:      "deploy-1-clone",
+			wantRenamed:   true,
+		},
+		{
+			name:          "namespaces are never renamed",
+			mapping:       &velerov1api.RestoreNameMapping{IncludedResources: []string{"*"}, Suffix: "-clone"},
+			groupResource: kuberesource.Namespaces,
+			itemName:      "ns-1",
+			wantName:      "ns-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NameMapping(tc.mapping).Result()
+			name, renamed := MapName(restore, tc.groupResource, tc.namespace, tc.itemName)
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantRenamed, renamed)
+		})
+	}
+}
+
+func TestMapLabels(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+		NameMapping(&velerov1api.RestoreNameMapping{Suffix: "-clone", LabelKeys: []string{"app"}}).
+		Result()
+
+	labels := map[string]string{"app": "web", "tier": "frontend"}
+	assert.True(t, MapLabels(restore, labels))
+	assert.Equal(t, map[string]string{"app": "web-clone", "tier": "frontend"}, labels)
+
+	labels = map[string]string{"tier": "frontend"}
+	assert.False(t, MapLabels(restore, labels))
+	assert.False(t, MapLabels(builder.ForRestore(velerov1api.DefaultNamespace, "restore-2").Result(), map[string]string{"app": "web"}))
+}
+
+func TestValidateNameMapping(t *testing.T) {
+	require.Empty(t, ValidateNameMapping(nil))
+	require.Empty(t, ValidateNameMapping(&velerov1api.RestoreNameMapping{Suffix: "-clone"}))
+
+	errs := ValidateNameMapping(&velerov1api.RestoreNameMapping{IncludedResources: []string{"namespaces"}})
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "name mapping must have names, a prefix or a suffix")
+	assert.EqualError(t, errs[1], "namespaces can't be renamed by the name mapping, use the namespace mapping instead")
+
+	errs = ValidateNameMapping(&velerov1api.RestoreNameMapping{Names: map[string]string{"pod-1": ""}})
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], `name mapping of "pod-1" has no target`)
+}
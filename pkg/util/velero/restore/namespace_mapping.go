@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// compiled regular expressions of the namespace mapping rules, keyed by pattern
+var namespaceMappingRegexps sync.Map
+
+// HasNamespaceMapping returns whether the restore remaps any namespaces.
+func HasNamespaceMapping(restore *api.Restore) bool {
+	return len(restore.Spec.NamespaceMapping) > 0 || len(restore.Spec.NamespaceMappingRules) > 0
+}
+
+// MapNamespace returns the namespace the items of the source namespace are restored into, and
+// whether the namespace is remapped. The exact NamespaceMapping takes precedence over the
+// NamespaceMappingRules, which are evaluated in order.
+func MapNamespace(restore *api.Restore, namespace string) (string, bool) {
+	if namespace == "" {
+		return namespace, false
+	}
+	if target, ok := restore.Spec.NamespaceMapping[namespace]; ok {
+		return target, true
+	}
+	for _, rule := range restore.Spec.NamespaceMappingRules {
+		if target, ok := applyNamespaceMappingRule(rule, namespace); ok {
+			return target, true
+		}
+	}
+	return namespace, false
+}
+
+// ValidateNamespaceMappingRules returns the errors of the invalid namespace mapping rules.
+func ValidateNamespaceMappingRules(rules []api.NamespaceMappingRule) []error {
+	var errs []error
+	for _, rule := range rules {
+		if rule.Target == "" {
+			errs = append(errs, errors.Errorf("namespace mapping rule %q has no target", rule.Source))
+		}
+		if isRegexpNamespacePattern(rule.Source) {
+			if _, err := namespaceMappingRegexp(rule.Source); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid namespace mapping rule %q", rule.Source))
+			}
+			continue
+		}
+		if strings.Count(rule.Source, "*") != 1 {
+			errs = append(errs, errors.Errorf("invalid namespace mapping rule %q: the pattern must contain a single \"*\" wildcard or be a regular expression starting with \"^\"", rule.Source))
+		}
+	}
+	return errs
+}
+
+func applyNamespaceMappingRule(rule api.NamespaceMappingRule, namespace string) (string, bool) {
+	if isRegexpNamespacePattern(rule.Source) {
+		re, err := namespaceMappingRegexp(rule.Source)
+		if err != nil {
+			return "", false
+		}
+		match := re.FindStringSubmatchIndex(namespace)
+		if match == nil {
+			return "", false
+		}
+		return string(re.ExpandString(nil, rule.Target, namespace, match)), true
+	}
+
+	prefix, suffix, found := strings.Cut(rule.Source, "*")
+	if !found || strings.Contains(suffix, "*") || len(namespace) < len(prefix)+len(suffix) ||
+		!strings.HasPrefix(namespace, prefix) || !strings.HasSuffix(namespace, suffix) {
+		return "", false
+	}
+	return strings.ReplaceAll(rule.Target, "*", namespace[len(prefix):len(namespace)-len(suffix)]), true
+}
+
+func isRegexpNamespacePattern(pattern string) bool {
+	return strings.HasPrefix(pattern, "^")
+}
+
+// namespaceMappingRegexp compiles the regular expression of a namespace mapping rule, anchored
+// so that it matches the whole namespace name.
+func namespaceMappingRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := namespaceMappingRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	namespaceMappingRegexps.Store(pattern, re)
+	return re, nil
+}
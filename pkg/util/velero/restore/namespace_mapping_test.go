@@ -0,0 +1,90 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestMapNamespace(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+		NamespaceMappings("team-a", "team-a-exact").
+		NamespaceMappingRules(
+			velerov1api.NamespaceMappingRule{Source: "team-*", Target: "team-*-dr"},
+			velerov1api.NamespaceMappingRule{Source: "*-prod", Target: "*-staging"},
+			velerov1api.NamespaceMappingRule{Source: "^app-([a-z]+)-([0-9]+)$", Target: "${2}-$1"},
+			velerov1api.NamespaceMappingRule{Source: "^(.*)$", Target: "$1-restored"},
+		).
+		Result()
+
+	tests := []struct {
+		namespace  string
+		wantTarget string
+		wantMapped bool
+	}{
+		{namespace: "team-a", wantTarget: "team-a-exact", wantMapped: true},
+		{namespace: "team-b", wantTarget: "team-b-dr", wantMapped: true},
+		{namespace: "web-prod", wantTarget: "web-staging", wantMapped: true},
+		{namespace: "app-web-1", wantTarget: "1-web", wantMapped: true},
+		{namespace: "other", wantTarget: "other-restored", wantMapped: true},
+		{namespace: "", wantTarget: "", wantMapped: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.namespace, func(t *testing.T) {
+			target, mapped := MapNamespace(restore, tc.namespace)
+			assert.Equal(t, tc.wantTarget, target)
+			assert.Equal(t, tc.wantMapped, mapped)
+		})
+	}
+
+	target, mapped := MapNamespace(builder.ForRestore(velerov1api.DefaultNamespace, "restore-2").Result(), "ns-1")
+	assert.Equal(t, "ns-1", target)
+	assert.False(t, mapped)
+}
+
+func TestHasNamespaceMapping(t *testing.T) {
+	assert.False(t, HasNamespaceMapping(builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()))
+	assert.True(t, HasNamespaceMapping(builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NamespaceMappings("ns-1", "ns-2").Result()))
+	assert.True(t, HasNamespaceMapping(builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").
+		NamespaceMappingRules(velerov1api.NamespaceMappingRule{Source: "ns-*", Target: "*-dr"}).Result()))
+}
+
+func TestValidateNamespaceMappingRules(t *testing.T) {
+	require.Empty(t, ValidateNamespaceMappingRules([]velerov1api.NamespaceMappingRule{
+		{Source: "team-*", Target: "team-*-dr"},
+		{Source: "^(.*)$", Target: "$1-restored"},
+	}))
+
+	errs := ValidateNamespaceMappingRules([]velerov1api.NamespaceMappingRule{
+		{Source: "team", Target: "team-dr"},
+		{Source: "*-team-*", Target: "dr"},
+		{Source: "^(.*$", Target: "dr"},
+		{Source: "team-*"},
+	})
+	require.Len(t, errs, 4)
+	assert.EqualError(t, errs[0], `invalid namespace mapping rule "team": the pattern must contain a single "*" wildcard or be a regular expression starting with "^"`)
+	assert.EqualError(t, errs[1], `invalid namespace mapping rule "*-team-*": the pattern must contain a single "*" wildcard or be a regular expression starting with "^"`)
+	assert.Contains(t, errs[2].Error(), `invalid namespace mapping rule "^(.*$"`)
+	assert.EqualError(t, errs[3], `namespace mapping rule "team-*" has no target`)
+}
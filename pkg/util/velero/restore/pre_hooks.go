@@ -0,0 +1,93 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	batchv1api "k8s.io/api/batch/v1"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PreHookScope returns the scope of the pre hook spec, defaulting to the Restore scope.
+func PreHookScope(spec api.RestorePreHookSpec) api.RestorePreHookScope {
+	if spec.Scope == "" {
+		return api.RestorePreHookScopeRestore
+	}
+	return spec.Scope
+}
+
+// DecodePreHookJob decodes the manifest of a Job pre hook.
+func DecodePreHookJob(hook *api.JobRestorePreHook) (*batchv1api.Job, error) {
+	job := new(batchv1api.Job)
+	if err := json.Unmarshal(hook.Manifest.Raw, job); err != nil {
+		return nil, errors.Wrap(err, "error decoding the manifest of the Job")
+	}
+	if job.Kind != "" && job.Kind != "Job" {
+		return nil, errors.Errorf("the manifest is a %s, not a Job", job.Kind)
+	}
+	return job, nil
+}
+
+// ValidatePreHooks returns the errors of the invalid pre hook specs.
+func ValidatePreHooks(specs []api.RestorePreHookSpec) []error {
+	var errs []error
+	for _, spec := range specs {
+		scope := PreHookScope(spec)
+		if scope != api.RestorePreHookScopeRestore && scope != api.RestorePreHookScopeNamespace {
+			errs = append(errs, errors.Errorf("pre hook %q has an invalid scope %s", spec.Name, scope))
+			continue
+		}
+		if scope == api.RestorePreHookScopeRestore && (len(spec.IncludedNamespaces) > 0 || len(spec.ExcludedNamespaces) > 0) {
+			errs = append(errs, errors.Errorf("pre hook %q selects namespaces, which requires the Namespace scope", spec.Name))
+		}
+		if len(spec.Hooks) == 0 {
+			errs = append(errs, errors.Errorf("pre hook %q has no hooks", spec.Name))
+		}
+
+		for i, hook := range spec.Hooks {
+			switch {
+			case hook.Exec != nil && hook.Job != nil:
+				errs = append(errs, errors.Errorf("hook %d of pre hook %q must be either an exec or a job", i, spec.Name))
+			case hook.Exec != nil:
+				if hook.Exec.Pod == "" && hook.Exec.PodSelector == nil {
+					errs = append(errs, errors.Errorf("exec hook %d of pre hook %q must have a pod or a pod selector", i, spec.Name))
+				}
+				if len(hook.Exec.Command) == 0 {
+					errs = append(errs, errors.Errorf("exec hook %d of pre hook %q has no command", i, spec.Name))
+				}
+				if scope == api.RestorePreHookScopeRestore && hook.Exec.Namespace == "" {
+					errs = append(errs, errors.Errorf("exec hook %d of pre hook %q must have a namespace with the Restore scope", i, spec.Name))
+				}
+			case hook.Job != nil:
+				job, err := DecodePreHookJob(hook.Job)
+				if err != nil {
+					errs = append(errs, errors.Wrapf(err, "job hook %d of pre hook %q is invalid", i, spec.Name))
+					continue
+				}
+				if scope == api.RestorePreHookScopeRestore && hook.Job.Namespace == "" && job.Namespace == "" {
+					errs = append(errs, errors.Errorf("job hook %d of pre hook %q must have a namespace with the Restore scope", i, spec.Name))
+				}
+			default:
+				errs = append(errs, errors.Errorf("hook %d of pre hook %q must be either an exec or a job", i, spec.Name))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestValidatePreHooks(t *testing.T) {
+	job := func(manifest string) *velerov1api.JobRestorePreHook {
+		return &velerov1api.JobRestorePreHook{Manifest: runtime.RawExtension{Raw: []byte(manifest)}}
+	}
+
+	require.Empty(t, ValidatePreHooks([]velerov1api.RestorePreHookSpec{
+		{
+			Name: "maintenance",
+			Hooks: []velerov1api.RestorePreHook{
+				{Exec: &velerov1api.ExecRestorePreHook{Namespace: "ops", Pod: "ops-0", Command: []string{"maintenance", "on"}}},
+				{Job: job(`{"apiVersion":"batch/v1","kind":"Job","metadata":{"namespace":"ops"}}`)},
+			},
+		},
+		{
+			Name:               "scale-down",
+			Scope:              velerov1api.RestorePreHookScopeNamespace,
+			IncludedNamespaces: []string{"ns-1"},
+			Hooks: []velerov1api.RestorePreHook{
+				{Exec: &velerov1api.ExecRestorePreHook{PodSelector: &metav1.LabelSelector{}, Command: []string{"true"}}},
+				{Job: job(`{"metadata":{"generateName":"scale-down-"}}`)},
+			},
+		},
+	}))
+
+	errs := ValidatePreHooks([]velerov1api.RestorePreHookSpec{
+		{Name: "scope", Scope: "Cluster", Hooks: []velerov1api.RestorePreHook{{}}},
+		{Name: "namespaces", IncludedNamespaces: []string{"ns-1"}},
+		{
+			Name: "hooks",
+			Hooks: []velerov1api.RestorePreHook{
+				{},
+				{Exec: &velerov1api.ExecRestorePreHook{Command: []string{"true"}}},
+				{Job: job(`{"kind":"Pod"}`)},
+				{Job: job(`{}`)},
+			},
+		},
+	})
+	require.Len(t, errs, 8)
+	assert.EqualError(t, errs[0], `pre hook "scope" has an invalid scope Cluster`)
+	assert.EqualError(t, errs[1], `pre hook "namespaces" selects namespaces, which requires the Namespace scope`)
+	assert.EqualError(t, errs[2], `pre hook "namespaces" has no hooks`)
+	assert.EqualError(t, errs[3], `hook 0 of pre hook "hooks" must be either an exec or a job`)
+	assert.EqualError(t, errs[4], `exec hook 1 of pre hook "hooks" must have a pod or a pod selector`)
+	assert.EqualError(t, errs[5], `exec hook 1 of pre hook "hooks" must have a namespace with the Restore scope`)
+	assert.EqualError(t, errs[6], `job hook 2 of pre hook "hooks" is invalid: the manifest is a Pod, not a Job`)
+	assert.EqualError(t, errs[7], `job hook 3 of pre hook "hooks" must have a namespace with the Restore scope`)
+}
@@ -5,7 +5,31 @@ import (
 )
 
 func IsResourcePolicyValid(resourcePolicy string) bool {
-	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) {
+	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) ||
+		resourcePolicy == string(api.PolicyTypeRecreate) {
+		return true
+	}
+	return false
+}
+
+func IsResourceOrderingValid(resourceOrdering string) bool {
+	if resourceOrdering == string(api.RestoreResourceOrderingPriority) || resourceOrdering == string(api.RestoreResourceOrderingDependencyGraph) {
+		return true
+	}
+	return false
+}
+
+func IsPreflightPolicyValid(policy string) bool {
+	switch api.RestorePreflightPolicy(policy) {
+	case api.RestorePreflightPolicyWarn, api.RestorePreflightPolicyEnforce:
+		return true
+	}
+	return false
+}
+
+func IsBackupSelectionPolicyValid(policy string) bool {
+	switch api.BackupSelectionPolicy(policy) {
+	case api.BackupSelectionPolicyCompleted, api.BackupSelectionPolicyPreferCompleted, api.BackupSelectionPolicyAllowPartiallyFailed:
 		return true
 	}
 	return false
@@ -11,5 +11,25 @@ import (
 func TestIsResourcePolicyValid(t *testing.T) {
 	require.True(t, IsResourcePolicyValid(string(velerov1api.PolicyTypeNone)))
 	require.True(t, IsResourcePolicyValid(string(velerov1api.PolicyTypeUpdate)))
+	require.True(t, IsResourcePolicyValid(string(velerov1api.PolicyTypeRecreate)))
 	require.False(t, IsResourcePolicyValid(""))
 }
+
+func TestIsResourceOrderingValid(t *testing.T) {
+	require.True(t, IsResourceOrderingValid(string(velerov1api.RestoreResourceOrderingPriority)))
+	require.True(t, IsResourceOrderingValid(string(velerov1api.RestoreResourceOrderingDependencyGraph)))
+	require.False(t, IsResourceOrderingValid("Alphabetical"))
+}
+
+func TestIsBackupSelectionPolicyValid(t *testing.T) {
+	require.True(t, IsBackupSelectionPolicyValid(string(velerov1api.BackupSelectionPolicyCompleted)))
+	require.True(t, IsBackupSelectionPolicyValid(string(velerov1api.BackupSelectionPolicyPreferCompleted)))
+	require.True(t, IsBackupSelectionPolicyValid(string(velerov1api.BackupSelectionPolicyAllowPartiallyFailed)))
+	require.False(t, IsBackupSelectionPolicyValid("Latest"))
+}
+
+func TestIsPreflightPolicyValid(t *testing.T) {
+	require.True(t, IsPreflightPolicyValid(string(velerov1api.RestorePreflightPolicyWarn)))
+	require.True(t, IsPreflightPolicyValid(string(velerov1api.RestorePreflightPolicyEnforce)))
+	require.False(t, IsPreflightPolicyValid("Skip"))
+}